@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+import (
+	"slices"
+)
+
+func (x *CodeAction) appendLSP(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	var err error
+	_ = err
+	dst = append(dst, '{')
+	first := true
+	_ = first
+	dst = appendObjectName(dst, &first, `title`)
+	dst = appendJSONString(dst, string(x.Title))
+	if x.Kind != nil {
+		dst = appendObjectName(dst, &first, `kind`)
+		if x.Kind == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendJSONString(dst, string(*x.Kind))
+		}
+	}
+	if len(x.Diagnostics) > 0 {
+		dst = appendObjectName(dst, &first, `diagnostics`)
+		if dst, err = appendSliceDiagnosticJSON(dst, x.Diagnostics); err != nil {
+			return nil, err
+		}
+	}
+	if x.IsPreferred != nil {
+		dst = appendObjectName(dst, &first, `isPreferred`)
+		if x.IsPreferred == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.IsPreferred))
+		}
+	}
+	if !isZeroGeneratedCodeActionDisabled(x.Disabled) {
+		dst = appendObjectName(dst, &first, `disabled`)
+		if dst, err = x.Disabled.appendLSP(dst); err != nil {
+			return nil, err
+		}
+	}
+	if x.Edit != nil {
+		dst = appendObjectName(dst, &first, `edit`)
+		if x.Edit == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			if dst, err = x.Edit.appendLSP(dst); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !isZeroCommand(x.Command) {
+		dst = appendObjectName(dst, &first, `command`)
+		if dst, err = x.Command.appendLSP(dst); err != nil {
+			return nil, err
+		}
+	}
+	if len(x.Data) > 0 {
+		dst = appendObjectName(dst, &first, `data`)
+		if dst, err = appendRawJSONValue(dst, x.Data); err != nil {
+			return nil, err
+		}
+	}
+	if len(x.Tags) > 0 {
+		dst = appendObjectName(dst, &first, `tags`)
+		dst = appendUint32SliceJSON(dst, x.Tags)
+	}
+	return append(dst, '}'), nil
+}
+
+// appendLSPJSON implements appendMarshaler with a pre-sized buffer.
+func (x *CodeAction) appendLSPJSON(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	dst = slices.Grow(dst, 1131)
+	return x.appendLSP(dst)
+}
+
+func (x *CodeActionClientCapabilities) appendLSP(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	var err error
+	_ = err
+	dst = append(dst, '{')
+	first := true
+	_ = first
+	if x.DynamicRegistration != nil {
+		dst = appendObjectName(dst, &first, `dynamicRegistration`)
+		if x.DynamicRegistration == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.DynamicRegistration))
+		}
+	}
+	if !isZeroGeneratedClientCodeActionLiteralOptions(x.CodeActionLiteralSupport) {
+		dst = appendObjectName(dst, &first, `codeActionLiteralSupport`)
+		if dst, err = x.CodeActionLiteralSupport.appendLSP(dst); err != nil {
+			return nil, err
+		}
+	}
+	if x.IsPreferredSupport != nil {
+		dst = appendObjectName(dst, &first, `isPreferredSupport`)
+		if x.IsPreferredSupport == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.IsPreferredSupport))
+		}
+	}
+	if x.DisabledSupport != nil {
+		dst = appendObjectName(dst, &first, `disabledSupport`)
+		if x.DisabledSupport == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.DisabledSupport))
+		}
+	}
+	if x.DataSupport != nil {
+		dst = appendObjectName(dst, &first, `dataSupport`)
+		if x.DataSupport == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.DataSupport))
+		}
+	}
+	if !isZeroGeneratedClientCodeActionResolveOptions(x.ResolveSupport) {
+		dst = appendObjectName(dst, &first, `resolveSupport`)
+		if dst, err = x.ResolveSupport.appendLSP(dst); err != nil {
+			return nil, err
+		}
+	}
+	if x.HonorsChangeAnnotations != nil {
+		dst = appendObjectName(dst, &first, `honorsChangeAnnotations`)
+		if x.HonorsChangeAnnotations == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.HonorsChangeAnnotations))
+		}
+	}
+	if x.DocumentationSupport != nil {
+		dst = appendObjectName(dst, &first, `documentationSupport`)
+		if x.DocumentationSupport == nil {
+			dst = append(dst, nullLiteral...)
+		} else {
+			dst = appendBoolJSON(dst, bool(*x.DocumentationSupport))
+		}
+	}
+	if !isZeroGeneratedCodeActionTagOptions(x.TagSupport) {
+		dst = appendObjectName(dst, &first, `tagSupport`)
+		if dst, err = x.TagSupport.appendLSP(dst); err != nil {
+			return nil, err
+		}
+	}
+	return append(dst, '}'), nil
+}
+
+// appendLSPJSON implements appendMarshaler with a pre-sized buffer.
+func (x *CodeActionClientCapabilities) appendLSPJSON(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	dst = slices.Grow(dst, 385)
+	return x.appendLSP(dst)
+}
+
+func (x *CodeActionTagOptions) appendLSP(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	var err error
+	_ = err
+	dst = append(dst, '{')
+	first := true
+	_ = first
+	dst = appendObjectName(dst, &first, `valueSet`)
+	dst = appendUint32SliceJSON(dst, x.ValueSet)
+	return append(dst, '}'), nil
+}
+
+// appendLSPJSON implements appendMarshaler with a pre-sized buffer.
+func (x *CodeActionTagOptions) appendLSPJSON(dst []byte) ([]byte, error) {
+	if x == nil {
+		return append(dst, nullLiteral...), nil
+	}
+	dst = slices.Grow(dst, 45)
+	return x.appendLSP(dst)
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+package protocol
+
+import (
+	"context"
+	"go.lsp.dev/jsonrpc2"
+)
+
+// Client is the LSP client interface: the set of requests and notifications a
+// language client handles. Its method set is the authoritative shape implemented
+// by [UnimplementedClient].
+type Client interface {
+	RegisterCapability(ctx context.Context, params *RegistrationParams) error
+	UnregisterCapability(ctx context.Context, params *UnregistrationParams) error
+	LogTrace(ctx context.Context, params *LogTraceParams) error
+	CodeLensRefresh(ctx context.Context) error
+	FoldingRangeRefresh(ctx context.Context) error
+	SemanticTokensRefresh(ctx context.Context) error
+	InlineValueRefresh(ctx context.Context) error
+	InlayHintRefresh(ctx context.Context) error
+	DiagnosticRefresh(ctx context.Context) error
+	PublishDiagnostics(ctx context.Context, params *PublishDiagnosticsParams) error
+	Configuration(ctx context.Context, params *ConfigurationParams) ([]LSPAny, error)
+	WorkspaceFolders(ctx context.Context) ([]WorkspaceFolder, error)
+	ApplyEdit(ctx context.Context, params *ApplyWorkspaceEditParams) (*ApplyWorkspaceEditResult, error)
+	TextDocumentContentRefresh(ctx context.Context, params *TextDocumentContentRefreshParams) error
+	ShowMessage(ctx context.Context, params *ShowMessageParams) error
+	ShowMessageRequest(ctx context.Context, params *ShowMessageRequestParams) (*MessageActionItem, error)
+	LogMessage(ctx context.Context, params *LogMessageParams) error
+	ShowDocument(ctx context.Context, params *ShowDocumentParams) (*ShowDocumentResult, error)
+	WorkDoneProgressCreate(ctx context.Context, params *WorkDoneProgressCreateParams) error
+	Telemetry(ctx context.Context, params LSPAny) error
+	Progress(ctx context.Context, params *ProgressParams) error
+}
+
+// client is the [Client] dispatcher: it issues server->client requests and
+// notifications over a jsonrpc2 connection.
+type client struct {
+	jsonrpc2.Conn
+}
+
+// compile-time assertion that *client satisfies Client.
+var _ Client = (*client)(nil)
+
+func (c *client) RegisterCapability(ctx context.Context, params *RegistrationParams) error {
+	return Call(ctx, c.Conn, MethodClientRegisterCapability, params, nil)
+}
+
+func (c *client) UnregisterCapability(ctx context.Context, params *UnregistrationParams) error {
+	return Call(ctx, c.Conn, MethodClientUnregisterCapability, params, nil)
+}
+
+func (c *client) LogTrace(ctx context.Context, params *LogTraceParams) error {
+	return c.Conn.Notify(ctx, MethodLogTrace, params)
+}
+
+func (c *client) CodeLensRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceCodeLensRefresh, nil, nil)
+}
+
+func (c *client) FoldingRangeRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceFoldingRangeRefresh, nil, nil)
+}
+
+func (c *client) SemanticTokensRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceSemanticTokensRefresh, nil, nil)
+}
+
+func (c *client) InlineValueRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceInlineValueRefresh, nil, nil)
+}
+
+func (c *client) InlayHintRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceInlayHintRefresh, nil, nil)
+}
+
+func (c *client) DiagnosticRefresh(ctx context.Context) error {
+	return Call(ctx, c.Conn, MethodWorkspaceDiagnosticRefresh, nil, nil)
+}
+
+func (c *client) PublishDiagnostics(ctx context.Context, params *PublishDiagnosticsParams) error {
+	return c.Conn.Notify(ctx, MethodTextDocumentPublishDiagnostics, params)
+}
+
+func (c *client) Configuration(ctx context.Context, params *ConfigurationParams) ([]LSPAny, error) {
+	var result []LSPAny
+	if err := Call(ctx, c.Conn, MethodWorkspaceConfiguration, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *client) WorkspaceFolders(ctx context.Context) ([]WorkspaceFolder, error) {
+	var result []WorkspaceFolder
+	if err := Call(ctx, c.Conn, MethodWorkspaceWorkspaceFolders, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *client) ApplyEdit(ctx context.Context, params *ApplyWorkspaceEditParams) (*ApplyWorkspaceEditResult, error) {
+	var result *ApplyWorkspaceEditResult
+	if err := Call(ctx, c.Conn, MethodWorkspaceApplyEdit, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *client) TextDocumentContentRefresh(ctx context.Context, params *TextDocumentContentRefreshParams) error {
+	return Call(ctx, c.Conn, MethodWorkspaceTextDocumentContentRefresh, params, nil)
+}
+
+func (c *client) ShowMessage(ctx context.Context, params *ShowMessageParams) error {
+	return c.Conn.Notify(ctx, MethodWindowShowMessage, params)
+}
+
+func (c *client) ShowMessageRequest(ctx context.Context, params *ShowMessageRequestParams) (*MessageActionItem, error) {
+	var result *MessageActionItem
+	if err := Call(ctx, c.Conn, MethodWindowShowMessageRequest, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *client) LogMessage(ctx context.Context, params *LogMessageParams) error {
+	return c.Conn.Notify(ctx, MethodWindowLogMessage, params)
+}
+
+func (c *client) ShowDocument(ctx context.Context, params *ShowDocumentParams) (*ShowDocumentResult, error) {
+	var result *ShowDocumentResult
+	if err := Call(ctx, c.Conn, MethodWindowShowDocument, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *client) WorkDoneProgressCreate(ctx context.Context, params *WorkDoneProgressCreateParams) error {
+	return Call(ctx, c.Conn, MethodWindowWorkDoneProgressCreate, params, nil)
+}
+
+func (c *client) Telemetry(ctx context.Context, params LSPAny) error {
+	return c.Conn.Notify(ctx, MethodTelemetryEvent, params)
+}
+
+func (c *client) Progress(ctx context.Context, params *ProgressParams) error {
+	return c.Conn.Notify(ctx, MethodProgress, params)
+}
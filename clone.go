@@ -3,14 +3,30 @@
 
 package protocol
 
-// Clone returns a JSON-round-trip copy of a protocol value.
+// Clone returns a deep copy of a protocol value that shares no memory with it.
 //
 // Clone is primarily useful after decoding a small value from a much larger
 // message: generated byte decoders may alias unescaped strings into an owned
 // per-message copy, so retaining those strings can keep the full message copy
-// live. A Clone result is detached from the original decode buffer because it
-// marshals the value to fresh JSON and decodes that JSON into a new value.
+// live. A Clone result is detached from the original decode buffer. To detach
+// a value in place instead, decode it with [OwnershipDetach] or call [Detach]
+// on it.
+//
+// Generated structures (by value or pointer), named slices and unions are
+// copied by their generated DeepCopy methods without touching JSON; the error
+// is then always nil. Any other value is copied by a JSON round trip, which
+// fails for values that do not marshal.
 func Clone[T any](v T) (T, error) {
+	if c, ok := any(&v).(interface{ DeepCopy() *T }); ok {
+		return *c.DeepCopy(), nil
+	}
+	if c, ok := any(v).(interface{ DeepCopy() T }); ok {
+		return c.DeepCopy(), nil
+	}
+	if deepCopyUnion(&v) {
+		return v, nil
+	}
+
 	data, err := Marshal(v)
 	if err != nil {
 		var zero T
@@ -0,0 +1,118 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CodeActionKindContains reports whether kind is parent or one of its
+// sub-kinds in the dot-separated hierarchy: "refactor" contains
+// "refactor.extract.function" but not "refactorx". The empty kind contains
+// every kind.
+func CodeActionKindContains(parent, kind CodeActionKind) bool {
+	if parent == CodeActionKindEmpty || parent == kind {
+		return true
+	}
+	return strings.HasPrefix(string(kind), string(parent)) && kind[len(parent)] == '.'
+}
+
+// CodeActionKindMatches reports whether kind is contained in one of only,
+// as [CodeActionContext.Only] selects actions. An empty only matches every
+// kind.
+func CodeActionKindMatches(only []CodeActionKind, kind CodeActionKind) bool {
+	if len(only) == 0 {
+		return true
+	}
+	for _, parent := range only {
+		if CodeActionKindContains(parent, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterCodeActions returns the actions a client asking for only would
+// show, in order, reusing the backing array of actions. As clients do, it
+// drops bare commands and code actions without a kind when only is set. An
+// empty only keeps every action.
+func FilterCodeActions(actions []CommandOrCodeAction, only []CodeActionKind) []CommandOrCodeAction {
+	if len(only) == 0 {
+		return actions
+	}
+	kept := actions[:0]
+	for _, action := range actions {
+		if action, ok := action.(*CodeAction); ok && action.Kind != nil && CodeActionKindMatches(only, *action.Kind) {
+			kept = append(kept, action)
+		}
+	}
+	clear(actions[len(kept):])
+	return kept
+}
+
+// ValidateCodeActionKinds reports an error for the first code action whose
+// kind is not contained in one of advertised, the
+// [CodeActionOptions.CodeActionKinds] the server registered. Bare commands
+// and code actions without a kind are always valid, as is every action when
+// the server advertised no kinds.
+func ValidateCodeActionKinds(actions []CommandOrCodeAction, advertised []CodeActionKind) error {
+	if len(advertised) == 0 {
+		return nil
+	}
+	for i, action := range actions {
+		action, ok := action.(*CodeAction)
+		if !ok || action.Kind == nil || CodeActionKindMatches(advertised, *action.Kind) {
+			continue
+		}
+		return fmt.Errorf("protocol: code action %d %q has kind %q, which the server does not advertise", i, action.Title, *action.Kind)
+	}
+	return nil
+}
+
+// CodeActionDocumentation returns the entry of docs for the most specific
+// kind containing kind, as a client picks the documentation it shows for
+// requested or returned actions of kind.
+func CodeActionDocumentation(docs []CodeActionKindDocumentation, kind CodeActionKind) (*CodeActionKindDocumentation, bool) {
+	var best *CodeActionKindDocumentation
+	for i := range docs {
+		doc := &docs[i]
+		if CodeActionKindContains(doc.Kind, kind) && (best == nil || len(doc.Kind) > len(best.Kind)) {
+			best = doc
+		}
+	}
+	return best, best != nil
+}
+
+// CodeActionLiteralSupported reports whether caps declares
+// codeActionLiteralSupport, so the client accepts [CodeAction] values in a
+// textDocument/codeAction result rather than only [Command] values.
+func CodeActionLiteralSupported(caps *CodeActionClientCapabilities) bool {
+	return caps != nil && caps.CodeActionLiteralSupport.CodeActionKind.ValueSet != nil
+}
+
+// DegradeCodeActions rewrites actions, in place, for a client with
+// capabilities caps. For a client without codeActionLiteralSupport, each
+// [CodeAction] is replaced by its command; one that is disabled or has no
+// command is dropped, since the client could not apply its edit. A server
+// that needs edits applied for such clients can give the actions a command
+// that sends the edit with workspace/applyEdit. For other clients actions
+// are returned unchanged.
+func DegradeCodeActions(actions []CommandOrCodeAction, caps *CodeActionClientCapabilities) []CommandOrCodeAction {
+	if CodeActionLiteralSupported(caps) {
+		return actions
+	}
+	kept := actions[:0]
+	for _, action := range actions {
+		if ca, ok := action.(*CodeAction); ok {
+			if ca.Command.Command == "" || ca.Disabled.Reason != "" {
+				continue
+			}
+			action = &ca.Command
+		}
+		kept = append(kept, action)
+	}
+	clear(actions[len(kept):])
+	return kept
+}
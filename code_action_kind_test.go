@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestCodeActionKindContains(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		parent, kind CodeActionKind
+		want         bool
+	}{
+		"success: same kind":          {parent: CodeActionKindRefactor, kind: CodeActionKindRefactor, want: true},
+		"success: sub-kind":           {parent: CodeActionKindRefactor, kind: "refactor.extract.function", want: true},
+		"success: empty contains all": {parent: CodeActionKindEmpty, kind: CodeActionKindQuickFix, want: true},
+		"error: sibling prefix":       {parent: CodeActionKindRefactor, kind: "refactorx", want: false},
+		"error: parent of parent":     {parent: CodeActionKindRefactorExtract, kind: CodeActionKindRefactor, want: false},
+		"error: other hierarchy":      {parent: CodeActionKindSource, kind: CodeActionKindQuickFix, want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := CodeActionKindContains(tt.parent, tt.kind); got != tt.want {
+				t.Errorf("CodeActionKindContains(%q, %q) = %t, want %t", tt.parent, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+// testCodeActions returns a bare command, a kindless code action and code
+// actions of the given kinds.
+func testCodeActions(kinds ...CodeActionKind) []CommandOrCodeAction {
+	actions := []CommandOrCodeAction{
+		&Command{Title: "Run", Command: "myls.run"},
+		&CodeAction{Title: "kindless", Command: Command{Title: "kindless", Command: "myls.kindless"}},
+	}
+	for _, kind := range kinds {
+		actions = append(actions, &CodeAction{Title: string(kind), Kind: &kind, Command: Command{Title: string(kind), Command: "myls." + string(kind)}})
+	}
+	return actions
+}
+
+// titles returns the title of each action.
+func titles(actions []CommandOrCodeAction) []string {
+	var got []string
+	for _, action := range actions {
+		switch action := action.(type) {
+		case *Command:
+			got = append(got, action.Title)
+		case *CodeAction:
+			got = append(got, action.Title)
+		}
+	}
+	return got
+}
+
+func TestFilterCodeActions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		only []CodeActionKind
+		want []string
+	}{
+		"success: no filter keeps all": {
+			want: []string{"Run", "kindless", "quickfix", "refactor.extract.function", "source.organizeImports"},
+		},
+		"success: parent kind": {
+			only: []CodeActionKind{CodeActionKindRefactor},
+			want: []string{"refactor.extract.function"},
+		},
+		"success: several kinds": {
+			only: []CodeActionKind{CodeActionKindQuickFix, CodeActionKindSourceOrganizeImports},
+			want: []string{"quickfix", "source.organizeImports"},
+		},
+		"success: more specific than any action": {
+			only: []CodeActionKind{CodeActionKindRefactorInline},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actions := testCodeActions(CodeActionKindQuickFix, "refactor.extract.function", CodeActionKindSourceOrganizeImports)
+			if diff := gocmp.Diff(tt.want, titles(FilterCodeActions(actions, tt.only))); diff != "" {
+				t.Errorf("FilterCodeActions(%v) mismatch (-want +got):\n%s", tt.only, diff)
+			}
+		})
+	}
+}
+
+func TestValidateCodeActionKinds(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		advertised []CodeActionKind
+		wantErr    bool
+	}{
+		"success: nothing advertised": {},
+		"success: covered by parents": {advertised: []CodeActionKind{CodeActionKindQuickFix, CodeActionKindRefactor}},
+		"error: kind not advertised":  {advertised: []CodeActionKind{CodeActionKindQuickFix, CodeActionKindRefactorInline}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateCodeActionKinds(testCodeActions(CodeActionKindQuickFix, CodeActionKindRefactorExtract), tt.advertised)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCodeActionKinds(%v) error = %v, wantErr %t", tt.advertised, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCodeActionDocumentation(t *testing.T) {
+	t.Parallel()
+
+	docs := []CodeActionKindDocumentation{
+		{Kind: CodeActionKindRefactor, Command: Command{Title: "Refactoring", Command: "myls.docs"}},
+		{Kind: CodeActionKindRefactorExtract, Command: Command{Title: "Extracting", Command: "myls.docs"}},
+	}
+	tests := map[string]struct {
+		kind CodeActionKind
+		want string
+	}{
+		"success: most specific":  {kind: "refactor.extract.function", want: "Extracting"},
+		"success: generic":        {kind: CodeActionKindRefactorInline, want: "Refactoring"},
+		"error: no documentation": {kind: CodeActionKindQuickFix},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ""
+			if doc, ok := CodeActionDocumentation(docs, tt.kind); ok {
+				got = doc.Command.Title
+			}
+			if got != tt.want {
+				t.Errorf("CodeActionDocumentation(%q) = %q, want %q", tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDegradeCodeActions(t *testing.T) {
+	t.Parallel()
+
+	var literal CodeActionClientCapabilities
+	if err := Unmarshal([]byte(`{"codeActionLiteralSupport":{"codeActionKind":{"valueSet":[]}}}`), &literal); err != nil {
+		t.Fatal(err)
+	}
+	quickfix := CodeActionKindQuickFix
+	newActions := func() []CommandOrCodeAction {
+		return []CommandOrCodeAction{
+			&Command{Title: "Run", Command: "myls.run"},
+			&CodeAction{Title: "Fix", Kind: &quickfix, Command: Command{Title: "Fix it", Command: "myls.fix"}},
+			&CodeAction{Title: "Edit only", Edit: &WorkspaceEdit{}},
+			&CodeAction{Title: "Disabled", Command: Command{Title: "Nope", Command: "myls.nope"}, Disabled: CodeActionDisabled{Reason: "busy"}},
+		}
+	}
+
+	tests := map[string]struct {
+		caps *CodeActionClientCapabilities
+		want []CommandOrCodeAction
+	}{
+		"success: literal support keeps code actions": {
+			caps: &literal,
+			want: newActions(),
+		},
+		"success: commands only": {
+			caps: &CodeActionClientCapabilities{},
+			want: []CommandOrCodeAction{
+				&Command{Title: "Run", Command: "myls.run"},
+				&Command{Title: "Fix it", Command: "myls.fix"},
+			},
+		},
+		"success: no capabilities": {
+			want: []CommandOrCodeAction{
+				&Command{Title: "Run", Command: "myls.run"},
+				&Command{Title: "Fix it", Command: "myls.fix"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, DegradeCodeActions(newActions(), tt.caps)); diff != "" {
+				t.Errorf("DegradeCodeActions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
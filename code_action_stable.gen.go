@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build !lsp_proposed
+
+package protocol
+
+// CodeAction A code action represents a change that can be performed in code, e.g. to fix a problem or
+// to refactor code.
+//
+// A CodeAction must set either `edit` and/or a `command`. If both are supplied, the `edit` is applied first, then the `command` is executed.
+type CodeAction struct {
+	// Title A short, human-readable, title for this code action.
+	Title string `json:"title"`
+
+	// Kind The kind of the code action.
+	//
+	// Used to filter code actions.
+	Kind *CodeActionKind `json:"kind,omitzero"`
+
+	// Diagnostics The diagnostics that this code action resolves.
+	Diagnostics []Diagnostic `json:"diagnostics,omitzero"`
+
+	// IsPreferred Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+	// by keybindings.
+	//
+	// A quick fix should be marked preferred if it properly addresses the underlying error.
+	// A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
+	//
+	// Since: 3.15.0
+	IsPreferred *bool `json:"isPreferred,omitzero"`
+
+	// Disabled Marks that the code action cannot currently be applied.
+	//
+	// Clients should follow the following guidelines regarding disabled code actions:
+	//
+	//   - Disabled code actions are not shown in automatic [lightbulbs](https://code.visualstudio.com/docs/editor/editingevolved#_code-action)
+	//     code action menus.
+	//
+	//   - Disabled actions are shown as faded out in the code action menu when the user requests a more specific type
+	//     of code action, such as refactorings.
+	//
+	//   - If the user has a [keybinding](https://code.visualstudio.com/docs/editor/refactoring#_keybindings-for-code-actions)
+	//     that auto applies a code action and only disabled code actions are returned, the client should show the user an
+	//     error message with `reason` in the editor.
+	//
+	// Since: 3.16.0
+	Disabled CodeActionDisabled `json:"disabled,omitzero"`
+
+	// Edit The workspace edit this code action performs.
+	Edit *WorkspaceEdit `json:"edit,omitzero"`
+
+	// Command A command this code action executes. If a code action
+	// provides an edit and a command, first the edit is
+	// executed and then the command.
+	Command Command `json:"command,omitzero"`
+
+	// Data A data entry field that is preserved on a code action between
+	// a `textDocument/codeAction` and a `codeAction/resolve` request.
+	//
+	// Since: 3.16.0
+	Data LSPAny `json:"data,omitzero"`
+}
+
+// CodeActionClientCapabilities The Client Capabilities of a [CodeActionRequest].
+type CodeActionClientCapabilities struct {
+	// DynamicRegistration Whether code action supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitzero"`
+
+	// CodeActionLiteralSupport The client support code action literals of type `CodeAction` as a valid
+	// response of the `textDocument/codeAction` request. If the property is not
+	// set the request can only return `Command` literals.
+	//
+	// Since: 3.8.0
+	CodeActionLiteralSupport ClientCodeActionLiteralOptions `json:"codeActionLiteralSupport,omitzero"`
+
+	// IsPreferredSupport Whether code action supports the `isPreferred` property.
+	//
+	// Since: 3.15.0
+	IsPreferredSupport *bool `json:"isPreferredSupport,omitzero"`
+
+	// DisabledSupport Whether code action supports the `disabled` property.
+	//
+	// Since: 3.16.0
+	DisabledSupport *bool `json:"disabledSupport,omitzero"`
+
+	// DataSupport Whether code action supports the `data` property which is
+	// preserved between a `textDocument/codeAction` and a
+	// `codeAction/resolve` request.
+	//
+	// Since: 3.16.0
+	DataSupport *bool `json:"dataSupport,omitzero"`
+
+	// ResolveSupport Whether the client supports resolving additional code action
+	// properties via a separate `codeAction/resolve` request.
+	//
+	// Since: 3.16.0
+	ResolveSupport ClientCodeActionResolveOptions `json:"resolveSupport,omitzero"`
+
+	// HonorsChangeAnnotations Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// `CodeAction#edit` property by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// Since: 3.16.0
+	HonorsChangeAnnotations *bool `json:"honorsChangeAnnotations,omitzero"`
+
+	// DocumentationSupport Whether the client supports documentation for a class of
+	// code actions.
+	//
+	// Since: 3.18.0
+	DocumentationSupport *bool `json:"documentationSupport,omitzero"`
+}
@@ -3,7 +3,11 @@
 
 package protocol
 
-import "go.lsp.dev/jsonrpc2"
+import (
+	"fmt"
+
+	"go.lsp.dev/jsonrpc2"
+)
 
 // lspCodec is the [jsonrpc2.Codec] that marshals LSP message payloads (request
 // params and response results) with the generated union-aware [Marshal] and
@@ -12,14 +16,22 @@ import "go.lsp.dev/jsonrpc2"
 // matching the jsonrpc2 JSONCodec contract.
 //
 // It is installed on every connection by [NewServer] and [NewClient] via
-// [jsonrpc2.WithCodec].
-type lspCodec struct{}
+// [jsonrpc2.WithCodec]. A connection pinned to a protocol version with
+// [WithVersion] sets version, and payloads are then encoded with
+// [MarshalVersion] under policy. Otherwise payloads are encoded into a pooled
+// scratch buffer and copied out once at their final size: [jsonrpc2.Codec]
+// has no way to learn when the connection is done with a payload, so the
+// copy handed to it must be its own.
+type lspCodec struct {
+	version string
+	policy  VersionPolicy
+}
 
 // compile-time check that lspCodec satisfies the Codec contract.
 var _ jsonrpc2.Codec = lspCodec{}
 
 // Marshal implements [jsonrpc2.Codec].
-func (lspCodec) Marshal(v any) ([]byte, error) {
+func (c lspCodec) Marshal(v any) ([]byte, error) {
 	switch m := v.(type) {
 	case jsonrpc2.RawMessage:
 		if m == nil {
@@ -32,7 +44,10 @@ func (lspCodec) Marshal(v any) ([]byte, error) {
 		}
 		return *m, nil
 	}
-	return Marshal(v)
+	if c.version != "" {
+		return MarshalVersion(v, c.version, c.policy)
+	}
+	return marshalPooled(v)
 }
 
 // Unmarshal implements [jsonrpc2.Codec].
@@ -50,3 +65,41 @@ func (lspCodec) Unmarshal(data []byte, v any) error {
 	}
 	return Unmarshal(data, v)
 }
+
+// appendPayload appends the encoding of v to dst as Marshal encodes it: raw
+// messages verbatim, versioned values with [MarshalVersion] and everything
+// else with [AppendMarshal].
+func (c lspCodec) appendPayload(dst []byte, v any) ([]byte, error) {
+	switch m := v.(type) {
+	case jsonrpc2.RawMessage:
+		if m == nil {
+			return append(dst, nullLiteral...), nil
+		}
+		return append(dst, m...), nil
+	case *jsonrpc2.RawMessage:
+		if m == nil || *m == nil {
+			return append(dst, nullLiteral...), nil
+		}
+		return append(dst, *m...), nil
+	}
+	if c.version != "" {
+		b, err := MarshalVersion(v, c.version, c.policy)
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, b...), nil
+	}
+	return AppendMarshal(dst, v)
+}
+
+// checkMethod reports an error wrapping [ErrVersionUnsupported] when the
+// codec is pinned to a version that does not define method.
+func (c lspCodec) checkMethod(method string) error {
+	if c.version == "" {
+		return nil
+	}
+	if since := MethodSince(method); !VersionAvailable(since, c.version) {
+		return fmt.Errorf("%w: method %s (since %s) in LSP %s", ErrVersionUnsupported, method, since, c.version)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// Commands routes workspace/executeCommand requests to typed handlers
+// registered with [HandleCommand]. Each command takes its arguments as one
+// Go value of its argument type A, carried as the single element of
+// [Command.Arguments] and [ExecuteCommandParams.Arguments]; a command that
+// needs none uses struct{}.
+//
+// Call [Commands.Execute] from the server's ExecuteCommand method and
+// advertise [Commands.Options] as [ServerCapabilities.ExecuteCommandProvider].
+// The zero value is ready to use, and commands may be registered while the
+// connection is serving.
+type Commands struct {
+	mu       sync.RWMutex
+	handlers map[string]commandHandler
+}
+
+// commandHandler decodes raw arguments and invokes a registered command.
+type commandHandler func(ctx context.Context, args []LSPAny) (LSPAny, error)
+
+// CommandID names a command registered with [HandleCommand] and builds
+// [Command] values that invoke it with arguments of type A.
+type CommandID[A any] struct {
+	name string
+}
+
+// Name returns the command identifier.
+func (id CommandID[A]) Name() string {
+	return id.name
+}
+
+// Command returns a Command titled title that invokes the command with args,
+// encoded as the package encodes them on the wire, for a code lens, code
+// action or other place the protocol embeds a Command.
+func (id CommandID[A]) Command(title string, args A) (Command, error) {
+	raw, err := Marshal(args)
+	if err != nil {
+		return Command{}, fmt.Errorf("protocol: command %s: %w", id.name, err)
+	}
+	return Command{Title: title, Command: id.name, Arguments: []LSPAny{raw}}, nil
+}
+
+// HandleCommand registers fn as the handler of command and returns its
+// [CommandID]. The argument is decoded into a new A; a call without
+// arguments passes the zero A. The result is encoded as the request's
+// result.
+//
+// It panics if command is empty or already has a handler.
+func HandleCommand[A, R any](c *Commands, command string, fn func(ctx context.Context, args *A) (R, error)) CommandID[A] {
+	c.register(command, func(ctx context.Context, raw []LSPAny) (LSPAny, error) {
+		args := new(A)
+		switch len(raw) {
+		case 0:
+		case 1:
+			if err := Unmarshal(raw[0], args); err != nil {
+				return nil, fmt.Errorf("%w: command %s: %w", jsonrpc2.ErrInvalidParams, command, err)
+			}
+		default:
+			return nil, fmt.Errorf("%w: command %s takes one argument, got %d", jsonrpc2.ErrInvalidParams, command, len(raw))
+		}
+
+		result, err := fn(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return Marshal(result)
+	})
+	return CommandID[A]{name: command}
+}
+
+func (c *Commands) register(command string, h commandHandler) {
+	if command == "" {
+		panic("protocol: command name is empty")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.handlers[command]; ok {
+		panic(fmt.Sprintf("protocol: multiple registrations for command %s", command))
+	}
+	if c.handlers == nil {
+		c.handlers = make(map[string]commandHandler)
+	}
+	c.handlers[command] = h
+}
+
+// Names returns the registered commands in sorted order.
+func (c *Commands) Names() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	names := make([]string, 0, len(c.handlers))
+	for name := range c.handlers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Options returns the execute command options listing the registered
+// commands.
+func (c *Commands) Options() ExecuteCommandOptions {
+	return ExecuteCommandOptions{Commands: c.Names()}
+}
+
+// Execute runs the command params names and returns its encoded result. An
+// unknown command or arguments that do not decode into the command's
+// argument type are reported with an error wrapping
+// [jsonrpc2.ErrInvalidParams].
+func (c *Commands) Execute(ctx context.Context, params *ExecuteCommandParams) (LSPAny, error) {
+	c.mu.RLock()
+	h, ok := c.handlers[params.Command]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown command %q", jsonrpc2.ErrInvalidParams, params.Command)
+	}
+	if ctx.Err() != nil {
+		return nil, ErrRequestCancelled
+	}
+
+	return h(ctx, params.Arguments)
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.lsp.dev/jsonrpc2"
+	"go.lsp.dev/uri"
+)
+
+type applyFixArgs struct {
+	URI   uri.URI `json:"uri"`
+	Range Range   `json:"range"`
+	Fix   string  `json:"fix"`
+}
+
+// commandServer answers workspace/executeCommand from a command registry.
+type commandServer struct {
+	UnimplementedServer
+	commands *Commands
+}
+
+func (s *commandServer) ExecuteCommand(ctx context.Context, params *ExecuteCommandParams) (LSPAny, error) {
+	return s.commands.Execute(ctx, params)
+}
+
+func TestCommandsOverConnection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	var commands Commands
+	applyFix := HandleCommand(&commands, "myls.applyFix", func(_ context.Context, args *applyFixArgs) (*WorkspaceEdit, error) {
+		return &WorkspaceEdit{Changes: map[uri.URI][]TextEdit{args.URI: {{Range: args.Range, NewText: args.Fix}}}}, nil
+	})
+	HandleCommand(&commands, "myls.restart", func(context.Context, *struct{}) (any, error) {
+		return nil, nil
+	})
+
+	if diff := gocmp.Diff(ExecuteCommandOptions{Commands: []string{"myls.applyFix", "myls.restart"}}, commands.Options()); diff != "" {
+		t.Errorf("Options() mismatch (-want +got):\n%s", diff)
+	}
+
+	args := applyFixArgs{URI: "file:///a.go", Range: Range{End: Position{Character: 3}}, Fix: "fmt"}
+	cmd, err := applyFix.Command("Apply fix", args)
+	if err != nil {
+		t.Fatalf("Command() error = %v", err)
+	}
+	if cmd.Command != "myls.applyFix" || len(cmd.Arguments) != 1 {
+		t.Fatalf("Command() = %+v, want one argument for myls.applyFix", cmd)
+	}
+
+	a, b := net.Pipe()
+	_, serverConn, _ := NewServer(ctx, &commandServer{commands: &commands}, NewStream(a))
+	defer func() { _ = serverConn.Close() }()
+	_, clientConn, _ := NewClient(ctx, &UnimplementedClient{}, NewStream(b))
+	defer func() { _ = clientConn.Close() }()
+
+	// The client executes the command exactly as a code lens carried it.
+	var edit WorkspaceEdit
+	params := &ExecuteCommandParams{Command: cmd.Command, Arguments: cmd.Arguments}
+	if _, err := clientConn.Call(ctx, MethodWorkspaceExecuteCommand, params, &edit); err != nil {
+		t.Fatalf("Call(applyFix): %v", err)
+	}
+	want := WorkspaceEdit{Changes: map[uri.URI][]TextEdit{args.URI: {{Range: args.Range, NewText: "fmt"}}}}
+	if diff := gocmp.Diff(want, edit); diff != "" {
+		t.Errorf("applyFix result mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := clientConn.Call(ctx, MethodWorkspaceExecuteCommand, &ExecuteCommandParams{Command: "myls.restart"}, nil); err != nil {
+		t.Fatalf("Call(restart): %v", err)
+	}
+
+	errTests := map[string]*ExecuteCommandParams{
+		"error: unknown command":    {Command: "myls.unknown"},
+		"error: mismatched type":    {Command: "myls.applyFix", Arguments: []LSPAny{LSPAny(`{"range":"whole file"}`)}},
+		"error: too many arguments": {Command: "myls.applyFix", Arguments: []LSPAny{LSPAny(`{}`), LSPAny(`{}`)}},
+	}
+	for name, params := range errTests {
+		t.Run(name, func(t *testing.T) {
+			_, err := clientConn.Call(ctx, MethodWorkspaceExecuteCommand, params, nil)
+			if !errors.Is(err, jsonrpc2.ErrInvalidParams) {
+				t.Fatalf("Call(%s) error = %v, want wrapping %v", params.Command, err, jsonrpc2.ErrInvalidParams)
+			}
+		})
+	}
+}
+
+func TestCommandsHandlerError(t *testing.T) {
+	t.Parallel()
+
+	var commands Commands
+	errBusy := errors.New("busy")
+	HandleCommand(&commands, "myls.build", func(context.Context, *struct{}) (any, error) {
+		return nil, errBusy
+	})
+	if _, err := commands.Execute(t.Context(), &ExecuteCommandParams{Command: "myls.build"}); !errors.Is(err, errBusy) {
+		t.Errorf("Execute() error = %v, want %v", err, errBusy)
+	}
+}
+
+func TestCommandsRegisterPanics(t *testing.T) {
+	t.Parallel()
+
+	noop := func(context.Context, *struct{}) (any, error) { return nil, nil }
+	tests := map[string]struct {
+		command string
+		before  func(c *Commands)
+	}{
+		"error: empty command": {command: ""},
+		"error: duplicate registration": {
+			command: "myls.restart",
+			before:  func(c *Commands) { HandleCommand(c, "myls.restart", noop) },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var c Commands
+			if tt.before != nil {
+				tt.before(&c)
+			}
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("HandleCommand(%q) did not panic", tt.command)
+				}
+			}()
+			HandleCommand(&c, tt.command, noop)
+		})
+	}
+}
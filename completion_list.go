@@ -0,0 +1,329 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CompletionListOptions configures [CompletionListOptions.Build], which turns
+// the candidates a server computed into the [CompletionList] it returns.
+type CompletionListOptions struct {
+	// Prefix is the text typed so far of the word being completed. Items
+	// whose filter text does not fuzzy-match it are dropped and the rest are
+	// ranked by match quality. An empty Prefix keeps every item.
+	Prefix string
+
+	// Limit caps the number of items returned; zero means no limit. A list
+	// cut short is marked IsIncomplete so the client asks again as the user
+	// types.
+	Limit int
+
+	// ItemDefaults lists the CompletionList.itemDefaults properties the
+	// client supports, as advertised in
+	// textDocument.completion.completionList.itemDefaults. Values shared by
+	// every returned item move into the list's ItemDefaults for these
+	// properties only: "commitCharacters", "editRange", "insertTextFormat",
+	// "insertTextMode" and "data".
+	ItemDefaults []string
+}
+
+// Build filters, ranks, truncates and compacts items into a completion list.
+//
+// Each item's filter text is decided first: its FilterText when set;
+// otherwise its plain-text insertion when that differs from its Label, which
+// the item then gets as FilterText so the client filters on the same text as
+// the user keeps typing; otherwise its Label. Items are matched against
+// Prefix by that filter text with the rules of VS Code's fuzzy matcher: the
+// characters of Prefix must occur in order, ignoring case, and the first one
+// must start the filter text, a word after a separator, or a camel-case
+// hump. The survivors are ordered by descending match score, ties keeping
+// the order of their SortText (or Label), and SortText is rewritten to that
+// final order so clients present it.
+//
+// Build reorders and modifies items in place; the returned list aliases them.
+func (o CompletionListOptions) Build(items []CompletionItem) *CompletionList {
+	type ranked struct {
+		score int
+		key   string
+	}
+	ranks := make([]ranked, 0, len(items))
+	kept := items[:0]
+	for i := range items {
+		item := &items[i]
+		filterText, set := item.FilterText.Get()
+		if !set {
+			if text, ok := completionInsertText(item); ok && text != item.Label {
+				filterText = text
+			}
+		}
+		if filterText == "" {
+			filterText = item.Label
+		}
+		score, ok := fuzzyScore(o.Prefix, filterText)
+		if !ok {
+			continue
+		}
+		if !set && filterText != item.Label {
+			item.FilterText.Set(filterText)
+		}
+		key, ok := item.SortText.Get()
+		if !ok || key == "" {
+			key = item.Label
+		}
+		kept = append(kept, *item)
+		ranks = append(ranks, ranked{score: score, key: key})
+	}
+
+	order := make([]int, len(kept))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if c := cmp.Compare(ranks[b].score, ranks[a].score); c != 0 {
+			return c
+		}
+		return cmp.Compare(ranks[a].key, ranks[b].key)
+	})
+	sorted := make([]CompletionItem, len(kept))
+	for i, j := range order {
+		sorted[i] = kept[j]
+	}
+	copy(kept, sorted)
+
+	list := &CompletionList{Items: kept}
+	if o.Limit > 0 && len(list.Items) > o.Limit {
+		list.Items = list.Items[:o.Limit]
+		list.IsIncomplete = true
+	}
+	width := len(strconv.Itoa(len(list.Items)))
+	for i := range list.Items {
+		key := strconv.Itoa(i)
+		list.Items[i].SortText.Set(strings.Repeat("0", width-len(key)) + key)
+	}
+	list.ItemDefaults = compactCompletionItemDefaults(list.Items, o.ItemDefaults)
+	return list
+}
+
+// completionInsertText returns the text item inserts when it is plain text.
+func completionInsertText(item *CompletionItem) (string, bool) {
+	if item.InsertTextFormat == InsertTextFormatSnippet {
+		return "", false
+	}
+	switch edit := item.TextEdit.(type) {
+	case *TextEdit:
+		return edit.NewText, true
+	case *InsertReplaceEdit:
+		return edit.NewText, true
+	}
+	if text, ok := item.TextEditText.Get(); ok {
+		return text, true
+	}
+	return item.InsertText.Get()
+}
+
+// compactCompletionItemDefaults moves the values every item shares into the
+// returned defaults, for the supported properties only. It returns nil when
+// nothing is shared.
+func compactCompletionItemDefaults(items []CompletionItem, supported []string) *CompletionItemDefaults {
+	if len(items) < 2 {
+		return nil
+	}
+	var defaults CompletionItemDefaults
+	shared := false
+	first := &items[0]
+	for _, property := range supported {
+		switch property {
+		case "commitCharacters":
+			if first.CommitCharacters == nil || !allItems(items, func(item *CompletionItem) bool {
+				return item.CommitCharacters != nil && slices.Equal(item.CommitCharacters, first.CommitCharacters)
+			}) {
+				continue
+			}
+			defaults.CommitCharacters = first.CommitCharacters
+			for i := range items {
+				items[i].CommitCharacters = nil
+			}
+		case "editRange":
+			editRange, ok := completionEditRange(first)
+			if !ok || !allItems(items, func(item *CompletionItem) bool {
+				r, ok := completionEditRange(item)
+				return ok && r == editRange
+			}) {
+				continue
+			}
+			if editRange.Insert == editRange.Replace {
+				defaults.EditRange = &editRange.Insert
+			} else {
+				defaults.EditRange = &editRange
+			}
+			for i := range items {
+				item := &items[i]
+				if text := completionEditText(item); text != item.Label {
+					item.TextEditText.Set(text)
+				}
+				item.TextEdit = nil
+			}
+		case "insertTextFormat":
+			if first.InsertTextFormat == 0 || !allItems(items, func(item *CompletionItem) bool {
+				return item.InsertTextFormat == first.InsertTextFormat
+			}) {
+				continue
+			}
+			defaults.InsertTextFormat = first.InsertTextFormat
+			for i := range items {
+				items[i].InsertTextFormat = 0
+			}
+		case "insertTextMode":
+			if first.InsertTextMode == 0 || !allItems(items, func(item *CompletionItem) bool {
+				return item.InsertTextMode == first.InsertTextMode
+			}) {
+				continue
+			}
+			defaults.InsertTextMode = first.InsertTextMode
+			for i := range items {
+				items[i].InsertTextMode = 0
+			}
+		case "data":
+			if len(first.Data) == 0 || !allItems(items, func(item *CompletionItem) bool {
+				return bytes.Equal(item.Data, first.Data)
+			}) {
+				continue
+			}
+			defaults.Data = first.Data
+			for i := range items {
+				items[i].Data = nil
+			}
+		default:
+			continue
+		}
+		shared = true
+	}
+	if !shared {
+		return nil
+	}
+	return &defaults
+}
+
+// allItems reports whether f holds for every item.
+func allItems(items []CompletionItem, f func(item *CompletionItem) bool) bool {
+	for i := range items {
+		if !f(&items[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// completionEditRange returns the ranges of the text edit of item, with
+// equal insert and replace ranges for a plain [TextEdit].
+func completionEditRange(item *CompletionItem) (EditRangeWithInsertReplace, bool) {
+	switch edit := item.TextEdit.(type) {
+	case *TextEdit:
+		return EditRangeWithInsertReplace{Insert: edit.Range, Replace: edit.Range}, true
+	case *InsertReplaceEdit:
+		return EditRangeWithInsertReplace{Insert: edit.Insert, Replace: edit.Replace}, true
+	}
+	return EditRangeWithInsertReplace{}, false
+}
+
+// completionEditText returns the new text of the text edit of item.
+func completionEditText(item *CompletionItem) string {
+	switch edit := item.TextEdit.(type) {
+	case *TextEdit:
+		return edit.NewText
+	case *InsertReplaceEdit:
+		return edit.NewText
+	}
+	return ""
+}
+
+// Fuzzy match scores, after VS Code's fuzzyScore: a character matched where
+// the word starts or continues a common prefix with the pattern, at a
+// camel-case hump, or after a separator scores high, exact case higher, and
+// each match directly following the previous one earns a bonus.
+const (
+	fuzzyScorePlain       = 1
+	fuzzyScoreStrong      = 5
+	fuzzyScoreStrongCase  = 7
+	fuzzyScoreConsecutive = 3
+)
+
+// fuzzyScore matches pattern against word as described at
+// [CompletionListOptions.Build] and returns the score of the best alignment.
+func fuzzyScore(pattern, word string) (int, bool) {
+	if pattern == "" {
+		return 0, true
+	}
+	p, w := []rune(pattern), []rune(word)
+	if len(p) > len(w) {
+		return 0, false
+	}
+	const none = math.MinInt / 2
+	prev := make([]int, len(w))
+	cur := make([]int, len(w))
+	for i := range p {
+		gapped := none // best alignment of p[:i] ending before w[j-1]
+		for j := range w {
+			cur[j] = none
+			if j > 1 {
+				gapped = max(gapped, prev[j-2])
+			}
+			s, ok := fuzzyCharScore(p, w, i, j)
+			switch {
+			case !ok:
+			case i == 0:
+				// The first character may not match weakly inside a word.
+				if s != fuzzyScorePlain || j == 0 {
+					cur[j] = s
+				}
+			case j > 0:
+				if pred := max(gapped, prev[j-1]+fuzzyScoreConsecutive); pred > none/2 {
+					cur[j] = s + pred
+				}
+			}
+		}
+		prev, cur = cur, prev
+	}
+	score := none
+	for _, s := range prev {
+		score = max(score, s)
+	}
+	return score, score > none/2
+}
+
+// fuzzyCharScore scores matching p[i] at w[j], reporting false when the
+// characters differ.
+func fuzzyCharScore(p, w []rune, i, j int) (int, bool) {
+	if unicode.ToLower(p[i]) != unicode.ToLower(w[j]) {
+		return 0, false
+	}
+	exact := p[i] == w[j]
+	strong := i == j ||
+		unicode.IsUpper(w[j]) && (j == 0 || !unicode.IsUpper(w[j-1])) ||
+		j > 0 && isFuzzySeparator(w[j-1])
+	switch {
+	case strong && exact:
+		return fuzzyScoreStrongCase, true
+	case strong:
+		return fuzzyScoreStrong, true
+	default:
+		return fuzzyScorePlain, true
+	}
+}
+
+// isFuzzySeparator reports whether r separates words for fuzzy matching.
+func isFuzzySeparator(r rune) bool {
+	switch r {
+	case '_', '-', '.', ' ', '/', '\\', '\'', '"', ':', '$', '<', '>', '(', ')', '[', ']', '{', '}':
+		return true
+	}
+	return false
+}
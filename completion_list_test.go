@@ -0,0 +1,257 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		word    string
+		want    bool
+	}{
+		"success: empty pattern":          {pattern: "", word: "anything", want: true},
+		"success: prefix":                 {pattern: "pri", word: "Println", want: true},
+		"success: camel humps":            {pattern: "pl", word: "Println", want: true},
+		"success: after separator":        {pattern: "wr", word: "io_writer", want: true},
+		"success: gaps":                   {pattern: "fbr", word: "fooBar", want: true},
+		"error: first char inside a word": {pattern: "ln", word: "Println", want: false},
+		"error: out of order":             {pattern: "np", word: "Println", want: false},
+		"error: longer than word":         {pattern: "printlnx", word: "Println", want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, got := fuzzyScore(tt.pattern, tt.word); got != tt.want {
+				t.Errorf("fuzzyScore(%q, %q) matched = %t, want %t", tt.pattern, tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuzzyScoreRanking(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern       string
+		better, worse string
+	}{
+		"success: exact case beats folded case":    {pattern: "Print", better: "Print", worse: "print"},
+		"success: contiguous beats gapped":         {pattern: "print", better: "print", worse: "pxrint"},
+		"success: camel hump beats a plain letter": {pattern: "fB", better: "fooBar", worse: "foobar"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			better, ok1 := fuzzyScore(tt.pattern, tt.better)
+			worse, ok2 := fuzzyScore(tt.pattern, tt.worse)
+			if !ok1 || !ok2 || better <= worse {
+				t.Errorf("fuzzyScore(%q): %q = %d, %q = %d, want the first higher", tt.pattern, tt.better, better, tt.worse, worse)
+			}
+		})
+	}
+}
+
+func TestCompletionListOptionsBuild(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts  CompletionListOptions
+		items []CompletionItem
+		want  *CompletionList
+	}{
+		"success: filters and ranks by match": {
+			opts: CompletionListOptions{Prefix: "pr"},
+			items: []CompletionItem{
+				{Label: "Sprintf"},
+				{Label: "appendRaw"},
+				{Label: "Println"},
+				{Label: "close"},
+				{Label: "Print"},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "Print", SortText: NewOptional("0")},
+				{Label: "Println", SortText: NewOptional("1")},
+			}},
+		},
+		"success: ties keep sort text order": {
+			items: []CompletionItem{
+				{Label: "b", SortText: NewOptional("2")},
+				{Label: "a", SortText: NewOptional("3")},
+				{Label: "c", SortText: NewOptional("1")},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "c", SortText: NewOptional("0")},
+				{Label: "b", SortText: NewOptional("1")},
+				{Label: "a", SortText: NewOptional("2")},
+			}},
+		},
+		"success: limit marks the list incomplete": {
+			opts: CompletionListOptions{Limit: 2},
+			items: []CompletionItem{
+				{Label: "c"},
+				{Label: "a"},
+				{Label: "b"},
+			},
+			want: &CompletionList{IsIncomplete: true, Items: []CompletionItem{
+				{Label: "a", SortText: NewOptional("0")},
+				{Label: "b", SortText: NewOptional("1")},
+			}},
+		},
+		"success: filter text from the insertion": {
+			opts: CompletionListOptions{Prefix: "ma"},
+			items: []CompletionItem{
+				{Label: "make(…)", InsertText: NewOptional("make")},
+				{Label: "map", InsertText: NewOptional("map")},
+				{Label: "max", InsertText: NewOptional("max(${1})"), InsertTextFormat: InsertTextFormatSnippet},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "make(…)", InsertText: NewOptional("make"), FilterText: NewOptional("make"), SortText: NewOptional("0")},
+				{Label: "map", InsertText: NewOptional("map"), SortText: NewOptional("1")},
+				{Label: "max", InsertText: NewOptional("max(${1})"), InsertTextFormat: InsertTextFormatSnippet, SortText: NewOptional("2")},
+			}},
+		},
+		"success: scored against the insertion that becomes filter text": {
+			opts: CompletionListOptions{Prefix: "pri"},
+			items: []CompletionItem{
+				{Label: "fmt.Println", InsertText: NewOptional("Println")},
+				{Label: "fmt.Sprint", InsertText: NewOptional("Sprint")},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "fmt.Println", InsertText: NewOptional("Println"), FilterText: NewOptional("Println"), SortText: NewOptional("0")},
+			}},
+		},
+		"success: a prefix matching only the label drops the item": {
+			opts: CompletionListOptions{Prefix: "fmtp"},
+			items: []CompletionItem{
+				{Label: "fmt.Println", InsertText: NewOptional("Println")},
+				{Label: "fmtPrint"},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "fmtPrint", SortText: NewOptional("0")},
+			}},
+		},
+		"success: sort text is zero padded": {
+			items: make([]CompletionItem, 11),
+			want: func() *CompletionList {
+				items := make([]CompletionItem, 11)
+				for i, key := range []string{"00", "01", "02", "03", "04", "05", "06", "07", "08", "09", "10"} {
+					items[i].SortText.Set(key)
+				}
+				return &CompletionList{Items: items}
+			}(),
+		},
+		"success: nothing matches": {
+			opts:  CompletionListOptions{Prefix: "zz"},
+			items: []CompletionItem{{Label: "a"}},
+			want:  &CompletionList{Items: []CompletionItem{}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.opts.Build(tt.items)
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Build() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompletionListItemDefaults(t *testing.T) {
+	t.Parallel()
+
+	all := []string{"commitCharacters", "editRange", "insertTextFormat", "insertTextMode", "data"}
+	r := Range{Start: Position{Line: 1, Character: 2}, End: Position{Line: 1, Character: 4}}
+	wider := Range{Start: r.Start, End: Position{Line: 1, Character: 6}}
+
+	tests := map[string]struct {
+		supported []string
+		items     []CompletionItem
+		want      *CompletionList
+	}{
+		"success: shared values move to the defaults": {
+			supported: all,
+			items: []CompletionItem{
+				{Label: "a", CommitCharacters: []string{"."}, TextEdit: &TextEdit{Range: r, NewText: "a"}, InsertTextFormat: InsertTextFormatPlainText, InsertTextMode: InsertTextModeAsIs, Data: LSPAny(`{"k":1}`)},
+				{Label: "b", CommitCharacters: []string{"."}, TextEdit: &TextEdit{Range: r, NewText: "bee"}, InsertTextFormat: InsertTextFormatPlainText, InsertTextMode: InsertTextModeAsIs, Data: LSPAny(`{"k":1}`)},
+			},
+			want: &CompletionList{
+				ItemDefaults: &CompletionItemDefaults{
+					CommitCharacters: []string{"."},
+					EditRange:        &r,
+					InsertTextFormat: InsertTextFormatPlainText,
+					InsertTextMode:   InsertTextModeAsIs,
+					Data:             LSPAny(`{"k":1}`),
+				},
+				Items: []CompletionItem{
+					{Label: "a", SortText: NewOptional("0")},
+					{Label: "b", FilterText: NewOptional("bee"), TextEditText: NewOptional("bee"), SortText: NewOptional("1")},
+				},
+			},
+		},
+		"success: insert replace ranges": {
+			supported: []string{"editRange"},
+			items: []CompletionItem{
+				{Label: "a", TextEdit: &InsertReplaceEdit{Insert: r, Replace: wider, NewText: "a"}},
+				{Label: "b", TextEdit: &InsertReplaceEdit{Insert: r, Replace: wider, NewText: "b"}},
+			},
+			want: &CompletionList{
+				ItemDefaults: &CompletionItemDefaults{EditRange: &EditRangeWithInsertReplace{Insert: r, Replace: wider}},
+				Items: []CompletionItem{
+					{Label: "a", SortText: NewOptional("0")},
+					{Label: "b", SortText: NewOptional("1")},
+				},
+			},
+		},
+		"success: unsupported properties stay on the items": {
+			supported: []string{"data"},
+			items: []CompletionItem{
+				{Label: "a", CommitCharacters: []string{"."}},
+				{Label: "b", CommitCharacters: []string{"."}},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "a", CommitCharacters: []string{"."}, SortText: NewOptional("0")},
+				{Label: "b", CommitCharacters: []string{"."}, SortText: NewOptional("1")},
+			}},
+		},
+		"success: differing values stay on the items": {
+			supported: all,
+			items: []CompletionItem{
+				{Label: "a", TextEdit: &TextEdit{Range: r, NewText: "a"}, Data: LSPAny(`1`)},
+				{Label: "b", TextEdit: &TextEdit{Range: wider, NewText: "b"}, Data: LSPAny(`2`)},
+			},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "a", TextEdit: &TextEdit{Range: r, NewText: "a"}, Data: LSPAny(`1`), SortText: NewOptional("0")},
+				{Label: "b", TextEdit: &TextEdit{Range: wider, NewText: "b"}, Data: LSPAny(`2`), SortText: NewOptional("1")},
+			}},
+		},
+		"success: a single item is not compacted": {
+			supported: all,
+			items:     []CompletionItem{{Label: "a", Data: LSPAny(`1`)}},
+			want: &CompletionList{Items: []CompletionItem{
+				{Label: "a", Data: LSPAny(`1`), SortText: NewOptional("0")},
+			}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := CompletionListOptions{ItemDefaults: tt.supported}.Build(tt.items)
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Build() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
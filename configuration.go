@@ -0,0 +1,184 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.lsp.dev/uri"
+)
+
+// Configuration reads one section of the client's settings, decoded into a
+// T, for the scopes the server asks about.
+//
+// With a client that supports workspace/configuration, [Configuration.Get]
+// requests the section for a scope and caches the result until the next
+// workspace/didChangeConfiguration notification. With other clients, the
+// section is taken from the settings the last such notification pushed,
+// and is the same for every scope.
+//
+// Call [Configuration.Start] once the connection is initialized and
+// [Configuration.DidChange] from the server's DidChangeConfiguration
+// method. A Configuration is safe for concurrent use.
+type Configuration[T any] struct {
+	section string
+
+	mu       sync.Mutex
+	client   Client
+	pull     bool
+	settings LSPAny        // last pushed settings
+	cache    map[uri.URI]T // by scope; "" is the global scope
+	gen      uint64        // bumped by every change, to drop stale fetches
+	onChange []func()
+}
+
+// NewConfiguration returns a Configuration for section, a dotted name such
+// as "myls" or "myls.format".
+func NewConfiguration[T any](section string) *Configuration[T] {
+	return &Configuration[T]{section: section}
+}
+
+// Section returns the configuration section.
+func (c *Configuration[T]) Section() string {
+	return c.section
+}
+
+// Start binds the configuration to client, whose capabilities are caps,
+// and, when the client supports dynamic registration of
+// workspace/didChangeConfiguration, registers for notifications of changes
+// to the section. Call it from the server's Initialized method, or later.
+func (c *Configuration[T]) Start(ctx context.Context, client Client, caps *ClientCapabilities) error {
+	var ws *WorkspaceClientCapabilities
+	if caps != nil {
+		ws = caps.Workspace
+	}
+	c.mu.Lock()
+	c.client = client
+	c.pull = ws != nil && ws.Configuration != nil && *ws.Configuration
+	c.cache = nil
+	c.gen++
+	c.mu.Unlock()
+
+	if ws == nil || ws.DidChangeConfiguration == nil || ws.DidChangeConfiguration.DynamicRegistration == nil || !*ws.DidChangeConfiguration.DynamicRegistration {
+		return nil
+	}
+	opts, err := Marshal(&DidChangeConfigurationRegistrationOptions{Section: String(c.section)})
+	if err != nil {
+		return err
+	}
+	err = client.RegisterCapability(ctx, &RegistrationParams{Registrations: []Registration{{
+		ID:              MethodWorkspaceDidChangeConfiguration + "/" + c.section,
+		Method:          MethodWorkspaceDidChangeConfiguration,
+		RegisterOptions: opts,
+	}}})
+	if err != nil {
+		return fmt.Errorf("protocol: configuration %s: register for changes: %w", c.section, err)
+	}
+	return nil
+}
+
+// OnChange registers fn to be called after each change notification, once
+// the cached values are dropped, so the server can re-read the settings it
+// uses.
+func (c *Configuration[T]) OnChange(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onChange = append(c.onChange, fn)
+}
+
+// DidChange handles a workspace/didChangeConfiguration notification: it
+// keeps the pushed settings and drops every cached value.
+func (c *Configuration[T]) DidChange(params *DidChangeConfigurationParams) {
+	c.mu.Lock()
+	c.settings = params.Settings
+	c.cache = nil
+	c.gen++
+	onChange := c.onChange
+	c.mu.Unlock()
+
+	for _, fn := range onChange {
+		fn()
+	}
+}
+
+// Get returns the section for scope, the URI of a resource or workspace
+// folder; an empty scope asks for the global settings. A section the client
+// does not have decodes as the zero T. The value is shared with the cache,
+// so maps, slices and pointers in it must not be modified.
+func (c *Configuration[T]) Get(ctx context.Context, scope uri.URI) (T, error) {
+	c.mu.Lock()
+	if !c.pull {
+		scope = ""
+	}
+	if v, ok := c.cache[scope]; ok {
+		c.mu.Unlock()
+		return v, nil
+	}
+	client, pull, settings, gen := c.client, c.pull, c.settings, c.gen
+	c.mu.Unlock()
+
+	var (
+		raw LSPAny
+		err error
+	)
+	if pull {
+		raw, err = c.fetch(ctx, client, scope)
+	} else {
+		raw, err = configurationSection(settings, c.section)
+	}
+	var v T
+	if err == nil && len(raw) > 0 && string(raw) != nullLiteral {
+		err = Unmarshal(raw, &v)
+	}
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("protocol: configuration %s: %w", c.section, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gen == gen {
+		if c.cache == nil {
+			c.cache = make(map[uri.URI]T)
+		}
+		c.cache[scope] = v
+	}
+	return v, nil
+}
+
+// fetch requests the section for scope with workspace/configuration.
+func (c *Configuration[T]) fetch(ctx context.Context, client Client, scope uri.URI) (LSPAny, error) {
+	item := ConfigurationItem{Section: &c.section}
+	if scope != "" {
+		item.ScopeURI = &scope
+	}
+	results, err := client.Configuration(ctx, &ConfigurationParams{Items: []ConfigurationItem{item}})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("client returned %d results for one item", len(results))
+	}
+	return results[0], nil
+}
+
+// configurationSection returns the member of settings at the dotted path
+// section, or nil when settings do not have it.
+func configurationSection(settings LSPAny, section string) (LSPAny, error) {
+	raw := settings
+	for name := range strings.SplitSeq(section, ".") {
+		if len(raw) == 0 || raw.Kind() != '{' {
+			return nil, nil
+		}
+		var obj LSPObject
+		if err := Unmarshal(raw, &obj); err != nil {
+			return nil, err
+		}
+		raw = obj[name]
+	}
+	return raw, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.lsp.dev/uri"
+)
+
+type testSettings struct {
+	Gofumpt bool     `json:"gofumpt"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// configurationClient answers workspace/configuration from per-scope
+// settings and records the requests it gets.
+type configurationClient struct {
+	UnimplementedClient
+
+	mu            sync.Mutex
+	settings      map[uri.URI]string
+	fetches       int
+	registrations []Registration
+	err           error
+}
+
+func (c *configurationClient) Configuration(_ context.Context, params *ConfigurationParams) ([]LSPAny, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetches++
+	if c.err != nil {
+		return nil, c.err
+	}
+	var results []LSPAny
+	for _, item := range params.Items {
+		var scope uri.URI
+		if item.ScopeURI != nil {
+			scope = *item.ScopeURI
+		}
+		raw, ok := c.settings[scope]
+		if !ok {
+			raw = "null"
+		}
+		results = append(results, LSPAny(raw))
+	}
+	return results, nil
+}
+
+func (c *configurationClient) RegisterCapability(_ context.Context, params *RegistrationParams) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.registrations = append(c.registrations, params.Registrations...)
+	return nil
+}
+
+func configurationCaps(t *testing.T, raw string) *ClientCapabilities {
+	t.Helper()
+
+	var caps ClientCapabilities
+	if err := Unmarshal([]byte(raw), &caps); err != nil {
+		t.Fatal(err)
+	}
+	return &caps
+}
+
+func TestConfigurationPull(t *testing.T) {
+	t.Parallel()
+
+	const folder uri.URI = "file:///src/a"
+	client := &configurationClient{settings: map[uri.URI]string{
+		"":     `{"gofumpt":false}`,
+		folder: `{"gofumpt":true,"tags":["integration"]}`,
+	}}
+	caps := configurationCaps(t, `{"workspace":{"configuration":true,"didChangeConfiguration":{"dynamicRegistration":true}}}`)
+
+	cfg := NewConfiguration[testSettings]("gopls")
+	changes := 0
+	cfg.OnChange(func() { changes++ })
+	if err := cfg.Start(t.Context(), client, caps); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	wantRegistrations := []Registration{{
+		ID:              "workspace/didChangeConfiguration/gopls",
+		Method:          MethodWorkspaceDidChangeConfiguration,
+		RegisterOptions: LSPAny(`{"section":"gopls"}`),
+	}}
+	if diff := gocmp.Diff(wantRegistrations, client.registrations); diff != "" {
+		t.Errorf("registrations mismatch (-want +got):\n%s", diff)
+	}
+
+	for range 2 {
+		got, err := cfg.Get(t.Context(), folder)
+		if err != nil {
+			t.Fatalf("Get(folder) error = %v", err)
+		}
+		if diff := gocmp.Diff(testSettings{Gofumpt: true, Tags: []string{"integration"}}, got); diff != "" {
+			t.Errorf("Get(folder) mismatch (-want +got):\n%s", diff)
+		}
+	}
+	if got, err := cfg.Get(t.Context(), ""); err != nil || got.Gofumpt {
+		t.Errorf("Get(global) = %+v, %v, want gofumpt off", got, err)
+	}
+	if got, err := cfg.Get(t.Context(), "file:///src/unset"); err != nil || got.Gofumpt || got.Tags != nil {
+		t.Errorf("Get(unset scope) = %+v, %v, want the zero value", got, err)
+	}
+	if client.fetches != 3 {
+		t.Errorf("fetches = %d, want 3 (one per scope)", client.fetches)
+	}
+
+	// A change drops the cache; pulled values come from the client again.
+	client.settings[folder] = `{"gofumpt":false}`
+	cfg.DidChange(&DidChangeConfigurationParams{Settings: LSPAny(`null`)})
+	if got, err := cfg.Get(t.Context(), folder); err != nil || got.Gofumpt {
+		t.Errorf("Get(folder) after change = %+v, %v, want gofumpt off", got, err)
+	}
+	if client.fetches != 4 || changes != 1 {
+		t.Errorf("fetches = %d, changes = %d, want 4 and 1", client.fetches, changes)
+	}
+}
+
+func TestConfigurationPush(t *testing.T) {
+	t.Parallel()
+
+	client := &configurationClient{}
+	cfg := NewConfiguration[testSettings]("myls.format")
+	if err := cfg.Start(t.Context(), client, configurationCaps(t, `{}`)); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if len(client.registrations) != 0 {
+		t.Errorf("Start() registered %v without dynamic registration support", client.registrations)
+	}
+
+	if got, err := cfg.Get(t.Context(), "file:///src/a"); err != nil || got.Gofumpt {
+		t.Errorf("Get() before any settings = %+v, %v, want the zero value", got, err)
+	}
+	cfg.DidChange(&DidChangeConfigurationParams{Settings: LSPAny(`{"myls":{"format":{"gofumpt":true}}}`)})
+	if got, err := cfg.Get(t.Context(), "file:///src/a"); err != nil || !got.Gofumpt {
+		t.Errorf("Get() after push = %+v, %v, want gofumpt on", got, err)
+	}
+	if client.fetches != 0 {
+		t.Errorf("fetches = %d, want none for a client without workspace/configuration", client.fetches)
+	}
+}
+
+func TestConfigurationErrors(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+	pull := `{"workspace":{"configuration":true}}`
+	tests := map[string]struct {
+		client   *configurationClient
+		caps     string
+		settings LSPAny
+		wantErr  error
+	}{
+		"error: request fails": {
+			client:  &configurationClient{err: errFailed},
+			caps:    pull,
+			wantErr: errFailed,
+		},
+		"error: mistyped pulled section": {
+			client: &configurationClient{settings: map[uri.URI]string{"": `{"gofumpt":"yes"}`}},
+			caps:   pull,
+		},
+		"error: mistyped pushed section": {
+			client:   &configurationClient{},
+			caps:     `{}`,
+			settings: LSPAny(`{"myls":{"tags":7}}`),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := NewConfiguration[testSettings]("myls")
+			if err := cfg.Start(t.Context(), tt.client, configurationCaps(t, tt.caps)); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			if tt.settings != nil {
+				cfg.DidChange(&DidChangeConfigurationParams{Settings: tt.settings})
+			}
+			_, err := cfg.Get(t.Context(), "")
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
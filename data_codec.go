@@ -0,0 +1,165 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Errors reported by [DataCodec.Decode]. Each means the data must not be
+// trusted as a payload of the codec, and a resolve handler should treat the
+// item as unresolvable rather than fail the request.
+var (
+	// ErrForeignData reports data this codec did not attach: not an envelope,
+	// or an envelope of another kind.
+	ErrForeignData = errors.New("protocol: data was not attached by this codec")
+
+	// ErrStaleData reports an envelope of the codec's kind written with
+	// another payload version.
+	ErrStaleData = errors.New("protocol: data has a stale payload version")
+
+	// ErrBadDataSignature reports an envelope whose signature is missing or
+	// does not match its contents.
+	ErrBadDataSignature = errors.New("protocol: data signature mismatch")
+)
+
+// dataSignatureSize is the length of the truncated HMAC-SHA256 signature.
+const dataSignatureSize = 16
+
+// DataCodec attaches values of type T to the data field that the protocol
+// round-trips through the client between a response and the matching resolve
+// request: [CompletionItem], [CodeLens], [InlayHint], [DocumentLink],
+// [CodeAction], [WorkspaceSymbol] and [Diagnostic]. The value is wrapped in
+// an envelope recording the codec's kind and payload version and, with a
+// key, an HMAC signature over both and the payload, so a resolve handler
+// cleanly rejects data from an older server build, another server sharing
+// the client, or a tampering client.
+//
+// The payload is carried as a JSON string rather than an embedded value so
+// that a client re-serializing the data cannot change the signed bytes.
+// Unknown envelope members are ignored, which keeps a shallow merge with
+// [CompletionItemDefaults] data harmless. A DataCodec is safe for concurrent
+// use.
+type DataCodec[T any] struct {
+	kind    string
+	version int
+	key     []byte
+}
+
+// NewDataCodec returns a codec for payloads of the given kind and version.
+// Bump version when the encoding of T changes incompatibly. A nil key leaves
+// envelopes unsigned; otherwise every envelope is signed with key and must
+// carry a valid signature to decode.
+func NewDataCodec[T any](kind string, version int, key []byte) *DataCodec[T] {
+	return &DataCodec[T]{kind: kind, version: version, key: key}
+}
+
+// Encode returns the envelope of v, ready to assign to a data field.
+func (c *DataCodec[T]) Encode(v T) (LSPAny, error) {
+	payload, err := Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("protocol: encode %s data: %w", c.kind, err)
+	}
+	dst := append(make([]byte, 0, len(payload)+64), `{"kind":`...)
+	dst = appendJSONString(dst, c.kind)
+	dst = append(dst, `,"version":`...)
+	dst = strconv.AppendInt(dst, int64(c.version), 10)
+	dst = append(dst, `,"payload":`...)
+	dst = appendJSONString(dst, string(payload))
+	if c.key != nil {
+		dst = append(dst, `,"sig":"`...)
+		dst = base64.RawURLEncoding.AppendEncode(dst, c.sign(payload))
+		dst = append(dst, '"')
+	}
+	return LSPAny(append(dst, '}')), nil
+}
+
+// Decode recovers the value attached by Encode. It returns [ErrForeignData],
+// [ErrStaleData] or [ErrBadDataSignature] for data it must not trust, and
+// the decoding error of the payload otherwise.
+func (c *DataCodec[T]) Decode(data LSPAny) (T, error) {
+	var (
+		zero T
+		env  struct {
+			Kind    *string `json:"kind"`
+			Version int     `json:"version"`
+			Payload string  `json:"payload"`
+			Sig     string  `json:"sig"`
+		}
+	)
+	if data.Kind() != '{' || decodeWith(data, &env) != nil || env.Kind == nil || *env.Kind != c.kind {
+		return zero, ErrForeignData
+	}
+	if env.Version != c.version {
+		return zero, fmt.Errorf("%w: %s data version %d, want %d", ErrStaleData, c.kind, env.Version, c.version)
+	}
+	if c.key != nil {
+		sig, err := base64.RawURLEncoding.DecodeString(env.Sig)
+		if err != nil || !hmac.Equal(sig, c.sign([]byte(env.Payload))) {
+			return zero, ErrBadDataSignature
+		}
+	}
+	var v T
+	if err := Unmarshal([]byte(env.Payload), &v); err != nil {
+		return zero, fmt.Errorf("protocol: decode %s data: %w", c.kind, err)
+	}
+	return v, nil
+}
+
+// sign returns the truncated HMAC of the kind, version and payload.
+func (c *DataCodec[T]) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(c.kind))
+	mac.Write([]byte{0})
+	mac.Write(strconv.AppendInt(nil, int64(c.version), 10))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+	return mac.Sum(nil)[:dataSignatureSize]
+}
+
+// CompletionItemData returns the data a resolve request for item carries
+// when item came from list: the item's own data combined with
+// list.ItemDefaults.Data under list.ApplyKind.Data, as a client applies the
+// defaults. With ApplyKindReplace (the default) the item's data wins unless
+// it is absent or null. With ApplyKindMerge two objects are merged
+// shallowly, the item's members overriding the defaults; any other pair
+// falls back to replacing.
+func CompletionItemData(list *CompletionList, item *CompletionItem) (LSPAny, error) {
+	var defaults LSPAny
+	if list != nil && list.ItemDefaults != nil {
+		defaults = list.ItemDefaults.Data
+	}
+	own := item.Data
+	if len(own) == 0 || own.Kind() == 'n' {
+		return defaults, nil
+	}
+	merge := list != nil && list.ApplyKind != nil && list.ApplyKind.Data == ApplyKindMerge
+	if !merge || defaults.Kind() != '{' || own.Kind() != '{' {
+		return own, nil
+	}
+	var base, over LSPObject
+	if err := decodeWith(defaults, &base); err != nil {
+		return nil, err
+	}
+	if err := decodeWith(own, &over); err != nil {
+		return nil, err
+	}
+	if base == nil {
+		base = make(LSPObject, len(over))
+	}
+	for name, v := range over {
+		base[name] = v
+	}
+	first := true
+	dst, err := appendExtraMembers([]byte{'{'}, &first, base, nil)
+	if err != nil {
+		return nil, err
+	}
+	return LSPAny(append(dst, '}')), nil
+}
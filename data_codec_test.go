@@ -0,0 +1,189 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+type testCompletionData struct {
+	Symbol  string   `json:"symbol"`
+	Offsets []uint32 `json:"offsets,omitempty"`
+	Pos     Position `json:"pos"`
+}
+
+func TestDataCodecRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+	want := testCompletionData{Symbol: `fmt."Println"`, Offsets: []uint32{1, 2}, Pos: Position{Line: 3, Character: 4}}
+
+	tests := map[string]*DataCodec[testCompletionData]{
+		"success: signed":   NewDataCodec[testCompletionData]("completion", 2, key),
+		"success: unsigned": NewDataCodec[testCompletionData]("completion", 2, nil),
+	}
+	for name, codec := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := codec.Encode(want)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if !data.IsValid() {
+				t.Fatalf("Encode() = %s, not valid JSON", data)
+			}
+
+			// The data travels inside a resolve request and back.
+			item := &CompletionItem{Label: "Println", Data: data}
+			wire, err := Marshal(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var resolved CompletionItem
+			if err := Unmarshal(wire, &resolved); err != nil {
+				t.Fatal(err)
+			}
+			got, err := codec.Decode(resolved.Data)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if diff := gocmp.Diff(want, got); diff != "" {
+				t.Errorf("Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDataCodecRejects(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+	codec := NewDataCodec[testCompletionData]("completion", 2, key)
+	signed, err := codec.Encode(testCompletionData{Symbol: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(c *DataCodec[testCompletionData]) LSPAny {
+		data, err := c.Encode(testCompletionData{Symbol: "a"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	tests := map[string]struct {
+		data LSPAny
+		want error
+	}{
+		"error: absent":           {data: nil, want: ErrForeignData},
+		"error: not an envelope":  {data: LSPAny(`{"symbol":"a"}`), want: ErrForeignData},
+		"error: array":            {data: LSPAny(`[1]`), want: ErrForeignData},
+		"error: other kind":       {data: encode(NewDataCodec[testCompletionData]("codeLens", 2, key)), want: ErrForeignData},
+		"error: older version":    {data: encode(NewDataCodec[testCompletionData]("completion", 1, key)), want: ErrStaleData},
+		"error: other key":        {data: encode(NewDataCodec[testCompletionData]("completion", 2, []byte("other"))), want: ErrBadDataSignature},
+		"error: unsigned":         {data: encode(NewDataCodec[testCompletionData]("completion", 2, nil)), want: ErrBadDataSignature},
+		"error: tampered payload": {data: LSPAny(strings.Replace(string(signed), `\"a\"`, `\"b\"`, 1)), want: ErrBadDataSignature},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := codec.Decode(tt.data); !errors.Is(err, tt.want) {
+				t.Errorf("Decode(%s) error = %v, want %v", tt.data, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompletionItemData(t *testing.T) {
+	t.Parallel()
+
+	merge := &CompletionItemApplyKinds{Data: ApplyKindMerge}
+	tests := map[string]struct {
+		list *CompletionList
+		item LSPAny
+		want string
+	}{
+		"success: no list keeps the item data": {
+			item: LSPAny(`{"a":1}`),
+			want: `{"a":1}`,
+		},
+		"success: absent item data takes the default": {
+			list: &CompletionList{ItemDefaults: &CompletionItemDefaults{Data: LSPAny(`{"d":1}`)}},
+			want: `{"d":1}`,
+		},
+		"success: null item data takes the default": {
+			list: &CompletionList{ItemDefaults: &CompletionItemDefaults{Data: LSPAny(`{"d":1}`)}},
+			item: LSPAny(`null`),
+			want: `{"d":1}`,
+		},
+		"success: replace prefers the item": {
+			list: &CompletionList{ItemDefaults: &CompletionItemDefaults{Data: LSPAny(`{"d":1}`)}},
+			item: LSPAny(`{}`),
+			want: `{}`,
+		},
+		"success: merge overrides default members": {
+			list: &CompletionList{ItemDefaults: &CompletionItemDefaults{Data: LSPAny(`{"d":1,"x":2}`)}, ApplyKind: merge},
+			item: LSPAny(`{"x":3,"a":[4]}`),
+			want: `{"a":[4],"d":1,"x":3}`,
+		},
+		"success: merge of non-objects replaces": {
+			list: &CompletionList{ItemDefaults: &CompletionItemDefaults{Data: LSPAny(`{"d":1}`)}, ApplyKind: merge},
+			item: LSPAny(`7`),
+			want: `7`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CompletionItemData(tt.list, &CompletionItem{Label: "x", Data: tt.item})
+			if err != nil {
+				t.Fatalf("CompletionItemData() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("CompletionItemData() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataCodecMergedDefaults(t *testing.T) {
+	t.Parallel()
+
+	codec := NewDataCodec[testCompletionData]("completion", 1, []byte("k"))
+	defaults, err := codec.Encode(testCompletionData{Symbol: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	own, err := codec.Encode(testCompletionData{Symbol: "own"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := &CompletionList{
+		ItemDefaults: &CompletionItemDefaults{Data: defaults},
+		ApplyKind:    &CompletionItemApplyKinds{Data: ApplyKindMerge},
+	}
+	for item, want := range map[*CompletionItem]string{
+		{Label: "a"}:            "default",
+		{Label: "b", Data: own}: "own",
+	} {
+		data, err := CompletionItemData(list, item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := codec.Decode(data)
+		if err != nil {
+			t.Fatalf("Decode(%s) error = %v", data, err)
+		}
+		if got.Symbol != want {
+			t.Errorf("item %s resolved %q, want %q", item.Label, got.Symbol, want)
+		}
+	}
+}
@@ -38,8 +38,8 @@ func appendBox[T any](boxes *[]T, capN int) *T {
 	return &(*boxes)[len(*boxes)-1]
 }
 
-// boxedArm constrains tryBoxArm to slab element types whose pointer form
-// carries a byte-walker whole-value decoder.
+// boxedArm constrains tryBoxArm and decodeArm to slab element types whose
+// pointer form carries a byte-walker whole-value decoder.
 type boxedArm[T any] interface {
 	*T
 	unmarshalLSPValue(raw jsontext.Value) error
@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"go.lsp.dev/jsonrpc2"
+)
+
+// ParamsMessage is a message naming a method and carrying its encoded params.
+// It is satisfied by the [*jsonrpc2.Request] a handler receives as well as by
+// the [*jsonrpc2.Call] and [*jsonrpc2.Notification] a connection sends.
+type ParamsMessage interface {
+	Method() string
+	Params() jsonrpc2.RawMessage
+}
+
+// DecodeParams decodes the params of req into the Go type its method declares,
+// as [UnmarshalParams] does. It lets proxies and middleware inspect a message
+// without duplicating the per-method switch of the dispatchers.
+func DecodeParams(req ParamsMessage) (any, error) {
+	return UnmarshalParams(req.Method(), req.Params())
+}
+
+// DecodeResult decodes the result of resp, a response to a request for
+// method, into the Go type that method's handler returns, as
+// [UnmarshalResult] does. An error response reports its error unchanged.
+func DecodeResult(method string, resp *jsonrpc2.Response) (any, error) {
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+
+	return UnmarshalResult(method, resp.Result())
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"testing"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+func TestDecodeParams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		msg      ParamsMessage
+		wantType string
+		wantErr  error
+	}{
+		"success: request params decode to a pointer": {
+			msg:      jsonrpc2.NewCall(jsonrpc2.NewNumberID(1), MethodTextDocumentHover, jsonrpc2.RawMessage(`{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2}}`)),
+			wantType: "*protocol.HoverParams",
+		},
+		"success: notification params decode to a pointer": {
+			msg:      jsonrpc2.NewNotification(MethodTextDocumentDidClose, jsonrpc2.RawMessage(`{"textDocument":{"uri":"file:///a.go"}}`)),
+			wantType: "*protocol.DidCloseTextDocumentParams",
+		},
+		"success: untyped params stay LSPAny": {
+			msg:      jsonrpc2.NewNotification(MethodTelemetryEvent, jsonrpc2.RawMessage(`{"k":1}`)),
+			wantType: "jsontext.Value",
+		},
+		"success: method without params decodes to nil": {
+			msg:      jsonrpc2.NewCall(jsonrpc2.NewNumberID(2), MethodShutdown, nil),
+			wantType: "<nil>",
+		},
+		"error: unknown method": {
+			msg:     jsonrpc2.NewNotification("custom/thing", jsonrpc2.RawMessage(`{}`)),
+			wantErr: jsonrpc2.ErrMethodNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DecodeParams(tt.msg)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DecodeParams() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeParams(): %v", err)
+			}
+			if gotType := typeName(got); gotType != tt.wantType {
+				t.Fatalf("DecodeParams() = %s, want %s", gotType, tt.wantType)
+			}
+		})
+	}
+
+	t.Run("success: hover params fields", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := UnmarshalParams(MethodTextDocumentHover, []byte(`{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2}}`))
+		if err != nil {
+			t.Fatalf("UnmarshalParams(): %v", err)
+		}
+		params := got.(*HoverParams)
+		if params.Position.Line != 1 || params.Position.Character != 2 || params.TextDocument.URI != "file:///a.go" {
+			t.Fatalf("UnmarshalParams() = %+v", params)
+		}
+	})
+}
+
+func TestDecodeResult(t *testing.T) {
+	t.Parallel()
+
+	errResponse := jsonrpc2.NewError(jsonrpc2.ErrInternal.Code, "boom")
+
+	tests := map[string]struct {
+		method   string
+		resp     *jsonrpc2.Response
+		wantType string
+		wantErr  error
+	}{
+		"success: struct result decodes to a pointer": {
+			method:   MethodTextDocumentHover,
+			resp:     jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`{"contents":"doc"}`), nil),
+			wantType: "*protocol.Hover",
+		},
+		"success: union result decodes to its arm": {
+			method:   MethodTextDocumentDefinition,
+			resp:     jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`{"uri":"file:///a.go","range":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}}}`), nil),
+			wantType: "*protocol.Location",
+		},
+		"success: slice result": {
+			method:   MethodTextDocumentReferences,
+			resp:     jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`[]`), nil),
+			wantType: "[]protocol.Location",
+		},
+		"success: null struct result decodes to a nil pointer": {
+			method:   MethodTextDocumentHover,
+			resp:     jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`null`), nil),
+			wantType: "*protocol.Hover",
+		},
+		"success: void result decodes to nil": {
+			method:   MethodShutdown,
+			resp:     jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`null`), nil),
+			wantType: "<nil>",
+		},
+		"error: error response is returned unchanged": {
+			method:  MethodTextDocumentHover,
+			resp:    jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), nil, errResponse),
+			wantErr: errResponse,
+		},
+		"error: notification has no result": {
+			method:  MethodTextDocumentDidOpen,
+			resp:    jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`null`), nil),
+			wantErr: jsonrpc2.ErrMethodNotFound,
+		},
+		"error: unknown method": {
+			method:  "custom/thing",
+			resp:    jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`null`), nil),
+			wantErr: jsonrpc2.ErrMethodNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DecodeResult(tt.method, tt.resp)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DecodeResult() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeResult(): %v", err)
+			}
+			if gotType := typeName(got); gotType != tt.wantType {
+				t.Fatalf("DecodeResult() = %s, want %s", gotType, tt.wantType)
+			}
+		})
+	}
+}
+
+// TestUnmarshalParamsCoversRegistry asserts every registered method has a
+// decode case, so UnmarshalParams never reports a spec method as unknown.
+func TestUnmarshalParamsCoversRegistry(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range Methods {
+		if _, err := UnmarshalParams(m.Method, []byte(`null`)); errors.Is(err, jsonrpc2.ErrMethodNotFound) {
+			t.Errorf("UnmarshalParams(%q) reports method not found", m.Method)
+		}
+		if m.Kind != "request" {
+			continue
+		}
+		if _, err := UnmarshalResult(m.Method, []byte(`null`)); errors.Is(err, jsonrpc2.ErrMethodNotFound) {
+			t.Errorf("UnmarshalResult(%q) reports method not found", m.Method)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+import (
+	"github.com/go-json-experiment/json/jsontext"
+	"slices"
+)
+
+func (x *CodeAction) unmarshalLSP(raw []byte, i int) (int, error) {
+	if n, ok := dvNull(raw, i); ok {
+		*x = CodeAction{}
+		return n, nil
+	}
+	if i >= len(raw) || raw[i] != '{' {
+		return i, dvSyntaxError(i, "object")
+	}
+	i = skipSpace(raw, i+1)
+	if i < len(raw) && raw[i] == '}' {
+		return i + 1, nil
+	}
+	for {
+		key, n, err := dvMemberKey(raw, i)
+		if err != nil {
+			return n, err
+		}
+		i = n
+		_ = key
+		switch {
+		case keyEquals(key, `title`):
+			v, n, err := dvString(raw, i)
+			if err != nil {
+				return n, err
+			}
+			x.Title = v
+			i = n
+		case keyEquals(key, `kind`):
+			if n, ok := dvNull(raw, i); ok {
+				x.Kind = nil
+				i = n
+			} else {
+				v, n, err := dvString(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.Kind == nil {
+					x.Kind = new(CodeActionKind)
+				}
+				*x.Kind = CodeActionKind(v)
+				i = n
+			}
+		case keyEquals(key, `diagnostics`):
+			v, n, err := unmarshalSliceDiagnostic(raw, i, x.Diagnostics)
+			if err != nil {
+				return n, err
+			}
+			x.Diagnostics = v
+			i = n
+		case keyEquals(key, `isPreferred`):
+			if n, ok := dvNull(raw, i); ok {
+				x.IsPreferred = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.IsPreferred == nil {
+					x.IsPreferred = new(bool)
+				}
+				*x.IsPreferred = v
+				i = n
+			}
+		case keyEquals(key, `disabled`):
+			n, err := x.Disabled.unmarshalLSP(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		case keyEquals(key, `edit`):
+			if n, ok := dvNull(raw, i); ok {
+				x.Edit = nil
+				i = n
+			} else {
+				if x.Edit == nil {
+					x.Edit = new(WorkspaceEdit)
+				}
+				n, err := x.Edit.unmarshalLSP(raw, i)
+				if err != nil {
+					return n, err
+				}
+				i = n
+			}
+		case keyEquals(key, `command`):
+			n, err := x.Command.unmarshalLSP(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		case keyEquals(key, `data`):
+			val, n, err := dvValue(raw, i)
+			if err != nil {
+				return n, err
+			}
+			x.Data = jsontext.Value(val)
+			i = n
+		case keyEquals(key, `tags`):
+			v, n, err := dvUint32Slice(raw, i, x.Tags)
+			if err != nil {
+				return n, err
+			}
+			x.Tags = v
+			i = n
+		default:
+			_, n, err := dvValue(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		}
+		var done bool
+		i, done, err = dvObjectNext(raw, i)
+		if err != nil {
+			return i, err
+		}
+		if done {
+			return i, nil
+		}
+	}
+}
+
+func (x *CodeActionClientCapabilities) unmarshalLSP(raw []byte, i int) (int, error) {
+	if n, ok := dvNull(raw, i); ok {
+		*x = CodeActionClientCapabilities{}
+		return n, nil
+	}
+	if i >= len(raw) || raw[i] != '{' {
+		return i, dvSyntaxError(i, "object")
+	}
+	i = skipSpace(raw, i+1)
+	if i < len(raw) && raw[i] == '}' {
+		return i + 1, nil
+	}
+	for {
+		key, n, err := dvMemberKey(raw, i)
+		if err != nil {
+			return n, err
+		}
+		i = n
+		_ = key
+		switch {
+		case keyEquals(key, `dynamicRegistration`):
+			if n, ok := dvNull(raw, i); ok {
+				x.DynamicRegistration = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.DynamicRegistration == nil {
+					x.DynamicRegistration = new(bool)
+				}
+				*x.DynamicRegistration = v
+				i = n
+			}
+		case keyEquals(key, `codeActionLiteralSupport`):
+			n, err := x.CodeActionLiteralSupport.unmarshalLSP(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		case keyEquals(key, `isPreferredSupport`):
+			if n, ok := dvNull(raw, i); ok {
+				x.IsPreferredSupport = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.IsPreferredSupport == nil {
+					x.IsPreferredSupport = new(bool)
+				}
+				*x.IsPreferredSupport = v
+				i = n
+			}
+		case keyEquals(key, `disabledSupport`):
+			if n, ok := dvNull(raw, i); ok {
+				x.DisabledSupport = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.DisabledSupport == nil {
+					x.DisabledSupport = new(bool)
+				}
+				*x.DisabledSupport = v
+				i = n
+			}
+		case keyEquals(key, `dataSupport`):
+			if n, ok := dvNull(raw, i); ok {
+				x.DataSupport = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.DataSupport == nil {
+					x.DataSupport = new(bool)
+				}
+				*x.DataSupport = v
+				i = n
+			}
+		case keyEquals(key, `resolveSupport`):
+			n, err := x.ResolveSupport.unmarshalLSP(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		case keyEquals(key, `honorsChangeAnnotations`):
+			if n, ok := dvNull(raw, i); ok {
+				x.HonorsChangeAnnotations = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.HonorsChangeAnnotations == nil {
+					x.HonorsChangeAnnotations = new(bool)
+				}
+				*x.HonorsChangeAnnotations = v
+				i = n
+			}
+		case keyEquals(key, `documentationSupport`):
+			if n, ok := dvNull(raw, i); ok {
+				x.DocumentationSupport = nil
+				i = n
+			} else {
+				v, n, err := dvBool(raw, i)
+				if err != nil {
+					return n, err
+				}
+				if x.DocumentationSupport == nil {
+					x.DocumentationSupport = new(bool)
+				}
+				*x.DocumentationSupport = v
+				i = n
+			}
+		case keyEquals(key, `tagSupport`):
+			n, err := x.TagSupport.unmarshalLSP(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		default:
+			_, n, err := dvValue(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		}
+		var done bool
+		i, done, err = dvObjectNext(raw, i)
+		if err != nil {
+			return i, err
+		}
+		if done {
+			return i, nil
+		}
+	}
+}
+
+func (x *CodeActionTagOptions) unmarshalLSP(raw []byte, i int) (int, error) {
+	if n, ok := dvNull(raw, i); ok {
+		*x = CodeActionTagOptions{}
+		return n, nil
+	}
+	if i >= len(raw) || raw[i] != '{' {
+		return i, dvSyntaxError(i, "object")
+	}
+	i = skipSpace(raw, i+1)
+	if i < len(raw) && raw[i] == '}' {
+		return i + 1, nil
+	}
+	for {
+		key, n, err := dvMemberKey(raw, i)
+		if err != nil {
+			return n, err
+		}
+		i = n
+		_ = key
+		switch {
+		case keyEquals(key, `valueSet`):
+			v, n, err := dvUint32Slice(raw, i, x.ValueSet)
+			if err != nil {
+				return n, err
+			}
+			x.ValueSet = v
+			i = n
+		default:
+			_, n, err := dvValue(raw, i)
+			if err != nil {
+				return n, err
+			}
+			i = n
+		}
+		var done bool
+		i, done, err = dvObjectNext(raw, i)
+		if err != nil {
+			return i, err
+		}
+		if done {
+			return i, nil
+		}
+	}
+}
+
+func (x *CodeActionTagOptions) unmarshalLSPValue(raw jsontext.Value) error {
+	i, err := x.unmarshalLSP(raw, skipSpace(raw, 0))
+	if err != nil {
+		return err
+	}
+	return dvEnd(raw, i)
+}
+
+// UnmarshalJSONFrom implements the v2 UnmarshalerFrom interface via the byte walker.
+func (x *CodeActionTagOptions) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	raw, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return x.unmarshalLSPValue(slices.Clone(raw))
+}
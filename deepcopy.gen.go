@@ -0,0 +1,13702 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+package protocol
+
+import (
+	"github.com/go-json-experiment/json/jsontext"
+	"go.lsp.dev/uri"
+	"slices"
+)
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Location) DeepCopy() *Location {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Location) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Location) Equal(y *Location) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.Range.Equal(&y.Range)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressOptions) DeepCopy() *WorkDoneProgressOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressOptions) deepCopyFields() {
+	if x.WorkDoneProgress != nil {
+		v0 := *x.WorkDoneProgress
+		x.WorkDoneProgress = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressOptions) Equal(y *WorkDoneProgressOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.WorkDoneProgress, y.WorkDoneProgress, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentRegistrationOptions) DeepCopy() *TextDocumentRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentRegistrationOptions) deepCopyFields() {
+	if x.DocumentSelector != nil {
+		v0 := *x.DocumentSelector
+		v0 = v0.DeepCopy()
+		x.DocumentSelector = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentRegistrationOptions) Equal(y *TextDocumentRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DocumentSelector, y.DocumentSelector, func(a, b *DocumentSelector) bool { return (*a).Equal(*b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceEdit) DeepCopy() *WorkspaceEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceEdit) deepCopyFields() {
+	if x.Changes != nil {
+		m0 := make(map[uri.URI][]TextEdit, len(x.Changes))
+		for k0, v0 := range x.Changes {
+			k0 = cloneString(k0)
+			v0 = slices.Clone(v0)
+			for i1 := range v0 {
+				v0[i1].deepCopyFields()
+			}
+			m0[k0] = v0
+		}
+		x.Changes = m0
+	}
+	x.DocumentChanges = slices.Clone(x.DocumentChanges)
+	for i0 := range x.DocumentChanges {
+		x.DocumentChanges[i0] = deepCopyDocumentChange(x.DocumentChanges[i0])
+	}
+	if x.ChangeAnnotations != nil {
+		m0 := make(map[ChangeAnnotationIdentifier]ChangeAnnotation, len(x.ChangeAnnotations))
+		for k0, v0 := range x.ChangeAnnotations {
+			k0 = cloneString(k0)
+			v0.deepCopyFields()
+			m0[k0] = v0
+		}
+		x.ChangeAnnotations = m0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceEdit) Equal(y *WorkspaceEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalMap(x.Changes, y.Changes, func(a, b *[]TextEdit) bool { return equalSlice(*a, *b, (*TextEdit).Equal) }) &&
+		equalSlice(x.DocumentChanges, y.DocumentChanges, func(a, b *DocumentChange) bool { return equalDocumentChange(*a, *b) }) &&
+		equalMap(x.ChangeAnnotations, y.ChangeAnnotations, (*ChangeAnnotation).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextEdit) DeepCopy() *TextEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextEdit) deepCopyFields() {
+	x.NewText = cloneString(x.NewText)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextEdit) Equal(y *TextEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.NewText == y.NewText
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SymbolInformation) DeepCopy() *SymbolInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SymbolInformation) deepCopyFields() {
+	x.BaseSymbolInformation.deepCopyFields()
+	if x.Deprecated != nil {
+		v0 := *x.Deprecated
+		x.Deprecated = &v0
+	}
+	x.Location.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SymbolInformation) Equal(y *SymbolInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.BaseSymbolInformation.Equal(&y.BaseSymbolInformation) &&
+		equalPtr(x.Deprecated, y.Deprecated, equalValue[bool]) &&
+		x.Location.Equal(&y.Location)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Command) DeepCopy() *Command {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Command) deepCopyFields() {
+	x.Title = cloneString(x.Title)
+	if x.Tooltip != nil {
+		v0 := *x.Tooltip
+		v0 = cloneString(v0)
+		x.Tooltip = &v0
+	}
+	x.Command = cloneString(x.Command)
+	x.Arguments = slices.Clone(x.Arguments)
+	for i0 := range x.Arguments {
+		x.Arguments[i0] = slices.Clone(x.Arguments[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Command) Equal(y *Command) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Title == y.Title &&
+		equalPtr(x.Tooltip, y.Tooltip, equalValue[string]) &&
+		x.Command == y.Command &&
+		equalSlice(x.Arguments, y.Arguments, func(a, b *LSPAny) bool { return equalRaw(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressBegin) DeepCopy() *WorkDoneProgressBegin {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressBegin) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.Title = cloneString(x.Title)
+	if x.Cancellable != nil {
+		v0 := *x.Cancellable
+		x.Cancellable = &v0
+	}
+	if x.Message != nil {
+		v0 := *x.Message
+		v0 = cloneString(v0)
+		x.Message = &v0
+	}
+	if x.Percentage != nil {
+		v0 := *x.Percentage
+		x.Percentage = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressBegin) Equal(y *WorkDoneProgressBegin) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.Title == y.Title &&
+		equalPtr(x.Cancellable, y.Cancellable, equalValue[bool]) &&
+		equalPtr(x.Message, y.Message, equalValue[string]) &&
+		equalPtr(x.Percentage, y.Percentage, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressReport) DeepCopy() *WorkDoneProgressReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressReport) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	if x.Cancellable != nil {
+		v0 := *x.Cancellable
+		x.Cancellable = &v0
+	}
+	if x.Message != nil {
+		v0 := *x.Message
+		v0 = cloneString(v0)
+		x.Message = &v0
+	}
+	if x.Percentage != nil {
+		v0 := *x.Percentage
+		x.Percentage = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressReport) Equal(y *WorkDoneProgressReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		equalPtr(x.Cancellable, y.Cancellable, equalValue[bool]) &&
+		equalPtr(x.Message, y.Message, equalValue[string]) &&
+		equalPtr(x.Percentage, y.Percentage, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressEnd) DeepCopy() *WorkDoneProgressEnd {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressEnd) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	if x.Message != nil {
+		v0 := *x.Message
+		v0 = cloneString(v0)
+		x.Message = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressEnd) Equal(y *WorkDoneProgressEnd) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		equalPtr(x.Message, y.Message, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CancelParams) DeepCopy() *CancelParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CancelParams) deepCopyFields() {
+	x.ID = deepCopyProgressToken(x.ID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CancelParams) Equal(y *CancelParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.ID, y.ID)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentPositionParams) DeepCopy() *TextDocumentPositionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentPositionParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentPositionParams) Equal(y *TextDocumentPositionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		x.Position.Equal(&y.Position)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressParams) DeepCopy() *WorkDoneProgressParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressParams) deepCopyFields() {
+	x.WorkDoneToken = deepCopyProgressToken(x.WorkDoneToken)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressParams) Equal(y *WorkDoneProgressParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.WorkDoneToken, y.WorkDoneToken)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PartialResultParams) DeepCopy() *PartialResultParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PartialResultParams) deepCopyFields() {
+	x.PartialResultToken = deepCopyProgressToken(x.PartialResultToken)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PartialResultParams) Equal(y *PartialResultParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.PartialResultToken, y.PartialResultToken)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LocationLink) DeepCopy() *LocationLink {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LocationLink) deepCopyFields() {
+	x.OriginSelectionRange = x.OriginSelectionRange.DeepCopy()
+	x.TargetURI = cloneString(x.TargetURI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LocationLink) Equal(y *LocationLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.OriginSelectionRange.Equal(y.OriginSelectionRange) &&
+		x.TargetURI == y.TargetURI &&
+		x.TargetRange.Equal(&y.TargetRange) &&
+		x.TargetSelectionRange.Equal(&y.TargetSelectionRange)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Range) DeepCopy() *Range {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Range) Equal(y *Range) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Start.Equal(&y.Start) &&
+		x.End.Equal(&y.End)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *StaticRegistrationOptions) DeepCopy() *StaticRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *StaticRegistrationOptions) deepCopyFields() {
+	if x.ID != nil {
+		v0 := *x.ID
+		v0 = cloneString(v0)
+		x.ID = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *StaticRegistrationOptions) Equal(y *StaticRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.ID, y.ID, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentIdentifier) DeepCopy() *TextDocumentIdentifier {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentIdentifier) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentIdentifier) Equal(y *TextDocumentIdentifier) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Position) DeepCopy() *Position {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Position) Equal(y *Position) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Line == y.Line &&
+		x.Character == y.Character
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentEdit) DeepCopy() *TextDocumentEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentEdit) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+	x.Edits = slices.Clone(x.Edits)
+	for i0 := range x.Edits {
+		x.Edits[i0] = deepCopyTextDocumentEditElement(x.Edits[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentEdit) Equal(y *TextDocumentEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		equalSlice(x.Edits, y.Edits, func(a, b *TextDocumentEditElement) bool { return equalTextDocumentEditElement(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CreateFile) DeepCopy() *CreateFile {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CreateFile) deepCopyFields() {
+	x.ResourceOperation.deepCopyFields()
+	x.Kind = cloneString(x.Kind)
+	x.URI = cloneString(x.URI)
+	x.Options = x.Options.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CreateFile) Equal(y *CreateFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ResourceOperation.Equal(&y.ResourceOperation) &&
+		x.Kind == y.Kind &&
+		x.URI == y.URI &&
+		x.Options.Equal(y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeleteFile) DeepCopy() *DeleteFile {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeleteFile) deepCopyFields() {
+	x.ResourceOperation.deepCopyFields()
+	x.Kind = cloneString(x.Kind)
+	x.URI = cloneString(x.URI)
+	x.Options = x.Options.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeleteFile) Equal(y *DeleteFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ResourceOperation.Equal(&y.ResourceOperation) &&
+		x.Kind == y.Kind &&
+		x.URI == y.URI &&
+		x.Options.Equal(y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ChangeAnnotation) DeepCopy() *ChangeAnnotation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ChangeAnnotation) deepCopyFields() {
+	x.Label = cloneString(x.Label)
+	if x.NeedsConfirmation != nil {
+		v0 := *x.NeedsConfirmation
+		x.NeedsConfirmation = &v0
+	}
+	if x.Description != nil {
+		v0 := *x.Description
+		v0 = cloneString(v0)
+		x.Description = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ChangeAnnotation) Equal(y *ChangeAnnotation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Label == y.Label &&
+		equalPtr(x.NeedsConfirmation, y.NeedsConfirmation, equalValue[bool]) &&
+		equalPtr(x.Description, y.Description, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MarkupContent) DeepCopy() *MarkupContent {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MarkupContent) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.Value = cloneString(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MarkupContent) Equal(y *MarkupContent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.Value == y.Value
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentItem) DeepCopy() *TextDocumentItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentItem) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	x.LanguageID = cloneString(x.LanguageID)
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentItem) Equal(y *TextDocumentItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.LanguageID == y.LanguageID &&
+		x.Version == y.Version &&
+		x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *StringValue) DeepCopy() *StringValue {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *StringValue) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.Value = cloneString(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *StringValue) Equal(y *StringValue) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.Value == y.Value
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *VersionedTextDocumentIdentifier) DeepCopy() *VersionedTextDocumentIdentifier {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *VersionedTextDocumentIdentifier) deepCopyFields() {
+	x.TextDocumentIdentifier.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *VersionedTextDocumentIdentifier) Equal(y *VersionedTextDocumentIdentifier) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentIdentifier.Equal(&y.TextDocumentIdentifier) &&
+		x.Version == y.Version
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Diagnostic) DeepCopy() *Diagnostic {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Diagnostic) deepCopyFields() {
+	x.Code = deepCopyProgressToken(x.Code)
+	x.CodeDescription.deepCopyFields()
+	if v0, ok := x.Source.Get(); ok {
+		v0 = cloneString(v0)
+		x.Source = NewOptional(v0)
+	}
+	x.Message = deepCopyInlayHintTooltip(x.Message)
+	x.Tags = x.Tags.clone()
+	x.RelatedInformation = slices.Clone(x.RelatedInformation)
+	for i0 := range x.RelatedInformation {
+		x.RelatedInformation[i0].deepCopyFields()
+	}
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Diagnostic) Equal(y *Diagnostic) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Severity == y.Severity &&
+		equalProgressToken(x.Code, y.Code) &&
+		x.CodeDescription.Equal(&y.CodeDescription) &&
+		equalOptional(x.Source, y.Source, equalValue[string]) &&
+		equalInlayHintTooltip(x.Message, y.Message) &&
+		x.Tags.equal(y.Tags) &&
+		equalSlice(x.RelatedInformation, y.RelatedInformation, (*DiagnosticRelatedInformation).Equal) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InsertReplaceEdit) DeepCopy() *InsertReplaceEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InsertReplaceEdit) deepCopyFields() {
+	x.NewText = cloneString(x.NewText)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InsertReplaceEdit) Equal(y *InsertReplaceEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NewText == y.NewText &&
+		x.Insert.Equal(&y.Insert) &&
+		x.Replace.Equal(&y.Replace)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *BaseSymbolInformation) DeepCopy() *BaseSymbolInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *BaseSymbolInformation) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	x.Tags = slices.Clone(x.Tags)
+	if x.ContainerName != nil {
+		v0 := *x.ContainerName
+		v0 = cloneString(v0)
+		x.ContainerName = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *BaseSymbolInformation) Equal(y *BaseSymbolInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		x.Kind == y.Kind &&
+		equalSlice(x.Tags, y.Tags, equalValue[SymbolTag]) &&
+		equalPtr(x.ContainerName, y.ContainerName, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LocationUriOnly) DeepCopy() *LocationUriOnly {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LocationUriOnly) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LocationUriOnly) Equal(y *LocationUriOnly) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *OptionalVersionedTextDocumentIdentifier) DeepCopy() *OptionalVersionedTextDocumentIdentifier {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *OptionalVersionedTextDocumentIdentifier) deepCopyFields() {
+	x.TextDocumentIdentifier.deepCopyFields()
+	if x.Version != nil {
+		v0 := *x.Version
+		x.Version = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *OptionalVersionedTextDocumentIdentifier) Equal(y *OptionalVersionedTextDocumentIdentifier) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentIdentifier.Equal(&y.TextDocumentIdentifier) &&
+		equalPtr(x.Version, y.Version, equalValue[int32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *AnnotatedTextEdit) DeepCopy() *AnnotatedTextEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *AnnotatedTextEdit) deepCopyFields() {
+	x.TextEdit.deepCopyFields()
+	x.AnnotationID = cloneString(x.AnnotationID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *AnnotatedTextEdit) Equal(y *AnnotatedTextEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextEdit.Equal(&y.TextEdit) &&
+		x.AnnotationID == y.AnnotationID
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SnippetTextEdit) DeepCopy() *SnippetTextEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SnippetTextEdit) deepCopyFields() {
+	x.Snippet.deepCopyFields()
+	x.AnnotationID = cloneString(x.AnnotationID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SnippetTextEdit) Equal(y *SnippetTextEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Snippet.Equal(&y.Snippet) &&
+		x.AnnotationID == y.AnnotationID
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ResourceOperation) DeepCopy() *ResourceOperation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ResourceOperation) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.AnnotationID = cloneString(x.AnnotationID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ResourceOperation) Equal(y *ResourceOperation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.AnnotationID == y.AnnotationID
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CreateFileOptions) DeepCopy() *CreateFileOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CreateFileOptions) deepCopyFields() {
+	if x.Overwrite != nil {
+		v0 := *x.Overwrite
+		x.Overwrite = &v0
+	}
+	if x.IgnoreIfExists != nil {
+		v0 := *x.IgnoreIfExists
+		x.IgnoreIfExists = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CreateFileOptions) Equal(y *CreateFileOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Overwrite, y.Overwrite, equalValue[bool]) &&
+		equalPtr(x.IgnoreIfExists, y.IgnoreIfExists, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeleteFileOptions) DeepCopy() *DeleteFileOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeleteFileOptions) deepCopyFields() {
+	if x.Recursive != nil {
+		v0 := *x.Recursive
+		x.Recursive = &v0
+	}
+	if x.IgnoreIfNotExists != nil {
+		v0 := *x.IgnoreIfNotExists
+		x.IgnoreIfNotExists = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeleteFileOptions) Equal(y *DeleteFileOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Recursive, y.Recursive, equalValue[bool]) &&
+		equalPtr(x.IgnoreIfNotExists, y.IgnoreIfNotExists, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeDescription) DeepCopy() *CodeDescription {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeDescription) deepCopyFields() {
+	x.Href = cloneString(x.Href)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeDescription) Equal(y *CodeDescription) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Href == y.Href
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticRelatedInformation) DeepCopy() *DiagnosticRelatedInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticRelatedInformation) deepCopyFields() {
+	x.Location.deepCopyFields()
+	x.Message = cloneString(x.Message)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticRelatedInformation) Equal(y *DiagnosticRelatedInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Location.Equal(&y.Location) &&
+		x.Message == y.Message
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MarkedStringWithLanguage) DeepCopy() *MarkedStringWithLanguage {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MarkedStringWithLanguage) deepCopyFields() {
+	x.Language = cloneString(x.Language)
+	x.Value = cloneString(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MarkedStringWithLanguage) Equal(y *MarkedStringWithLanguage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Language == y.Language &&
+		x.Value == y.Value
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentFilterLanguage) DeepCopy() *TextDocumentFilterLanguage {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentFilterLanguage) deepCopyFields() {
+	x.Language = cloneString(x.Language)
+	if x.Scheme != nil {
+		v0 := *x.Scheme
+		v0 = cloneString(v0)
+		x.Scheme = &v0
+	}
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentFilterLanguage) Equal(y *TextDocumentFilterLanguage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Language == y.Language &&
+		equalPtr(x.Scheme, y.Scheme, equalValue[string]) &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentFilterScheme) DeepCopy() *TextDocumentFilterScheme {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentFilterScheme) deepCopyFields() {
+	if x.Language != nil {
+		v0 := *x.Language
+		v0 = cloneString(v0)
+		x.Language = &v0
+	}
+	x.Scheme = cloneString(x.Scheme)
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentFilterScheme) Equal(y *TextDocumentFilterScheme) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Language, y.Language, equalValue[string]) &&
+		x.Scheme == y.Scheme &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentFilterPattern) DeepCopy() *TextDocumentFilterPattern {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentFilterPattern) deepCopyFields() {
+	if x.Language != nil {
+		v0 := *x.Language
+		v0 = cloneString(v0)
+		x.Language = &v0
+	}
+	if x.Scheme != nil {
+		v0 := *x.Scheme
+		v0 = cloneString(v0)
+		x.Scheme = &v0
+	}
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentFilterPattern) Equal(y *TextDocumentFilterPattern) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Language, y.Language, equalValue[string]) &&
+		equalPtr(x.Scheme, y.Scheme, equalValue[string]) &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceEditClientCapabilities) DeepCopy() *WorkspaceEditClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceEditClientCapabilities) deepCopyFields() {
+	if x.DocumentChanges != nil {
+		v0 := *x.DocumentChanges
+		x.DocumentChanges = &v0
+	}
+	x.ResourceOperations = slices.Clone(x.ResourceOperations)
+	for i0 := range x.ResourceOperations {
+		x.ResourceOperations[i0] = cloneString(x.ResourceOperations[i0])
+	}
+	x.FailureHandling = cloneString(x.FailureHandling)
+	if x.NormalizesLineEndings != nil {
+		v0 := *x.NormalizesLineEndings
+		x.NormalizesLineEndings = &v0
+	}
+	x.ChangeAnnotationSupport = x.ChangeAnnotationSupport.DeepCopy()
+	if x.MetadataSupport != nil {
+		v0 := *x.MetadataSupport
+		x.MetadataSupport = &v0
+	}
+	if x.SnippetEditSupport != nil {
+		v0 := *x.SnippetEditSupport
+		x.SnippetEditSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceEditClientCapabilities) Equal(y *WorkspaceEditClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DocumentChanges, y.DocumentChanges, equalValue[bool]) &&
+		equalSlice(x.ResourceOperations, y.ResourceOperations, equalValue[ResourceOperationKind]) &&
+		x.FailureHandling == y.FailureHandling &&
+		equalPtr(x.NormalizesLineEndings, y.NormalizesLineEndings, equalValue[bool]) &&
+		x.ChangeAnnotationSupport.Equal(y.ChangeAnnotationSupport) &&
+		equalPtr(x.MetadataSupport, y.MetadataSupport, equalValue[bool]) &&
+		equalPtr(x.SnippetEditSupport, y.SnippetEditSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentFilterClientCapabilities) DeepCopy() *TextDocumentFilterClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentFilterClientCapabilities) deepCopyFields() {
+	if x.RelativePatternSupport != nil {
+		v0 := *x.RelativePatternSupport
+		x.RelativePatternSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentFilterClientCapabilities) Equal(y *TextDocumentFilterClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RelativePatternSupport, y.RelativePatternSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RegularExpressionsClientCapabilities) DeepCopy() *RegularExpressionsClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RegularExpressionsClientCapabilities) deepCopyFields() {
+	x.Engine = cloneString(x.Engine)
+	if x.Version != nil {
+		v0 := *x.Version
+		v0 = cloneString(v0)
+		x.Version = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RegularExpressionsClientCapabilities) Equal(y *RegularExpressionsClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Engine == y.Engine &&
+		equalPtr(x.Version, y.Version, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MarkdownClientCapabilities) DeepCopy() *MarkdownClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MarkdownClientCapabilities) deepCopyFields() {
+	x.Parser = cloneString(x.Parser)
+	if x.Version != nil {
+		v0 := *x.Version
+		v0 = cloneString(v0)
+		x.Version = &v0
+	}
+	x.AllowedTags = slices.Clone(x.AllowedTags)
+	for i0 := range x.AllowedTags {
+		x.AllowedTags[i0] = cloneString(x.AllowedTags[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MarkdownClientCapabilities) Equal(y *MarkdownClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Parser == y.Parser &&
+		equalPtr(x.Version, y.Version, equalValue[string]) &&
+		equalSlice(x.AllowedTags, y.AllowedTags, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ChangeAnnotationsSupportOptions) DeepCopy() *ChangeAnnotationsSupportOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ChangeAnnotationsSupportOptions) deepCopyFields() {
+	if x.GroupsOnLabel != nil {
+		v0 := *x.GroupsOnLabel
+		x.GroupsOnLabel = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ChangeAnnotationsSupportOptions) Equal(y *ChangeAnnotationsSupportOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.GroupsOnLabel, y.GroupsOnLabel, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InitializeParams) DeepCopy() *InitializeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InitializeParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.WorkspaceFoldersInitializeParams.deepCopyFields()
+	if x.ProcessID != nil {
+		v0 := *x.ProcessID
+		x.ProcessID = &v0
+	}
+	x.ClientInfo.deepCopyFields()
+	if x.Locale != nil {
+		v0 := *x.Locale
+		v0 = cloneString(v0)
+		x.Locale = &v0
+	}
+	if v0, ok := x.RootPath.Get(); ok {
+		v0 = cloneString(v0)
+		x.RootPath = NewNullable(v0)
+	}
+	if x.RootURI != nil {
+		v0 := *x.RootURI
+		v0 = cloneString(v0)
+		x.RootURI = &v0
+	}
+	x.Capabilities.deepCopyFields()
+	x.InitializationOptions = slices.Clone(x.InitializationOptions)
+	x.Trace = cloneString(x.Trace)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InitializeParams) Equal(y *InitializeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.WorkspaceFoldersInitializeParams.Equal(&y.WorkspaceFoldersInitializeParams) &&
+		equalPtr(x.ProcessID, y.ProcessID, equalValue[int32]) &&
+		x.ClientInfo.Equal(&y.ClientInfo) &&
+		equalPtr(x.Locale, y.Locale, equalValue[string]) &&
+		equalNullable(x.RootPath, y.RootPath, equalValue[string]) &&
+		equalPtr(x.RootURI, y.RootURI, equalValue[uri.URI]) &&
+		x.Capabilities.Equal(&y.Capabilities) &&
+		equalRaw(x.InitializationOptions, y.InitializationOptions) &&
+		x.Trace == y.Trace
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InitializeResult) DeepCopy() *InitializeResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InitializeResult) deepCopyFields() {
+	x.Capabilities.deepCopyFields()
+	x.ServerInfo.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InitializeResult) Equal(y *InitializeResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Capabilities.Equal(&y.Capabilities) &&
+		x.ServerInfo.Equal(&y.ServerInfo)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InitializeError) DeepCopy() *InitializeError {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InitializeError) Equal(y *InitializeError) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Retry == y.Retry
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InitializedParams) DeepCopy() *InitializedParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InitializedParams) Equal(y *InitializedParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ServerCapabilities) DeepCopy() *ServerCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ServerCapabilities) deepCopyFields() {
+	x.PositionEncoding = cloneString(x.PositionEncoding)
+	x.TextDocumentSync = deepCopyTextDocumentSync(x.TextDocumentSync)
+	x.NotebookDocumentSync = deepCopyNotebookDocumentSync(x.NotebookDocumentSync)
+	x.CompletionProvider = x.CompletionProvider.DeepCopy()
+	x.HoverProvider = deepCopyHoverProvider(x.HoverProvider)
+	x.SignatureHelpProvider = x.SignatureHelpProvider.DeepCopy()
+	x.DeclarationProvider = deepCopyDeclarationProvider(x.DeclarationProvider)
+	x.DefinitionProvider = deepCopyDefinitionProvider(x.DefinitionProvider)
+	x.TypeDefinitionProvider = deepCopyTypeDefinitionProvider(x.TypeDefinitionProvider)
+	x.ImplementationProvider = deepCopyImplementationProvider(x.ImplementationProvider)
+	x.ReferencesProvider = deepCopyReferencesProvider(x.ReferencesProvider)
+	x.DocumentHighlightProvider = deepCopyDocumentHighlightProvider(x.DocumentHighlightProvider)
+	x.DocumentSymbolProvider = deepCopyDocumentSymbolProvider(x.DocumentSymbolProvider)
+	x.CodeActionProvider = deepCopyCodeActionProvider(x.CodeActionProvider)
+	x.CodeLensProvider = x.CodeLensProvider.DeepCopy()
+	x.DocumentLinkProvider = x.DocumentLinkProvider.DeepCopy()
+	x.ColorProvider = deepCopyColorProvider(x.ColorProvider)
+	x.WorkspaceSymbolProvider = deepCopyWorkspaceSymbolProvider(x.WorkspaceSymbolProvider)
+	x.DocumentFormattingProvider = deepCopyDocumentFormattingProvider(x.DocumentFormattingProvider)
+	x.DocumentRangeFormattingProvider = deepCopyDocumentRangeFormattingProvider(x.DocumentRangeFormattingProvider)
+	x.DocumentOnTypeFormattingProvider.deepCopyFields()
+	x.RenameProvider = deepCopyRenameProvider(x.RenameProvider)
+	x.FoldingRangeProvider = deepCopyFoldingRangeProvider(x.FoldingRangeProvider)
+	x.SelectionRangeProvider = deepCopySelectionRangeProvider(x.SelectionRangeProvider)
+	x.ExecuteCommandProvider.deepCopyFields()
+	x.CallHierarchyProvider = deepCopyCallHierarchyProvider(x.CallHierarchyProvider)
+	x.LinkedEditingRangeProvider = deepCopyLinkedEditingRangeProvider(x.LinkedEditingRangeProvider)
+	x.SemanticTokensProvider = deepCopySemanticTokensProvider(x.SemanticTokensProvider)
+	x.MonikerProvider = deepCopyMonikerProvider(x.MonikerProvider)
+	x.TypeHierarchyProvider = deepCopyTypeHierarchyProvider(x.TypeHierarchyProvider)
+	x.InlineValueProvider = deepCopyInlineValueProvider(x.InlineValueProvider)
+	x.InlayHintProvider = deepCopyInlayHintProvider(x.InlayHintProvider)
+	x.DiagnosticProvider = deepCopyDiagnosticProvider(x.DiagnosticProvider)
+	x.InlineCompletionProvider = deepCopyInlineCompletionProvider(x.InlineCompletionProvider)
+	x.Workspace = x.Workspace.DeepCopy()
+	x.Experimental = slices.Clone(x.Experimental)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ServerCapabilities) Equal(y *ServerCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.PositionEncoding == y.PositionEncoding &&
+		equalTextDocumentSync(x.TextDocumentSync, y.TextDocumentSync) &&
+		equalNotebookDocumentSync(x.NotebookDocumentSync, y.NotebookDocumentSync) &&
+		x.CompletionProvider.Equal(y.CompletionProvider) &&
+		equalHoverProvider(x.HoverProvider, y.HoverProvider) &&
+		x.SignatureHelpProvider.Equal(y.SignatureHelpProvider) &&
+		equalDeclarationProvider(x.DeclarationProvider, y.DeclarationProvider) &&
+		equalDefinitionProvider(x.DefinitionProvider, y.DefinitionProvider) &&
+		equalTypeDefinitionProvider(x.TypeDefinitionProvider, y.TypeDefinitionProvider) &&
+		equalImplementationProvider(x.ImplementationProvider, y.ImplementationProvider) &&
+		equalReferencesProvider(x.ReferencesProvider, y.ReferencesProvider) &&
+		equalDocumentHighlightProvider(x.DocumentHighlightProvider, y.DocumentHighlightProvider) &&
+		equalDocumentSymbolProvider(x.DocumentSymbolProvider, y.DocumentSymbolProvider) &&
+		equalCodeActionProvider(x.CodeActionProvider, y.CodeActionProvider) &&
+		x.CodeLensProvider.Equal(y.CodeLensProvider) &&
+		x.DocumentLinkProvider.Equal(y.DocumentLinkProvider) &&
+		equalColorProvider(x.ColorProvider, y.ColorProvider) &&
+		equalWorkspaceSymbolProvider(x.WorkspaceSymbolProvider, y.WorkspaceSymbolProvider) &&
+		equalDocumentFormattingProvider(x.DocumentFormattingProvider, y.DocumentFormattingProvider) &&
+		equalDocumentRangeFormattingProvider(x.DocumentRangeFormattingProvider, y.DocumentRangeFormattingProvider) &&
+		x.DocumentOnTypeFormattingProvider.Equal(&y.DocumentOnTypeFormattingProvider) &&
+		equalRenameProvider(x.RenameProvider, y.RenameProvider) &&
+		equalFoldingRangeProvider(x.FoldingRangeProvider, y.FoldingRangeProvider) &&
+		equalSelectionRangeProvider(x.SelectionRangeProvider, y.SelectionRangeProvider) &&
+		x.ExecuteCommandProvider.Equal(&y.ExecuteCommandProvider) &&
+		equalCallHierarchyProvider(x.CallHierarchyProvider, y.CallHierarchyProvider) &&
+		equalLinkedEditingRangeProvider(x.LinkedEditingRangeProvider, y.LinkedEditingRangeProvider) &&
+		equalSemanticTokensProvider(x.SemanticTokensProvider, y.SemanticTokensProvider) &&
+		equalMonikerProvider(x.MonikerProvider, y.MonikerProvider) &&
+		equalTypeHierarchyProvider(x.TypeHierarchyProvider, y.TypeHierarchyProvider) &&
+		equalInlineValueProvider(x.InlineValueProvider, y.InlineValueProvider) &&
+		equalInlayHintProvider(x.InlayHintProvider, y.InlayHintProvider) &&
+		equalDiagnosticProvider(x.DiagnosticProvider, y.DiagnosticProvider) &&
+		equalInlineCompletionProvider(x.InlineCompletionProvider, y.InlineCompletionProvider) &&
+		x.Workspace.Equal(y.Workspace) &&
+		equalRaw(x.Experimental, y.Experimental)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ServerInfo) DeepCopy() *ServerInfo {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ServerInfo) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	if v0, ok := x.Version.Get(); ok {
+		v0 = cloneString(v0)
+		x.Version = NewOptional(v0)
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ServerInfo) Equal(y *ServerInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		equalOptional(x.Version, y.Version, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientInfo) DeepCopy() *ClientInfo {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientInfo) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	if v0, ok := x.Version.Get(); ok {
+		v0 = cloneString(v0)
+		x.Version = NewOptional(v0)
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientInfo) Equal(y *ClientInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		equalOptional(x.Version, y.Version, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCapabilities) DeepCopy() *ClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCapabilities) deepCopyFields() {
+	x.Workspace = x.Workspace.DeepCopy()
+	x.TextDocument = x.TextDocument.DeepCopy()
+	x.NotebookDocument = x.NotebookDocument.DeepCopy()
+	x.Window = x.Window.DeepCopy()
+	x.General = x.General.DeepCopy()
+	x.Experimental = slices.Clone(x.Experimental)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCapabilities) Equal(y *ClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Workspace.Equal(y.Workspace) &&
+		x.TextDocument.Equal(y.TextDocument) &&
+		x.NotebookDocument.Equal(y.NotebookDocument) &&
+		x.Window.Equal(y.Window) &&
+		x.General.Equal(y.General) &&
+		equalRaw(x.Experimental, y.Experimental)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceOptions) DeepCopy() *WorkspaceOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceOptions) deepCopyFields() {
+	x.WorkspaceFolders = x.WorkspaceFolders.DeepCopy()
+	x.FileOperations = x.FileOperations.DeepCopy()
+	x.TextDocumentContent = deepCopyWorkspaceOptionsTextDocumentContent(x.TextDocumentContent)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceOptions) Equal(y *WorkspaceOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkspaceFolders.Equal(y.WorkspaceFolders) &&
+		x.FileOperations.Equal(y.FileOperations) &&
+		equalWorkspaceOptionsTextDocumentContent(x.TextDocumentContent, y.TextDocumentContent)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceClientCapabilities) DeepCopy() *WorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceClientCapabilities) deepCopyFields() {
+	if x.ApplyEdit != nil {
+		v0 := *x.ApplyEdit
+		x.ApplyEdit = &v0
+	}
+	x.WorkspaceEdit = x.WorkspaceEdit.DeepCopy()
+	x.DidChangeConfiguration = x.DidChangeConfiguration.DeepCopy()
+	x.DidChangeWatchedFiles = x.DidChangeWatchedFiles.DeepCopy()
+	x.Symbol = x.Symbol.DeepCopy()
+	x.ExecuteCommand = x.ExecuteCommand.DeepCopy()
+	if x.WorkspaceFolders != nil {
+		v0 := *x.WorkspaceFolders
+		x.WorkspaceFolders = &v0
+	}
+	if x.Configuration != nil {
+		v0 := *x.Configuration
+		x.Configuration = &v0
+	}
+	x.SemanticTokens = x.SemanticTokens.DeepCopy()
+	x.CodeLens = x.CodeLens.DeepCopy()
+	x.FileOperations = x.FileOperations.DeepCopy()
+	x.InlineValue = x.InlineValue.DeepCopy()
+	x.InlayHint = x.InlayHint.DeepCopy()
+	x.Diagnostics = x.Diagnostics.DeepCopy()
+	x.FoldingRange = x.FoldingRange.DeepCopy()
+	x.TextDocumentContent = x.TextDocumentContent.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceClientCapabilities) Equal(y *WorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.ApplyEdit, y.ApplyEdit, equalValue[bool]) &&
+		x.WorkspaceEdit.Equal(y.WorkspaceEdit) &&
+		x.DidChangeConfiguration.Equal(y.DidChangeConfiguration) &&
+		x.DidChangeWatchedFiles.Equal(y.DidChangeWatchedFiles) &&
+		x.Symbol.Equal(y.Symbol) &&
+		x.ExecuteCommand.Equal(y.ExecuteCommand) &&
+		equalPtr(x.WorkspaceFolders, y.WorkspaceFolders, equalValue[bool]) &&
+		equalPtr(x.Configuration, y.Configuration, equalValue[bool]) &&
+		x.SemanticTokens.Equal(y.SemanticTokens) &&
+		x.CodeLens.Equal(y.CodeLens) &&
+		x.FileOperations.Equal(y.FileOperations) &&
+		x.InlineValue.Equal(y.InlineValue) &&
+		x.InlayHint.Equal(y.InlayHint) &&
+		x.Diagnostics.Equal(y.Diagnostics) &&
+		x.FoldingRange.Equal(y.FoldingRange) &&
+		x.TextDocumentContent.Equal(y.TextDocumentContent)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentClientCapabilities) DeepCopy() *TextDocumentClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentClientCapabilities) deepCopyFields() {
+	x.Synchronization = x.Synchronization.DeepCopy()
+	x.Filters = x.Filters.DeepCopy()
+	x.Completion = x.Completion.DeepCopy()
+	x.Hover = x.Hover.DeepCopy()
+	x.SignatureHelp = x.SignatureHelp.DeepCopy()
+	x.Declaration = x.Declaration.DeepCopy()
+	x.Definition = x.Definition.DeepCopy()
+	x.TypeDefinition = x.TypeDefinition.DeepCopy()
+	x.Implementation = x.Implementation.DeepCopy()
+	x.References = x.References.DeepCopy()
+	x.DocumentHighlight = x.DocumentHighlight.DeepCopy()
+	x.DocumentSymbol = x.DocumentSymbol.DeepCopy()
+	x.CodeAction = x.CodeAction.DeepCopy()
+	x.CodeLens = x.CodeLens.DeepCopy()
+	x.DocumentLink = x.DocumentLink.DeepCopy()
+	x.ColorProvider = x.ColorProvider.DeepCopy()
+	x.Formatting = x.Formatting.DeepCopy()
+	x.RangeFormatting = x.RangeFormatting.DeepCopy()
+	x.OnTypeFormatting = x.OnTypeFormatting.DeepCopy()
+	x.Rename = x.Rename.DeepCopy()
+	x.FoldingRange = x.FoldingRange.DeepCopy()
+	x.SelectionRange = x.SelectionRange.DeepCopy()
+	x.PublishDiagnostics = x.PublishDiagnostics.DeepCopy()
+	x.CallHierarchy = x.CallHierarchy.DeepCopy()
+	x.SemanticTokens.deepCopyFields()
+	x.LinkedEditingRange = x.LinkedEditingRange.DeepCopy()
+	x.Moniker = x.Moniker.DeepCopy()
+	x.TypeHierarchy = x.TypeHierarchy.DeepCopy()
+	x.InlineValue = x.InlineValue.DeepCopy()
+	x.InlayHint = x.InlayHint.DeepCopy()
+	x.Diagnostic = x.Diagnostic.DeepCopy()
+	x.InlineCompletion = x.InlineCompletion.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentClientCapabilities) Equal(y *TextDocumentClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Synchronization.Equal(y.Synchronization) &&
+		x.Filters.Equal(y.Filters) &&
+		x.Completion.Equal(y.Completion) &&
+		x.Hover.Equal(y.Hover) &&
+		x.SignatureHelp.Equal(y.SignatureHelp) &&
+		x.Declaration.Equal(y.Declaration) &&
+		x.Definition.Equal(y.Definition) &&
+		x.TypeDefinition.Equal(y.TypeDefinition) &&
+		x.Implementation.Equal(y.Implementation) &&
+		x.References.Equal(y.References) &&
+		x.DocumentHighlight.Equal(y.DocumentHighlight) &&
+		x.DocumentSymbol.Equal(y.DocumentSymbol) &&
+		x.CodeAction.Equal(y.CodeAction) &&
+		x.CodeLens.Equal(y.CodeLens) &&
+		x.DocumentLink.Equal(y.DocumentLink) &&
+		x.ColorProvider.Equal(y.ColorProvider) &&
+		x.Formatting.Equal(y.Formatting) &&
+		x.RangeFormatting.Equal(y.RangeFormatting) &&
+		x.OnTypeFormatting.Equal(y.OnTypeFormatting) &&
+		x.Rename.Equal(y.Rename) &&
+		x.FoldingRange.Equal(y.FoldingRange) &&
+		x.SelectionRange.Equal(y.SelectionRange) &&
+		x.PublishDiagnostics.Equal(y.PublishDiagnostics) &&
+		x.CallHierarchy.Equal(y.CallHierarchy) &&
+		x.SemanticTokens.Equal(&y.SemanticTokens) &&
+		x.LinkedEditingRange.Equal(y.LinkedEditingRange) &&
+		x.Moniker.Equal(y.Moniker) &&
+		x.TypeHierarchy.Equal(y.TypeHierarchy) &&
+		x.InlineValue.Equal(y.InlineValue) &&
+		x.InlayHint.Equal(y.InlayHint) &&
+		x.Diagnostic.Equal(y.Diagnostic) &&
+		x.InlineCompletion.Equal(y.InlineCompletion)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentClientCapabilities) DeepCopy() *NotebookDocumentClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentClientCapabilities) deepCopyFields() {
+	x.Synchronization.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentClientCapabilities) Equal(y *NotebookDocumentClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Synchronization.Equal(&y.Synchronization)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WindowClientCapabilities) DeepCopy() *WindowClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WindowClientCapabilities) deepCopyFields() {
+	if x.WorkDoneProgress != nil {
+		v0 := *x.WorkDoneProgress
+		x.WorkDoneProgress = &v0
+	}
+	x.ShowMessage = x.ShowMessage.DeepCopy()
+	x.ShowDocument = x.ShowDocument.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WindowClientCapabilities) Equal(y *WindowClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.WorkDoneProgress, y.WorkDoneProgress, equalValue[bool]) &&
+		x.ShowMessage.Equal(y.ShowMessage) &&
+		x.ShowDocument.Equal(y.ShowDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *GeneralClientCapabilities) DeepCopy() *GeneralClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *GeneralClientCapabilities) deepCopyFields() {
+	x.StaleRequestSupport.deepCopyFields()
+	x.RegularExpressions.deepCopyFields()
+	x.Markdown.deepCopyFields()
+	x.PositionEncodings = slices.Clone(x.PositionEncodings)
+	for i0 := range x.PositionEncodings {
+		x.PositionEncodings[i0] = cloneString(x.PositionEncodings[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *GeneralClientCapabilities) Equal(y *GeneralClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.StaleRequestSupport.Equal(&y.StaleRequestSupport) &&
+		x.RegularExpressions.Equal(&y.RegularExpressions) &&
+		x.Markdown.Equal(&y.Markdown) &&
+		equalSlice(x.PositionEncodings, y.PositionEncodings, equalValue[PositionEncodingKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceFoldersServerCapabilities) DeepCopy() *WorkspaceFoldersServerCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceFoldersServerCapabilities) deepCopyFields() {
+	if x.Supported != nil {
+		v0 := *x.Supported
+		x.Supported = &v0
+	}
+	x.ChangeNotifications = deepCopyChangeNotifications(x.ChangeNotifications)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceFoldersServerCapabilities) Equal(y *WorkspaceFoldersServerCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Supported, y.Supported, equalValue[bool]) &&
+		equalChangeNotifications(x.ChangeNotifications, y.ChangeNotifications)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *StaleRequestSupportOptions) DeepCopy() *StaleRequestSupportOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *StaleRequestSupportOptions) deepCopyFields() {
+	x.RetryOnContentModified = slices.Clone(x.RetryOnContentModified)
+	for i0 := range x.RetryOnContentModified {
+		x.RetryOnContentModified[i0] = cloneString(x.RetryOnContentModified[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *StaleRequestSupportOptions) Equal(y *StaleRequestSupportOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Cancel == y.Cancel &&
+		equalSlice(x.RetryOnContentModified, y.RetryOnContentModified, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RegistrationParams) DeepCopy() *RegistrationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RegistrationParams) deepCopyFields() {
+	x.Registrations = slices.Clone(x.Registrations)
+	for i0 := range x.Registrations {
+		x.Registrations[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RegistrationParams) Equal(y *RegistrationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Registrations, y.Registrations, (*Registration).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *UnregistrationParams) DeepCopy() *UnregistrationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *UnregistrationParams) deepCopyFields() {
+	x.Unregisterations = slices.Clone(x.Unregisterations)
+	for i0 := range x.Unregisterations {
+		x.Unregisterations[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *UnregistrationParams) Equal(y *UnregistrationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Unregisterations, y.Unregisterations, (*Unregistration).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Registration) DeepCopy() *Registration {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Registration) deepCopyFields() {
+	x.ID = cloneString(x.ID)
+	x.Method = cloneString(x.Method)
+	x.RegisterOptions = slices.Clone(x.RegisterOptions)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Registration) Equal(y *Registration) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ID == y.ID &&
+		x.Method == y.Method &&
+		equalRaw(x.RegisterOptions, y.RegisterOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Unregistration) DeepCopy() *Unregistration {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Unregistration) deepCopyFields() {
+	x.ID = cloneString(x.ID)
+	x.Method = cloneString(x.Method)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Unregistration) Equal(y *Unregistration) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ID == y.ID &&
+		x.Method == y.Method
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SetTraceParams) DeepCopy() *SetTraceParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SetTraceParams) deepCopyFields() {
+	x.Value = cloneString(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SetTraceParams) Equal(y *SetTraceParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Value == y.Value
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LogTraceParams) DeepCopy() *LogTraceParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LogTraceParams) deepCopyFields() {
+	x.Message = cloneString(x.Message)
+	if x.Verbose != nil {
+		v0 := *x.Verbose
+		v0 = cloneString(v0)
+		x.Verbose = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LogTraceParams) Equal(y *LogTraceParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Message == y.Message &&
+		equalPtr(x.Verbose, y.Verbose, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidOpenTextDocumentParams) DeepCopy() *DidOpenTextDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidOpenTextDocumentParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidOpenTextDocumentParams) Equal(y *DidOpenTextDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeTextDocumentParams) DeepCopy() *DidChangeTextDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeTextDocumentParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+	x.ContentChanges = slices.Clone(x.ContentChanges)
+	for i0 := range x.ContentChanges {
+		x.ContentChanges[i0] = deepCopyTextDocumentContentChangeEvent(x.ContentChanges[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeTextDocumentParams) Equal(y *DidChangeTextDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		equalSlice(x.ContentChanges, y.ContentChanges, func(a, b *TextDocumentContentChangeEvent) bool { return equalTextDocumentContentChangeEvent(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentChangeRegistrationOptions) DeepCopy() *TextDocumentChangeRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentChangeRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentChangeRegistrationOptions) Equal(y *TextDocumentChangeRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.SyncKind == y.SyncKind
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WillSaveTextDocumentParams) DeepCopy() *WillSaveTextDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WillSaveTextDocumentParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WillSaveTextDocumentParams) Equal(y *WillSaveTextDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		x.Reason == y.Reason
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidSaveTextDocumentParams) DeepCopy() *DidSaveTextDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidSaveTextDocumentParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+	if x.Text != nil {
+		v0 := *x.Text
+		v0 = cloneString(v0)
+		x.Text = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidSaveTextDocumentParams) Equal(y *DidSaveTextDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		equalPtr(x.Text, y.Text, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentSaveRegistrationOptions) DeepCopy() *TextDocumentSaveRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentSaveRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.SaveOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentSaveRegistrationOptions) Equal(y *TextDocumentSaveRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.SaveOptions.Equal(&y.SaveOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SaveOptions) DeepCopy() *SaveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SaveOptions) deepCopyFields() {
+	if x.IncludeText != nil {
+		v0 := *x.IncludeText
+		x.IncludeText = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SaveOptions) Equal(y *SaveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.IncludeText, y.IncludeText, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidCloseTextDocumentParams) DeepCopy() *DidCloseTextDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidCloseTextDocumentParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidCloseTextDocumentParams) Equal(y *DidCloseTextDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentSyncOptions) DeepCopy() *TextDocumentSyncOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentSyncOptions) deepCopyFields() {
+	if x.OpenClose != nil {
+		v0 := *x.OpenClose
+		x.OpenClose = &v0
+	}
+	if x.Change != nil {
+		v0 := *x.Change
+		x.Change = &v0
+	}
+	if x.WillSave != nil {
+		v0 := *x.WillSave
+		x.WillSave = &v0
+	}
+	if x.WillSaveWaitUntil != nil {
+		v0 := *x.WillSaveWaitUntil
+		x.WillSaveWaitUntil = &v0
+	}
+	x.Save = deepCopyTextDocumentSyncOptionsSave(x.Save)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentSyncOptions) Equal(y *TextDocumentSyncOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.OpenClose, y.OpenClose, equalValue[bool]) &&
+		equalPtr(x.Change, y.Change, equalValue[TextDocumentSyncKind]) &&
+		equalPtr(x.WillSave, y.WillSave, equalValue[bool]) &&
+		equalPtr(x.WillSaveWaitUntil, y.WillSaveWaitUntil, equalValue[bool]) &&
+		equalTextDocumentSyncOptionsSave(x.Save, y.Save)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentSyncClientCapabilities) DeepCopy() *TextDocumentSyncClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentSyncClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.WillSave != nil {
+		v0 := *x.WillSave
+		x.WillSave = &v0
+	}
+	if x.WillSaveWaitUntil != nil {
+		v0 := *x.WillSaveWaitUntil
+		x.WillSaveWaitUntil = &v0
+	}
+	if x.DidSave != nil {
+		v0 := *x.DidSave
+		x.DidSave = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentSyncClientCapabilities) Equal(y *TextDocumentSyncClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.WillSave, y.WillSave, equalValue[bool]) &&
+		equalPtr(x.WillSaveWaitUntil, y.WillSaveWaitUntil, equalValue[bool]) &&
+		equalPtr(x.DidSave, y.DidSave, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidOpenNotebookDocumentParams) DeepCopy() *DidOpenNotebookDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidOpenNotebookDocumentParams) deepCopyFields() {
+	x.NotebookDocument.deepCopyFields()
+	x.CellTextDocuments = slices.Clone(x.CellTextDocuments)
+	for i0 := range x.CellTextDocuments {
+		x.CellTextDocuments[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidOpenNotebookDocumentParams) Equal(y *DidOpenNotebookDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookDocument.Equal(&y.NotebookDocument) &&
+		equalSlice(x.CellTextDocuments, y.CellTextDocuments, (*TextDocumentItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentSyncRegistrationOptions) DeepCopy() *NotebookDocumentSyncRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentSyncRegistrationOptions) deepCopyFields() {
+	x.NotebookDocumentSyncOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentSyncRegistrationOptions) Equal(y *NotebookDocumentSyncRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookDocumentSyncOptions.Equal(&y.NotebookDocumentSyncOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeNotebookDocumentParams) DeepCopy() *DidChangeNotebookDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeNotebookDocumentParams) deepCopyFields() {
+	x.NotebookDocument.deepCopyFields()
+	x.Change.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeNotebookDocumentParams) Equal(y *DidChangeNotebookDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookDocument.Equal(&y.NotebookDocument) &&
+		x.Change.Equal(&y.Change)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidSaveNotebookDocumentParams) DeepCopy() *DidSaveNotebookDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidSaveNotebookDocumentParams) deepCopyFields() {
+	x.NotebookDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidSaveNotebookDocumentParams) Equal(y *DidSaveNotebookDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookDocument.Equal(&y.NotebookDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidCloseNotebookDocumentParams) DeepCopy() *DidCloseNotebookDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidCloseNotebookDocumentParams) deepCopyFields() {
+	x.NotebookDocument.deepCopyFields()
+	x.CellTextDocuments = slices.Clone(x.CellTextDocuments)
+	for i0 := range x.CellTextDocuments {
+		x.CellTextDocuments[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidCloseNotebookDocumentParams) Equal(y *DidCloseNotebookDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookDocument.Equal(&y.NotebookDocument) &&
+		equalSlice(x.CellTextDocuments, y.CellTextDocuments, (*TextDocumentIdentifier).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocument) DeepCopy() *NotebookDocument {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocument) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	x.NotebookType = cloneString(x.NotebookType)
+	if x.Metadata != nil {
+		m0 := make(LSPObject, len(x.Metadata))
+		for k0, v0 := range x.Metadata {
+			k0 = cloneString(k0)
+			v0 = slices.Clone(v0)
+			m0[k0] = v0
+		}
+		x.Metadata = m0
+	}
+	x.Cells = slices.Clone(x.Cells)
+	for i0 := range x.Cells {
+		x.Cells[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocument) Equal(y *NotebookDocument) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.NotebookType == y.NotebookType &&
+		x.Version == y.Version &&
+		equalMap(x.Metadata, y.Metadata, func(a, b *jsontext.Value) bool { return equalRaw(*a, *b) }) &&
+		equalSlice(x.Cells, y.Cells, (*NotebookCell).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentSyncOptions) DeepCopy() *NotebookDocumentSyncOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentSyncOptions) deepCopyFields() {
+	x.NotebookSelector = slices.Clone(x.NotebookSelector)
+	for i0 := range x.NotebookSelector {
+		x.NotebookSelector[i0] = deepCopyNotebookSelector(x.NotebookSelector[i0])
+	}
+	if x.Save != nil {
+		v0 := *x.Save
+		x.Save = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentSyncOptions) Equal(y *NotebookDocumentSyncOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.NotebookSelector, y.NotebookSelector, func(a, b *NotebookSelector) bool { return equalNotebookSelector(*a, *b) }) &&
+		equalPtr(x.Save, y.Save, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *VersionedNotebookDocumentIdentifier) DeepCopy() *VersionedNotebookDocumentIdentifier {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *VersionedNotebookDocumentIdentifier) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *VersionedNotebookDocumentIdentifier) Equal(y *VersionedNotebookDocumentIdentifier) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Version == y.Version &&
+		x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentChangeEvent) DeepCopy() *NotebookDocumentChangeEvent {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentChangeEvent) deepCopyFields() {
+	if x.Metadata != nil {
+		m0 := make(LSPObject, len(x.Metadata))
+		for k0, v0 := range x.Metadata {
+			k0 = cloneString(k0)
+			v0 = slices.Clone(v0)
+			m0[k0] = v0
+		}
+		x.Metadata = m0
+	}
+	x.Cells = x.Cells.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentChangeEvent) Equal(y *NotebookDocumentChangeEvent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalMap(x.Metadata, y.Metadata, func(a, b *jsontext.Value) bool { return equalRaw(*a, *b) }) &&
+		x.Cells.Equal(y.Cells)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentIdentifier) DeepCopy() *NotebookDocumentIdentifier {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentIdentifier) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentIdentifier) Equal(y *NotebookDocumentIdentifier) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookCell) DeepCopy() *NotebookCell {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookCell) deepCopyFields() {
+	x.Document = cloneString(x.Document)
+	if x.Metadata != nil {
+		m0 := make(LSPObject, len(x.Metadata))
+		for k0, v0 := range x.Metadata {
+			k0 = cloneString(k0)
+			v0 = slices.Clone(v0)
+			m0[k0] = v0
+		}
+		x.Metadata = m0
+	}
+	x.ExecutionSummary = x.ExecutionSummary.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookCell) Equal(y *NotebookCell) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.Document == y.Document &&
+		equalMap(x.Metadata, y.Metadata, func(a, b *jsontext.Value) bool { return equalRaw(*a, *b) }) &&
+		x.ExecutionSummary.Equal(y.ExecutionSummary)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentFilterWithNotebook) DeepCopy() *NotebookDocumentFilterWithNotebook {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentFilterWithNotebook) deepCopyFields() {
+	x.Notebook = deepCopyNotebookDocumentFilterNotebook(x.Notebook)
+	x.Cells = slices.Clone(x.Cells)
+	for i0 := range x.Cells {
+		x.Cells[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentFilterWithNotebook) Equal(y *NotebookDocumentFilterWithNotebook) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalNotebookDocumentFilterNotebook(x.Notebook, y.Notebook) &&
+		equalSlice(x.Cells, y.Cells, (*NotebookCellLanguage).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentFilterWithCells) DeepCopy() *NotebookDocumentFilterWithCells {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentFilterWithCells) deepCopyFields() {
+	x.Notebook = deepCopyNotebookDocumentFilterNotebook(x.Notebook)
+	x.Cells = slices.Clone(x.Cells)
+	for i0 := range x.Cells {
+		x.Cells[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentFilterWithCells) Equal(y *NotebookDocumentFilterWithCells) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalNotebookDocumentFilterNotebook(x.Notebook, y.Notebook) &&
+		equalSlice(x.Cells, y.Cells, (*NotebookCellLanguage).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentCellChanges) DeepCopy() *NotebookDocumentCellChanges {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentCellChanges) deepCopyFields() {
+	x.Structure = x.Structure.DeepCopy()
+	x.Data = slices.Clone(x.Data)
+	for i0 := range x.Data {
+		x.Data[i0].deepCopyFields()
+	}
+	x.TextContent = slices.Clone(x.TextContent)
+	for i0 := range x.TextContent {
+		x.TextContent[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentCellChanges) Equal(y *NotebookDocumentCellChanges) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Structure.Equal(y.Structure) &&
+		equalSlice(x.Data, y.Data, (*NotebookCell).Equal) &&
+		equalSlice(x.TextContent, y.TextContent, (*NotebookDocumentCellContentChanges).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookCellTextDocumentFilter) DeepCopy() *NotebookCellTextDocumentFilter {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookCellTextDocumentFilter) deepCopyFields() {
+	x.Notebook = deepCopyNotebookDocumentFilterNotebook(x.Notebook)
+	if x.Language != nil {
+		v0 := *x.Language
+		v0 = cloneString(v0)
+		x.Language = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookCellTextDocumentFilter) Equal(y *NotebookCellTextDocumentFilter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalNotebookDocumentFilterNotebook(x.Notebook, y.Notebook) &&
+		equalPtr(x.Language, y.Language, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ExecutionSummary) DeepCopy() *ExecutionSummary {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ExecutionSummary) deepCopyFields() {
+	if x.Success != nil {
+		v0 := *x.Success
+		x.Success = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ExecutionSummary) Equal(y *ExecutionSummary) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ExecutionOrder == y.ExecutionOrder &&
+		equalPtr(x.Success, y.Success, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookCellLanguage) DeepCopy() *NotebookCellLanguage {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookCellLanguage) deepCopyFields() {
+	x.Language = cloneString(x.Language)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookCellLanguage) Equal(y *NotebookCellLanguage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Language == y.Language
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentCellChangeStructure) DeepCopy() *NotebookDocumentCellChangeStructure {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentCellChangeStructure) deepCopyFields() {
+	x.Array.deepCopyFields()
+	x.DidOpen = slices.Clone(x.DidOpen)
+	for i0 := range x.DidOpen {
+		x.DidOpen[i0].deepCopyFields()
+	}
+	x.DidClose = slices.Clone(x.DidClose)
+	for i0 := range x.DidClose {
+		x.DidClose[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentCellChangeStructure) Equal(y *NotebookDocumentCellChangeStructure) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Array.Equal(&y.Array) &&
+		equalSlice(x.DidOpen, y.DidOpen, (*TextDocumentItem).Equal) &&
+		equalSlice(x.DidClose, y.DidClose, (*TextDocumentIdentifier).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentCellContentChanges) DeepCopy() *NotebookDocumentCellContentChanges {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentCellContentChanges) deepCopyFields() {
+	x.Document.deepCopyFields()
+	x.Changes = slices.Clone(x.Changes)
+	for i0 := range x.Changes {
+		x.Changes[i0] = deepCopyTextDocumentContentChangeEvent(x.Changes[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentCellContentChanges) Equal(y *NotebookDocumentCellContentChanges) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Document.Equal(&y.Document) &&
+		equalSlice(x.Changes, y.Changes, func(a, b *TextDocumentContentChangeEvent) bool { return equalTextDocumentContentChangeEvent(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentFilterNotebookType) DeepCopy() *NotebookDocumentFilterNotebookType {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentFilterNotebookType) deepCopyFields() {
+	x.NotebookType = cloneString(x.NotebookType)
+	if x.Scheme != nil {
+		v0 := *x.Scheme
+		v0 = cloneString(v0)
+		x.Scheme = &v0
+	}
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentFilterNotebookType) Equal(y *NotebookDocumentFilterNotebookType) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.NotebookType == y.NotebookType &&
+		equalPtr(x.Scheme, y.Scheme, equalValue[string]) &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentFilterScheme) DeepCopy() *NotebookDocumentFilterScheme {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentFilterScheme) deepCopyFields() {
+	if x.NotebookType != nil {
+		v0 := *x.NotebookType
+		v0 = cloneString(v0)
+		x.NotebookType = &v0
+	}
+	x.Scheme = cloneString(x.Scheme)
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentFilterScheme) Equal(y *NotebookDocumentFilterScheme) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.NotebookType, y.NotebookType, equalValue[string]) &&
+		x.Scheme == y.Scheme &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentFilterPattern) DeepCopy() *NotebookDocumentFilterPattern {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentFilterPattern) deepCopyFields() {
+	if x.NotebookType != nil {
+		v0 := *x.NotebookType
+		v0 = cloneString(v0)
+		x.NotebookType = &v0
+	}
+	if x.Scheme != nil {
+		v0 := *x.Scheme
+		v0 = cloneString(v0)
+		x.Scheme = &v0
+	}
+	x.Pattern = deepCopyGlobPattern(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentFilterPattern) Equal(y *NotebookDocumentFilterPattern) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.NotebookType, y.NotebookType, equalValue[string]) &&
+		equalPtr(x.Scheme, y.Scheme, equalValue[string]) &&
+		equalGlobPattern(x.Pattern, y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookCellArrayChange) DeepCopy() *NotebookCellArrayChange {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookCellArrayChange) deepCopyFields() {
+	x.Cells = slices.Clone(x.Cells)
+	for i0 := range x.Cells {
+		x.Cells[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookCellArrayChange) Equal(y *NotebookCellArrayChange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Start == y.Start &&
+		x.DeleteCount == y.DeleteCount &&
+		equalSlice(x.Cells, y.Cells, (*NotebookCell).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *NotebookDocumentSyncClientCapabilities) DeepCopy() *NotebookDocumentSyncClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *NotebookDocumentSyncClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.ExecutionSummarySupport != nil {
+		v0 := *x.ExecutionSummarySupport
+		x.ExecutionSummarySupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *NotebookDocumentSyncClientCapabilities) Equal(y *NotebookDocumentSyncClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.ExecutionSummarySupport, y.ExecutionSummarySupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeclarationParams) DeepCopy() *DeclarationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeclarationParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeclarationParams) Equal(y *DeclarationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeclarationRegistrationOptions) DeepCopy() *DeclarationRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeclarationRegistrationOptions) deepCopyFields() {
+	x.DeclarationOptions.deepCopyFields()
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeclarationRegistrationOptions) Equal(y *DeclarationRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.DeclarationOptions.Equal(&y.DeclarationOptions) &&
+		x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeclarationOptions) DeepCopy() *DeclarationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeclarationOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeclarationOptions) Equal(y *DeclarationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeclarationClientCapabilities) DeepCopy() *DeclarationClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeclarationClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.LinkSupport != nil {
+		v0 := *x.LinkSupport
+		x.LinkSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeclarationClientCapabilities) Equal(y *DeclarationClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.LinkSupport, y.LinkSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DefinitionParams) DeepCopy() *DefinitionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DefinitionParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DefinitionParams) Equal(y *DefinitionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DefinitionRegistrationOptions) DeepCopy() *DefinitionRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DefinitionRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DefinitionOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DefinitionRegistrationOptions) Equal(y *DefinitionRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DefinitionOptions.Equal(&y.DefinitionOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DefinitionOptions) DeepCopy() *DefinitionOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DefinitionOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DefinitionOptions) Equal(y *DefinitionOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DefinitionClientCapabilities) DeepCopy() *DefinitionClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DefinitionClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.LinkSupport != nil {
+		v0 := *x.LinkSupport
+		x.LinkSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DefinitionClientCapabilities) Equal(y *DefinitionClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.LinkSupport, y.LinkSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeDefinitionParams) DeepCopy() *TypeDefinitionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeDefinitionParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeDefinitionParams) Equal(y *TypeDefinitionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeDefinitionRegistrationOptions) DeepCopy() *TypeDefinitionRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeDefinitionRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.TypeDefinitionOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeDefinitionRegistrationOptions) Equal(y *TypeDefinitionRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.TypeDefinitionOptions.Equal(&y.TypeDefinitionOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeDefinitionOptions) DeepCopy() *TypeDefinitionOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeDefinitionOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeDefinitionOptions) Equal(y *TypeDefinitionOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeDefinitionClientCapabilities) DeepCopy() *TypeDefinitionClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeDefinitionClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.LinkSupport != nil {
+		v0 := *x.LinkSupport
+		x.LinkSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeDefinitionClientCapabilities) Equal(y *TypeDefinitionClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.LinkSupport, y.LinkSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ImplementationParams) DeepCopy() *ImplementationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ImplementationParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ImplementationParams) Equal(y *ImplementationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ImplementationRegistrationOptions) DeepCopy() *ImplementationRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ImplementationRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.ImplementationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ImplementationRegistrationOptions) Equal(y *ImplementationRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.ImplementationOptions.Equal(&y.ImplementationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ImplementationOptions) DeepCopy() *ImplementationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ImplementationOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ImplementationOptions) Equal(y *ImplementationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ImplementationClientCapabilities) DeepCopy() *ImplementationClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ImplementationClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.LinkSupport != nil {
+		v0 := *x.LinkSupport
+		x.LinkSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ImplementationClientCapabilities) Equal(y *ImplementationClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.LinkSupport, y.LinkSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ReferenceParams) DeepCopy() *ReferenceParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ReferenceParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ReferenceParams) Equal(y *ReferenceParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ReferenceRegistrationOptions) DeepCopy() *ReferenceRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ReferenceRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.ReferenceOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ReferenceRegistrationOptions) Equal(y *ReferenceRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.ReferenceOptions.Equal(&y.ReferenceOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ReferenceContext) DeepCopy() *ReferenceContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ReferenceContext) Equal(y *ReferenceContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.IncludeDeclaration == y.IncludeDeclaration
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ReferenceOptions) DeepCopy() *ReferenceOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ReferenceOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ReferenceOptions) Equal(y *ReferenceOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ReferenceClientCapabilities) DeepCopy() *ReferenceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ReferenceClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ReferenceClientCapabilities) Equal(y *ReferenceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyPrepareParams) DeepCopy() *CallHierarchyPrepareParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyPrepareParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyPrepareParams) Equal(y *CallHierarchyPrepareParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyItem) DeepCopy() *CallHierarchyItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyItem) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	x.Tags = slices.Clone(x.Tags)
+	if x.Detail != nil {
+		v0 := *x.Detail
+		v0 = cloneString(v0)
+		x.Detail = &v0
+	}
+	x.URI = cloneString(x.URI)
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyItem) Equal(y *CallHierarchyItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		x.Kind == y.Kind &&
+		equalSlice(x.Tags, y.Tags, equalValue[SymbolTag]) &&
+		equalPtr(x.Detail, y.Detail, equalValue[string]) &&
+		x.URI == y.URI &&
+		x.Range.Equal(&y.Range) &&
+		x.SelectionRange.Equal(&y.SelectionRange) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyRegistrationOptions) DeepCopy() *CallHierarchyRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.CallHierarchyOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyRegistrationOptions) Equal(y *CallHierarchyRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.CallHierarchyOptions.Equal(&y.CallHierarchyOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyIncomingCallsParams) DeepCopy() *CallHierarchyIncomingCallsParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyIncomingCallsParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Item.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyIncomingCallsParams) Equal(y *CallHierarchyIncomingCallsParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Item.Equal(&y.Item)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyIncomingCall) DeepCopy() *CallHierarchyIncomingCall {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyIncomingCall) deepCopyFields() {
+	x.From.deepCopyFields()
+	x.FromRanges = slices.Clone(x.FromRanges)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyIncomingCall) Equal(y *CallHierarchyIncomingCall) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.From.Equal(&y.From) &&
+		equalSlice(x.FromRanges, y.FromRanges, (*Range).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyOutgoingCallsParams) DeepCopy() *CallHierarchyOutgoingCallsParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyOutgoingCallsParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Item.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyOutgoingCallsParams) Equal(y *CallHierarchyOutgoingCallsParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Item.Equal(&y.Item)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyOutgoingCall) DeepCopy() *CallHierarchyOutgoingCall {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyOutgoingCall) deepCopyFields() {
+	x.To.deepCopyFields()
+	x.FromRanges = slices.Clone(x.FromRanges)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyOutgoingCall) Equal(y *CallHierarchyOutgoingCall) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.To.Equal(&y.To) &&
+		equalSlice(x.FromRanges, y.FromRanges, (*Range).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyOptions) DeepCopy() *CallHierarchyOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyOptions) Equal(y *CallHierarchyOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CallHierarchyClientCapabilities) DeepCopy() *CallHierarchyClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CallHierarchyClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CallHierarchyClientCapabilities) Equal(y *CallHierarchyClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchyPrepareParams) DeepCopy() *TypeHierarchyPrepareParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchyPrepareParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchyPrepareParams) Equal(y *TypeHierarchyPrepareParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchyItem) DeepCopy() *TypeHierarchyItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchyItem) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	x.Tags = slices.Clone(x.Tags)
+	if x.Detail != nil {
+		v0 := *x.Detail
+		v0 = cloneString(v0)
+		x.Detail = &v0
+	}
+	x.URI = cloneString(x.URI)
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchyItem) Equal(y *TypeHierarchyItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		x.Kind == y.Kind &&
+		equalSlice(x.Tags, y.Tags, equalValue[SymbolTag]) &&
+		equalPtr(x.Detail, y.Detail, equalValue[string]) &&
+		x.URI == y.URI &&
+		x.Range.Equal(&y.Range) &&
+		x.SelectionRange.Equal(&y.SelectionRange) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchyRegistrationOptions) DeepCopy() *TypeHierarchyRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchyRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.TypeHierarchyOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchyRegistrationOptions) Equal(y *TypeHierarchyRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.TypeHierarchyOptions.Equal(&y.TypeHierarchyOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchySupertypesParams) DeepCopy() *TypeHierarchySupertypesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchySupertypesParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Item.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchySupertypesParams) Equal(y *TypeHierarchySupertypesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Item.Equal(&y.Item)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchySubtypesParams) DeepCopy() *TypeHierarchySubtypesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchySubtypesParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Item.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchySubtypesParams) Equal(y *TypeHierarchySubtypesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Item.Equal(&y.Item)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchyOptions) DeepCopy() *TypeHierarchyOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchyOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchyOptions) Equal(y *TypeHierarchyOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TypeHierarchyClientCapabilities) DeepCopy() *TypeHierarchyClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TypeHierarchyClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TypeHierarchyClientCapabilities) Equal(y *TypeHierarchyClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentHighlightParams) DeepCopy() *DocumentHighlightParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentHighlightParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentHighlightParams) Equal(y *DocumentHighlightParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentHighlight) DeepCopy() *DocumentHighlight {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentHighlight) Equal(y *DocumentHighlight) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Kind == y.Kind
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentHighlightRegistrationOptions) DeepCopy() *DocumentHighlightRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentHighlightRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentHighlightOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentHighlightRegistrationOptions) Equal(y *DocumentHighlightRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentHighlightOptions.Equal(&y.DocumentHighlightOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentHighlightOptions) DeepCopy() *DocumentHighlightOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentHighlightOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentHighlightOptions) Equal(y *DocumentHighlightOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentHighlightClientCapabilities) DeepCopy() *DocumentHighlightClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentHighlightClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentHighlightClientCapabilities) Equal(y *DocumentHighlightClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentLinkParams) DeepCopy() *DocumentLinkParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentLinkParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentLinkParams) Equal(y *DocumentLinkParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentLink) DeepCopy() *DocumentLink {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentLink) deepCopyFields() {
+	if x.Target != nil {
+		v0 := *x.Target
+		v0 = cloneString(v0)
+		x.Target = &v0
+	}
+	if x.Tooltip != nil {
+		v0 := *x.Tooltip
+		v0 = cloneString(v0)
+		x.Tooltip = &v0
+	}
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentLink) Equal(y *DocumentLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		equalPtr(x.Target, y.Target, equalValue[uri.URI]) &&
+		equalPtr(x.Tooltip, y.Tooltip, equalValue[string]) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentLinkRegistrationOptions) DeepCopy() *DocumentLinkRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentLinkRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentLinkOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentLinkRegistrationOptions) Equal(y *DocumentLinkRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentLinkOptions.Equal(&y.DocumentLinkOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentLinkOptions) DeepCopy() *DocumentLinkOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentLinkOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentLinkOptions) Equal(y *DocumentLinkOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentLinkClientCapabilities) DeepCopy() *DocumentLinkClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentLinkClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.TooltipSupport != nil {
+		v0 := *x.TooltipSupport
+		x.TooltipSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentLinkClientCapabilities) Equal(y *DocumentLinkClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.TooltipSupport, y.TooltipSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *HoverParams) DeepCopy() *HoverParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *HoverParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *HoverParams) Equal(y *HoverParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Hover) DeepCopy() *Hover {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Hover) deepCopyFields() {
+	x.Contents = deepCopyHoverContents(x.Contents)
+	x.Range = x.Range.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Hover) Equal(y *Hover) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalHoverContents(x.Contents, y.Contents) &&
+		x.Range.Equal(y.Range)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *HoverRegistrationOptions) DeepCopy() *HoverRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *HoverRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.HoverOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *HoverRegistrationOptions) Equal(y *HoverRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.HoverOptions.Equal(&y.HoverOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *HoverOptions) DeepCopy() *HoverOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *HoverOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *HoverOptions) Equal(y *HoverOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *HoverClientCapabilities) DeepCopy() *HoverClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *HoverClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.ContentFormat = slices.Clone(x.ContentFormat)
+	for i0 := range x.ContentFormat {
+		x.ContentFormat[i0] = cloneString(x.ContentFormat[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *HoverClientCapabilities) Equal(y *HoverClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalSlice(x.ContentFormat, y.ContentFormat, equalValue[MarkupKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLensParams) DeepCopy() *CodeLensParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLensParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLensParams) Equal(y *CodeLensParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLens) DeepCopy() *CodeLens {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLens) deepCopyFields() {
+	x.Command.deepCopyFields()
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLens) Equal(y *CodeLens) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Command.Equal(&y.Command) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLensRegistrationOptions) DeepCopy() *CodeLensRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLensRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.CodeLensOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLensRegistrationOptions) Equal(y *CodeLensRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.CodeLensOptions.Equal(&y.CodeLensOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLensOptions) DeepCopy() *CodeLensOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLensOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLensOptions) Equal(y *CodeLensOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLensWorkspaceClientCapabilities) DeepCopy() *CodeLensWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLensWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLensWorkspaceClientCapabilities) Equal(y *CodeLensWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeLensClientCapabilities) DeepCopy() *CodeLensClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeLensClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.ResolveSupport.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeLensClientCapabilities) Equal(y *CodeLensClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.ResolveSupport.Equal(&y.ResolveSupport)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCodeLensResolveOptions) DeepCopy() *ClientCodeLensResolveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCodeLensResolveOptions) deepCopyFields() {
+	x.Properties = slices.Clone(x.Properties)
+	for i0 := range x.Properties {
+		x.Properties[i0] = cloneString(x.Properties[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCodeLensResolveOptions) Equal(y *ClientCodeLensResolveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Properties, y.Properties, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRangeParams) DeepCopy() *FoldingRangeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRangeParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRangeParams) Equal(y *FoldingRangeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRange) DeepCopy() *FoldingRange {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRange) deepCopyFields() {
+	if x.StartCharacter != nil {
+		v0 := *x.StartCharacter
+		x.StartCharacter = &v0
+	}
+	if x.EndCharacter != nil {
+		v0 := *x.EndCharacter
+		x.EndCharacter = &v0
+	}
+	x.Kind = cloneString(x.Kind)
+	if x.CollapsedText != nil {
+		v0 := *x.CollapsedText
+		v0 = cloneString(v0)
+		x.CollapsedText = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRange) Equal(y *FoldingRange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.StartLine == y.StartLine &&
+		equalPtr(x.StartCharacter, y.StartCharacter, equalValue[uint32]) &&
+		x.EndLine == y.EndLine &&
+		equalPtr(x.EndCharacter, y.EndCharacter, equalValue[uint32]) &&
+		x.Kind == y.Kind &&
+		equalPtr(x.CollapsedText, y.CollapsedText, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRangeRegistrationOptions) DeepCopy() *FoldingRangeRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRangeRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.FoldingRangeOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRangeRegistrationOptions) Equal(y *FoldingRangeRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.FoldingRangeOptions.Equal(&y.FoldingRangeOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRangeOptions) DeepCopy() *FoldingRangeOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRangeOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRangeOptions) Equal(y *FoldingRangeOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRangeWorkspaceClientCapabilities) DeepCopy() *FoldingRangeWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRangeWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRangeWorkspaceClientCapabilities) Equal(y *FoldingRangeWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FoldingRangeClientCapabilities) DeepCopy() *FoldingRangeClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FoldingRangeClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.RangeLimit != nil {
+		v0 := *x.RangeLimit
+		x.RangeLimit = &v0
+	}
+	if x.LineFoldingOnly != nil {
+		v0 := *x.LineFoldingOnly
+		x.LineFoldingOnly = &v0
+	}
+	x.FoldingRangeKind = x.FoldingRangeKind.DeepCopy()
+	x.FoldingRange = x.FoldingRange.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FoldingRangeClientCapabilities) Equal(y *FoldingRangeClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.RangeLimit, y.RangeLimit, equalValue[uint32]) &&
+		equalPtr(x.LineFoldingOnly, y.LineFoldingOnly, equalValue[bool]) &&
+		x.FoldingRangeKind.Equal(y.FoldingRangeKind) &&
+		x.FoldingRange.Equal(y.FoldingRange)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientFoldingRangeKindOptions) DeepCopy() *ClientFoldingRangeKindOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientFoldingRangeKindOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+	for i0 := range x.ValueSet {
+		x.ValueSet[i0] = cloneString(x.ValueSet[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientFoldingRangeKindOptions) Equal(y *ClientFoldingRangeKindOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[FoldingRangeKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientFoldingRangeOptions) DeepCopy() *ClientFoldingRangeOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientFoldingRangeOptions) deepCopyFields() {
+	if x.CollapsedText != nil {
+		v0 := *x.CollapsedText
+		x.CollapsedText = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientFoldingRangeOptions) Equal(y *ClientFoldingRangeOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.CollapsedText, y.CollapsedText, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectionRangeParams) DeepCopy() *SelectionRangeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectionRangeParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.Positions = slices.Clone(x.Positions)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectionRangeParams) Equal(y *SelectionRangeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		equalSlice(x.Positions, y.Positions, (*Position).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectionRange) DeepCopy() *SelectionRange {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectionRange) deepCopyFields() {
+	x.Parent = x.Parent.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectionRange) Equal(y *SelectionRange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Parent.Equal(y.Parent)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectionRangeRegistrationOptions) DeepCopy() *SelectionRangeRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectionRangeRegistrationOptions) deepCopyFields() {
+	x.SelectionRangeOptions.deepCopyFields()
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectionRangeRegistrationOptions) Equal(y *SelectionRangeRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.SelectionRangeOptions.Equal(&y.SelectionRangeOptions) &&
+		x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectionRangeOptions) DeepCopy() *SelectionRangeOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectionRangeOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectionRangeOptions) Equal(y *SelectionRangeOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectionRangeClientCapabilities) DeepCopy() *SelectionRangeClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectionRangeClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectionRangeClientCapabilities) Equal(y *SelectionRangeClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentSymbolParams) DeepCopy() *DocumentSymbolParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentSymbolParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentSymbolParams) Equal(y *DocumentSymbolParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentSymbol) DeepCopy() *DocumentSymbol {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentSymbol) deepCopyFields() {
+	x.Name = cloneString(x.Name)
+	if x.Detail != nil {
+		v0 := *x.Detail
+		v0 = cloneString(v0)
+		x.Detail = &v0
+	}
+	x.Tags = slices.Clone(x.Tags)
+	if x.Deprecated != nil {
+		v0 := *x.Deprecated
+		x.Deprecated = &v0
+	}
+	x.Children = slices.Clone(x.Children)
+	for i0 := range x.Children {
+		x.Children[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentSymbol) Equal(y *DocumentSymbol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Name == y.Name &&
+		equalPtr(x.Detail, y.Detail, equalValue[string]) &&
+		x.Kind == y.Kind &&
+		equalSlice(x.Tags, y.Tags, equalValue[SymbolTag]) &&
+		equalPtr(x.Deprecated, y.Deprecated, equalValue[bool]) &&
+		x.Range.Equal(&y.Range) &&
+		x.SelectionRange.Equal(&y.SelectionRange) &&
+		equalSlice(x.Children, y.Children, (*DocumentSymbol).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentSymbolRegistrationOptions) DeepCopy() *DocumentSymbolRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentSymbolRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentSymbolOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentSymbolRegistrationOptions) Equal(y *DocumentSymbolRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentSymbolOptions.Equal(&y.DocumentSymbolOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentSymbolOptions) DeepCopy() *DocumentSymbolOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentSymbolOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.Label != nil {
+		v0 := *x.Label
+		v0 = cloneString(v0)
+		x.Label = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentSymbolOptions) Equal(y *DocumentSymbolOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.Label, y.Label, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentSymbolClientCapabilities) DeepCopy() *DocumentSymbolClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentSymbolClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.SymbolKind = x.SymbolKind.DeepCopy()
+	if x.HierarchicalDocumentSymbolSupport != nil {
+		v0 := *x.HierarchicalDocumentSymbolSupport
+		x.HierarchicalDocumentSymbolSupport = &v0
+	}
+	x.TagSupport.deepCopyFields()
+	if x.LabelSupport != nil {
+		v0 := *x.LabelSupport
+		x.LabelSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentSymbolClientCapabilities) Equal(y *DocumentSymbolClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.SymbolKind.Equal(y.SymbolKind) &&
+		equalPtr(x.HierarchicalDocumentSymbolSupport, y.HierarchicalDocumentSymbolSupport, equalValue[bool]) &&
+		x.TagSupport.Equal(&y.TagSupport) &&
+		equalPtr(x.LabelSupport, y.LabelSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensParams) DeepCopy() *SemanticTokensParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensParams) Equal(y *SemanticTokensParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokens) DeepCopy() *SemanticTokens {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokens) deepCopyFields() {
+	if x.ResultID != nil {
+		v0 := *x.ResultID
+		v0 = cloneString(v0)
+		x.ResultID = &v0
+	}
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokens) Equal(y *SemanticTokens) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.ResultID, y.ResultID, equalValue[string]) &&
+		equalSlice(x.Data, y.Data, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensPartialResult) DeepCopy() *SemanticTokensPartialResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensPartialResult) deepCopyFields() {
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensPartialResult) Equal(y *SemanticTokensPartialResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Data, y.Data, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensRegistrationOptions) DeepCopy() *SemanticTokensRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.SemanticTokensOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensRegistrationOptions) Equal(y *SemanticTokensRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.SemanticTokensOptions.Equal(&y.SemanticTokensOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensDeltaParams) DeepCopy() *SemanticTokensDeltaParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensDeltaParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.PreviousResultID = cloneString(x.PreviousResultID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensDeltaParams) Equal(y *SemanticTokensDeltaParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.PreviousResultID == y.PreviousResultID
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensDelta) DeepCopy() *SemanticTokensDelta {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensDelta) deepCopyFields() {
+	if x.ResultID != nil {
+		v0 := *x.ResultID
+		v0 = cloneString(v0)
+		x.ResultID = &v0
+	}
+	x.Edits = slices.Clone(x.Edits)
+	for i0 := range x.Edits {
+		x.Edits[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensDelta) Equal(y *SemanticTokensDelta) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.ResultID, y.ResultID, equalValue[string]) &&
+		equalSlice(x.Edits, y.Edits, (*SemanticTokensEdit).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensDeltaPartialResult) DeepCopy() *SemanticTokensDeltaPartialResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensDeltaPartialResult) deepCopyFields() {
+	x.Edits = slices.Clone(x.Edits)
+	for i0 := range x.Edits {
+		x.Edits[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensDeltaPartialResult) Equal(y *SemanticTokensDeltaPartialResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Edits, y.Edits, (*SemanticTokensEdit).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensRangeParams) DeepCopy() *SemanticTokensRangeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensRangeParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensRangeParams) Equal(y *SemanticTokensRangeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Range.Equal(&y.Range)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensOptions) DeepCopy() *SemanticTokensOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	x.Legend.deepCopyFields()
+	x.Range = deepCopyClientSemanticTokensRequestOptionsRange(x.Range)
+	x.Full = deepCopySemanticTokensOptionsFull(x.Full)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensOptions) Equal(y *SemanticTokensOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		x.Legend.Equal(&y.Legend) &&
+		equalClientSemanticTokensRequestOptionsRange(x.Range, y.Range) &&
+		equalSemanticTokensOptionsFull(x.Full, y.Full)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensEdit) DeepCopy() *SemanticTokensEdit {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensEdit) deepCopyFields() {
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensEdit) Equal(y *SemanticTokensEdit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Start == y.Start &&
+		x.DeleteCount == y.DeleteCount &&
+		equalSlice(x.Data, y.Data, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensLegend) DeepCopy() *SemanticTokensLegend {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensLegend) deepCopyFields() {
+	x.TokenTypes = slices.Clone(x.TokenTypes)
+	for i0 := range x.TokenTypes {
+		x.TokenTypes[i0] = cloneString(x.TokenTypes[i0])
+	}
+	x.TokenModifiers = slices.Clone(x.TokenModifiers)
+	for i0 := range x.TokenModifiers {
+		x.TokenModifiers[i0] = cloneString(x.TokenModifiers[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensLegend) Equal(y *SemanticTokensLegend) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.TokenTypes, y.TokenTypes, equalValue[string]) &&
+		equalSlice(x.TokenModifiers, y.TokenModifiers, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensFullDelta) DeepCopy() *SemanticTokensFullDelta {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensFullDelta) deepCopyFields() {
+	if x.Delta != nil {
+		v0 := *x.Delta
+		x.Delta = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensFullDelta) Equal(y *SemanticTokensFullDelta) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Delta, y.Delta, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensWorkspaceClientCapabilities) DeepCopy() *SemanticTokensWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensWorkspaceClientCapabilities) Equal(y *SemanticTokensWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensClientCapabilities) DeepCopy() *SemanticTokensClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SemanticTokensClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.Requests.deepCopyFields()
+	x.TokenTypes = slices.Clone(x.TokenTypes)
+	for i0 := range x.TokenTypes {
+		x.TokenTypes[i0] = cloneString(x.TokenTypes[i0])
+	}
+	x.TokenModifiers = slices.Clone(x.TokenModifiers)
+	for i0 := range x.TokenModifiers {
+		x.TokenModifiers[i0] = cloneString(x.TokenModifiers[i0])
+	}
+	x.Formats = slices.Clone(x.Formats)
+	for i0 := range x.Formats {
+		x.Formats[i0] = cloneString(x.Formats[i0])
+	}
+	if x.OverlappingTokenSupport != nil {
+		v0 := *x.OverlappingTokenSupport
+		x.OverlappingTokenSupport = &v0
+	}
+	if x.MultilineTokenSupport != nil {
+		v0 := *x.MultilineTokenSupport
+		x.MultilineTokenSupport = &v0
+	}
+	if x.ServerCancelSupport != nil {
+		v0 := *x.ServerCancelSupport
+		x.ServerCancelSupport = &v0
+	}
+	if x.AugmentsSyntaxTokens != nil {
+		v0 := *x.AugmentsSyntaxTokens
+		x.AugmentsSyntaxTokens = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensClientCapabilities) Equal(y *SemanticTokensClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.Requests.Equal(&y.Requests) &&
+		equalSlice(x.TokenTypes, y.TokenTypes, equalValue[string]) &&
+		equalSlice(x.TokenModifiers, y.TokenModifiers, equalValue[string]) &&
+		equalSlice(x.Formats, y.Formats, equalValue[TokenFormat]) &&
+		equalPtr(x.OverlappingTokenSupport, y.OverlappingTokenSupport, equalValue[bool]) &&
+		equalPtr(x.MultilineTokenSupport, y.MultilineTokenSupport, equalValue[bool]) &&
+		equalPtr(x.ServerCancelSupport, y.ServerCancelSupport, equalValue[bool]) &&
+		equalPtr(x.AugmentsSyntaxTokens, y.AugmentsSyntaxTokens, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSemanticTokensRequestOptions) DeepCopy() *ClientSemanticTokensRequestOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSemanticTokensRequestOptions) deepCopyFields() {
+	x.Range = deepCopyClientSemanticTokensRequestOptionsRange(x.Range)
+	x.Full = deepCopyClientSemanticTokensRequestOptionsFull(x.Full)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSemanticTokensRequestOptions) Equal(y *ClientSemanticTokensRequestOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalClientSemanticTokensRequestOptionsRange(x.Range, y.Range) &&
+		equalClientSemanticTokensRequestOptionsFull(x.Full, y.Full)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSemanticTokensRequestFullDelta) DeepCopy() *ClientSemanticTokensRequestFullDelta {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSemanticTokensRequestFullDelta) deepCopyFields() {
+	if x.Delta != nil {
+		v0 := *x.Delta
+		x.Delta = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSemanticTokensRequestFullDelta) Equal(y *ClientSemanticTokensRequestFullDelta) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Delta, y.Delta, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueParams) DeepCopy() *InlineValueParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueParams) Equal(y *InlineValueParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Range.Equal(&y.Range) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueRegistrationOptions) DeepCopy() *InlineValueRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueRegistrationOptions) deepCopyFields() {
+	x.InlineValueOptions.deepCopyFields()
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueRegistrationOptions) Equal(y *InlineValueRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.InlineValueOptions.Equal(&y.InlineValueOptions) &&
+		x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueContext) DeepCopy() *InlineValueContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueContext) Equal(y *InlineValueContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.FrameID == y.FrameID &&
+		x.StoppedLocation.Equal(&y.StoppedLocation)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueText) DeepCopy() *InlineValueText {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueText) deepCopyFields() {
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueText) Equal(y *InlineValueText) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueVariableLookup) DeepCopy() *InlineValueVariableLookup {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueVariableLookup) deepCopyFields() {
+	if x.VariableName != nil {
+		v0 := *x.VariableName
+		v0 = cloneString(v0)
+		x.VariableName = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueVariableLookup) Equal(y *InlineValueVariableLookup) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		equalPtr(x.VariableName, y.VariableName, equalValue[string]) &&
+		x.CaseSensitiveLookup == y.CaseSensitiveLookup
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueEvaluatableExpression) DeepCopy() *InlineValueEvaluatableExpression {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueEvaluatableExpression) deepCopyFields() {
+	if x.Expression != nil {
+		v0 := *x.Expression
+		v0 = cloneString(v0)
+		x.Expression = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueEvaluatableExpression) Equal(y *InlineValueEvaluatableExpression) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		equalPtr(x.Expression, y.Expression, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueOptions) DeepCopy() *InlineValueOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueOptions) Equal(y *InlineValueOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueWorkspaceClientCapabilities) DeepCopy() *InlineValueWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueWorkspaceClientCapabilities) Equal(y *InlineValueWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineValueClientCapabilities) DeepCopy() *InlineValueClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineValueClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineValueClientCapabilities) Equal(y *InlineValueClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintParams) DeepCopy() *InlayHintParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintParams) Equal(y *InlayHintParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Range.Equal(&y.Range)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHint) DeepCopy() *InlayHint {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHint) deepCopyFields() {
+	x.Label = deepCopyInlayHintLabel(x.Label)
+	x.TextEdits = slices.Clone(x.TextEdits)
+	for i0 := range x.TextEdits {
+		x.TextEdits[i0].deepCopyFields()
+	}
+	x.Tooltip = deepCopyInlayHintTooltip(x.Tooltip)
+	if x.PaddingLeft != nil {
+		v0 := *x.PaddingLeft
+		x.PaddingLeft = &v0
+	}
+	if x.PaddingRight != nil {
+		v0 := *x.PaddingRight
+		x.PaddingRight = &v0
+	}
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHint) Equal(y *InlayHint) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Position.Equal(&y.Position) &&
+		equalInlayHintLabel(x.Label, y.Label) &&
+		x.Kind == y.Kind &&
+		equalSlice(x.TextEdits, y.TextEdits, (*TextEdit).Equal) &&
+		equalInlayHintTooltip(x.Tooltip, y.Tooltip) &&
+		equalPtr(x.PaddingLeft, y.PaddingLeft, equalValue[bool]) &&
+		equalPtr(x.PaddingRight, y.PaddingRight, equalValue[bool]) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintRegistrationOptions) DeepCopy() *InlayHintRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintRegistrationOptions) deepCopyFields() {
+	x.InlayHintOptions.deepCopyFields()
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintRegistrationOptions) Equal(y *InlayHintRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.InlayHintOptions.Equal(&y.InlayHintOptions) &&
+		x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintLabelPart) DeepCopy() *InlayHintLabelPart {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintLabelPart) deepCopyFields() {
+	x.Value = cloneString(x.Value)
+	x.Tooltip = deepCopyInlayHintTooltip(x.Tooltip)
+	x.Location.deepCopyFields()
+	x.Command.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintLabelPart) Equal(y *InlayHintLabelPart) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Value == y.Value &&
+		equalInlayHintTooltip(x.Tooltip, y.Tooltip) &&
+		x.Location.Equal(&y.Location) &&
+		x.Command.Equal(&y.Command)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintOptions) DeepCopy() *InlayHintOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintOptions) Equal(y *InlayHintOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintWorkspaceClientCapabilities) DeepCopy() *InlayHintWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintWorkspaceClientCapabilities) Equal(y *InlayHintWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlayHintClientCapabilities) DeepCopy() *InlayHintClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlayHintClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.ResolveSupport.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlayHintClientCapabilities) Equal(y *InlayHintClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.ResolveSupport.Equal(&y.ResolveSupport)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientInlayHintResolveOptions) DeepCopy() *ClientInlayHintResolveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientInlayHintResolveOptions) deepCopyFields() {
+	x.Properties = slices.Clone(x.Properties)
+	for i0 := range x.Properties {
+		x.Properties[i0] = cloneString(x.Properties[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientInlayHintResolveOptions) Equal(y *ClientInlayHintResolveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Properties, y.Properties, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MonikerParams) DeepCopy() *MonikerParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MonikerParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MonikerParams) Equal(y *MonikerParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Moniker) DeepCopy() *Moniker {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *Moniker) deepCopyFields() {
+	x.Scheme = cloneString(x.Scheme)
+	x.Identifier = cloneString(x.Identifier)
+	x.Unique = cloneString(x.Unique)
+	x.Kind = cloneString(x.Kind)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Moniker) Equal(y *Moniker) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Scheme == y.Scheme &&
+		x.Identifier == y.Identifier &&
+		x.Unique == y.Unique &&
+		x.Kind == y.Kind
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MonikerRegistrationOptions) DeepCopy() *MonikerRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MonikerRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.MonikerOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MonikerRegistrationOptions) Equal(y *MonikerRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.MonikerOptions.Equal(&y.MonikerOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MonikerOptions) DeepCopy() *MonikerOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MonikerOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MonikerOptions) Equal(y *MonikerOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MonikerClientCapabilities) DeepCopy() *MonikerClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MonikerClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MonikerClientCapabilities) Equal(y *MonikerClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionParams) DeepCopy() *CompletionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Context.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionParams) Equal(y *CompletionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionItem) DeepCopy() *CompletionItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionItem) deepCopyFields() {
+	x.Label = cloneString(x.Label)
+	x.LabelDetails = x.LabelDetails.DeepCopy()
+	x.Tags = slices.Clone(x.Tags)
+	if v0, ok := x.Detail.Get(); ok {
+		v0 = cloneString(v0)
+		x.Detail = NewOptional(v0)
+	}
+	x.Documentation = deepCopyInlayHintTooltip(x.Documentation)
+	if v0, ok := x.SortText.Get(); ok {
+		v0 = cloneString(v0)
+		x.SortText = NewOptional(v0)
+	}
+	if v0, ok := x.FilterText.Get(); ok {
+		v0 = cloneString(v0)
+		x.FilterText = NewOptional(v0)
+	}
+	if v0, ok := x.InsertText.Get(); ok {
+		v0 = cloneString(v0)
+		x.InsertText = NewOptional(v0)
+	}
+	x.TextEdit = deepCopyCompletionItemTextEdit(x.TextEdit)
+	if v0, ok := x.TextEditText.Get(); ok {
+		v0 = cloneString(v0)
+		x.TextEditText = NewOptional(v0)
+	}
+	x.AdditionalTextEdits = slices.Clone(x.AdditionalTextEdits)
+	for i0 := range x.AdditionalTextEdits {
+		x.AdditionalTextEdits[i0].deepCopyFields()
+	}
+	x.CommitCharacters = slices.Clone(x.CommitCharacters)
+	for i0 := range x.CommitCharacters {
+		x.CommitCharacters[i0] = cloneString(x.CommitCharacters[i0])
+	}
+	x.Command.deepCopyFields()
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionItem) Equal(y *CompletionItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Label == y.Label &&
+		x.LabelDetails.Equal(y.LabelDetails) &&
+		x.Kind == y.Kind &&
+		equalSlice(x.Tags, y.Tags, equalValue[CompletionItemTag]) &&
+		equalOptional(x.Detail, y.Detail, equalValue[string]) &&
+		equalInlayHintTooltip(x.Documentation, y.Documentation) &&
+		equalOptional(x.Deprecated, y.Deprecated, equalValue[bool]) &&
+		equalOptional(x.Preselect, y.Preselect, equalValue[bool]) &&
+		equalOptional(x.SortText, y.SortText, equalValue[string]) &&
+		equalOptional(x.FilterText, y.FilterText, equalValue[string]) &&
+		equalOptional(x.InsertText, y.InsertText, equalValue[string]) &&
+		x.InsertTextFormat == y.InsertTextFormat &&
+		x.InsertTextMode == y.InsertTextMode &&
+		equalCompletionItemTextEdit(x.TextEdit, y.TextEdit) &&
+		equalOptional(x.TextEditText, y.TextEditText, equalValue[string]) &&
+		equalSlice(x.AdditionalTextEdits, y.AdditionalTextEdits, (*TextEdit).Equal) &&
+		equalSlice(x.CommitCharacters, y.CommitCharacters, equalValue[string]) &&
+		x.Command.Equal(&y.Command) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionList) DeepCopy() *CompletionList {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionList) deepCopyFields() {
+	x.ItemDefaults = x.ItemDefaults.DeepCopy()
+	x.ApplyKind = x.ApplyKind.DeepCopy()
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionList) Equal(y *CompletionList) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.IsIncomplete == y.IsIncomplete &&
+		x.ItemDefaults.Equal(y.ItemDefaults) &&
+		x.ApplyKind.Equal(y.ApplyKind) &&
+		equalSlice(x.Items, y.Items, (*CompletionItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionRegistrationOptions) DeepCopy() *CompletionRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.CompletionOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionRegistrationOptions) Equal(y *CompletionRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.CompletionOptions.Equal(&y.CompletionOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionContext) DeepCopy() *CompletionContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionContext) deepCopyFields() {
+	if x.TriggerCharacter != nil {
+		v0 := *x.TriggerCharacter
+		v0 = cloneString(v0)
+		x.TriggerCharacter = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionContext) Equal(y *CompletionContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TriggerKind == y.TriggerKind &&
+		equalPtr(x.TriggerCharacter, y.TriggerCharacter, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionItemLabelDetails) DeepCopy() *CompletionItemLabelDetails {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionItemLabelDetails) deepCopyFields() {
+	if x.Detail != nil {
+		v0 := *x.Detail
+		v0 = cloneString(v0)
+		x.Detail = &v0
+	}
+	if x.Description != nil {
+		v0 := *x.Description
+		v0 = cloneString(v0)
+		x.Description = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionItemLabelDetails) Equal(y *CompletionItemLabelDetails) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Detail, y.Detail, equalValue[string]) &&
+		equalPtr(x.Description, y.Description, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionItemDefaults) DeepCopy() *CompletionItemDefaults {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionItemDefaults) deepCopyFields() {
+	x.CommitCharacters = slices.Clone(x.CommitCharacters)
+	for i0 := range x.CommitCharacters {
+		x.CommitCharacters[i0] = cloneString(x.CommitCharacters[i0])
+	}
+	x.EditRange = deepCopyCompletionItemDefaultsEditRange(x.EditRange)
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionItemDefaults) Equal(y *CompletionItemDefaults) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.CommitCharacters, y.CommitCharacters, equalValue[string]) &&
+		equalCompletionItemDefaultsEditRange(x.EditRange, y.EditRange) &&
+		x.InsertTextFormat == y.InsertTextFormat &&
+		x.InsertTextMode == y.InsertTextMode &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionItemApplyKinds) DeepCopy() *CompletionItemApplyKinds {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionItemApplyKinds) Equal(y *CompletionItemApplyKinds) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.CommitCharacters == y.CommitCharacters &&
+		x.Data == y.Data
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionOptions) DeepCopy() *CompletionOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	x.TriggerCharacters = slices.Clone(x.TriggerCharacters)
+	for i0 := range x.TriggerCharacters {
+		x.TriggerCharacters[i0] = cloneString(x.TriggerCharacters[i0])
+	}
+	x.AllCommitCharacters = slices.Clone(x.AllCommitCharacters)
+	for i0 := range x.AllCommitCharacters {
+		x.AllCommitCharacters[i0] = cloneString(x.AllCommitCharacters[i0])
+	}
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+	x.CompletionItem = x.CompletionItem.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionOptions) Equal(y *CompletionOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalSlice(x.TriggerCharacters, y.TriggerCharacters, equalValue[string]) &&
+		equalSlice(x.AllCommitCharacters, y.AllCommitCharacters, equalValue[string]) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool]) &&
+		x.CompletionItem.Equal(y.CompletionItem)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SelectedCompletionInfo) DeepCopy() *SelectedCompletionInfo {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SelectedCompletionInfo) deepCopyFields() {
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SelectedCompletionInfo) Equal(y *SelectedCompletionInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *EditRangeWithInsertReplace) DeepCopy() *EditRangeWithInsertReplace {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *EditRangeWithInsertReplace) Equal(y *EditRangeWithInsertReplace) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Insert.Equal(&y.Insert) &&
+		x.Replace.Equal(&y.Replace)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ServerCompletionItemOptions) DeepCopy() *ServerCompletionItemOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ServerCompletionItemOptions) deepCopyFields() {
+	if x.LabelDetailsSupport != nil {
+		v0 := *x.LabelDetailsSupport
+		x.LabelDetailsSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ServerCompletionItemOptions) Equal(y *ServerCompletionItemOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.LabelDetailsSupport, y.LabelDetailsSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionClientCapabilities) DeepCopy() *CompletionClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.CompletionItem = x.CompletionItem.DeepCopy()
+	x.CompletionItemKind = x.CompletionItemKind.DeepCopy()
+	if x.ContextSupport != nil {
+		v0 := *x.ContextSupport
+		x.ContextSupport = &v0
+	}
+	x.CompletionList = x.CompletionList.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionClientCapabilities) Equal(y *CompletionClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.CompletionItem.Equal(y.CompletionItem) &&
+		x.CompletionItemKind.Equal(y.CompletionItemKind) &&
+		x.InsertTextMode == y.InsertTextMode &&
+		equalPtr(x.ContextSupport, y.ContextSupport, equalValue[bool]) &&
+		x.CompletionList.Equal(y.CompletionList)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCompletionItemOptions) DeepCopy() *ClientCompletionItemOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCompletionItemOptions) deepCopyFields() {
+	if x.SnippetSupport != nil {
+		v0 := *x.SnippetSupport
+		x.SnippetSupport = &v0
+	}
+	if x.CommitCharactersSupport != nil {
+		v0 := *x.CommitCharactersSupport
+		x.CommitCharactersSupport = &v0
+	}
+	x.DocumentationFormat = slices.Clone(x.DocumentationFormat)
+	for i0 := range x.DocumentationFormat {
+		x.DocumentationFormat[i0] = cloneString(x.DocumentationFormat[i0])
+	}
+	if x.DeprecatedSupport != nil {
+		v0 := *x.DeprecatedSupport
+		x.DeprecatedSupport = &v0
+	}
+	if x.PreselectSupport != nil {
+		v0 := *x.PreselectSupport
+		x.PreselectSupport = &v0
+	}
+	x.TagSupport.deepCopyFields()
+	if x.InsertReplaceSupport != nil {
+		v0 := *x.InsertReplaceSupport
+		x.InsertReplaceSupport = &v0
+	}
+	x.ResolveSupport.deepCopyFields()
+	x.InsertTextModeSupport.deepCopyFields()
+	if x.LabelDetailsSupport != nil {
+		v0 := *x.LabelDetailsSupport
+		x.LabelDetailsSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCompletionItemOptions) Equal(y *ClientCompletionItemOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.SnippetSupport, y.SnippetSupport, equalValue[bool]) &&
+		equalPtr(x.CommitCharactersSupport, y.CommitCharactersSupport, equalValue[bool]) &&
+		equalSlice(x.DocumentationFormat, y.DocumentationFormat, equalValue[MarkupKind]) &&
+		equalPtr(x.DeprecatedSupport, y.DeprecatedSupport, equalValue[bool]) &&
+		equalPtr(x.PreselectSupport, y.PreselectSupport, equalValue[bool]) &&
+		x.TagSupport.Equal(&y.TagSupport) &&
+		equalPtr(x.InsertReplaceSupport, y.InsertReplaceSupport, equalValue[bool]) &&
+		x.ResolveSupport.Equal(&y.ResolveSupport) &&
+		x.InsertTextModeSupport.Equal(&y.InsertTextModeSupport) &&
+		equalPtr(x.LabelDetailsSupport, y.LabelDetailsSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCompletionItemOptionsKind) DeepCopy() *ClientCompletionItemOptionsKind {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCompletionItemOptionsKind) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCompletionItemOptionsKind) Equal(y *ClientCompletionItemOptionsKind) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[CompletionItemKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionListCapabilities) DeepCopy() *CompletionListCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionListCapabilities) deepCopyFields() {
+	x.ItemDefaults = slices.Clone(x.ItemDefaults)
+	for i0 := range x.ItemDefaults {
+		x.ItemDefaults[i0] = cloneString(x.ItemDefaults[i0])
+	}
+	if x.ApplyKindSupport != nil {
+		v0 := *x.ApplyKindSupport
+		x.ApplyKindSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionListCapabilities) Equal(y *CompletionListCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ItemDefaults, y.ItemDefaults, equalValue[string]) &&
+		equalPtr(x.ApplyKindSupport, y.ApplyKindSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CompletionItemTagOptions) DeepCopy() *CompletionItemTagOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CompletionItemTagOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CompletionItemTagOptions) Equal(y *CompletionItemTagOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[CompletionItemTag])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCompletionItemResolveOptions) DeepCopy() *ClientCompletionItemResolveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCompletionItemResolveOptions) deepCopyFields() {
+	x.Properties = slices.Clone(x.Properties)
+	for i0 := range x.Properties {
+		x.Properties[i0] = cloneString(x.Properties[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCompletionItemResolveOptions) Equal(y *ClientCompletionItemResolveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Properties, y.Properties, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCompletionItemInsertTextModeOptions) DeepCopy() *ClientCompletionItemInsertTextModeOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCompletionItemInsertTextModeOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCompletionItemInsertTextModeOptions) Equal(y *ClientCompletionItemInsertTextModeOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[InsertTextMode])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentDiagnosticParams) DeepCopy() *DocumentDiagnosticParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentDiagnosticParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	if x.Identifier != nil {
+		v0 := *x.Identifier
+		v0 = cloneString(v0)
+		x.Identifier = &v0
+	}
+	if x.PreviousResultID != nil {
+		v0 := *x.PreviousResultID
+		v0 = cloneString(v0)
+		x.PreviousResultID = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentDiagnosticParams) Equal(y *DocumentDiagnosticParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		equalPtr(x.Identifier, y.Identifier, equalValue[string]) &&
+		equalPtr(x.PreviousResultID, y.PreviousResultID, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentDiagnosticReportPartialResult) DeepCopy() *DocumentDiagnosticReportPartialResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentDiagnosticReportPartialResult) deepCopyFields() {
+	if x.RelatedDocuments != nil {
+		m0 := make(map[uri.URI]FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport, len(x.RelatedDocuments))
+		for k0, v0 := range x.RelatedDocuments {
+			k0 = cloneString(k0)
+			v0 = deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(v0)
+			m0[k0] = v0
+		}
+		x.RelatedDocuments = m0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentDiagnosticReportPartialResult) Equal(y *DocumentDiagnosticReportPartialResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalMap(x.RelatedDocuments, y.RelatedDocuments, func(a, b *FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) bool {
+		return equalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(*a, *b)
+	})
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticServerCancellationData) DeepCopy() *DiagnosticServerCancellationData {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticServerCancellationData) Equal(y *DiagnosticServerCancellationData) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.RetriggerRequest == y.RetriggerRequest
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticRegistrationOptions) DeepCopy() *DiagnosticRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DiagnosticOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticRegistrationOptions) Equal(y *DiagnosticRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DiagnosticOptions.Equal(&y.DiagnosticOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceDiagnosticParams) DeepCopy() *WorkspaceDiagnosticParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceDiagnosticParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	if x.Identifier != nil {
+		v0 := *x.Identifier
+		v0 = cloneString(v0)
+		x.Identifier = &v0
+	}
+	x.PreviousResultIds = slices.Clone(x.PreviousResultIds)
+	for i0 := range x.PreviousResultIds {
+		x.PreviousResultIds[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceDiagnosticParams) Equal(y *WorkspaceDiagnosticParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		equalPtr(x.Identifier, y.Identifier, equalValue[string]) &&
+		equalSlice(x.PreviousResultIds, y.PreviousResultIds, (*PreviousResultId).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceDiagnosticReport) DeepCopy() *WorkspaceDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceDiagnosticReport) deepCopyFields() {
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0] = deepCopyWorkspaceDocumentDiagnosticReport(x.Items[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceDiagnosticReport) Equal(y *WorkspaceDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Items, y.Items, func(a, b *WorkspaceDocumentDiagnosticReport) bool {
+		return equalWorkspaceDocumentDiagnosticReport(*a, *b)
+	})
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceDiagnosticReportPartialResult) DeepCopy() *WorkspaceDiagnosticReportPartialResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceDiagnosticReportPartialResult) deepCopyFields() {
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0] = deepCopyWorkspaceDocumentDiagnosticReport(x.Items[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceDiagnosticReportPartialResult) Equal(y *WorkspaceDiagnosticReportPartialResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Items, y.Items, func(a, b *WorkspaceDocumentDiagnosticReport) bool {
+		return equalWorkspaceDocumentDiagnosticReport(*a, *b)
+	})
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RelatedFullDocumentDiagnosticReport) DeepCopy() *RelatedFullDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RelatedFullDocumentDiagnosticReport) deepCopyFields() {
+	x.FullDocumentDiagnosticReport.deepCopyFields()
+	if x.RelatedDocuments != nil {
+		m0 := make(map[uri.URI]FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport, len(x.RelatedDocuments))
+		for k0, v0 := range x.RelatedDocuments {
+			k0 = cloneString(k0)
+			v0 = deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(v0)
+			m0[k0] = v0
+		}
+		x.RelatedDocuments = m0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RelatedFullDocumentDiagnosticReport) Equal(y *RelatedFullDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.FullDocumentDiagnosticReport.Equal(&y.FullDocumentDiagnosticReport) &&
+		equalMap(x.RelatedDocuments, y.RelatedDocuments, func(a, b *FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) bool {
+			return equalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(*a, *b)
+		})
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RelatedUnchangedDocumentDiagnosticReport) DeepCopy() *RelatedUnchangedDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RelatedUnchangedDocumentDiagnosticReport) deepCopyFields() {
+	x.UnchangedDocumentDiagnosticReport.deepCopyFields()
+	if x.RelatedDocuments != nil {
+		m0 := make(map[uri.URI]FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport, len(x.RelatedDocuments))
+		for k0, v0 := range x.RelatedDocuments {
+			k0 = cloneString(k0)
+			v0 = deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(v0)
+			m0[k0] = v0
+		}
+		x.RelatedDocuments = m0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RelatedUnchangedDocumentDiagnosticReport) Equal(y *RelatedUnchangedDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.UnchangedDocumentDiagnosticReport.Equal(&y.UnchangedDocumentDiagnosticReport) &&
+		equalMap(x.RelatedDocuments, y.RelatedDocuments, func(a, b *FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) bool {
+			return equalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(*a, *b)
+		})
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FullDocumentDiagnosticReport) DeepCopy() *FullDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FullDocumentDiagnosticReport) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	if x.ResultID != nil {
+		v0 := *x.ResultID
+		v0 = cloneString(v0)
+		x.ResultID = &v0
+	}
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FullDocumentDiagnosticReport) Equal(y *FullDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		equalPtr(x.ResultID, y.ResultID, equalValue[string]) &&
+		equalSlice(x.Items, y.Items, (*Diagnostic).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *UnchangedDocumentDiagnosticReport) DeepCopy() *UnchangedDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *UnchangedDocumentDiagnosticReport) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.ResultID = cloneString(x.ResultID)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *UnchangedDocumentDiagnosticReport) Equal(y *UnchangedDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.ResultID == y.ResultID
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticOptions) DeepCopy() *DiagnosticOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.Identifier != nil {
+		v0 := *x.Identifier
+		v0 = cloneString(v0)
+		x.Identifier = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticOptions) Equal(y *DiagnosticOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.Identifier, y.Identifier, equalValue[string]) &&
+		x.InterFileDependencies == y.InterFileDependencies &&
+		x.WorkspaceDiagnostics == y.WorkspaceDiagnostics
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PreviousResultId) DeepCopy() *PreviousResultId {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PreviousResultId) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	x.Value = cloneString(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PreviousResultId) Equal(y *PreviousResultId) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.Value == y.Value
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceFullDocumentDiagnosticReport) DeepCopy() *WorkspaceFullDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceFullDocumentDiagnosticReport) deepCopyFields() {
+	x.FullDocumentDiagnosticReport.deepCopyFields()
+	x.URI = cloneString(x.URI)
+	if x.Version != nil {
+		v0 := *x.Version
+		x.Version = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceFullDocumentDiagnosticReport) Equal(y *WorkspaceFullDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.FullDocumentDiagnosticReport.Equal(&y.FullDocumentDiagnosticReport) &&
+		x.URI == y.URI &&
+		equalPtr(x.Version, y.Version, equalValue[int32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceUnchangedDocumentDiagnosticReport) DeepCopy() *WorkspaceUnchangedDocumentDiagnosticReport {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceUnchangedDocumentDiagnosticReport) deepCopyFields() {
+	x.UnchangedDocumentDiagnosticReport.deepCopyFields()
+	x.URI = cloneString(x.URI)
+	if x.Version != nil {
+		v0 := *x.Version
+		x.Version = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceUnchangedDocumentDiagnosticReport) Equal(y *WorkspaceUnchangedDocumentDiagnosticReport) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.UnchangedDocumentDiagnosticReport.Equal(&y.UnchangedDocumentDiagnosticReport) &&
+		x.URI == y.URI &&
+		equalPtr(x.Version, y.Version, equalValue[int32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticWorkspaceClientCapabilities) DeepCopy() *DiagnosticWorkspaceClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticWorkspaceClientCapabilities) deepCopyFields() {
+	if x.RefreshSupport != nil {
+		v0 := *x.RefreshSupport
+		x.RefreshSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticWorkspaceClientCapabilities) Equal(y *DiagnosticWorkspaceClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RefreshSupport, y.RefreshSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticClientCapabilities) DeepCopy() *DiagnosticClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticClientCapabilities) deepCopyFields() {
+	x.DiagnosticsCapabilities.deepCopyFields()
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.RelatedDocumentSupport != nil {
+		v0 := *x.RelatedDocumentSupport
+		x.RelatedDocumentSupport = &v0
+	}
+	if x.MarkupMessageSupport != nil {
+		v0 := *x.MarkupMessageSupport
+		x.MarkupMessageSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticClientCapabilities) Equal(y *DiagnosticClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.DiagnosticsCapabilities.Equal(&y.DiagnosticsCapabilities) &&
+		equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.RelatedDocumentSupport, y.RelatedDocumentSupport, equalValue[bool]) &&
+		equalPtr(x.MarkupMessageSupport, y.MarkupMessageSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DiagnosticsCapabilities) DeepCopy() *DiagnosticsCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DiagnosticsCapabilities) deepCopyFields() {
+	if x.RelatedInformation != nil {
+		v0 := *x.RelatedInformation
+		x.RelatedInformation = &v0
+	}
+	x.TagSupport.deepCopyFields()
+	if x.CodeDescriptionSupport != nil {
+		v0 := *x.CodeDescriptionSupport
+		x.CodeDescriptionSupport = &v0
+	}
+	if x.DataSupport != nil {
+		v0 := *x.DataSupport
+		x.DataSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DiagnosticsCapabilities) Equal(y *DiagnosticsCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.RelatedInformation, y.RelatedInformation, equalValue[bool]) &&
+		x.TagSupport.Equal(&y.TagSupport) &&
+		equalPtr(x.CodeDescriptionSupport, y.CodeDescriptionSupport, equalValue[bool]) &&
+		equalPtr(x.DataSupport, y.DataSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientDiagnosticsTagOptions) DeepCopy() *ClientDiagnosticsTagOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientDiagnosticsTagOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientDiagnosticsTagOptions) Equal(y *ClientDiagnosticsTagOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[DiagnosticTag])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PublishDiagnosticsParams) DeepCopy() *PublishDiagnosticsParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PublishDiagnosticsParams) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	x.Diagnostics = slices.Clone(x.Diagnostics)
+	for i0 := range x.Diagnostics {
+		x.Diagnostics[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PublishDiagnosticsParams) Equal(y *PublishDiagnosticsParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		equalOptional(x.Version, y.Version, equalValue[int32]) &&
+		equalSlice(x.Diagnostics, y.Diagnostics, (*Diagnostic).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PublishDiagnosticsClientCapabilities) DeepCopy() *PublishDiagnosticsClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PublishDiagnosticsClientCapabilities) deepCopyFields() {
+	x.DiagnosticsCapabilities.deepCopyFields()
+	if x.VersionSupport != nil {
+		v0 := *x.VersionSupport
+		x.VersionSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PublishDiagnosticsClientCapabilities) Equal(y *PublishDiagnosticsClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.DiagnosticsCapabilities.Equal(&y.DiagnosticsCapabilities) &&
+		equalPtr(x.VersionSupport, y.VersionSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelpParams) DeepCopy() *SignatureHelpParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelpParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.Context.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelpParams) Equal(y *SignatureHelpParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelp) DeepCopy() *SignatureHelp {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelp) deepCopyFields() {
+	x.Signatures = slices.Clone(x.Signatures)
+	for i0 := range x.Signatures {
+		x.Signatures[i0].deepCopyFields()
+	}
+	if x.ActiveSignature != nil {
+		v0 := *x.ActiveSignature
+		x.ActiveSignature = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelp) Equal(y *SignatureHelp) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Signatures, y.Signatures, (*SignatureInformation).Equal) &&
+		equalPtr(x.ActiveSignature, y.ActiveSignature, equalValue[uint32]) &&
+		equalNullable(x.ActiveParameter, y.ActiveParameter, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelpRegistrationOptions) DeepCopy() *SignatureHelpRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelpRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.SignatureHelpOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelpRegistrationOptions) Equal(y *SignatureHelpRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.SignatureHelpOptions.Equal(&y.SignatureHelpOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelpContext) DeepCopy() *SignatureHelpContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelpContext) deepCopyFields() {
+	if x.TriggerCharacter != nil {
+		v0 := *x.TriggerCharacter
+		v0 = cloneString(v0)
+		x.TriggerCharacter = &v0
+	}
+	x.ActiveSignatureHelp.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelpContext) Equal(y *SignatureHelpContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TriggerKind == y.TriggerKind &&
+		equalPtr(x.TriggerCharacter, y.TriggerCharacter, equalValue[string]) &&
+		x.IsRetrigger == y.IsRetrigger &&
+		x.ActiveSignatureHelp.Equal(&y.ActiveSignatureHelp)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureInformation) DeepCopy() *SignatureInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureInformation) deepCopyFields() {
+	x.Label = cloneString(x.Label)
+	x.Documentation = deepCopyInlayHintTooltip(x.Documentation)
+	x.Parameters = slices.Clone(x.Parameters)
+	for i0 := range x.Parameters {
+		x.Parameters[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureInformation) Equal(y *SignatureInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Label == y.Label &&
+		equalInlayHintTooltip(x.Documentation, y.Documentation) &&
+		equalSlice(x.Parameters, y.Parameters, (*ParameterInformation).Equal) &&
+		equalNullable(x.ActiveParameter, y.ActiveParameter, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelpOptions) DeepCopy() *SignatureHelpOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelpOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	x.TriggerCharacters = slices.Clone(x.TriggerCharacters)
+	for i0 := range x.TriggerCharacters {
+		x.TriggerCharacters[i0] = cloneString(x.TriggerCharacters[i0])
+	}
+	x.RetriggerCharacters = slices.Clone(x.RetriggerCharacters)
+	for i0 := range x.RetriggerCharacters {
+		x.RetriggerCharacters[i0] = cloneString(x.RetriggerCharacters[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelpOptions) Equal(y *SignatureHelpOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalSlice(x.TriggerCharacters, y.TriggerCharacters, equalValue[string]) &&
+		equalSlice(x.RetriggerCharacters, y.RetriggerCharacters, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ParameterInformation) DeepCopy() *ParameterInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ParameterInformation) deepCopyFields() {
+	x.Label = deepCopyParameterInformationLabel(x.Label)
+	x.Documentation = deepCopyInlayHintTooltip(x.Documentation)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ParameterInformation) Equal(y *ParameterInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalParameterInformationLabel(x.Label, y.Label) &&
+		equalInlayHintTooltip(x.Documentation, y.Documentation)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SignatureHelpClientCapabilities) DeepCopy() *SignatureHelpClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *SignatureHelpClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.SignatureInformation = x.SignatureInformation.DeepCopy()
+	if x.ContextSupport != nil {
+		v0 := *x.ContextSupport
+		x.ContextSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SignatureHelpClientCapabilities) Equal(y *SignatureHelpClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.SignatureInformation.Equal(y.SignatureInformation) &&
+		equalPtr(x.ContextSupport, y.ContextSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSignatureInformationOptions) DeepCopy() *ClientSignatureInformationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSignatureInformationOptions) deepCopyFields() {
+	x.DocumentationFormat = slices.Clone(x.DocumentationFormat)
+	for i0 := range x.DocumentationFormat {
+		x.DocumentationFormat[i0] = cloneString(x.DocumentationFormat[i0])
+	}
+	x.ParameterInformation = x.ParameterInformation.DeepCopy()
+	if x.ActiveParameterSupport != nil {
+		v0 := *x.ActiveParameterSupport
+		x.ActiveParameterSupport = &v0
+	}
+	if x.NoActiveParameterSupport != nil {
+		v0 := *x.NoActiveParameterSupport
+		x.NoActiveParameterSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSignatureInformationOptions) Equal(y *ClientSignatureInformationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.DocumentationFormat, y.DocumentationFormat, equalValue[MarkupKind]) &&
+		x.ParameterInformation.Equal(y.ParameterInformation) &&
+		equalPtr(x.ActiveParameterSupport, y.ActiveParameterSupport, equalValue[bool]) &&
+		equalPtr(x.NoActiveParameterSupport, y.NoActiveParameterSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSignatureParameterInformationOptions) DeepCopy() *ClientSignatureParameterInformationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSignatureParameterInformationOptions) deepCopyFields() {
+	if x.LabelOffsetSupport != nil {
+		v0 := *x.LabelOffsetSupport
+		x.LabelOffsetSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSignatureParameterInformationOptions) Equal(y *ClientSignatureParameterInformationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.LabelOffsetSupport, y.LabelOffsetSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionParams) DeepCopy() *CodeActionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.Context.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionParams) Equal(y *CodeActionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Range.Equal(&y.Range) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeAction) DeepCopy() *CodeAction {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionRegistrationOptions) DeepCopy() *CodeActionRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.CodeActionOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionRegistrationOptions) Equal(y *CodeActionRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.CodeActionOptions.Equal(&y.CodeActionOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionContext) DeepCopy() *CodeActionContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionContext) deepCopyFields() {
+	x.Diagnostics = slices.Clone(x.Diagnostics)
+	for i0 := range x.Diagnostics {
+		x.Diagnostics[i0].deepCopyFields()
+	}
+	x.Only = slices.Clone(x.Only)
+	for i0 := range x.Only {
+		x.Only[i0] = cloneString(x.Only[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionContext) Equal(y *CodeActionContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Diagnostics, y.Diagnostics, (*Diagnostic).Equal) &&
+		equalSlice(x.Only, y.Only, equalValue[CodeActionKind]) &&
+		x.TriggerKind == y.TriggerKind
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionDisabled) DeepCopy() *CodeActionDisabled {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionDisabled) deepCopyFields() {
+	x.Reason = cloneString(x.Reason)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionDisabled) Equal(y *CodeActionDisabled) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Reason == y.Reason
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionOptions) DeepCopy() *CodeActionOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	x.CodeActionKinds = slices.Clone(x.CodeActionKinds)
+	for i0 := range x.CodeActionKinds {
+		x.CodeActionKinds[i0] = cloneString(x.CodeActionKinds[i0])
+	}
+	x.Documentation = slices.Clone(x.Documentation)
+	for i0 := range x.Documentation {
+		x.Documentation[i0].deepCopyFields()
+	}
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionOptions) Equal(y *CodeActionOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalSlice(x.CodeActionKinds, y.CodeActionKinds, equalValue[CodeActionKind]) &&
+		equalSlice(x.Documentation, y.Documentation, (*CodeActionKindDocumentation).Equal) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionKindDocumentation) DeepCopy() *CodeActionKindDocumentation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionKindDocumentation) deepCopyFields() {
+	x.Kind = cloneString(x.Kind)
+	x.Command.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionKindDocumentation) Equal(y *CodeActionKindDocumentation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Kind == y.Kind &&
+		x.Command.Equal(&y.Command)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CodeActionClientCapabilities) DeepCopy() *CodeActionClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCodeActionLiteralOptions) DeepCopy() *ClientCodeActionLiteralOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCodeActionLiteralOptions) deepCopyFields() {
+	x.CodeActionKind.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCodeActionLiteralOptions) Equal(y *ClientCodeActionLiteralOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.CodeActionKind.Equal(&y.CodeActionKind)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCodeActionResolveOptions) DeepCopy() *ClientCodeActionResolveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCodeActionResolveOptions) deepCopyFields() {
+	x.Properties = slices.Clone(x.Properties)
+	for i0 := range x.Properties {
+		x.Properties[i0] = cloneString(x.Properties[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCodeActionResolveOptions) Equal(y *ClientCodeActionResolveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Properties, y.Properties, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientCodeActionKindOptions) DeepCopy() *ClientCodeActionKindOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientCodeActionKindOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+	for i0 := range x.ValueSet {
+		x.ValueSet[i0] = cloneString(x.ValueSet[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientCodeActionKindOptions) Equal(y *ClientCodeActionKindOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[CodeActionKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentColorParams) DeepCopy() *DocumentColorParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentColorParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentColorParams) Equal(y *DocumentColorParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ColorInformation) DeepCopy() *ColorInformation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ColorInformation) Equal(y *ColorInformation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Color.Equal(&y.Color)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentColorRegistrationOptions) DeepCopy() *DocumentColorRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentColorRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentColorOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentColorRegistrationOptions) Equal(y *DocumentColorRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentColorOptions.Equal(&y.DocumentColorOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *Color) DeepCopy() *Color {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *Color) Equal(y *Color) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Red == y.Red &&
+		x.Green == y.Green &&
+		x.Blue == y.Blue &&
+		x.Alpha == y.Alpha
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentColorOptions) DeepCopy() *DocumentColorOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentColorOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentColorOptions) Equal(y *DocumentColorOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentColorClientCapabilities) DeepCopy() *DocumentColorClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentColorClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentColorClientCapabilities) Equal(y *DocumentColorClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ColorPresentationParams) DeepCopy() *ColorPresentationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ColorPresentationParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ColorPresentationParams) Equal(y *ColorPresentationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Color.Equal(&y.Color) &&
+		x.Range.Equal(&y.Range)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ColorPresentation) DeepCopy() *ColorPresentation {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ColorPresentation) deepCopyFields() {
+	x.Label = cloneString(x.Label)
+	x.TextEdit.deepCopyFields()
+	x.AdditionalTextEdits = slices.Clone(x.AdditionalTextEdits)
+	for i0 := range x.AdditionalTextEdits {
+		x.AdditionalTextEdits[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ColorPresentation) Equal(y *ColorPresentation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Label == y.Label &&
+		x.TextEdit.Equal(&y.TextEdit) &&
+		equalSlice(x.AdditionalTextEdits, y.AdditionalTextEdits, (*TextEdit).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentFormattingParams) DeepCopy() *DocumentFormattingParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentFormattingParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.Options.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentFormattingParams) Equal(y *DocumentFormattingParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Options.Equal(&y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentFormattingRegistrationOptions) DeepCopy() *DocumentFormattingRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentFormattingRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentFormattingOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentFormattingRegistrationOptions) Equal(y *DocumentFormattingRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentFormattingOptions.Equal(&y.DocumentFormattingOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FormattingOptions) DeepCopy() *FormattingOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FormattingOptions) deepCopyFields() {
+	if x.TrimTrailingWhitespace != nil {
+		v0 := *x.TrimTrailingWhitespace
+		x.TrimTrailingWhitespace = &v0
+	}
+	if x.InsertFinalNewline != nil {
+		v0 := *x.InsertFinalNewline
+		x.InsertFinalNewline = &v0
+	}
+	if x.TrimFinalNewlines != nil {
+		v0 := *x.TrimFinalNewlines
+		x.TrimFinalNewlines = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FormattingOptions) Equal(y *FormattingOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TabSize == y.TabSize &&
+		x.InsertSpaces == y.InsertSpaces &&
+		equalPtr(x.TrimTrailingWhitespace, y.TrimTrailingWhitespace, equalValue[bool]) &&
+		equalPtr(x.InsertFinalNewline, y.InsertFinalNewline, equalValue[bool]) &&
+		equalPtr(x.TrimFinalNewlines, y.TrimFinalNewlines, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentFormattingOptions) DeepCopy() *DocumentFormattingOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentFormattingOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentFormattingOptions) Equal(y *DocumentFormattingOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentFormattingClientCapabilities) DeepCopy() *DocumentFormattingClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentFormattingClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentFormattingClientCapabilities) Equal(y *DocumentFormattingClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentRangeFormattingParams) DeepCopy() *DocumentRangeFormattingParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentRangeFormattingParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.Options.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentRangeFormattingParams) Equal(y *DocumentRangeFormattingParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		x.Range.Equal(&y.Range) &&
+		x.Options.Equal(&y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentRangeFormattingRegistrationOptions) DeepCopy() *DocumentRangeFormattingRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentRangeFormattingRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentRangeFormattingOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentRangeFormattingRegistrationOptions) Equal(y *DocumentRangeFormattingRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentRangeFormattingOptions.Equal(&y.DocumentRangeFormattingOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentRangesFormattingParams) DeepCopy() *DocumentRangesFormattingParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentRangesFormattingParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.TextDocument.deepCopyFields()
+	x.Ranges = slices.Clone(x.Ranges)
+	x.Options.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentRangesFormattingParams) Equal(y *DocumentRangesFormattingParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.TextDocument.Equal(&y.TextDocument) &&
+		equalSlice(x.Ranges, y.Ranges, (*Range).Equal) &&
+		x.Options.Equal(&y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentRangeFormattingOptions) DeepCopy() *DocumentRangeFormattingOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentRangeFormattingOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.RangesSupport != nil {
+		v0 := *x.RangesSupport
+		x.RangesSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentRangeFormattingOptions) Equal(y *DocumentRangeFormattingOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.RangesSupport, y.RangesSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentRangeFormattingClientCapabilities) DeepCopy() *DocumentRangeFormattingClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentRangeFormattingClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.RangesSupport != nil {
+		v0 := *x.RangesSupport
+		x.RangesSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentRangeFormattingClientCapabilities) Equal(y *DocumentRangeFormattingClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.RangesSupport, y.RangesSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentOnTypeFormattingParams) DeepCopy() *DocumentOnTypeFormattingParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentOnTypeFormattingParams) deepCopyFields() {
+	x.TextDocument.deepCopyFields()
+	x.Ch = cloneString(x.Ch)
+	x.Options.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentOnTypeFormattingParams) Equal(y *DocumentOnTypeFormattingParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocument.Equal(&y.TextDocument) &&
+		x.Position.Equal(&y.Position) &&
+		x.Ch == y.Ch &&
+		x.Options.Equal(&y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentOnTypeFormattingRegistrationOptions) DeepCopy() *DocumentOnTypeFormattingRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentOnTypeFormattingRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.DocumentOnTypeFormattingOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentOnTypeFormattingRegistrationOptions) Equal(y *DocumentOnTypeFormattingRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.DocumentOnTypeFormattingOptions.Equal(&y.DocumentOnTypeFormattingOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentOnTypeFormattingOptions) DeepCopy() *DocumentOnTypeFormattingOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentOnTypeFormattingOptions) deepCopyFields() {
+	x.FirstTriggerCharacter = cloneString(x.FirstTriggerCharacter)
+	x.MoreTriggerCharacter = slices.Clone(x.MoreTriggerCharacter)
+	for i0 := range x.MoreTriggerCharacter {
+		x.MoreTriggerCharacter[i0] = cloneString(x.MoreTriggerCharacter[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentOnTypeFormattingOptions) Equal(y *DocumentOnTypeFormattingOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.FirstTriggerCharacter == y.FirstTriggerCharacter &&
+		equalSlice(x.MoreTriggerCharacter, y.MoreTriggerCharacter, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DocumentOnTypeFormattingClientCapabilities) DeepCopy() *DocumentOnTypeFormattingClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DocumentOnTypeFormattingClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DocumentOnTypeFormattingClientCapabilities) Equal(y *DocumentOnTypeFormattingClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameParams) DeepCopy() *RenameParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.NewName = cloneString(x.NewName)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameParams) Equal(y *RenameParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.NewName == y.NewName
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameRegistrationOptions) DeepCopy() *RenameRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.RenameOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameRegistrationOptions) Equal(y *RenameRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.RenameOptions.Equal(&y.RenameOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PrepareRenameParams) DeepCopy() *PrepareRenameParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PrepareRenameParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PrepareRenameParams) Equal(y *PrepareRenameParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameFile) DeepCopy() *RenameFile {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameFile) deepCopyFields() {
+	x.ResourceOperation.deepCopyFields()
+	x.Kind = cloneString(x.Kind)
+	x.OldURI = cloneString(x.OldURI)
+	x.NewURI = cloneString(x.NewURI)
+	x.Options = x.Options.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameFile) Equal(y *RenameFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ResourceOperation.Equal(&y.ResourceOperation) &&
+		x.Kind == y.Kind &&
+		x.OldURI == y.OldURI &&
+		x.NewURI == y.NewURI &&
+		x.Options.Equal(y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameOptions) DeepCopy() *RenameOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.PrepareProvider != nil {
+		v0 := *x.PrepareProvider
+		x.PrepareProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameOptions) Equal(y *RenameOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.PrepareProvider, y.PrepareProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PrepareRenamePlaceholder) DeepCopy() *PrepareRenamePlaceholder {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *PrepareRenamePlaceholder) deepCopyFields() {
+	x.Placeholder = cloneString(x.Placeholder)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PrepareRenamePlaceholder) Equal(y *PrepareRenamePlaceholder) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		x.Placeholder == y.Placeholder
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *PrepareRenameDefaultBehavior) DeepCopy() *PrepareRenameDefaultBehavior {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *PrepareRenameDefaultBehavior) Equal(y *PrepareRenameDefaultBehavior) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.DefaultBehavior == y.DefaultBehavior
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameFileOptions) DeepCopy() *RenameFileOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameFileOptions) deepCopyFields() {
+	if x.Overwrite != nil {
+		v0 := *x.Overwrite
+		x.Overwrite = &v0
+	}
+	if x.IgnoreIfExists != nil {
+		v0 := *x.IgnoreIfExists
+		x.IgnoreIfExists = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameFileOptions) Equal(y *RenameFileOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Overwrite, y.Overwrite, equalValue[bool]) &&
+		equalPtr(x.IgnoreIfExists, y.IgnoreIfExists, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameClientCapabilities) DeepCopy() *RenameClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.PrepareSupport != nil {
+		v0 := *x.PrepareSupport
+		x.PrepareSupport = &v0
+	}
+	if x.HonorsChangeAnnotations != nil {
+		v0 := *x.HonorsChangeAnnotations
+		x.HonorsChangeAnnotations = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameClientCapabilities) Equal(y *RenameClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.PrepareSupport, y.PrepareSupport, equalValue[bool]) &&
+		x.PrepareSupportDefaultBehavior == y.PrepareSupportDefaultBehavior &&
+		equalPtr(x.HonorsChangeAnnotations, y.HonorsChangeAnnotations, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LinkedEditingRangeParams) DeepCopy() *LinkedEditingRangeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LinkedEditingRangeParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LinkedEditingRangeParams) Equal(y *LinkedEditingRangeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LinkedEditingRanges) DeepCopy() *LinkedEditingRanges {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LinkedEditingRanges) deepCopyFields() {
+	x.Ranges = slices.Clone(x.Ranges)
+	if x.WordPattern != nil {
+		v0 := *x.WordPattern
+		v0 = cloneString(v0)
+		x.WordPattern = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LinkedEditingRanges) Equal(y *LinkedEditingRanges) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Ranges, y.Ranges, (*Range).Equal) &&
+		equalPtr(x.WordPattern, y.WordPattern, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LinkedEditingRangeRegistrationOptions) DeepCopy() *LinkedEditingRangeRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LinkedEditingRangeRegistrationOptions) deepCopyFields() {
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.LinkedEditingRangeOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LinkedEditingRangeRegistrationOptions) Equal(y *LinkedEditingRangeRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.LinkedEditingRangeOptions.Equal(&y.LinkedEditingRangeOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LinkedEditingRangeOptions) DeepCopy() *LinkedEditingRangeOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LinkedEditingRangeOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LinkedEditingRangeOptions) Equal(y *LinkedEditingRangeOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LinkedEditingRangeClientCapabilities) DeepCopy() *LinkedEditingRangeClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LinkedEditingRangeClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LinkedEditingRangeClientCapabilities) Equal(y *LinkedEditingRangeClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionParams) DeepCopy() *InlineCompletionParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionParams) deepCopyFields() {
+	x.TextDocumentPositionParams.deepCopyFields()
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.Context.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionParams) Equal(y *InlineCompletionParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentPositionParams.Equal(&y.TextDocumentPositionParams) &&
+		x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.Context.Equal(&y.Context)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionList) DeepCopy() *InlineCompletionList {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionList) deepCopyFields() {
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionList) Equal(y *InlineCompletionList) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Items, y.Items, (*InlineCompletionItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionItem) DeepCopy() *InlineCompletionItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionItem) deepCopyFields() {
+	x.InsertText = deepCopyInlineCompletionItemInsertText(x.InsertText)
+	if x.FilterText != nil {
+		v0 := *x.FilterText
+		v0 = cloneString(v0)
+		x.FilterText = &v0
+	}
+	x.Range = x.Range.DeepCopy()
+	x.Command.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionItem) Equal(y *InlineCompletionItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalInlineCompletionItemInsertText(x.InsertText, y.InsertText) &&
+		equalPtr(x.FilterText, y.FilterText, equalValue[string]) &&
+		x.Range.Equal(y.Range) &&
+		x.Command.Equal(&y.Command)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionRegistrationOptions) DeepCopy() *InlineCompletionRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionRegistrationOptions) deepCopyFields() {
+	x.InlineCompletionOptions.deepCopyFields()
+	x.TextDocumentRegistrationOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionRegistrationOptions) Equal(y *InlineCompletionRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.InlineCompletionOptions.Equal(&y.InlineCompletionOptions) &&
+		x.TextDocumentRegistrationOptions.Equal(&y.TextDocumentRegistrationOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionContext) DeepCopy() *InlineCompletionContext {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionContext) deepCopyFields() {
+	x.SelectedCompletionInfo.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionContext) Equal(y *InlineCompletionContext) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TriggerKind == y.TriggerKind &&
+		x.SelectedCompletionInfo.Equal(&y.SelectedCompletionInfo)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionOptions) DeepCopy() *InlineCompletionOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionOptions) Equal(y *InlineCompletionOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *InlineCompletionClientCapabilities) DeepCopy() *InlineCompletionClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *InlineCompletionClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *InlineCompletionClientCapabilities) Equal(y *InlineCompletionClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceSymbolParams) DeepCopy() *WorkspaceSymbolParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceSymbolParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.PartialResultParams.deepCopyFields()
+	x.Query = cloneString(x.Query)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceSymbolParams) Equal(y *WorkspaceSymbolParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.PartialResultParams.Equal(&y.PartialResultParams) &&
+		x.Query == y.Query
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceSymbol) DeepCopy() *WorkspaceSymbol {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceSymbol) deepCopyFields() {
+	x.BaseSymbolInformation.deepCopyFields()
+	x.Location = deepCopyWorkspaceSymbolLocation(x.Location)
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceSymbol) Equal(y *WorkspaceSymbol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.BaseSymbolInformation.Equal(&y.BaseSymbolInformation) &&
+		equalWorkspaceSymbolLocation(x.Location, y.Location) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceSymbolRegistrationOptions) DeepCopy() *WorkspaceSymbolRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceSymbolRegistrationOptions) deepCopyFields() {
+	x.WorkspaceSymbolOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceSymbolRegistrationOptions) Equal(y *WorkspaceSymbolRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkspaceSymbolOptions.Equal(&y.WorkspaceSymbolOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceSymbolOptions) DeepCopy() *WorkspaceSymbolOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceSymbolOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	if x.ResolveProvider != nil {
+		v0 := *x.ResolveProvider
+		x.ResolveProvider = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceSymbolOptions) Equal(y *WorkspaceSymbolOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalPtr(x.ResolveProvider, y.ResolveProvider, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceSymbolClientCapabilities) DeepCopy() *WorkspaceSymbolClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceSymbolClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.SymbolKind = x.SymbolKind.DeepCopy()
+	x.TagSupport.deepCopyFields()
+	x.ResolveSupport.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceSymbolClientCapabilities) Equal(y *WorkspaceSymbolClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.SymbolKind.Equal(y.SymbolKind) &&
+		x.TagSupport.Equal(&y.TagSupport) &&
+		x.ResolveSupport.Equal(&y.ResolveSupport)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSymbolKindOptions) DeepCopy() *ClientSymbolKindOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSymbolKindOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSymbolKindOptions) Equal(y *ClientSymbolKindOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[SymbolKind])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSymbolTagOptions) DeepCopy() *ClientSymbolTagOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSymbolTagOptions) deepCopyFields() {
+	x.ValueSet = slices.Clone(x.ValueSet)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSymbolTagOptions) Equal(y *ClientSymbolTagOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.ValueSet, y.ValueSet, equalValue[SymbolTag])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientSymbolResolveOptions) DeepCopy() *ClientSymbolResolveOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientSymbolResolveOptions) deepCopyFields() {
+	x.Properties = slices.Clone(x.Properties)
+	for i0 := range x.Properties {
+		x.Properties[i0] = cloneString(x.Properties[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientSymbolResolveOptions) Equal(y *ClientSymbolResolveOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Properties, y.Properties, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ConfigurationParams) DeepCopy() *ConfigurationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ConfigurationParams) deepCopyFields() {
+	x.Items = slices.Clone(x.Items)
+	for i0 := range x.Items {
+		x.Items[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ConfigurationParams) Equal(y *ConfigurationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Items, y.Items, (*ConfigurationItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ConfigurationItem) DeepCopy() *ConfigurationItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ConfigurationItem) deepCopyFields() {
+	if x.ScopeURI != nil {
+		v0 := *x.ScopeURI
+		v0 = cloneString(v0)
+		x.ScopeURI = &v0
+	}
+	if x.Section != nil {
+		v0 := *x.Section
+		v0 = cloneString(v0)
+		x.Section = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ConfigurationItem) Equal(y *ConfigurationItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.ScopeURI, y.ScopeURI, equalValue[uri.URI]) &&
+		equalPtr(x.Section, y.Section, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeConfigurationParams) DeepCopy() *DidChangeConfigurationParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeConfigurationParams) deepCopyFields() {
+	x.Settings = slices.Clone(x.Settings)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeConfigurationParams) Equal(y *DidChangeConfigurationParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalRaw(x.Settings, y.Settings)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeConfigurationRegistrationOptions) DeepCopy() *DidChangeConfigurationRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeConfigurationRegistrationOptions) deepCopyFields() {
+	x.Section = deepCopyDidChangeConfigurationRegistrationOptionsSection(x.Section)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeConfigurationRegistrationOptions) Equal(y *DidChangeConfigurationRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalDidChangeConfigurationRegistrationOptionsSection(x.Section, y.Section)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeConfigurationClientCapabilities) DeepCopy() *DidChangeConfigurationClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeConfigurationClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeConfigurationClientCapabilities) Equal(y *DidChangeConfigurationClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceFolder) DeepCopy() *WorkspaceFolder {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceFolder) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	x.Name = cloneString(x.Name)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceFolder) Equal(y *WorkspaceFolder) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.Name == y.Name
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceFoldersChangeEvent) DeepCopy() *WorkspaceFoldersChangeEvent {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceFoldersChangeEvent) deepCopyFields() {
+	x.Added = slices.Clone(x.Added)
+	for i0 := range x.Added {
+		x.Added[i0].deepCopyFields()
+	}
+	x.Removed = slices.Clone(x.Removed)
+	for i0 := range x.Removed {
+		x.Removed[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceFoldersChangeEvent) Equal(y *WorkspaceFoldersChangeEvent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Added, y.Added, (*WorkspaceFolder).Equal) &&
+		equalSlice(x.Removed, y.Removed, (*WorkspaceFolder).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceFoldersInitializeParams) DeepCopy() *WorkspaceFoldersInitializeParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceFoldersInitializeParams) deepCopyFields() {
+	if v0, ok := x.WorkspaceFolders.Get(); ok {
+		v0 = slices.Clone(v0)
+		for i1 := range v0 {
+			v0[i1].deepCopyFields()
+		}
+		x.WorkspaceFolders = NewNullable(v0)
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceFoldersInitializeParams) Equal(y *WorkspaceFoldersInitializeParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalNullable(x.WorkspaceFolders, y.WorkspaceFolders, func(a, b *[]WorkspaceFolder) bool { return equalSlice(*a, *b, (*WorkspaceFolder).Equal) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeWorkspaceFoldersParams) DeepCopy() *DidChangeWorkspaceFoldersParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeWorkspaceFoldersParams) deepCopyFields() {
+	x.Event.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeWorkspaceFoldersParams) Equal(y *DidChangeWorkspaceFoldersParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Event.Equal(&y.Event)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *CreateFilesParams) DeepCopy() *CreateFilesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CreateFilesParams) deepCopyFields() {
+	x.Files = slices.Clone(x.Files)
+	for i0 := range x.Files {
+		x.Files[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CreateFilesParams) Equal(y *CreateFilesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Files, y.Files, (*FileCreate).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationRegistrationOptions) DeepCopy() *FileOperationRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationRegistrationOptions) deepCopyFields() {
+	x.Filters = slices.Clone(x.Filters)
+	for i0 := range x.Filters {
+		x.Filters[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationRegistrationOptions) Equal(y *FileOperationRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Filters, y.Filters, (*FileOperationFilter).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RenameFilesParams) DeepCopy() *RenameFilesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RenameFilesParams) deepCopyFields() {
+	x.Files = slices.Clone(x.Files)
+	for i0 := range x.Files {
+		x.Files[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RenameFilesParams) Equal(y *RenameFilesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Files, y.Files, (*FileRename).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeleteFilesParams) DeepCopy() *DeleteFilesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DeleteFilesParams) deepCopyFields() {
+	x.Files = slices.Clone(x.Files)
+	for i0 := range x.Files {
+		x.Files[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeleteFilesParams) Equal(y *DeleteFilesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Files, y.Files, (*FileDelete).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileCreate) DeepCopy() *FileCreate {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileCreate) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileCreate) Equal(y *FileCreate) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationFilter) DeepCopy() *FileOperationFilter {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationFilter) deepCopyFields() {
+	if x.Scheme != nil {
+		v0 := *x.Scheme
+		v0 = cloneString(v0)
+		x.Scheme = &v0
+	}
+	x.Pattern.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationFilter) Equal(y *FileOperationFilter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Scheme, y.Scheme, equalValue[string]) &&
+		x.Pattern.Equal(&y.Pattern)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileRename) DeepCopy() *FileRename {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileRename) deepCopyFields() {
+	x.OldURI = cloneString(x.OldURI)
+	x.NewURI = cloneString(x.NewURI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileRename) Equal(y *FileRename) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.OldURI == y.OldURI &&
+		x.NewURI == y.NewURI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileDelete) DeepCopy() *FileDelete {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileDelete) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileDelete) Equal(y *FileDelete) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationPattern) DeepCopy() *FileOperationPattern {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationPattern) deepCopyFields() {
+	x.Glob = cloneString(x.Glob)
+	x.Matches = cloneString(x.Matches)
+	x.Options = x.Options.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationPattern) Equal(y *FileOperationPattern) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Glob == y.Glob &&
+		x.Matches == y.Matches &&
+		x.Options.Equal(y.Options)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationPatternOptions) DeepCopy() *FileOperationPatternOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationPatternOptions) deepCopyFields() {
+	if x.IgnoreCase != nil {
+		v0 := *x.IgnoreCase
+		x.IgnoreCase = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationPatternOptions) Equal(y *FileOperationPatternOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.IgnoreCase, y.IgnoreCase, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationOptions) DeepCopy() *FileOperationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationOptions) deepCopyFields() {
+	x.DidCreate.deepCopyFields()
+	x.WillCreate.deepCopyFields()
+	x.DidRename.deepCopyFields()
+	x.WillRename.deepCopyFields()
+	x.DidDelete.deepCopyFields()
+	x.WillDelete.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationOptions) Equal(y *FileOperationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.DidCreate.Equal(&y.DidCreate) &&
+		x.WillCreate.Equal(&y.WillCreate) &&
+		x.DidRename.Equal(&y.DidRename) &&
+		x.WillRename.Equal(&y.WillRename) &&
+		x.DidDelete.Equal(&y.DidDelete) &&
+		x.WillDelete.Equal(&y.WillDelete)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileOperationClientCapabilities) DeepCopy() *FileOperationClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileOperationClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.DidCreate != nil {
+		v0 := *x.DidCreate
+		x.DidCreate = &v0
+	}
+	if x.WillCreate != nil {
+		v0 := *x.WillCreate
+		x.WillCreate = &v0
+	}
+	if x.DidRename != nil {
+		v0 := *x.DidRename
+		x.DidRename = &v0
+	}
+	if x.WillRename != nil {
+		v0 := *x.WillRename
+		x.WillRename = &v0
+	}
+	if x.DidDelete != nil {
+		v0 := *x.DidDelete
+		x.DidDelete = &v0
+	}
+	if x.WillDelete != nil {
+		v0 := *x.WillDelete
+		x.WillDelete = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileOperationClientCapabilities) Equal(y *FileOperationClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.DidCreate, y.DidCreate, equalValue[bool]) &&
+		equalPtr(x.WillCreate, y.WillCreate, equalValue[bool]) &&
+		equalPtr(x.DidRename, y.DidRename, equalValue[bool]) &&
+		equalPtr(x.WillRename, y.WillRename, equalValue[bool]) &&
+		equalPtr(x.DidDelete, y.DidDelete, equalValue[bool]) &&
+		equalPtr(x.WillDelete, y.WillDelete, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeWatchedFilesParams) DeepCopy() *DidChangeWatchedFilesParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeWatchedFilesParams) deepCopyFields() {
+	x.Changes = slices.Clone(x.Changes)
+	for i0 := range x.Changes {
+		x.Changes[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeWatchedFilesParams) Equal(y *DidChangeWatchedFilesParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Changes, y.Changes, (*FileEvent).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeWatchedFilesRegistrationOptions) DeepCopy() *DidChangeWatchedFilesRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeWatchedFilesRegistrationOptions) deepCopyFields() {
+	x.Watchers = slices.Clone(x.Watchers)
+	for i0 := range x.Watchers {
+		x.Watchers[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeWatchedFilesRegistrationOptions) Equal(y *DidChangeWatchedFilesRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Watchers, y.Watchers, (*FileSystemWatcher).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileEvent) DeepCopy() *FileEvent {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileEvent) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileEvent) Equal(y *FileEvent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		x.Type == y.Type
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *FileSystemWatcher) DeepCopy() *FileSystemWatcher {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *FileSystemWatcher) deepCopyFields() {
+	x.GlobPattern = deepCopyGlobPattern(x.GlobPattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *FileSystemWatcher) Equal(y *FileSystemWatcher) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalGlobPattern(x.GlobPattern, y.GlobPattern) &&
+		x.Kind == y.Kind
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *RelativePattern) DeepCopy() *RelativePattern {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *RelativePattern) deepCopyFields() {
+	x.BaseURI = deepCopyRelativePatternBaseURI(x.BaseURI)
+	x.Pattern = cloneString(x.Pattern)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *RelativePattern) Equal(y *RelativePattern) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalRelativePatternBaseURI(x.BaseURI, y.BaseURI) &&
+		x.Pattern == y.Pattern
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DidChangeWatchedFilesClientCapabilities) DeepCopy() *DidChangeWatchedFilesClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *DidChangeWatchedFilesClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	if x.RelativePatternSupport != nil {
+		v0 := *x.RelativePatternSupport
+		x.RelativePatternSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DidChangeWatchedFilesClientCapabilities) Equal(y *DidChangeWatchedFilesClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		equalPtr(x.RelativePatternSupport, y.RelativePatternSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ExecuteCommandParams) DeepCopy() *ExecuteCommandParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ExecuteCommandParams) deepCopyFields() {
+	x.WorkDoneProgressParams.deepCopyFields()
+	x.Command = cloneString(x.Command)
+	x.Arguments = slices.Clone(x.Arguments)
+	for i0 := range x.Arguments {
+		x.Arguments[i0] = slices.Clone(x.Arguments[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ExecuteCommandParams) Equal(y *ExecuteCommandParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressParams.Equal(&y.WorkDoneProgressParams) &&
+		x.Command == y.Command &&
+		equalSlice(x.Arguments, y.Arguments, func(a, b *LSPAny) bool { return equalRaw(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ExecuteCommandRegistrationOptions) DeepCopy() *ExecuteCommandRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ExecuteCommandRegistrationOptions) deepCopyFields() {
+	x.ExecuteCommandOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ExecuteCommandRegistrationOptions) Equal(y *ExecuteCommandRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.ExecuteCommandOptions.Equal(&y.ExecuteCommandOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ExecuteCommandOptions) DeepCopy() *ExecuteCommandOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ExecuteCommandOptions) deepCopyFields() {
+	x.WorkDoneProgressOptions.deepCopyFields()
+	x.Commands = slices.Clone(x.Commands)
+	for i0 := range x.Commands {
+		x.Commands[i0] = cloneString(x.Commands[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ExecuteCommandOptions) Equal(y *ExecuteCommandOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.WorkDoneProgressOptions.Equal(&y.WorkDoneProgressOptions) &&
+		equalSlice(x.Commands, y.Commands, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ExecuteCommandClientCapabilities) DeepCopy() *ExecuteCommandClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ExecuteCommandClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ExecuteCommandClientCapabilities) Equal(y *ExecuteCommandClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ApplyWorkspaceEditParams) DeepCopy() *ApplyWorkspaceEditParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ApplyWorkspaceEditParams) deepCopyFields() {
+	if x.Label != nil {
+		v0 := *x.Label
+		v0 = cloneString(v0)
+		x.Label = &v0
+	}
+	x.Edit.deepCopyFields()
+	x.Metadata = x.Metadata.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ApplyWorkspaceEditParams) Equal(y *ApplyWorkspaceEditParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.Label, y.Label, equalValue[string]) &&
+		x.Edit.Equal(&y.Edit) &&
+		x.Metadata.Equal(y.Metadata)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ApplyWorkspaceEditResult) DeepCopy() *ApplyWorkspaceEditResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ApplyWorkspaceEditResult) deepCopyFields() {
+	if x.FailureReason != nil {
+		v0 := *x.FailureReason
+		v0 = cloneString(v0)
+		x.FailureReason = &v0
+	}
+	if x.FailedChange != nil {
+		v0 := *x.FailedChange
+		x.FailedChange = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ApplyWorkspaceEditResult) Equal(y *ApplyWorkspaceEditResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Applied == y.Applied &&
+		equalPtr(x.FailureReason, y.FailureReason, equalValue[string]) &&
+		equalPtr(x.FailedChange, y.FailedChange, equalValue[uint32])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkspaceEditMetadata) DeepCopy() *WorkspaceEditMetadata {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkspaceEditMetadata) deepCopyFields() {
+	if x.IsRefactoring != nil {
+		v0 := *x.IsRefactoring
+		x.IsRefactoring = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkspaceEditMetadata) Equal(y *WorkspaceEditMetadata) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.IsRefactoring, y.IsRefactoring, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentParams) DeepCopy() *TextDocumentContentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentParams) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentParams) Equal(y *TextDocumentContentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentResult) DeepCopy() *TextDocumentContentResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentResult) deepCopyFields() {
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentResult) Equal(y *TextDocumentContentResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentRegistrationOptions) DeepCopy() *TextDocumentContentRegistrationOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentRegistrationOptions) deepCopyFields() {
+	x.TextDocumentContentOptions.deepCopyFields()
+	x.StaticRegistrationOptions.deepCopyFields()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentRegistrationOptions) Equal(y *TextDocumentContentRegistrationOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.TextDocumentContentOptions.Equal(&y.TextDocumentContentOptions) &&
+		x.StaticRegistrationOptions.Equal(&y.StaticRegistrationOptions)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentRefreshParams) DeepCopy() *TextDocumentContentRefreshParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentRefreshParams) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentRefreshParams) Equal(y *TextDocumentContentRefreshParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentOptions) DeepCopy() *TextDocumentContentOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentOptions) deepCopyFields() {
+	x.Schemes = slices.Clone(x.Schemes)
+	for i0 := range x.Schemes {
+		x.Schemes[i0] = cloneString(x.Schemes[i0])
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentOptions) Equal(y *TextDocumentContentOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalSlice(x.Schemes, y.Schemes, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentChangePartial) DeepCopy() *TextDocumentContentChangePartial {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentChangePartial) deepCopyFields() {
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentChangePartial) Equal(y *TextDocumentContentChangePartial) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Range.Equal(&y.Range) &&
+		equalOptional(x.RangeLength, y.RangeLength, equalValue[uint32]) &&
+		x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentChangeWholeDocument) DeepCopy() *TextDocumentContentChangeWholeDocument {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentChangeWholeDocument) deepCopyFields() {
+	x.Text = cloneString(x.Text)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentChangeWholeDocument) Equal(y *TextDocumentContentChangeWholeDocument) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Text == y.Text
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *TextDocumentContentClientCapabilities) DeepCopy() *TextDocumentContentClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *TextDocumentContentClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *TextDocumentContentClientCapabilities) Equal(y *TextDocumentContentClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowMessageParams) DeepCopy() *ShowMessageParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ShowMessageParams) deepCopyFields() {
+	x.Message = cloneString(x.Message)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowMessageParams) Equal(y *ShowMessageParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Type == y.Type &&
+		x.Message == y.Message
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowMessageRequestParams) DeepCopy() *ShowMessageRequestParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ShowMessageRequestParams) deepCopyFields() {
+	x.Message = cloneString(x.Message)
+	x.Actions = slices.Clone(x.Actions)
+	for i0 := range x.Actions {
+		x.Actions[i0].deepCopyFields()
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowMessageRequestParams) Equal(y *ShowMessageRequestParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Type == y.Type &&
+		x.Message == y.Message &&
+		equalSlice(x.Actions, y.Actions, (*MessageActionItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *MessageActionItem) DeepCopy() *MessageActionItem {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *MessageActionItem) deepCopyFields() {
+	x.Title = cloneString(x.Title)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *MessageActionItem) Equal(y *MessageActionItem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Title == y.Title
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowMessageRequestClientCapabilities) DeepCopy() *ShowMessageRequestClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ShowMessageRequestClientCapabilities) deepCopyFields() {
+	x.MessageActionItem = x.MessageActionItem.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowMessageRequestClientCapabilities) Equal(y *ShowMessageRequestClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.MessageActionItem.Equal(y.MessageActionItem)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ClientShowMessageActionItemOptions) DeepCopy() *ClientShowMessageActionItemOptions {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ClientShowMessageActionItemOptions) deepCopyFields() {
+	if x.AdditionalPropertiesSupport != nil {
+		v0 := *x.AdditionalPropertiesSupport
+		x.AdditionalPropertiesSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ClientShowMessageActionItemOptions) Equal(y *ClientShowMessageActionItemOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.AdditionalPropertiesSupport, y.AdditionalPropertiesSupport, equalValue[bool])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *LogMessageParams) DeepCopy() *LogMessageParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *LogMessageParams) deepCopyFields() {
+	x.Message = cloneString(x.Message)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *LogMessageParams) Equal(y *LogMessageParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Type == y.Type &&
+		x.Message == y.Message
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowDocumentParams) DeepCopy() *ShowDocumentParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ShowDocumentParams) deepCopyFields() {
+	x.URI = cloneString(x.URI)
+	if x.External != nil {
+		v0 := *x.External
+		x.External = &v0
+	}
+	if x.TakeFocus != nil {
+		v0 := *x.TakeFocus
+		x.TakeFocus = &v0
+	}
+	x.Selection = x.Selection.DeepCopy()
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowDocumentParams) Equal(y *ShowDocumentParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.URI == y.URI &&
+		equalPtr(x.External, y.External, equalValue[bool]) &&
+		equalPtr(x.TakeFocus, y.TakeFocus, equalValue[bool]) &&
+		x.Selection.Equal(y.Selection)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowDocumentResult) DeepCopy() *ShowDocumentResult {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowDocumentResult) Equal(y *ShowDocumentResult) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Success == y.Success
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ShowDocumentClientCapabilities) DeepCopy() *ShowDocumentClientCapabilities {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ShowDocumentClientCapabilities) Equal(y *ShowDocumentClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Support == y.Support
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressCreateParams) DeepCopy() *WorkDoneProgressCreateParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressCreateParams) deepCopyFields() {
+	x.Token = deepCopyProgressToken(x.Token)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressCreateParams) Equal(y *WorkDoneProgressCreateParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.Token, y.Token)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *WorkDoneProgressCancelParams) DeepCopy() *WorkDoneProgressCancelParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *WorkDoneProgressCancelParams) deepCopyFields() {
+	x.Token = deepCopyProgressToken(x.Token)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *WorkDoneProgressCancelParams) Equal(y *WorkDoneProgressCancelParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.Token, y.Token)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *ProgressParams) DeepCopy() *ProgressParams {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	out.deepCopyFields()
+	return &out
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *ProgressParams) deepCopyFields() {
+	x.Token = deepCopyProgressToken(x.Token)
+	x.Value = slices.Clone(x.Value)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *ProgressParams) Equal(y *ProgressParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalProgressToken(x.Token, y.Token) &&
+		equalRaw(x.Value, y.Value)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *SemanticTokensOptionsRange) DeepCopy() *SemanticTokensOptionsRange {
+	if x == nil {
+		return nil
+	}
+	out := *x
+	return &out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *SemanticTokensOptionsRange) Equal(y *SemanticTokensOptionsRange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DeclarationLink) DeepCopy() *DeclarationLink {
+	return (*DeclarationLink)((*LocationLink)(x).DeepCopy())
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DeclarationLink) Equal(y *DeclarationLink) bool {
+	return (*LocationLink)(x).Equal((*LocationLink)(y))
+}
+
+// DeepCopy returns a copy of x that shares no memory with it, or nil if x is
+// nil.
+func (x *DefinitionLink) DeepCopy() *DefinitionLink {
+	return (*DefinitionLink)((*LocationLink)(x).DeepCopy())
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *DefinitionLink) Equal(y *DefinitionLink) bool {
+	return (*LocationLink)(x).Equal((*LocationLink)(y))
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x CompletionItemSlice) DeepCopy() CompletionItemSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x CompletionItemSlice) Equal(y CompletionItemSlice) bool {
+	return equalSlice(x, y, (*CompletionItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x DeclarationLinkSlice) DeepCopy() DeclarationLinkSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		(*LocationLink)(&out[i0]).deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x DeclarationLinkSlice) Equal(y DeclarationLinkSlice) bool {
+	return equalSlice(x, y, func(a, b *DeclarationLink) bool { return (*LocationLink)(a).Equal((*LocationLink)(b)) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x DefinitionLinkSlice) DeepCopy() DefinitionLinkSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		(*LocationLink)(&out[i0]).deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x DefinitionLinkSlice) Equal(y DefinitionLinkSlice) bool {
+	return equalSlice(x, y, func(a, b *DefinitionLink) bool { return (*LocationLink)(a).Equal((*LocationLink)(b)) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x DocumentSelector) DeepCopy() DocumentSelector {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0] = deepCopyDocumentFilter(out[i0])
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x DocumentSelector) Equal(y DocumentSelector) bool {
+	return equalSlice(x, y, func(a, b *DocumentFilter) bool { return equalDocumentFilter(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x DocumentSymbolSlice) DeepCopy() DocumentSymbolSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x DocumentSymbolSlice) Equal(y DocumentSymbolSlice) bool {
+	return equalSlice(x, y, (*DocumentSymbol).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x InlayHintLabelPartSlice) DeepCopy() InlayHintLabelPartSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x InlayHintLabelPartSlice) Equal(y InlayHintLabelPartSlice) bool {
+	return equalSlice(x, y, (*InlayHintLabelPart).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x InlineCompletionItemSlice) DeepCopy() InlineCompletionItemSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x InlineCompletionItemSlice) Equal(y InlineCompletionItemSlice) bool {
+	return equalSlice(x, y, (*InlineCompletionItem).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x LocationSlice) DeepCopy() LocationSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x LocationSlice) Equal(y LocationSlice) bool {
+	return equalSlice(x, y, (*Location).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x MarkedStringSlice) DeepCopy() MarkedStringSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0] = deepCopyMarkedString(out[i0])
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x MarkedStringSlice) Equal(y MarkedStringSlice) bool {
+	return equalSlice(x, y, func(a, b *MarkedString) bool { return equalMarkedString(*a, *b) })
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x StringSlice) DeepCopy() StringSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0] = cloneString(out[i0])
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x StringSlice) Equal(y StringSlice) bool {
+	return equalSlice(x, y, equalValue[string])
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x SymbolInformationSlice) DeepCopy() SymbolInformationSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x SymbolInformationSlice) Equal(y SymbolInformationSlice) bool {
+	return equalSlice(x, y, (*SymbolInformation).Equal)
+}
+
+// DeepCopy returns a copy of x that shares no memory with it.
+func (x WorkspaceSymbolSlice) DeepCopy() WorkspaceSymbolSlice {
+	out := slices.Clone(x)
+	for i0 := range out {
+		out[i0].deepCopyFields()
+	}
+	return out
+}
+
+// Equal reports whether x and y hold the same value.
+func (x WorkspaceSymbolSlice) Equal(y WorkspaceSymbolSlice) bool {
+	return equalSlice(x, y, (*WorkspaceSymbol).Equal)
+}
+
+// deepCopyDeclaration returns a copy of v that shares no memory with it.
+func deepCopyDeclaration(v Declaration) Declaration {
+	switch v := v.(type) {
+	case *Location:
+		return v.DeepCopy()
+	case LocationSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDeclaration reports whether a and b hold the same arm and value.
+func equalDeclaration(a, b Declaration) bool {
+	switch a := a.(type) {
+	case *Location:
+		b, ok := b.(*Location)
+		return ok && a.Equal(b)
+	case LocationSlice:
+		b, ok := b.(LocationSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlineValue returns a copy of v that shares no memory with it.
+func deepCopyInlineValue(v InlineValue) InlineValue {
+	switch v := v.(type) {
+	case *InlineValueText:
+		return v.DeepCopy()
+	case *InlineValueVariableLookup:
+		return v.DeepCopy()
+	case *InlineValueEvaluatableExpression:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlineValue reports whether a and b hold the same arm and value.
+func equalInlineValue(a, b InlineValue) bool {
+	switch a := a.(type) {
+	case *InlineValueText:
+		b, ok := b.(*InlineValueText)
+		return ok && a.Equal(b)
+	case *InlineValueVariableLookup:
+		b, ok := b.(*InlineValueVariableLookup)
+		return ok && a.Equal(b)
+	case *InlineValueEvaluatableExpression:
+		b, ok := b.(*InlineValueEvaluatableExpression)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentDiagnosticReport returns a copy of v that shares no memory with it.
+func deepCopyDocumentDiagnosticReport(v DocumentDiagnosticReport) DocumentDiagnosticReport {
+	switch v := v.(type) {
+	case *RelatedFullDocumentDiagnosticReport:
+		return v.DeepCopy()
+	case *RelatedUnchangedDocumentDiagnosticReport:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentDiagnosticReport reports whether a and b hold the same arm and value.
+func equalDocumentDiagnosticReport(a, b DocumentDiagnosticReport) bool {
+	switch a := a.(type) {
+	case *RelatedFullDocumentDiagnosticReport:
+		b, ok := b.(*RelatedFullDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	case *RelatedUnchangedDocumentDiagnosticReport:
+		b, ok := b.(*RelatedUnchangedDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyPrepareRenameResult returns a copy of v that shares no memory with it.
+func deepCopyPrepareRenameResult(v PrepareRenameResult) PrepareRenameResult {
+	switch v := v.(type) {
+	case *Range:
+		return v.DeepCopy()
+	case *PrepareRenamePlaceholder:
+		return v.DeepCopy()
+	case *PrepareRenameDefaultBehavior:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalPrepareRenameResult reports whether a and b hold the same arm and value.
+func equalPrepareRenameResult(a, b PrepareRenameResult) bool {
+	switch a := a.(type) {
+	case *Range:
+		b, ok := b.(*Range)
+		return ok && a.Equal(b)
+	case *PrepareRenamePlaceholder:
+		b, ok := b.(*PrepareRenamePlaceholder)
+		return ok && a.Equal(b)
+	case *PrepareRenameDefaultBehavior:
+		b, ok := b.(*PrepareRenameDefaultBehavior)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyProgressToken returns a copy of v that shares no memory with it.
+func deepCopyProgressToken(v ProgressToken) ProgressToken {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	}
+	return v
+}
+
+// equalProgressToken reports whether a and b hold the same arm and value.
+func equalProgressToken(a, b ProgressToken) bool {
+	switch a := a.(type) {
+	case Integer:
+		b, ok := b.(Integer)
+		return ok && a == b
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyWorkspaceDocumentDiagnosticReport returns a copy of v that shares no memory with it.
+func deepCopyWorkspaceDocumentDiagnosticReport(v WorkspaceDocumentDiagnosticReport) WorkspaceDocumentDiagnosticReport {
+	switch v := v.(type) {
+	case *WorkspaceFullDocumentDiagnosticReport:
+		return v.DeepCopy()
+	case *WorkspaceUnchangedDocumentDiagnosticReport:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalWorkspaceDocumentDiagnosticReport reports whether a and b hold the same arm and value.
+func equalWorkspaceDocumentDiagnosticReport(a, b WorkspaceDocumentDiagnosticReport) bool {
+	switch a := a.(type) {
+	case *WorkspaceFullDocumentDiagnosticReport:
+		b, ok := b.(*WorkspaceFullDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	case *WorkspaceUnchangedDocumentDiagnosticReport:
+		b, ok := b.(*WorkspaceUnchangedDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTextDocumentContentChangeEvent returns a copy of v that shares no memory with it.
+func deepCopyTextDocumentContentChangeEvent(v TextDocumentContentChangeEvent) TextDocumentContentChangeEvent {
+	switch v := v.(type) {
+	case *TextDocumentContentChangePartial:
+		return v.DeepCopy()
+	case *TextDocumentContentChangeWholeDocument:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTextDocumentContentChangeEvent reports whether a and b hold the same arm and value.
+func equalTextDocumentContentChangeEvent(a, b TextDocumentContentChangeEvent) bool {
+	switch a := a.(type) {
+	case *TextDocumentContentChangePartial:
+		b, ok := b.(*TextDocumentContentChangePartial)
+		return ok && a.Equal(b)
+	case *TextDocumentContentChangeWholeDocument:
+		b, ok := b.(*TextDocumentContentChangeWholeDocument)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyMarkedString returns a copy of v that shares no memory with it.
+func deepCopyMarkedString(v MarkedString) MarkedString {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case *MarkedStringWithLanguage:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalMarkedString reports whether a and b hold the same arm and value.
+func equalMarkedString(a, b MarkedString) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case *MarkedStringWithLanguage:
+		b, ok := b.(*MarkedStringWithLanguage)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentFilter returns a copy of v that shares no memory with it.
+func deepCopyDocumentFilter(v DocumentFilter) DocumentFilter {
+	switch v := v.(type) {
+	case *TextDocumentFilterLanguage:
+		return v.DeepCopy()
+	case *TextDocumentFilterScheme:
+		return v.DeepCopy()
+	case *TextDocumentFilterPattern:
+		return v.DeepCopy()
+	case *NotebookCellTextDocumentFilter:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentFilter reports whether a and b hold the same arm and value.
+func equalDocumentFilter(a, b DocumentFilter) bool {
+	switch a := a.(type) {
+	case *TextDocumentFilterLanguage:
+		b, ok := b.(*TextDocumentFilterLanguage)
+		return ok && a.Equal(b)
+	case *TextDocumentFilterScheme:
+		b, ok := b.(*TextDocumentFilterScheme)
+		return ok && a.Equal(b)
+	case *TextDocumentFilterPattern:
+		b, ok := b.(*TextDocumentFilterPattern)
+		return ok && a.Equal(b)
+	case *NotebookCellTextDocumentFilter:
+		b, ok := b.(*NotebookCellTextDocumentFilter)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyGlobPattern returns a copy of v that shares no memory with it.
+func deepCopyGlobPattern(v GlobPattern) GlobPattern {
+	switch v := v.(type) {
+	case Pattern:
+		return cloneString(v)
+	case *RelativePattern:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalGlobPattern reports whether a and b hold the same arm and value.
+func equalGlobPattern(a, b GlobPattern) bool {
+	switch a := a.(type) {
+	case Pattern:
+		b, ok := b.(Pattern)
+		return ok && a == b
+	case *RelativePattern:
+		b, ok := b.(*RelativePattern)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTextDocumentFilter returns a copy of v that shares no memory with it.
+func deepCopyTextDocumentFilter(v TextDocumentFilter) TextDocumentFilter {
+	switch v := v.(type) {
+	case *TextDocumentFilterLanguage:
+		return v.DeepCopy()
+	case *TextDocumentFilterScheme:
+		return v.DeepCopy()
+	case *TextDocumentFilterPattern:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTextDocumentFilter reports whether a and b hold the same arm and value.
+func equalTextDocumentFilter(a, b TextDocumentFilter) bool {
+	switch a := a.(type) {
+	case *TextDocumentFilterLanguage:
+		b, ok := b.(*TextDocumentFilterLanguage)
+		return ok && a.Equal(b)
+	case *TextDocumentFilterScheme:
+		b, ok := b.(*TextDocumentFilterScheme)
+		return ok && a.Equal(b)
+	case *TextDocumentFilterPattern:
+		b, ok := b.(*TextDocumentFilterPattern)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyNotebookDocumentFilter returns a copy of v that shares no memory with it.
+func deepCopyNotebookDocumentFilter(v NotebookDocumentFilter) NotebookDocumentFilter {
+	switch v := v.(type) {
+	case *NotebookDocumentFilterNotebookType:
+		return v.DeepCopy()
+	case *NotebookDocumentFilterScheme:
+		return v.DeepCopy()
+	case *NotebookDocumentFilterPattern:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalNotebookDocumentFilter reports whether a and b hold the same arm and value.
+func equalNotebookDocumentFilter(a, b NotebookDocumentFilter) bool {
+	switch a := a.(type) {
+	case *NotebookDocumentFilterNotebookType:
+		b, ok := b.(*NotebookDocumentFilterNotebookType)
+		return ok && a.Equal(b)
+	case *NotebookDocumentFilterScheme:
+		b, ok := b.(*NotebookDocumentFilterScheme)
+		return ok && a.Equal(b)
+	case *NotebookDocumentFilterPattern:
+		b, ok := b.(*NotebookDocumentFilterPattern)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCompletionResult returns a copy of v that shares no memory with it.
+func deepCopyCompletionResult(v CompletionResult) CompletionResult {
+	switch v := v.(type) {
+	case CompletionItemSlice:
+		return v.DeepCopy()
+	case *CompletionList:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCompletionResult reports whether a and b hold the same arm and value.
+func equalCompletionResult(a, b CompletionResult) bool {
+	switch a := a.(type) {
+	case CompletionItemSlice:
+		b, ok := b.(CompletionItemSlice)
+		return ok && a.Equal(b)
+	case *CompletionList:
+		b, ok := b.(*CompletionList)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDeclarationResult returns a copy of v that shares no memory with it.
+func deepCopyDeclarationResult(v DeclarationResult) DeclarationResult {
+	switch v := v.(type) {
+	case *Location:
+		return v.DeepCopy()
+	case LocationSlice:
+		return v.DeepCopy()
+	case DeclarationLinkSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDeclarationResult reports whether a and b hold the same arm and value.
+func equalDeclarationResult(a, b DeclarationResult) bool {
+	switch a := a.(type) {
+	case *Location:
+		b, ok := b.(*Location)
+		return ok && a.Equal(b)
+	case LocationSlice:
+		b, ok := b.(LocationSlice)
+		return ok && a.Equal(b)
+	case DeclarationLinkSlice:
+		b, ok := b.(DeclarationLinkSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDefinitionResult returns a copy of v that shares no memory with it.
+func deepCopyDefinitionResult(v DefinitionResult) DefinitionResult {
+	switch v := v.(type) {
+	case *Location:
+		return v.DeepCopy()
+	case LocationSlice:
+		return v.DeepCopy()
+	case DefinitionLinkSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDefinitionResult reports whether a and b hold the same arm and value.
+func equalDefinitionResult(a, b DefinitionResult) bool {
+	switch a := a.(type) {
+	case *Location:
+		b, ok := b.(*Location)
+		return ok && a.Equal(b)
+	case LocationSlice:
+		b, ok := b.(LocationSlice)
+		return ok && a.Equal(b)
+	case DefinitionLinkSlice:
+		b, ok := b.(DefinitionLinkSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentSymbolResult returns a copy of v that shares no memory with it.
+func deepCopyDocumentSymbolResult(v DocumentSymbolResult) DocumentSymbolResult {
+	switch v := v.(type) {
+	case SymbolInformationSlice:
+		return v.DeepCopy()
+	case DocumentSymbolSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentSymbolResult reports whether a and b hold the same arm and value.
+func equalDocumentSymbolResult(a, b DocumentSymbolResult) bool {
+	switch a := a.(type) {
+	case SymbolInformationSlice:
+		b, ok := b.(SymbolInformationSlice)
+		return ok && a.Equal(b)
+	case DocumentSymbolSlice:
+		b, ok := b.(DocumentSymbolSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlineCompletionResult returns a copy of v that shares no memory with it.
+func deepCopyInlineCompletionResult(v InlineCompletionResult) InlineCompletionResult {
+	switch v := v.(type) {
+	case *InlineCompletionList:
+		return v.DeepCopy()
+	case InlineCompletionItemSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlineCompletionResult reports whether a and b hold the same arm and value.
+func equalInlineCompletionResult(a, b InlineCompletionResult) bool {
+	switch a := a.(type) {
+	case *InlineCompletionList:
+		b, ok := b.(*InlineCompletionList)
+		return ok && a.Equal(b)
+	case InlineCompletionItemSlice:
+		b, ok := b.(InlineCompletionItemSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopySemanticTokensDeltaResult returns a copy of v that shares no memory with it.
+func deepCopySemanticTokensDeltaResult(v SemanticTokensDeltaResult) SemanticTokensDeltaResult {
+	switch v := v.(type) {
+	case *SemanticTokens:
+		return v.DeepCopy()
+	case *SemanticTokensDelta:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalSemanticTokensDeltaResult reports whether a and b hold the same arm and value.
+func equalSemanticTokensDeltaResult(a, b SemanticTokensDeltaResult) bool {
+	switch a := a.(type) {
+	case *SemanticTokens:
+		b, ok := b.(*SemanticTokens)
+		return ok && a.Equal(b)
+	case *SemanticTokensDelta:
+		b, ok := b.(*SemanticTokensDelta)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyWorkspaceSymbolResult returns a copy of v that shares no memory with it.
+func deepCopyWorkspaceSymbolResult(v WorkspaceSymbolResult) WorkspaceSymbolResult {
+	switch v := v.(type) {
+	case SymbolInformationSlice:
+		return v.DeepCopy()
+	case WorkspaceSymbolSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalWorkspaceSymbolResult reports whether a and b hold the same arm and value.
+func equalWorkspaceSymbolResult(a, b WorkspaceSymbolResult) bool {
+	switch a := a.(type) {
+	case SymbolInformationSlice:
+		b, ok := b.(SymbolInformationSlice)
+		return ok && a.Equal(b)
+	case WorkspaceSymbolSlice:
+		b, ok := b.(WorkspaceSymbolSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentChange returns a copy of v that shares no memory with it.
+func deepCopyDocumentChange(v DocumentChange) DocumentChange {
+	switch v := v.(type) {
+	case *TextDocumentEdit:
+		return v.DeepCopy()
+	case *CreateFile:
+		return v.DeepCopy()
+	case *RenameFile:
+		return v.DeepCopy()
+	case *DeleteFile:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentChange reports whether a and b hold the same arm and value.
+func equalDocumentChange(a, b DocumentChange) bool {
+	switch a := a.(type) {
+	case *TextDocumentEdit:
+		b, ok := b.(*TextDocumentEdit)
+		return ok && a.Equal(b)
+	case *CreateFile:
+		b, ok := b.(*CreateFile)
+		return ok && a.Equal(b)
+	case *RenameFile:
+		b, ok := b.(*RenameFile)
+		return ok && a.Equal(b)
+	case *DeleteFile:
+		b, ok := b.(*DeleteFile)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlayHintLabel returns a copy of v that shares no memory with it.
+func deepCopyInlayHintLabel(v InlayHintLabel) InlayHintLabel {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case InlayHintLabelPartSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlayHintLabel reports whether a and b hold the same arm and value.
+func equalInlayHintLabel(a, b InlayHintLabel) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case InlayHintLabelPartSlice:
+		b, ok := b.(InlayHintLabelPartSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlayHintTooltip returns a copy of v that shares no memory with it.
+func deepCopyInlayHintTooltip(v InlayHintTooltip) InlayHintTooltip {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case *MarkupContent:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlayHintTooltip reports whether a and b hold the same arm and value.
+func equalInlayHintTooltip(a, b InlayHintTooltip) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case *MarkupContent:
+		b, ok := b.(*MarkupContent)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlineCompletionItemInsertText returns a copy of v that shares no memory with it.
+func deepCopyInlineCompletionItemInsertText(v InlineCompletionItemInsertText) InlineCompletionItemInsertText {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case *StringValue:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlineCompletionItemInsertText reports whether a and b hold the same arm and value.
+func equalInlineCompletionItemInsertText(a, b InlineCompletionItemInsertText) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case *StringValue:
+		b, ok := b.(*StringValue)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDidChangeConfigurationRegistrationOptionsSection returns a copy of v that shares no memory with it.
+func deepCopyDidChangeConfigurationRegistrationOptionsSection(v DidChangeConfigurationRegistrationOptionsSection) DidChangeConfigurationRegistrationOptionsSection {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case StringSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDidChangeConfigurationRegistrationOptionsSection reports whether a and b hold the same arm and value.
+func equalDidChangeConfigurationRegistrationOptionsSection(a, b DidChangeConfigurationRegistrationOptionsSection) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case StringSlice:
+		b, ok := b.(StringSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCompletionItemTextEdit returns a copy of v that shares no memory with it.
+func deepCopyCompletionItemTextEdit(v CompletionItemTextEdit) CompletionItemTextEdit {
+	switch v := v.(type) {
+	case *TextEdit:
+		return v.DeepCopy()
+	case *InsertReplaceEdit:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCompletionItemTextEdit reports whether a and b hold the same arm and value.
+func equalCompletionItemTextEdit(a, b CompletionItemTextEdit) bool {
+	switch a := a.(type) {
+	case *TextEdit:
+		b, ok := b.(*TextEdit)
+		return ok && a.Equal(b)
+	case *InsertReplaceEdit:
+		b, ok := b.(*InsertReplaceEdit)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyHoverContents returns a copy of v that shares no memory with it.
+func deepCopyHoverContents(v HoverContents) HoverContents {
+	switch v := v.(type) {
+	case *MarkupContent:
+		return v.DeepCopy()
+	case String:
+		return cloneString(v)
+	case *MarkedStringWithLanguage:
+		return v.DeepCopy()
+	case MarkedStringSlice:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalHoverContents reports whether a and b hold the same arm and value.
+func equalHoverContents(a, b HoverContents) bool {
+	switch a := a.(type) {
+	case *MarkupContent:
+		b, ok := b.(*MarkupContent)
+		return ok && a.Equal(b)
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case *MarkedStringWithLanguage:
+		b, ok := b.(*MarkedStringWithLanguage)
+		return ok && a.Equal(b)
+	case MarkedStringSlice:
+		b, ok := b.(MarkedStringSlice)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyWorkspaceSymbolLocation returns a copy of v that shares no memory with it.
+func deepCopyWorkspaceSymbolLocation(v WorkspaceSymbolLocation) WorkspaceSymbolLocation {
+	switch v := v.(type) {
+	case *Location:
+		return v.DeepCopy()
+	case *LocationUriOnly:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalWorkspaceSymbolLocation reports whether a and b hold the same arm and value.
+func equalWorkspaceSymbolLocation(a, b WorkspaceSymbolLocation) bool {
+	switch a := a.(type) {
+	case *Location:
+		b, ok := b.(*Location)
+		return ok && a.Equal(b)
+	case *LocationUriOnly:
+		b, ok := b.(*LocationUriOnly)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopySemanticTokensOptionsFull returns a copy of v that shares no memory with it.
+func deepCopySemanticTokensOptionsFull(v SemanticTokensOptionsFull) SemanticTokensOptionsFull {
+	switch v := v.(type) {
+	case *SemanticTokensFullDelta:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalSemanticTokensOptionsFull reports whether a and b hold the same arm and value.
+func equalSemanticTokensOptionsFull(a, b SemanticTokensOptionsFull) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *SemanticTokensFullDelta:
+		b, ok := b.(*SemanticTokensFullDelta)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTextDocumentEditElement returns a copy of v that shares no memory with it.
+func deepCopyTextDocumentEditElement(v TextDocumentEditElement) TextDocumentEditElement {
+	switch v := v.(type) {
+	case *TextEdit:
+		return v.DeepCopy()
+	case *AnnotatedTextEdit:
+		return v.DeepCopy()
+	case *SnippetTextEdit:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTextDocumentEditElement reports whether a and b hold the same arm and value.
+func equalTextDocumentEditElement(a, b TextDocumentEditElement) bool {
+	switch a := a.(type) {
+	case *TextEdit:
+		b, ok := b.(*TextEdit)
+		return ok && a.Equal(b)
+	case *AnnotatedTextEdit:
+		b, ok := b.(*AnnotatedTextEdit)
+		return ok && a.Equal(b)
+	case *SnippetTextEdit:
+		b, ok := b.(*SnippetTextEdit)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyNotebookSelector returns a copy of v that shares no memory with it.
+func deepCopyNotebookSelector(v NotebookSelector) NotebookSelector {
+	switch v := v.(type) {
+	case *NotebookDocumentFilterWithNotebook:
+		return v.DeepCopy()
+	case *NotebookDocumentFilterWithCells:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalNotebookSelector reports whether a and b hold the same arm and value.
+func equalNotebookSelector(a, b NotebookSelector) bool {
+	switch a := a.(type) {
+	case *NotebookDocumentFilterWithNotebook:
+		b, ok := b.(*NotebookDocumentFilterWithNotebook)
+		return ok && a.Equal(b)
+	case *NotebookDocumentFilterWithCells:
+		b, ok := b.(*NotebookDocumentFilterWithCells)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTextDocumentSync returns a copy of v that shares no memory with it.
+func deepCopyTextDocumentSync(v TextDocumentSync) TextDocumentSync {
+	switch v := v.(type) {
+	case *TextDocumentSyncOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTextDocumentSync reports whether a and b hold the same arm and value.
+func equalTextDocumentSync(a, b TextDocumentSync) bool {
+	switch a := a.(type) {
+	case *TextDocumentSyncOptions:
+		b, ok := b.(*TextDocumentSyncOptions)
+		return ok && a.Equal(b)
+	case TextDocumentSyncKind:
+		b, ok := b.(TextDocumentSyncKind)
+		return ok && a == b
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyNotebookDocumentSync returns a copy of v that shares no memory with it.
+func deepCopyNotebookDocumentSync(v NotebookDocumentSync) NotebookDocumentSync {
+	switch v := v.(type) {
+	case *NotebookDocumentSyncOptions:
+		return v.DeepCopy()
+	case *NotebookDocumentSyncRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalNotebookDocumentSync reports whether a and b hold the same arm and value.
+func equalNotebookDocumentSync(a, b NotebookDocumentSync) bool {
+	switch a := a.(type) {
+	case *NotebookDocumentSyncOptions:
+		b, ok := b.(*NotebookDocumentSyncOptions)
+		return ok && a.Equal(b)
+	case *NotebookDocumentSyncRegistrationOptions:
+		b, ok := b.(*NotebookDocumentSyncRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyHoverProvider returns a copy of v that shares no memory with it.
+func deepCopyHoverProvider(v HoverProvider) HoverProvider {
+	switch v := v.(type) {
+	case *HoverOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalHoverProvider reports whether a and b hold the same arm and value.
+func equalHoverProvider(a, b HoverProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *HoverOptions:
+		b, ok := b.(*HoverOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDeclarationProvider returns a copy of v that shares no memory with it.
+func deepCopyDeclarationProvider(v DeclarationProvider) DeclarationProvider {
+	switch v := v.(type) {
+	case *DeclarationOptions:
+		return v.DeepCopy()
+	case *DeclarationRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDeclarationProvider reports whether a and b hold the same arm and value.
+func equalDeclarationProvider(a, b DeclarationProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DeclarationOptions:
+		b, ok := b.(*DeclarationOptions)
+		return ok && a.Equal(b)
+	case *DeclarationRegistrationOptions:
+		b, ok := b.(*DeclarationRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDefinitionProvider returns a copy of v that shares no memory with it.
+func deepCopyDefinitionProvider(v DefinitionProvider) DefinitionProvider {
+	switch v := v.(type) {
+	case *DefinitionOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDefinitionProvider reports whether a and b hold the same arm and value.
+func equalDefinitionProvider(a, b DefinitionProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DefinitionOptions:
+		b, ok := b.(*DefinitionOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTypeDefinitionProvider returns a copy of v that shares no memory with it.
+func deepCopyTypeDefinitionProvider(v TypeDefinitionProvider) TypeDefinitionProvider {
+	switch v := v.(type) {
+	case *TypeDefinitionOptions:
+		return v.DeepCopy()
+	case *TypeDefinitionRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTypeDefinitionProvider reports whether a and b hold the same arm and value.
+func equalTypeDefinitionProvider(a, b TypeDefinitionProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *TypeDefinitionOptions:
+		b, ok := b.(*TypeDefinitionOptions)
+		return ok && a.Equal(b)
+	case *TypeDefinitionRegistrationOptions:
+		b, ok := b.(*TypeDefinitionRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyImplementationProvider returns a copy of v that shares no memory with it.
+func deepCopyImplementationProvider(v ImplementationProvider) ImplementationProvider {
+	switch v := v.(type) {
+	case *ImplementationOptions:
+		return v.DeepCopy()
+	case *ImplementationRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalImplementationProvider reports whether a and b hold the same arm and value.
+func equalImplementationProvider(a, b ImplementationProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *ImplementationOptions:
+		b, ok := b.(*ImplementationOptions)
+		return ok && a.Equal(b)
+	case *ImplementationRegistrationOptions:
+		b, ok := b.(*ImplementationRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyReferencesProvider returns a copy of v that shares no memory with it.
+func deepCopyReferencesProvider(v ReferencesProvider) ReferencesProvider {
+	switch v := v.(type) {
+	case *ReferenceOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalReferencesProvider reports whether a and b hold the same arm and value.
+func equalReferencesProvider(a, b ReferencesProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *ReferenceOptions:
+		b, ok := b.(*ReferenceOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentHighlightProvider returns a copy of v that shares no memory with it.
+func deepCopyDocumentHighlightProvider(v DocumentHighlightProvider) DocumentHighlightProvider {
+	switch v := v.(type) {
+	case *DocumentHighlightOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentHighlightProvider reports whether a and b hold the same arm and value.
+func equalDocumentHighlightProvider(a, b DocumentHighlightProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DocumentHighlightOptions:
+		b, ok := b.(*DocumentHighlightOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentSymbolProvider returns a copy of v that shares no memory with it.
+func deepCopyDocumentSymbolProvider(v DocumentSymbolProvider) DocumentSymbolProvider {
+	switch v := v.(type) {
+	case *DocumentSymbolOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentSymbolProvider reports whether a and b hold the same arm and value.
+func equalDocumentSymbolProvider(a, b DocumentSymbolProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DocumentSymbolOptions:
+		b, ok := b.(*DocumentSymbolOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCodeActionProvider returns a copy of v that shares no memory with it.
+func deepCopyCodeActionProvider(v CodeActionProvider) CodeActionProvider {
+	switch v := v.(type) {
+	case *CodeActionOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCodeActionProvider reports whether a and b hold the same arm and value.
+func equalCodeActionProvider(a, b CodeActionProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *CodeActionOptions:
+		b, ok := b.(*CodeActionOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyColorProvider returns a copy of v that shares no memory with it.
+func deepCopyColorProvider(v ColorProvider) ColorProvider {
+	switch v := v.(type) {
+	case *DocumentColorOptions:
+		return v.DeepCopy()
+	case *DocumentColorRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalColorProvider reports whether a and b hold the same arm and value.
+func equalColorProvider(a, b ColorProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DocumentColorOptions:
+		b, ok := b.(*DocumentColorOptions)
+		return ok && a.Equal(b)
+	case *DocumentColorRegistrationOptions:
+		b, ok := b.(*DocumentColorRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyWorkspaceSymbolProvider returns a copy of v that shares no memory with it.
+func deepCopyWorkspaceSymbolProvider(v WorkspaceSymbolProvider) WorkspaceSymbolProvider {
+	switch v := v.(type) {
+	case *WorkspaceSymbolOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalWorkspaceSymbolProvider reports whether a and b hold the same arm and value.
+func equalWorkspaceSymbolProvider(a, b WorkspaceSymbolProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *WorkspaceSymbolOptions:
+		b, ok := b.(*WorkspaceSymbolOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentFormattingProvider returns a copy of v that shares no memory with it.
+func deepCopyDocumentFormattingProvider(v DocumentFormattingProvider) DocumentFormattingProvider {
+	switch v := v.(type) {
+	case *DocumentFormattingOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentFormattingProvider reports whether a and b hold the same arm and value.
+func equalDocumentFormattingProvider(a, b DocumentFormattingProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DocumentFormattingOptions:
+		b, ok := b.(*DocumentFormattingOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDocumentRangeFormattingProvider returns a copy of v that shares no memory with it.
+func deepCopyDocumentRangeFormattingProvider(v DocumentRangeFormattingProvider) DocumentRangeFormattingProvider {
+	switch v := v.(type) {
+	case *DocumentRangeFormattingOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDocumentRangeFormattingProvider reports whether a and b hold the same arm and value.
+func equalDocumentRangeFormattingProvider(a, b DocumentRangeFormattingProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *DocumentRangeFormattingOptions:
+		b, ok := b.(*DocumentRangeFormattingOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyRenameProvider returns a copy of v that shares no memory with it.
+func deepCopyRenameProvider(v RenameProvider) RenameProvider {
+	switch v := v.(type) {
+	case *RenameOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalRenameProvider reports whether a and b hold the same arm and value.
+func equalRenameProvider(a, b RenameProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *RenameOptions:
+		b, ok := b.(*RenameOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyFoldingRangeProvider returns a copy of v that shares no memory with it.
+func deepCopyFoldingRangeProvider(v FoldingRangeProvider) FoldingRangeProvider {
+	switch v := v.(type) {
+	case *FoldingRangeOptions:
+		return v.DeepCopy()
+	case *FoldingRangeRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalFoldingRangeProvider reports whether a and b hold the same arm and value.
+func equalFoldingRangeProvider(a, b FoldingRangeProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *FoldingRangeOptions:
+		b, ok := b.(*FoldingRangeOptions)
+		return ok && a.Equal(b)
+	case *FoldingRangeRegistrationOptions:
+		b, ok := b.(*FoldingRangeRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopySelectionRangeProvider returns a copy of v that shares no memory with it.
+func deepCopySelectionRangeProvider(v SelectionRangeProvider) SelectionRangeProvider {
+	switch v := v.(type) {
+	case *SelectionRangeOptions:
+		return v.DeepCopy()
+	case *SelectionRangeRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalSelectionRangeProvider reports whether a and b hold the same arm and value.
+func equalSelectionRangeProvider(a, b SelectionRangeProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *SelectionRangeOptions:
+		b, ok := b.(*SelectionRangeOptions)
+		return ok && a.Equal(b)
+	case *SelectionRangeRegistrationOptions:
+		b, ok := b.(*SelectionRangeRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCallHierarchyProvider returns a copy of v that shares no memory with it.
+func deepCopyCallHierarchyProvider(v CallHierarchyProvider) CallHierarchyProvider {
+	switch v := v.(type) {
+	case *CallHierarchyOptions:
+		return v.DeepCopy()
+	case *CallHierarchyRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCallHierarchyProvider reports whether a and b hold the same arm and value.
+func equalCallHierarchyProvider(a, b CallHierarchyProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *CallHierarchyOptions:
+		b, ok := b.(*CallHierarchyOptions)
+		return ok && a.Equal(b)
+	case *CallHierarchyRegistrationOptions:
+		b, ok := b.(*CallHierarchyRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyLinkedEditingRangeProvider returns a copy of v that shares no memory with it.
+func deepCopyLinkedEditingRangeProvider(v LinkedEditingRangeProvider) LinkedEditingRangeProvider {
+	switch v := v.(type) {
+	case *LinkedEditingRangeOptions:
+		return v.DeepCopy()
+	case *LinkedEditingRangeRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalLinkedEditingRangeProvider reports whether a and b hold the same arm and value.
+func equalLinkedEditingRangeProvider(a, b LinkedEditingRangeProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *LinkedEditingRangeOptions:
+		b, ok := b.(*LinkedEditingRangeOptions)
+		return ok && a.Equal(b)
+	case *LinkedEditingRangeRegistrationOptions:
+		b, ok := b.(*LinkedEditingRangeRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopySemanticTokensProvider returns a copy of v that shares no memory with it.
+func deepCopySemanticTokensProvider(v SemanticTokensProvider) SemanticTokensProvider {
+	switch v := v.(type) {
+	case *SemanticTokensOptions:
+		return v.DeepCopy()
+	case *SemanticTokensRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalSemanticTokensProvider reports whether a and b hold the same arm and value.
+func equalSemanticTokensProvider(a, b SemanticTokensProvider) bool {
+	switch a := a.(type) {
+	case *SemanticTokensOptions:
+		b, ok := b.(*SemanticTokensOptions)
+		return ok && a.Equal(b)
+	case *SemanticTokensRegistrationOptions:
+		b, ok := b.(*SemanticTokensRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyMonikerProvider returns a copy of v that shares no memory with it.
+func deepCopyMonikerProvider(v MonikerProvider) MonikerProvider {
+	switch v := v.(type) {
+	case *MonikerOptions:
+		return v.DeepCopy()
+	case *MonikerRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalMonikerProvider reports whether a and b hold the same arm and value.
+func equalMonikerProvider(a, b MonikerProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *MonikerOptions:
+		b, ok := b.(*MonikerOptions)
+		return ok && a.Equal(b)
+	case *MonikerRegistrationOptions:
+		b, ok := b.(*MonikerRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTypeHierarchyProvider returns a copy of v that shares no memory with it.
+func deepCopyTypeHierarchyProvider(v TypeHierarchyProvider) TypeHierarchyProvider {
+	switch v := v.(type) {
+	case *TypeHierarchyOptions:
+		return v.DeepCopy()
+	case *TypeHierarchyRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTypeHierarchyProvider reports whether a and b hold the same arm and value.
+func equalTypeHierarchyProvider(a, b TypeHierarchyProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *TypeHierarchyOptions:
+		b, ok := b.(*TypeHierarchyOptions)
+		return ok && a.Equal(b)
+	case *TypeHierarchyRegistrationOptions:
+		b, ok := b.(*TypeHierarchyRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlineValueProvider returns a copy of v that shares no memory with it.
+func deepCopyInlineValueProvider(v InlineValueProvider) InlineValueProvider {
+	switch v := v.(type) {
+	case *InlineValueOptions:
+		return v.DeepCopy()
+	case *InlineValueRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlineValueProvider reports whether a and b hold the same arm and value.
+func equalInlineValueProvider(a, b InlineValueProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *InlineValueOptions:
+		b, ok := b.(*InlineValueOptions)
+		return ok && a.Equal(b)
+	case *InlineValueRegistrationOptions:
+		b, ok := b.(*InlineValueRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlayHintProvider returns a copy of v that shares no memory with it.
+func deepCopyInlayHintProvider(v InlayHintProvider) InlayHintProvider {
+	switch v := v.(type) {
+	case *InlayHintOptions:
+		return v.DeepCopy()
+	case *InlayHintRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlayHintProvider reports whether a and b hold the same arm and value.
+func equalInlayHintProvider(a, b InlayHintProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *InlayHintOptions:
+		b, ok := b.(*InlayHintOptions)
+		return ok && a.Equal(b)
+	case *InlayHintRegistrationOptions:
+		b, ok := b.(*InlayHintRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyDiagnosticProvider returns a copy of v that shares no memory with it.
+func deepCopyDiagnosticProvider(v DiagnosticProvider) DiagnosticProvider {
+	switch v := v.(type) {
+	case *DiagnosticOptions:
+		return v.DeepCopy()
+	case *DiagnosticRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalDiagnosticProvider reports whether a and b hold the same arm and value.
+func equalDiagnosticProvider(a, b DiagnosticProvider) bool {
+	switch a := a.(type) {
+	case *DiagnosticOptions:
+		b, ok := b.(*DiagnosticOptions)
+		return ok && a.Equal(b)
+	case *DiagnosticRegistrationOptions:
+		b, ok := b.(*DiagnosticRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyInlineCompletionProvider returns a copy of v that shares no memory with it.
+func deepCopyInlineCompletionProvider(v InlineCompletionProvider) InlineCompletionProvider {
+	switch v := v.(type) {
+	case *InlineCompletionOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalInlineCompletionProvider reports whether a and b hold the same arm and value.
+func equalInlineCompletionProvider(a, b InlineCompletionProvider) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *InlineCompletionOptions:
+		b, ok := b.(*InlineCompletionOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCompletionItemDefaultsEditRange returns a copy of v that shares no memory with it.
+func deepCopyCompletionItemDefaultsEditRange(v CompletionItemDefaultsEditRange) CompletionItemDefaultsEditRange {
+	switch v := v.(type) {
+	case *Range:
+		return v.DeepCopy()
+	case *EditRangeWithInsertReplace:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCompletionItemDefaultsEditRange reports whether a and b hold the same arm and value.
+func equalCompletionItemDefaultsEditRange(a, b CompletionItemDefaultsEditRange) bool {
+	switch a := a.(type) {
+	case *Range:
+		b, ok := b.(*Range)
+		return ok && a.Equal(b)
+	case *EditRangeWithInsertReplace:
+		b, ok := b.(*EditRangeWithInsertReplace)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyNotebookDocumentFilterNotebook returns a copy of v that shares no memory with it.
+func deepCopyNotebookDocumentFilterNotebook(v NotebookDocumentFilterNotebook) NotebookDocumentFilterNotebook {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	case *NotebookDocumentFilterNotebookType:
+		return v.DeepCopy()
+	case *NotebookDocumentFilterScheme:
+		return v.DeepCopy()
+	case *NotebookDocumentFilterPattern:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalNotebookDocumentFilterNotebook reports whether a and b hold the same arm and value.
+func equalNotebookDocumentFilterNotebook(a, b NotebookDocumentFilterNotebook) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case *NotebookDocumentFilterNotebookType:
+		b, ok := b.(*NotebookDocumentFilterNotebookType)
+		return ok && a.Equal(b)
+	case *NotebookDocumentFilterScheme:
+		b, ok := b.(*NotebookDocumentFilterScheme)
+		return ok && a.Equal(b)
+	case *NotebookDocumentFilterPattern:
+		b, ok := b.(*NotebookDocumentFilterPattern)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyTextDocumentSyncOptionsSave returns a copy of v that shares no memory with it.
+func deepCopyTextDocumentSyncOptionsSave(v TextDocumentSyncOptionsSave) TextDocumentSyncOptionsSave {
+	switch v := v.(type) {
+	case *SaveOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalTextDocumentSyncOptionsSave reports whether a and b hold the same arm and value.
+func equalTextDocumentSyncOptionsSave(a, b TextDocumentSyncOptionsSave) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *SaveOptions:
+		b, ok := b.(*SaveOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyWorkspaceOptionsTextDocumentContent returns a copy of v that shares no memory with it.
+func deepCopyWorkspaceOptionsTextDocumentContent(v WorkspaceOptionsTextDocumentContent) WorkspaceOptionsTextDocumentContent {
+	switch v := v.(type) {
+	case *TextDocumentContentOptions:
+		return v.DeepCopy()
+	case *TextDocumentContentRegistrationOptions:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalWorkspaceOptionsTextDocumentContent reports whether a and b hold the same arm and value.
+func equalWorkspaceOptionsTextDocumentContent(a, b WorkspaceOptionsTextDocumentContent) bool {
+	switch a := a.(type) {
+	case *TextDocumentContentOptions:
+		b, ok := b.(*TextDocumentContentOptions)
+		return ok && a.Equal(b)
+	case *TextDocumentContentRegistrationOptions:
+		b, ok := b.(*TextDocumentContentRegistrationOptions)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyParameterInformationLabel returns a copy of v that shares no memory with it.
+func deepCopyParameterInformationLabel(v ParameterInformationLabel) ParameterInformationLabel {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	}
+	return v
+}
+
+// equalParameterInformationLabel reports whether a and b hold the same arm and value.
+func equalParameterInformationLabel(a, b ParameterInformationLabel) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case ParameterInformationLabelTuple:
+		b, ok := b.(ParameterInformationLabelTuple)
+		return ok && a == b
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyChangeNotifications returns a copy of v that shares no memory with it.
+func deepCopyChangeNotifications(v ChangeNotifications) ChangeNotifications {
+	switch v := v.(type) {
+	case String:
+		return cloneString(v)
+	}
+	return v
+}
+
+// equalChangeNotifications reports whether a and b hold the same arm and value.
+func equalChangeNotifications(a, b ChangeNotifications) bool {
+	switch a := a.(type) {
+	case String:
+		b, ok := b.(String)
+		return ok && a == b
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyRelativePatternBaseURI returns a copy of v that shares no memory with it.
+func deepCopyRelativePatternBaseURI(v RelativePatternBaseURI) RelativePatternBaseURI {
+	switch v := v.(type) {
+	case *WorkspaceFolder:
+		return v.DeepCopy()
+	case URI:
+		return cloneString(v)
+	}
+	return v
+}
+
+// equalRelativePatternBaseURI reports whether a and b hold the same arm and value.
+func equalRelativePatternBaseURI(a, b RelativePatternBaseURI) bool {
+	switch a := a.(type) {
+	case *WorkspaceFolder:
+		b, ok := b.(*WorkspaceFolder)
+		return ok && a.Equal(b)
+	case URI:
+		b, ok := b.(URI)
+		return ok && a == b
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyClientSemanticTokensRequestOptionsRange returns a copy of v that shares no memory with it.
+func deepCopyClientSemanticTokensRequestOptionsRange(v ClientSemanticTokensRequestOptionsRange) ClientSemanticTokensRequestOptionsRange {
+	switch v := v.(type) {
+	case *SemanticTokensOptionsRange:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalClientSemanticTokensRequestOptionsRange reports whether a and b hold the same arm and value.
+func equalClientSemanticTokensRequestOptionsRange(a, b ClientSemanticTokensRequestOptionsRange) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *SemanticTokensOptionsRange:
+		b, ok := b.(*SemanticTokensOptionsRange)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyClientSemanticTokensRequestOptionsFull returns a copy of v that shares no memory with it.
+func deepCopyClientSemanticTokensRequestOptionsFull(v ClientSemanticTokensRequestOptionsFull) ClientSemanticTokensRequestOptionsFull {
+	switch v := v.(type) {
+	case *ClientSemanticTokensRequestFullDelta:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalClientSemanticTokensRequestOptionsFull reports whether a and b hold the same arm and value.
+func equalClientSemanticTokensRequestOptionsFull(a, b ClientSemanticTokensRequestOptionsFull) bool {
+	switch a := a.(type) {
+	case Boolean:
+		b, ok := b.(Boolean)
+		return ok && a == b
+	case *ClientSemanticTokensRequestFullDelta:
+		b, ok := b.(*ClientSemanticTokensRequestFullDelta)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport returns a copy of v that shares no memory with it.
+func deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(v FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport {
+	switch v := v.(type) {
+	case *FullDocumentDiagnosticReport:
+		return v.DeepCopy()
+	case *UnchangedDocumentDiagnosticReport:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport reports whether a and b hold the same arm and value.
+func equalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(a, b FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) bool {
+	switch a := a.(type) {
+	case *FullDocumentDiagnosticReport:
+		b, ok := b.(*FullDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	case *UnchangedDocumentDiagnosticReport:
+		b, ok := b.(*UnchangedDocumentDiagnosticReport)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyCommandOrCodeAction returns a copy of v that shares no memory with it.
+func deepCopyCommandOrCodeAction(v CommandOrCodeAction) CommandOrCodeAction {
+	switch v := v.(type) {
+	case *Command:
+		return v.DeepCopy()
+	case *CodeAction:
+		return v.DeepCopy()
+	}
+	return v
+}
+
+// equalCommandOrCodeAction reports whether a and b hold the same arm and value.
+func equalCommandOrCodeAction(a, b CommandOrCodeAction) bool {
+	switch a := a.(type) {
+	case *Command:
+		b, ok := b.(*Command)
+		return ok && a.Equal(b)
+	case *CodeAction:
+		b, ok := b.(*CodeAction)
+		return ok && a.Equal(b)
+	}
+	return a == nil && b == nil
+}
+
+// deepCopyUnion replaces the union p points to with a deep copy and reports
+// whether p points to a union.
+func deepCopyUnion(p any) bool {
+	switch p := p.(type) {
+	case *Declaration:
+		*p = deepCopyDeclaration(*p)
+	case *InlineValue:
+		*p = deepCopyInlineValue(*p)
+	case *DocumentDiagnosticReport:
+		*p = deepCopyDocumentDiagnosticReport(*p)
+	case *PrepareRenameResult:
+		*p = deepCopyPrepareRenameResult(*p)
+	case *ProgressToken:
+		*p = deepCopyProgressToken(*p)
+	case *WorkspaceDocumentDiagnosticReport:
+		*p = deepCopyWorkspaceDocumentDiagnosticReport(*p)
+	case *TextDocumentContentChangeEvent:
+		*p = deepCopyTextDocumentContentChangeEvent(*p)
+	case *MarkedString:
+		*p = deepCopyMarkedString(*p)
+	case *DocumentFilter:
+		*p = deepCopyDocumentFilter(*p)
+	case *GlobPattern:
+		*p = deepCopyGlobPattern(*p)
+	case *TextDocumentFilter:
+		*p = deepCopyTextDocumentFilter(*p)
+	case *NotebookDocumentFilter:
+		*p = deepCopyNotebookDocumentFilter(*p)
+	case *CompletionResult:
+		*p = deepCopyCompletionResult(*p)
+	case *DeclarationResult:
+		*p = deepCopyDeclarationResult(*p)
+	case *DefinitionResult:
+		*p = deepCopyDefinitionResult(*p)
+	case *DocumentSymbolResult:
+		*p = deepCopyDocumentSymbolResult(*p)
+	case *InlineCompletionResult:
+		*p = deepCopyInlineCompletionResult(*p)
+	case *SemanticTokensDeltaResult:
+		*p = deepCopySemanticTokensDeltaResult(*p)
+	case *WorkspaceSymbolResult:
+		*p = deepCopyWorkspaceSymbolResult(*p)
+	case *DocumentChange:
+		*p = deepCopyDocumentChange(*p)
+	case *InlayHintLabel:
+		*p = deepCopyInlayHintLabel(*p)
+	case *InlayHintTooltip:
+		*p = deepCopyInlayHintTooltip(*p)
+	case *InlineCompletionItemInsertText:
+		*p = deepCopyInlineCompletionItemInsertText(*p)
+	case *DidChangeConfigurationRegistrationOptionsSection:
+		*p = deepCopyDidChangeConfigurationRegistrationOptionsSection(*p)
+	case *CompletionItemTextEdit:
+		*p = deepCopyCompletionItemTextEdit(*p)
+	case *HoverContents:
+		*p = deepCopyHoverContents(*p)
+	case *WorkspaceSymbolLocation:
+		*p = deepCopyWorkspaceSymbolLocation(*p)
+	case *SemanticTokensOptionsFull:
+		*p = deepCopySemanticTokensOptionsFull(*p)
+	case *TextDocumentEditElement:
+		*p = deepCopyTextDocumentEditElement(*p)
+	case *NotebookSelector:
+		*p = deepCopyNotebookSelector(*p)
+	case *TextDocumentSync:
+		*p = deepCopyTextDocumentSync(*p)
+	case *NotebookDocumentSync:
+		*p = deepCopyNotebookDocumentSync(*p)
+	case *HoverProvider:
+		*p = deepCopyHoverProvider(*p)
+	case *DeclarationProvider:
+		*p = deepCopyDeclarationProvider(*p)
+	case *DefinitionProvider:
+		*p = deepCopyDefinitionProvider(*p)
+	case *TypeDefinitionProvider:
+		*p = deepCopyTypeDefinitionProvider(*p)
+	case *ImplementationProvider:
+		*p = deepCopyImplementationProvider(*p)
+	case *ReferencesProvider:
+		*p = deepCopyReferencesProvider(*p)
+	case *DocumentHighlightProvider:
+		*p = deepCopyDocumentHighlightProvider(*p)
+	case *DocumentSymbolProvider:
+		*p = deepCopyDocumentSymbolProvider(*p)
+	case *CodeActionProvider:
+		*p = deepCopyCodeActionProvider(*p)
+	case *ColorProvider:
+		*p = deepCopyColorProvider(*p)
+	case *WorkspaceSymbolProvider:
+		*p = deepCopyWorkspaceSymbolProvider(*p)
+	case *DocumentFormattingProvider:
+		*p = deepCopyDocumentFormattingProvider(*p)
+	case *DocumentRangeFormattingProvider:
+		*p = deepCopyDocumentRangeFormattingProvider(*p)
+	case *RenameProvider:
+		*p = deepCopyRenameProvider(*p)
+	case *FoldingRangeProvider:
+		*p = deepCopyFoldingRangeProvider(*p)
+	case *SelectionRangeProvider:
+		*p = deepCopySelectionRangeProvider(*p)
+	case *CallHierarchyProvider:
+		*p = deepCopyCallHierarchyProvider(*p)
+	case *LinkedEditingRangeProvider:
+		*p = deepCopyLinkedEditingRangeProvider(*p)
+	case *SemanticTokensProvider:
+		*p = deepCopySemanticTokensProvider(*p)
+	case *MonikerProvider:
+		*p = deepCopyMonikerProvider(*p)
+	case *TypeHierarchyProvider:
+		*p = deepCopyTypeHierarchyProvider(*p)
+	case *InlineValueProvider:
+		*p = deepCopyInlineValueProvider(*p)
+	case *InlayHintProvider:
+		*p = deepCopyInlayHintProvider(*p)
+	case *DiagnosticProvider:
+		*p = deepCopyDiagnosticProvider(*p)
+	case *InlineCompletionProvider:
+		*p = deepCopyInlineCompletionProvider(*p)
+	case *CompletionItemDefaultsEditRange:
+		*p = deepCopyCompletionItemDefaultsEditRange(*p)
+	case *NotebookDocumentFilterNotebook:
+		*p = deepCopyNotebookDocumentFilterNotebook(*p)
+	case *TextDocumentSyncOptionsSave:
+		*p = deepCopyTextDocumentSyncOptionsSave(*p)
+	case *WorkspaceOptionsTextDocumentContent:
+		*p = deepCopyWorkspaceOptionsTextDocumentContent(*p)
+	case *ParameterInformationLabel:
+		*p = deepCopyParameterInformationLabel(*p)
+	case *ChangeNotifications:
+		*p = deepCopyChangeNotifications(*p)
+	case *RelativePatternBaseURI:
+		*p = deepCopyRelativePatternBaseURI(*p)
+	case *ClientSemanticTokensRequestOptionsRange:
+		*p = deepCopyClientSemanticTokensRequestOptionsRange(*p)
+	case *ClientSemanticTokensRequestOptionsFull:
+		*p = deepCopyClientSemanticTokensRequestOptionsFull(*p)
+	case *FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport:
+		*p = deepCopyFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport(*p)
+	case *CommandOrCodeAction:
+		*p = deepCopyCommandOrCodeAction(*p)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"strings"
+)
+
+// This file holds the runtime the generated DeepCopy and Equal methods in
+// deepcopy.gen.go call into. The equal helpers take an element comparison by
+// pointer so large structs are not copied per element.
+
+// cloneString returns a copy of s that does not share memory with it.
+func cloneString[T ~string](s T) T {
+	return T(strings.Clone(string(s)))
+}
+
+// equalValue compares comparable values with ==.
+func equalValue[T comparable](a, b *T) bool {
+	return *a == *b
+}
+
+// equalPtr reports whether a and b are both nil, or both non-nil and point to
+// equal values.
+func equalPtr[T any](a, b *T, eq func(a, b *T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return eq(a, b)
+}
+
+// equalSlice reports whether a and b hold equal elements. As with
+// reflect.DeepEqual, a nil slice differs from an empty one.
+func equalSlice[T any](a, b []T, eq func(a, b *T) bool) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(&a[i], &b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalMap reports whether a and b hold the same keys with equal values. As
+// with reflect.DeepEqual, a nil map differs from an empty one.
+func equalMap[K comparable, V any](a, b map[K]V, eq func(a, b *V) bool) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !eq(&av, &bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalOptional reports whether a and b are both absent, or both present with
+// equal values.
+func equalOptional[T any](a, b Optional[T], eq func(a, b *T) bool) bool {
+	if a.set != b.set {
+		return false
+	}
+	return !a.set || eq(&a.value, &b.value)
+}
+
+// equalNullable reports whether a and b are in the same state and, when
+// present, hold equal values.
+func equalNullable[T any](a, b Nullable[T], eq func(a, b *T) bool) bool {
+	if a.set != b.set || a.null != b.null {
+		return false
+	}
+	return !a.set || a.null || eq(&a.value, &b.value)
+}
+
+// equalRaw compares raw JSON byte-wise. A nil value (an absent LSPAny)
+// differs from any present one.
+func equalRaw[T ~[]byte](a, b T) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return bytes.Equal(a, b)
+}
+
+// clone returns a copy of t that does not share its overflow storage.
+func (t DiagnosticTags) clone() DiagnosticTags {
+	t.rest = append([]DiagnosticTag(nil), t.rest[:max(t.n-1, 0)]...)
+	return t
+}
+
+// equal reports whether t and u hold the same tags in the same order.
+func (t DiagnosticTags) equal(u DiagnosticTags) bool {
+	if t.n != u.n || t.first != u.first {
+		return false
+	}
+	for i := range t.n - 1 {
+		if t.rest[i] != u.rest[i] {
+			return false
+		}
+	}
+	return true
+}
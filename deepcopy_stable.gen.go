@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build !lsp_proposed
+
+package protocol
+
+import (
+	"slices"
+)
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeAction) deepCopyFields() {
+	x.Title = cloneString(x.Title)
+	if x.Kind != nil {
+		v0 := *x.Kind
+		v0 = cloneString(v0)
+		x.Kind = &v0
+	}
+	x.Diagnostics = slices.Clone(x.Diagnostics)
+	for i0 := range x.Diagnostics {
+		x.Diagnostics[i0].deepCopyFields()
+	}
+	if x.IsPreferred != nil {
+		v0 := *x.IsPreferred
+		x.IsPreferred = &v0
+	}
+	x.Disabled.deepCopyFields()
+	x.Edit = x.Edit.DeepCopy()
+	x.Command.deepCopyFields()
+	x.Data = slices.Clone(x.Data)
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeAction) Equal(y *CodeAction) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Title == y.Title &&
+		equalPtr(x.Kind, y.Kind, equalValue[CodeActionKind]) &&
+		equalSlice(x.Diagnostics, y.Diagnostics, (*Diagnostic).Equal) &&
+		equalPtr(x.IsPreferred, y.IsPreferred, equalValue[bool]) &&
+		x.Disabled.Equal(&y.Disabled) &&
+		x.Edit.Equal(y.Edit) &&
+		x.Command.Equal(&y.Command) &&
+		equalRaw(x.Data, y.Data)
+}
+
+// deepCopyFields replaces the memory x shares with the value it was
+// shallow-copied from.
+func (x *CodeActionClientCapabilities) deepCopyFields() {
+	if x.DynamicRegistration != nil {
+		v0 := *x.DynamicRegistration
+		x.DynamicRegistration = &v0
+	}
+	x.CodeActionLiteralSupport.deepCopyFields()
+	if x.IsPreferredSupport != nil {
+		v0 := *x.IsPreferredSupport
+		x.IsPreferredSupport = &v0
+	}
+	if x.DisabledSupport != nil {
+		v0 := *x.DisabledSupport
+		x.DisabledSupport = &v0
+	}
+	if x.DataSupport != nil {
+		v0 := *x.DataSupport
+		x.DataSupport = &v0
+	}
+	x.ResolveSupport.deepCopyFields()
+	if x.HonorsChangeAnnotations != nil {
+		v0 := *x.HonorsChangeAnnotations
+		x.HonorsChangeAnnotations = &v0
+	}
+	if x.DocumentationSupport != nil {
+		v0 := *x.DocumentationSupport
+		x.DocumentationSupport = &v0
+	}
+}
+
+// Equal reports whether x and y hold the same value.
+func (x *CodeActionClientCapabilities) Equal(y *CodeActionClientCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return equalPtr(x.DynamicRegistration, y.DynamicRegistration, equalValue[bool]) &&
+		x.CodeActionLiteralSupport.Equal(&y.CodeActionLiteralSupport) &&
+		equalPtr(x.IsPreferredSupport, y.IsPreferredSupport, equalValue[bool]) &&
+		equalPtr(x.DisabledSupport, y.DisabledSupport, equalValue[bool]) &&
+		equalPtr(x.DataSupport, y.DataSupport, equalValue[bool]) &&
+		x.ResolveSupport.Equal(&y.ResolveSupport) &&
+		equalPtr(x.HonorsChangeAnnotations, y.HonorsChangeAnnotations, equalValue[bool]) &&
+		equalPtr(x.DocumentationSupport, y.DocumentationSupport, equalValue[bool])
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+// memoryOf records the backing memory of every string, slice, map and pointer
+// reachable from v.
+func memoryOf(v reflect.Value, seen map[uintptr]string) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() > 0 {
+			seen[uintptr(unsafe.Pointer(unsafe.StringData(v.String())))] = v.Type().String()
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			seen[v.Pointer()] = v.Type().String()
+			memoryOf(v.Elem(), seen)
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			memoryOf(v.Elem(), seen)
+		}
+	case reflect.Slice:
+		if v.Cap() > 0 {
+			seen[v.Pointer()] = v.Type().String()
+		}
+		for i := range v.Len() {
+			memoryOf(v.Index(i), seen)
+		}
+	case reflect.Array:
+		for i := range v.Len() {
+			memoryOf(v.Index(i), seen)
+		}
+	case reflect.Map:
+		if !v.IsNil() {
+			seen[v.Pointer()] = v.Type().String()
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			memoryOf(iter.Key(), seen)
+			memoryOf(iter.Value(), seen)
+		}
+	case reflect.Struct:
+		// Optional, Nullable and DiagnosticTags keep their state unexported.
+		p := reflect.New(v.Type()).Elem()
+		p.Set(v)
+		for i := range p.NumField() {
+			f := p.Field(i)
+			memoryOf(reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem(), seen)
+		}
+	default:
+	}
+}
+
+// checkDeepCopy decodes data into a T, deep-copies it and checks that the
+// copy is equal to the original and shares no memory with it.
+func checkDeepCopy[T any, P interface {
+	*T
+	DeepCopy() P
+	Equal(P) bool
+}](t *testing.T, data []byte,
+) {
+	t.Helper()
+
+	orig := P(new(T))
+	if err := Unmarshal(data, orig); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	cp := orig.DeepCopy()
+	if !cp.Equal(orig) || !orig.Equal(cp) {
+		t.Fatal("DeepCopy() is not Equal to the original")
+	}
+	if !reflect.DeepEqual(cp, orig) {
+		t.Fatal("DeepCopy() is not reflect.DeepEqual to the original")
+	}
+
+	origMem := map[uintptr]string{}
+	memoryOf(reflect.ValueOf(orig).Elem(), origMem)
+	cpMem := map[uintptr]string{}
+	memoryOf(reflect.ValueOf(cp).Elem(), cpMem)
+	for p, typ := range cpMem {
+		if _, ok := origMem[p]; ok {
+			t.Fatalf("DeepCopy() shares a %s with the original", typ)
+		}
+	}
+}
+
+func TestDeepCopyCorpus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T, []byte){
+		"completion_result.json":                checkDeepCopy[CompletionList],
+		"didchange.json":                        checkDeepCopy[DidChangeTextDocumentParams],
+		"dispatch_codeaction_command_obj.json":  checkDeepCopy[CodeAction],
+		"dispatch_codeaction_kind.json":         checkDeepCopy[CodeAction],
+		"dispatch_documentchange_textedit.json": checkDeepCopy[TextDocumentEdit],
+		"dispatch_notebook_sync_id.json":        checkDeepCopy[NotebookDocumentSyncRegistrationOptions],
+		"dispatch_textdocfilter_pattern.json":   checkDeepCopy[TextDocumentFilterPattern],
+		"initialize_request.json":               checkDeepCopy[InitializeParams],
+		"initialize_result.json":                checkDeepCopy[InitializeResult],
+		"publish_diagnostics.json":              checkDeepCopy[PublishDiagnosticsParams],
+		"semantic_tokens.json":                  checkDeepCopy[SemanticTokens],
+	}
+	for name, check := range tests {
+		t.Run("success: "+name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, data)
+		})
+	}
+}
+
+func TestEqualMatchesDeepEqual(t *testing.T) {
+	t.Parallel()
+
+	base := func() *CompletionItem {
+		return &CompletionItem{
+			Label:         "fmt",
+			Tags:          []CompletionItemTag{CompletionItemTagDeprecated},
+			Detail:        NewOptional("package"),
+			Documentation: &MarkupContent{Kind: MarkupKindMarkdown, Value: "**fmt**"},
+			Data:          LSPAny(`{"k":1}`),
+		}
+	}
+	tests := map[string]struct {
+		mutate func(*CompletionItem)
+		want   bool
+	}{
+		"success: identical":          {mutate: func(*CompletionItem) {}, want: true},
+		"success: different label":    {mutate: func(c *CompletionItem) { c.Label = "os" }},
+		"success: nil versus empty":   {mutate: func(c *CompletionItem) { c.Tags = []CompletionItemTag{} }},
+		"success: optional cleared":   {mutate: func(c *CompletionItem) { c.Detail.Clear() }},
+		"success: optional empty":     {mutate: func(c *CompletionItem) { c.Detail = NewOptional("") }},
+		"success: union arm differs":  {mutate: func(c *CompletionItem) { c.Documentation = String("**fmt**") }},
+		"success: union value":        {mutate: func(c *CompletionItem) { c.Documentation = &MarkupContent{Kind: MarkupKindMarkdown, Value: "*fmt*"} }},
+		"success: union nil":          {mutate: func(c *CompletionItem) { c.Documentation = nil }},
+		"success: raw data differs":   {mutate: func(c *CompletionItem) { c.Data = LSPAny(`{"k":2}`) }},
+		"success: raw data absent":    {mutate: func(c *CompletionItem) { c.Data = nil }},
+		"success: nested ptr differs": {mutate: func(c *CompletionItem) { c.LabelDetails = &CompletionItemLabelDetails{} }},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := base(), base()
+			tt.mutate(b)
+			if got := a.Equal(b); got != tt.want {
+				t.Fatalf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := b.Equal(a); got != tt.want {
+				t.Fatalf("Equal() reversed = %v, want %v", got, tt.want)
+			}
+			if deep := reflect.DeepEqual(a, b); deep != tt.want {
+				t.Fatalf("reflect.DeepEqual() = %v, want %v", deep, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloneUsesDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	// Invalid raw JSON does not survive a Marshal round trip.
+	item := CompletionItem{Label: "x", Data: LSPAny(`{invalid`)}
+	got, err := Clone(item)
+	if err != nil {
+		t.Fatalf("Clone(CompletionItem) error = %v", err)
+	}
+	if !got.Equal(&item) {
+		t.Fatalf("Clone(CompletionItem) = %+v, want %+v", got, item)
+	}
+	got.Data[0] = '['
+	if item.Data[0] != '{' {
+		t.Fatal("Clone(CompletionItem) shares Data with the original")
+	}
+
+	ptr, err := Clone(&item)
+	if err != nil || ptr == &item || !ptr.Equal(&item) {
+		t.Fatalf("Clone(*CompletionItem) = %p, %v; want an equal copy", ptr, err)
+	}
+
+	var doc InlayHintTooltip = &MarkupContent{Kind: MarkupKindPlainText, Value: "v"}
+	docCopy, err := Clone(doc)
+	if err != nil || docCopy == doc || !equalInlayHintTooltip(docCopy, doc) {
+		t.Fatalf("Clone(InlayHintTooltip) = %v, %v; want an equal copy", docCopy, err)
+	}
+
+	sel := DocumentSelector{&TextDocumentFilterLanguage{Language: "go"}}
+	selCopy, err := Clone(sel)
+	if err != nil || !selCopy.Equal(sel) || selCopy[0] == sel[0] {
+		t.Fatalf("Clone(DocumentSelector) = %v, %v; want an equal copy", selCopy, err)
+	}
+}
@@ -0,0 +1,1767 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"go.lsp.dev/jsonrpc2"
+)
+
+// UnmarshalParams decodes data, the params of a method message, into the Go
+// type the method declares: a pointer to its params structure (e.g.
+// *HoverParams), LSPAny for untyped params, or nil for a method that takes no
+// params. An unknown method reports an error wrapping
+// [jsonrpc2.ErrMethodNotFound].
+func UnmarshalParams(method string, data []byte) (any, error) {
+	switch method {
+	case MethodInitialize:
+		var params InitializeParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodInitialized:
+		var params InitializedParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodClientRegisterCapability:
+		var params RegistrationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodClientUnregisterCapability:
+		var params UnregistrationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodSetTrace:
+		var params SetTraceParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodLogTrace:
+		var params LogTraceParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodShutdown:
+		return nil, nil
+	case MethodExit:
+		return nil, nil
+	case MethodTextDocumentDidOpen:
+		var params DidOpenTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDidChange:
+		var params DidChangeTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentWillSave:
+		var params WillSaveTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentWillSaveWaitUntil:
+		var params WillSaveTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDidSave:
+		var params DidSaveTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDidClose:
+		var params DidCloseTextDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodNotebookDocumentDidOpen:
+		var params DidOpenNotebookDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodNotebookDocumentDidChange:
+		var params DidChangeNotebookDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodNotebookDocumentDidSave:
+		var params DidSaveNotebookDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodNotebookDocumentDidClose:
+		var params DidCloseNotebookDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDeclaration:
+		var params DeclarationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDefinition:
+		var params DefinitionParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentTypeDefinition:
+		var params TypeDefinitionParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentImplementation:
+		var params ImplementationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentReferences:
+		var params ReferenceParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentPrepareCallHierarchy:
+		var params CallHierarchyPrepareParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCallHierarchyIncomingCalls:
+		var params CallHierarchyIncomingCallsParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCallHierarchyOutgoingCalls:
+		var params CallHierarchyOutgoingCallsParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentPrepareTypeHierarchy:
+		var params TypeHierarchyPrepareParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTypeHierarchySupertypes:
+		var params TypeHierarchySupertypesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTypeHierarchySubtypes:
+		var params TypeHierarchySubtypesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDocumentHighlight:
+		var params DocumentHighlightParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDocumentLink:
+		var params DocumentLinkParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodDocumentLinkResolve:
+		var params DocumentLink
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentHover:
+		var params HoverParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentCodeLens:
+		var params CodeLensParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCodeLensResolve:
+		var params CodeLens
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceCodeLensRefresh:
+		return nil, nil
+	case MethodTextDocumentFoldingRange:
+		var params FoldingRangeParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceFoldingRangeRefresh:
+		return nil, nil
+	case MethodTextDocumentSelectionRange:
+		var params SelectionRangeParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDocumentSymbol:
+		var params DocumentSymbolParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentSemanticTokensFull:
+		var params SemanticTokensParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentSemanticTokensFullDelta:
+		var params SemanticTokensDeltaParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentSemanticTokensRange:
+		var params SemanticTokensRangeParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceSemanticTokensRefresh:
+		return nil, nil
+	case MethodTextDocumentInlineValue:
+		var params InlineValueParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceInlineValueRefresh:
+		return nil, nil
+	case MethodTextDocumentInlayHint:
+		var params InlayHintParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodInlayHintResolve:
+		var params InlayHint
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceInlayHintRefresh:
+		return nil, nil
+	case MethodTextDocumentMoniker:
+		var params MonikerParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentCompletion:
+		var params CompletionParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCompletionItemResolve:
+		var params CompletionItem
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDiagnostic:
+		var params DocumentDiagnosticParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDiagnostic:
+		var params WorkspaceDiagnosticParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDiagnosticRefresh:
+		return nil, nil
+	case MethodTextDocumentPublishDiagnostics:
+		var params PublishDiagnosticsParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentSignatureHelp:
+		var params SignatureHelpParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentCodeAction:
+		var params CodeActionParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCodeActionResolve:
+		var params CodeAction
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentDocumentColor:
+		var params DocumentColorParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentColorPresentation:
+		var params ColorPresentationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentFormatting:
+		var params DocumentFormattingParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentRangeFormatting:
+		var params DocumentRangeFormattingParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentRangesFormatting:
+		var params DocumentRangesFormattingParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentOnTypeFormatting:
+		var params DocumentOnTypeFormattingParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentRename:
+		var params RenameParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentPrepareRename:
+		var params PrepareRenameParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentLinkedEditingRange:
+		var params LinkedEditingRangeParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTextDocumentInlineCompletion:
+		var params InlineCompletionParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceSymbol:
+		var params WorkspaceSymbolParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceSymbolResolve:
+		var params WorkspaceSymbol
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceConfiguration:
+		var params ConfigurationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDidChangeConfiguration:
+		var params DidChangeConfigurationParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceWorkspaceFolders:
+		return nil, nil
+	case MethodWorkspaceDidChangeWorkspaceFolders:
+		var params DidChangeWorkspaceFoldersParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceWillCreateFiles:
+		var params CreateFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceWillRenameFiles:
+		var params RenameFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceWillDeleteFiles:
+		var params DeleteFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDidCreateFiles:
+		var params CreateFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDidRenameFiles:
+		var params RenameFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDidDeleteFiles:
+		var params DeleteFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceDidChangeWatchedFiles:
+		var params DidChangeWatchedFilesParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceExecuteCommand:
+		var params ExecuteCommandParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceApplyEdit:
+		var params ApplyWorkspaceEditParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceTextDocumentContent:
+		var params TextDocumentContentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWorkspaceTextDocumentContentRefresh:
+		var params TextDocumentContentRefreshParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowShowMessage:
+		var params ShowMessageParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowShowMessageRequest:
+		var params ShowMessageRequestParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowLogMessage:
+		var params LogMessageParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowShowDocument:
+		var params ShowDocumentParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowWorkDoneProgressCreate:
+		var params WorkDoneProgressCreateParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodWindowWorkDoneProgressCancel:
+		var params WorkDoneProgressCancelParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodTelemetryEvent:
+		var params LSPAny
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return params, nil
+	case MethodProgress:
+		var params ProgressParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	case MethodCancelRequest:
+		var params CancelParams
+		if err := Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", jsonrpc2.ErrMethodNotFound, method)
+	}
+}
+
+// UnmarshalResult decodes data, the result of a response to a method request,
+// into the Go type the method's handler returns (e.g. *Hover,
+// DefinitionResult, []Location). Requests whose result is always null decode
+// to nil. A notification or unknown method reports an error wrapping
+// [jsonrpc2.ErrMethodNotFound].
+func UnmarshalResult(method string, data []byte) (any, error) {
+	switch method {
+	case MethodInitialize:
+		var result *InitializeResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodClientRegisterCapability:
+		return nil, nil
+	case MethodClientUnregisterCapability:
+		return nil, nil
+	case MethodShutdown:
+		return nil, nil
+	case MethodTextDocumentWillSaveWaitUntil:
+		var result []TextEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDeclaration:
+		var result DeclarationResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDefinition:
+		var result DefinitionResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentTypeDefinition:
+		var result DefinitionResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentImplementation:
+		var result DefinitionResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentReferences:
+		var result []Location
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentPrepareCallHierarchy:
+		var result []CallHierarchyItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodCallHierarchyIncomingCalls:
+		var result []CallHierarchyIncomingCall
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodCallHierarchyOutgoingCalls:
+		var result []CallHierarchyOutgoingCall
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentPrepareTypeHierarchy:
+		var result []TypeHierarchyItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTypeHierarchySupertypes:
+		var result []TypeHierarchyItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTypeHierarchySubtypes:
+		var result []TypeHierarchyItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDocumentHighlight:
+		var result []DocumentHighlight
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDocumentLink:
+		var result []DocumentLink
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodDocumentLinkResolve:
+		var result *DocumentLink
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentHover:
+		var result *Hover
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentCodeLens:
+		var result []CodeLens
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodCodeLensResolve:
+		var result *CodeLens
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceCodeLensRefresh:
+		return nil, nil
+	case MethodTextDocumentFoldingRange:
+		var result []FoldingRange
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceFoldingRangeRefresh:
+		return nil, nil
+	case MethodTextDocumentSelectionRange:
+		var result []SelectionRange
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDocumentSymbol:
+		var result DocumentSymbolResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentSemanticTokensFull:
+		var result *SemanticTokens
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentSemanticTokensFullDelta:
+		var result SemanticTokensDeltaResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentSemanticTokensRange:
+		var result *SemanticTokens
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceSemanticTokensRefresh:
+		return nil, nil
+	case MethodTextDocumentInlineValue:
+		var result []InlineValue
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceInlineValueRefresh:
+		return nil, nil
+	case MethodTextDocumentInlayHint:
+		var result []InlayHint
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodInlayHintResolve:
+		var result *InlayHint
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceInlayHintRefresh:
+		return nil, nil
+	case MethodTextDocumentMoniker:
+		var result []Moniker
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentCompletion:
+		var result CompletionResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodCompletionItemResolve:
+		var result *CompletionItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDiagnostic:
+		var result DocumentDiagnosticReport
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceDiagnostic:
+		var result *WorkspaceDiagnosticReport
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceDiagnosticRefresh:
+		return nil, nil
+	case MethodTextDocumentSignatureHelp:
+		var result *SignatureHelp
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentCodeAction:
+		var result []CommandOrCodeAction
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodCodeActionResolve:
+		var result *CodeAction
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentDocumentColor:
+		var result []ColorInformation
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentColorPresentation:
+		var result []ColorPresentation
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentFormatting:
+		var result []TextEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentRangeFormatting:
+		var result []TextEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentRangesFormatting:
+		var result []TextEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentOnTypeFormatting:
+		var result []TextEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentRename:
+		var result *WorkspaceEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentPrepareRename:
+		var result PrepareRenameResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentLinkedEditingRange:
+		var result *LinkedEditingRanges
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodTextDocumentInlineCompletion:
+		var result InlineCompletionResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceSymbol:
+		var result WorkspaceSymbolResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceSymbolResolve:
+		var result *WorkspaceSymbol
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceConfiguration:
+		var result []LSPAny
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceWorkspaceFolders:
+		var result []WorkspaceFolder
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceWillCreateFiles:
+		var result *WorkspaceEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceWillRenameFiles:
+		var result *WorkspaceEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceWillDeleteFiles:
+		var result *WorkspaceEdit
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceExecuteCommand:
+		var result LSPAny
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceApplyEdit:
+		var result *ApplyWorkspaceEditResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceTextDocumentContent:
+		var result *TextDocumentContentResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWorkspaceTextDocumentContentRefresh:
+		return nil, nil
+	case MethodWindowShowMessageRequest:
+		var result *MessageActionItem
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWindowShowDocument:
+		var result *ShowDocumentResult
+		if err := Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case MethodWindowWorkDoneProgressCreate:
+		return nil, nil
+	case MethodInitialized,
+		MethodSetTrace,
+		MethodLogTrace,
+		MethodExit,
+		MethodTextDocumentDidOpen,
+		MethodTextDocumentDidChange,
+		MethodTextDocumentWillSave,
+		MethodTextDocumentDidSave,
+		MethodTextDocumentDidClose,
+		MethodNotebookDocumentDidOpen,
+		MethodNotebookDocumentDidChange,
+		MethodNotebookDocumentDidSave,
+		MethodNotebookDocumentDidClose,
+		MethodTextDocumentPublishDiagnostics,
+		MethodWorkspaceDidChangeConfiguration,
+		MethodWorkspaceDidChangeWorkspaceFolders,
+		MethodWorkspaceDidCreateFiles,
+		MethodWorkspaceDidRenameFiles,
+		MethodWorkspaceDidDeleteFiles,
+		MethodWorkspaceDidChangeWatchedFiles,
+		MethodWindowShowMessage,
+		MethodWindowLogMessage,
+		MethodWindowWorkDoneProgressCancel,
+		MethodTelemetryEvent,
+		MethodProgress,
+		MethodCancelRequest:
+		return nil, fmt.Errorf("%w: notification %s has no result", jsonrpc2.ErrMethodNotFound, method)
+	default:
+		return nil, fmt.Errorf("%w: %s", jsonrpc2.ErrMethodNotFound, method)
+	}
+}
+
+// serverDispatch decodes req and invokes the matching [Server] method, reporting
+// handled=true when req named a standard server method.
+func serverDispatch(ctx context.Context, server Server, req *jsonrpc2.Request) (result any, handled bool, err error) {
+	if ctx.Err() != nil {
+		return nil, true, ErrRequestCancelled
+	}
+
+	switch req.Method() {
+	case MethodInitialize: // request
+		var params InitializeParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Initialize(ctx, &params)
+
+		return resp, true, err
+
+	case MethodInitialized: // notification
+		var params InitializedParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.Initialized(ctx, &params)
+
+	case MethodSetTrace: // notification
+		var params SetTraceParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.SetTrace(ctx, &params)
+
+	case MethodShutdown: // request
+		return nil, true, server.Shutdown(ctx)
+
+	case MethodExit: // notification
+		return nil, true, server.Exit(ctx)
+
+	case MethodTextDocumentDidOpen: // notification
+		var params DidOpenTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidOpen(ctx, &params)
+
+	case MethodTextDocumentDidChange: // notification
+		var params DidChangeTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidChange(ctx, &params)
+
+	case MethodTextDocumentWillSave: // notification
+		var params WillSaveTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.WillSave(ctx, &params)
+
+	case MethodTextDocumentWillSaveWaitUntil: // request
+		var params WillSaveTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.WillSaveWaitUntil(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDidSave: // notification
+		var params DidSaveTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidSave(ctx, &params)
+
+	case MethodTextDocumentDidClose: // notification
+		var params DidCloseTextDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidClose(ctx, &params)
+
+	case MethodNotebookDocumentDidOpen: // notification
+		var params DidOpenNotebookDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidOpenNotebookDocument(ctx, &params)
+
+	case MethodNotebookDocumentDidChange: // notification
+		var params DidChangeNotebookDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidChangeNotebookDocument(ctx, &params)
+
+	case MethodNotebookDocumentDidSave: // notification
+		var params DidSaveNotebookDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidSaveNotebookDocument(ctx, &params)
+
+	case MethodNotebookDocumentDidClose: // notification
+		var params DidCloseNotebookDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidCloseNotebookDocument(ctx, &params)
+
+	case MethodTextDocumentDeclaration: // request
+		var params DeclarationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Declaration(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDefinition: // request
+		var params DefinitionParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Definition(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentTypeDefinition: // request
+		var params TypeDefinitionParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.TypeDefinition(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentImplementation: // request
+		var params ImplementationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Implementation(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentReferences: // request
+		var params ReferenceParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.References(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentPrepareCallHierarchy: // request
+		var params CallHierarchyPrepareParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.PrepareCallHierarchy(ctx, &params)
+
+		return resp, true, err
+
+	case MethodCallHierarchyIncomingCalls: // request
+		var params CallHierarchyIncomingCallsParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.IncomingCalls(ctx, &params)
+
+		return resp, true, err
+
+	case MethodCallHierarchyOutgoingCalls: // request
+		var params CallHierarchyOutgoingCallsParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.OutgoingCalls(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentPrepareTypeHierarchy: // request
+		var params TypeHierarchyPrepareParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.PrepareTypeHierarchy(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTypeHierarchySupertypes: // request
+		var params TypeHierarchySupertypesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Supertypes(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTypeHierarchySubtypes: // request
+		var params TypeHierarchySubtypesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Subtypes(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDocumentHighlight: // request
+		var params DocumentHighlightParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DocumentHighlight(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDocumentLink: // request
+		var params DocumentLinkParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DocumentLink(ctx, &params)
+
+		return resp, true, err
+
+	case MethodDocumentLinkResolve: // request
+		var params DocumentLink
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DocumentLinkResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentHover: // request
+		var params HoverParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Hover(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentCodeLens: // request
+		var params CodeLensParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.CodeLens(ctx, &params)
+
+		return resp, true, err
+
+	case MethodCodeLensResolve: // request
+		var params CodeLens
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.CodeLensResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentFoldingRange: // request
+		var params FoldingRangeParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.FoldingRanges(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentSelectionRange: // request
+		var params SelectionRangeParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.SelectionRange(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDocumentSymbol: // request
+		var params DocumentSymbolParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DocumentSymbol(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentSemanticTokensFull: // request
+		var params SemanticTokensParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.SemanticTokensFull(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentSemanticTokensFullDelta: // request
+		var params SemanticTokensDeltaParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.SemanticTokensFullDelta(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentSemanticTokensRange: // request
+		var params SemanticTokensRangeParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.SemanticTokensRange(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentInlineValue: // request
+		var params InlineValueParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.InlineValue(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentInlayHint: // request
+		var params InlayHintParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.InlayHint(ctx, &params)
+
+		return resp, true, err
+
+	case MethodInlayHintResolve: // request
+		var params InlayHint
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.InlayHintResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentMoniker: // request
+		var params MonikerParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Moniker(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentCompletion: // request
+		var params CompletionParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Completion(ctx, &params)
+
+		return resp, true, err
+
+	case MethodCompletionItemResolve: // request
+		var params CompletionItem
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.CompletionResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDiagnostic: // request
+		var params DocumentDiagnosticParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Diagnostic(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceDiagnostic: // request
+		var params WorkspaceDiagnosticParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DiagnosticWorkspace(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentSignatureHelp: // request
+		var params SignatureHelpParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.SignatureHelp(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentCodeAction: // request
+		var params CodeActionParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.CodeAction(ctx, &params)
+
+		return resp, true, err
+
+	case MethodCodeActionResolve: // request
+		var params CodeAction
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.CodeActionResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentDocumentColor: // request
+		var params DocumentColorParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.DocumentColor(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentColorPresentation: // request
+		var params ColorPresentationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.ColorPresentation(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentFormatting: // request
+		var params DocumentFormattingParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Formatting(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentRangeFormatting: // request
+		var params DocumentRangeFormattingParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.RangeFormatting(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentRangesFormatting: // request
+		var params DocumentRangesFormattingParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.RangesFormatting(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentOnTypeFormatting: // request
+		var params DocumentOnTypeFormattingParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.OnTypeFormatting(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentRename: // request
+		var params RenameParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Rename(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentPrepareRename: // request
+		var params PrepareRenameParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.PrepareRename(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentLinkedEditingRange: // request
+		var params LinkedEditingRangeParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.LinkedEditingRange(ctx, &params)
+
+		return resp, true, err
+
+	case MethodTextDocumentInlineCompletion: // request
+		var params InlineCompletionParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.InlineCompletion(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceSymbol: // request
+		var params WorkspaceSymbolParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.Symbols(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceSymbolResolve: // request
+		var params WorkspaceSymbol
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.WorkspaceSymbolResolve(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceDidChangeConfiguration: // notification
+		var params DidChangeConfigurationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidChangeConfiguration(ctx, &params)
+
+	case MethodWorkspaceDidChangeWorkspaceFolders: // notification
+		var params DidChangeWorkspaceFoldersParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidChangeWorkspaceFolders(ctx, &params)
+
+	case MethodWorkspaceWillCreateFiles: // request
+		var params CreateFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.WillCreateFiles(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceWillRenameFiles: // request
+		var params RenameFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.WillRenameFiles(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceWillDeleteFiles: // request
+		var params DeleteFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.WillDeleteFiles(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceDidCreateFiles: // notification
+		var params CreateFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidCreateFiles(ctx, &params)
+
+	case MethodWorkspaceDidRenameFiles: // notification
+		var params RenameFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidRenameFiles(ctx, &params)
+
+	case MethodWorkspaceDidDeleteFiles: // notification
+		var params DeleteFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidDeleteFiles(ctx, &params)
+
+	case MethodWorkspaceDidChangeWatchedFiles: // notification
+		var params DidChangeWatchedFilesParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.DidChangeWatchedFiles(ctx, &params)
+
+	case MethodWorkspaceExecuteCommand: // request
+		var params ExecuteCommandParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.ExecuteCommand(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceTextDocumentContent: // request
+		var params TextDocumentContentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := server.TextDocumentContent(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWindowWorkDoneProgressCancel: // notification
+		var params WorkDoneProgressCancelParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.WorkDoneProgressCancel(ctx, &params)
+
+	case MethodProgress: // notification
+		var params ProgressParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, server.Progress(ctx, &params)
+
+	default:
+		return nil, false, nil
+	}
+}
+
+// clientDispatch decodes req and invokes the matching [Client] method, reporting
+// handled=true when req named a standard client method.
+func clientDispatch(ctx context.Context, client Client, req *jsonrpc2.Request) (result any, handled bool, err error) {
+	if ctx.Err() != nil {
+		return nil, true, ErrRequestCancelled
+	}
+
+	switch req.Method() {
+	case MethodClientRegisterCapability: // request
+		var params RegistrationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.RegisterCapability(ctx, &params)
+
+	case MethodClientUnregisterCapability: // request
+		var params UnregistrationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.UnregisterCapability(ctx, &params)
+
+	case MethodLogTrace: // notification
+		var params LogTraceParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.LogTrace(ctx, &params)
+
+	case MethodWorkspaceCodeLensRefresh: // request
+		return nil, true, client.CodeLensRefresh(ctx)
+
+	case MethodWorkspaceFoldingRangeRefresh: // request
+		return nil, true, client.FoldingRangeRefresh(ctx)
+
+	case MethodWorkspaceSemanticTokensRefresh: // request
+		return nil, true, client.SemanticTokensRefresh(ctx)
+
+	case MethodWorkspaceInlineValueRefresh: // request
+		return nil, true, client.InlineValueRefresh(ctx)
+
+	case MethodWorkspaceInlayHintRefresh: // request
+		return nil, true, client.InlayHintRefresh(ctx)
+
+	case MethodWorkspaceDiagnosticRefresh: // request
+		return nil, true, client.DiagnosticRefresh(ctx)
+
+	case MethodTextDocumentPublishDiagnostics: // notification
+		var params PublishDiagnosticsParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.PublishDiagnostics(ctx, &params)
+
+	case MethodWorkspaceConfiguration: // request
+		var params ConfigurationParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := client.Configuration(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceWorkspaceFolders: // request
+		resp, err := client.WorkspaceFolders(ctx)
+
+		return resp, true, err
+
+	case MethodWorkspaceApplyEdit: // request
+		var params ApplyWorkspaceEditParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := client.ApplyEdit(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWorkspaceTextDocumentContentRefresh: // request
+		var params TextDocumentContentRefreshParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.TextDocumentContentRefresh(ctx, &params)
+
+	case MethodWindowShowMessage: // notification
+		var params ShowMessageParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.ShowMessage(ctx, &params)
+
+	case MethodWindowShowMessageRequest: // request
+		var params ShowMessageRequestParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := client.ShowMessageRequest(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWindowLogMessage: // notification
+		var params LogMessageParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.LogMessage(ctx, &params)
+
+	case MethodWindowShowDocument: // request
+		var params ShowDocumentParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+		resp, err := client.ShowDocument(ctx, &params)
+
+		return resp, true, err
+
+	case MethodWindowWorkDoneProgressCreate: // request
+		var params WorkDoneProgressCreateParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.WorkDoneProgressCreate(ctx, &params)
+
+	case MethodTelemetryEvent: // notification
+		var params LSPAny
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.Telemetry(ctx, params)
+
+	case MethodProgress: // notification
+		var params ProgressParams
+		if err := Unmarshal(req.Params(), &params); err != nil {
+			return nil, true, replyParseError(err)
+		}
+
+		return nil, true, client.Progress(ctx, &params)
+
+	default:
+		return nil, false, nil
+	}
+}
@@ -21,5 +21,8 @@
 // type for the generated marker method. Prefer go.lsp.dev/uri.URI for ordinary
 // fields; convert explicitly to protocol.URI only at those union boundaries.
 //
+// Features the meta-model marks as proposed are only compiled in with the
+// lsp_proposed build tag; the default build contains the stable protocol.
+//
 // The generator lives in go.lsp.dev/protocol/internal/genlsp.
 package protocol
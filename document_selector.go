@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+
+	"go.lsp.dev/uri"
+)
+
+// Document selector scores, as VS Code ranks the providers of a document.
+const (
+	// SelectorNoMatch is the score of a selector that does not match.
+	SelectorNoMatch = 0
+
+	// SelectorWildcardMatch is the score of a match through a "*" language,
+	// scheme or notebook type only.
+	SelectorWildcardMatch = 5
+
+	// SelectorExactMatch is the score of a match through an exact language,
+	// scheme, notebook type or a glob pattern.
+	SelectorExactMatch = 10
+)
+
+// DocumentSelectorMatcher scores documents against a compiled
+// [DocumentSelector] with the rules of VS Code's languages.match, so a server
+// with dynamic registrations or a client routing between several servers
+// ranks registrations the way the editor does.
+type DocumentSelectorMatcher struct {
+	filters []documentFilter
+}
+
+// documentFilter is one compiled [DocumentFilter]. Nil properties are
+// absent. A cell filter matches notebook cells only, with its scheme and
+// pattern applied to the notebook's URI.
+type documentFilter struct {
+	language     *string
+	scheme       *string
+	notebookType *string
+	pattern      *GlobMatcher
+	literal      string
+	cell         bool
+}
+
+// CompileDocumentSelector compiles the glob patterns of selector.
+func CompileDocumentSelector(selector DocumentSelector) (*DocumentSelectorMatcher, error) {
+	m := &DocumentSelectorMatcher{filters: make([]documentFilter, 0, len(selector))}
+	for _, filter := range selector {
+		f, err := compileDocumentFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		m.filters = append(m.filters, f)
+	}
+	return m, nil
+}
+
+// MatchDocumentSelector returns the score of the document documentURI with
+// languageID against selector. notebook is the notebook containing the
+// document when it is a notebook cell, and nil otherwise. A filter whose
+// pattern does not compile matches nothing.
+//
+// MatchDocumentSelector compiles the selector's glob patterns on every
+// call. To score documents against the same selector repeatedly, as for
+// each request to a registered provider, compile it once with
+// [CompileDocumentSelector] and use [DocumentSelectorMatcher.Score].
+func MatchDocumentSelector(selector DocumentSelector, documentURI uri.URI, languageID string, notebook *NotebookDocument) int {
+	best := SelectorNoMatch
+	for _, filter := range selector {
+		f, err := compileDocumentFilter(filter)
+		if err != nil {
+			continue
+		}
+		if s := f.score(documentURI, languageID, notebook); s > best {
+			if best = s; best == SelectorExactMatch {
+				break
+			}
+		}
+	}
+	return best
+}
+
+// Score returns the highest score of a filter of the selector for the
+// document documentURI with languageID, as [MatchDocumentSelector] does.
+func (m *DocumentSelectorMatcher) Score(documentURI uri.URI, languageID string, notebook *NotebookDocument) int {
+	best := SelectorNoMatch
+	for i := range m.filters {
+		if s := m.filters[i].score(documentURI, languageID, notebook); s > best {
+			if best = s; best == SelectorExactMatch {
+				break
+			}
+		}
+	}
+	return best
+}
+
+// Match reports whether the selector matches the document at all.
+func (m *DocumentSelectorMatcher) Match(documentURI uri.URI, languageID string, notebook *NotebookDocument) bool {
+	return m.Score(documentURI, languageID, notebook) > SelectorNoMatch
+}
+
+// MatchNotebookDocumentFilter returns the score of notebook against filter,
+// the notebook half of a [NotebookCellTextDocumentFilter] or a
+// [NotebookDocumentFilterWithNotebook] in a notebook document sync selector.
+// A string filter is a notebook type. A filter whose pattern does not
+// compile matches nothing.
+func MatchNotebookDocumentFilter(filter NotebookDocumentFilterNotebook, notebook *NotebookDocument) int {
+	f, err := compileDocumentFilter(&NotebookCellTextDocumentFilter{Notebook: filter})
+	if err != nil || notebook == nil {
+		return SelectorNoMatch
+	}
+	return f.score(notebook.URI, "", notebook)
+}
+
+func compileDocumentFilter(filter DocumentFilter) (documentFilter, error) {
+	var (
+		f       documentFilter
+		pattern GlobPattern
+	)
+	switch filter := filter.(type) {
+	case *TextDocumentFilterLanguage:
+		f.language, f.scheme, pattern = &filter.Language, filter.Scheme, filter.Pattern
+	case *TextDocumentFilterScheme:
+		f.language, f.scheme, pattern = filter.Language, &filter.Scheme, filter.Pattern
+	case *TextDocumentFilterPattern:
+		f.language, f.scheme, pattern = filter.Language, filter.Scheme, filter.Pattern
+	case *NotebookCellTextDocumentFilter:
+		f.cell, f.language = true, filter.Language
+		switch nb := filter.Notebook.(type) {
+		case String:
+			notebookType := string(nb)
+			f.notebookType = &notebookType
+		case *NotebookDocumentFilterNotebookType:
+			f.notebookType, f.scheme, pattern = &nb.NotebookType, nb.Scheme, nb.Pattern
+		case *NotebookDocumentFilterScheme:
+			f.notebookType, f.scheme, pattern = nb.NotebookType, &nb.Scheme, nb.Pattern
+		case *NotebookDocumentFilterPattern:
+			f.notebookType, f.scheme, pattern = nb.NotebookType, nb.Scheme, nb.Pattern
+		default:
+			return f, errors.New("protocol: notebook cell filter has no notebook filter")
+		}
+	default:
+		return f, fmt.Errorf("protocol: unsupported document filter %T", filter)
+	}
+	if pattern != nil {
+		m, err := CompileGlobPattern(pattern)
+		if err != nil {
+			return f, err
+		}
+		f.pattern = m
+		if p, ok := pattern.(Pattern); ok {
+			f.literal = string(p)
+		}
+	}
+	return f, nil
+}
+
+// score rates the document against f: every property present must match,
+// and the result is SelectorExactMatch if any matched exactly.
+func (f *documentFilter) score(documentURI uri.URI, languageID string, notebook *NotebookDocument) int {
+	if f.cell {
+		if notebook == nil {
+			return SelectorNoMatch
+		}
+		documentURI = notebook.URI
+	}
+	score := SelectorNoMatch
+	if f.scheme != nil {
+		switch *f.scheme {
+		case documentURI.Scheme():
+			score = SelectorExactMatch
+		case "*":
+			score = SelectorWildcardMatch
+		default:
+			return SelectorNoMatch
+		}
+	}
+	if f.language != nil {
+		switch *f.language {
+		case languageID:
+			score = SelectorExactMatch
+		case "*":
+			score = max(score, SelectorWildcardMatch)
+		default:
+			return SelectorNoMatch
+		}
+	}
+	if f.notebookType != nil {
+		switch {
+		case notebook == nil:
+			return SelectorNoMatch
+		case *f.notebookType == notebook.NotebookType:
+			score = SelectorExactMatch
+		case *f.notebookType == "*":
+			score = max(score, SelectorWildcardMatch)
+		default:
+			return SelectorNoMatch
+		}
+	}
+	if f.pattern != nil {
+		if f.literal != "" && f.literal == documentURI.Path() || f.pattern.MatchURI(documentURI) {
+			score = SelectorExactMatch
+		} else {
+			return SelectorNoMatch
+		}
+	}
+	return score
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+
+	"go.lsp.dev/uri"
+)
+
+func TestMatchDocumentSelector(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	jupyter := &NotebookDocument{URI: "file:///w/a.ipynb", NotebookType: "jupyter-notebook"}
+
+	tests := map[string]struct {
+		selector DocumentSelector
+		uri      string
+		language string
+		notebook *NotebookDocument
+		want     int
+	}{
+		"success: exact language": {
+			selector: DocumentSelector{&TextDocumentFilterLanguage{Language: "go"}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorExactMatch,
+		},
+		"success: wildcard language": {
+			selector: DocumentSelector{&TextDocumentFilterLanguage{Language: "*"}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorWildcardMatch,
+		},
+		"success: other language": {
+			selector: DocumentSelector{&TextDocumentFilterLanguage{Language: "rust"}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorNoMatch,
+		},
+		"success: every property must match": {
+			selector: DocumentSelector{&TextDocumentFilterLanguage{Language: "go", Scheme: str("untitled")}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorNoMatch,
+		},
+		"success: exact scheme outranks wildcard language": {
+			selector: DocumentSelector{&TextDocumentFilterScheme{Scheme: "file", Language: str("*")}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorExactMatch,
+		},
+		"success: wildcard scheme and language": {
+			selector: DocumentSelector{&TextDocumentFilterScheme{Scheme: "*", Language: str("*")}},
+			uri:      "untitled:Untitled-1",
+			language: "go",
+			want:     SelectorWildcardMatch,
+		},
+		"success: glob pattern": {
+			selector: DocumentSelector{&TextDocumentFilterPattern{Pattern: Pattern("**/go.mod")}},
+			uri:      "file:///w/go.mod",
+			language: "go.mod",
+			want:     SelectorExactMatch,
+		},
+		"success: glob pattern mismatch vetoes a language match": {
+			selector: DocumentSelector{&TextDocumentFilterLanguage{Language: "json", Pattern: Pattern("**/tsconfig.json")}},
+			uri:      "file:///w/package.json",
+			language: "json",
+			want:     SelectorNoMatch,
+		},
+		"success: literal path pattern": {
+			selector: DocumentSelector{&TextDocumentFilterPattern{Pattern: Pattern("/w/[id].go")}},
+			uri:      "file:///w/%5Bid%5D.go",
+			language: "go",
+			want:     SelectorExactMatch,
+		},
+		"success: relative pattern": {
+			selector: DocumentSelector{&TextDocumentFilterPattern{Pattern: &RelativePattern{BaseURI: URI("file:///w"), Pattern: "*.go"}}},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorExactMatch,
+		},
+		"success: best filter wins": {
+			selector: DocumentSelector{
+				&TextDocumentFilterLanguage{Language: "*"},
+				&TextDocumentFilterLanguage{Language: "rust"},
+				&TextDocumentFilterLanguage{Language: "go"},
+			},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorExactMatch,
+		},
+		"success: empty selector": {
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorNoMatch,
+		},
+		"success: notebook cell by notebook type": {
+			selector: DocumentSelector{&NotebookCellTextDocumentFilter{Notebook: String("jupyter-notebook"), Language: str("python")}},
+			uri:      "vscode-notebook-cell:/w/a.ipynb#c1",
+			language: "python",
+			notebook: jupyter,
+			want:     SelectorExactMatch,
+		},
+		"success: notebook cell with wildcard notebook": {
+			selector: DocumentSelector{&NotebookCellTextDocumentFilter{Notebook: String("*")}},
+			uri:      "vscode-notebook-cell:/w/a.ipynb#c1",
+			language: "python",
+			notebook: jupyter,
+			want:     SelectorWildcardMatch,
+		},
+		"success: notebook cell filter skips plain documents": {
+			selector: DocumentSelector{&NotebookCellTextDocumentFilter{Notebook: String("*")}},
+			uri:      "file:///w/a.py",
+			language: "python",
+			want:     SelectorNoMatch,
+		},
+		"success: notebook scheme and pattern apply to the notebook URI": {
+			selector: DocumentSelector{&NotebookCellTextDocumentFilter{
+				Notebook: &NotebookDocumentFilterScheme{Scheme: "file", Pattern: Pattern("**/*.ipynb")},
+			}},
+			uri:      "vscode-notebook-cell:/w/a.ipynb#c1",
+			language: "python",
+			notebook: jupyter,
+			want:     SelectorExactMatch,
+		},
+		"success: other notebook type": {
+			selector: DocumentSelector{&NotebookCellTextDocumentFilter{
+				Notebook: &NotebookDocumentFilterNotebookType{NotebookType: "interactive"},
+			}},
+			uri:      "vscode-notebook-cell:/w/a.ipynb#c1",
+			language: "python",
+			notebook: jupyter,
+			want:     SelectorNoMatch,
+		},
+		"success: invalid pattern matches nothing": {
+			selector: DocumentSelector{
+				&TextDocumentFilterPattern{Pattern: Pattern("*.{go")},
+				&TextDocumentFilterLanguage{Language: "*"},
+			},
+			uri:      "file:///w/a.go",
+			language: "go",
+			want:     SelectorWildcardMatch,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			u := uri.MustParse(tt.uri)
+			if got := MatchDocumentSelector(tt.selector, u, tt.language, tt.notebook); got != tt.want {
+				t.Errorf("MatchDocumentSelector() = %d, want %d", got, tt.want)
+			}
+			m, err := CompileDocumentSelector(tt.selector)
+			if err != nil {
+				return
+			}
+			if got := m.Score(u, tt.language, tt.notebook); got != tt.want {
+				t.Errorf("Score() = %d, want %d", got, tt.want)
+			}
+			if got := m.Match(u, tt.language, tt.notebook); got != (tt.want > SelectorNoMatch) {
+				t.Errorf("Match() = %t, want %t", got, tt.want > SelectorNoMatch)
+			}
+		})
+	}
+}
+
+func TestCompileDocumentSelectorError(t *testing.T) {
+	t.Parallel()
+
+	if _, err := CompileDocumentSelector(DocumentSelector{&TextDocumentFilterPattern{Pattern: Pattern("[a")}}); err == nil {
+		t.Error("CompileDocumentSelector() succeeded with an invalid pattern")
+	}
+	if _, err := CompileDocumentSelector(DocumentSelector{&NotebookCellTextDocumentFilter{}}); err == nil {
+		t.Error("CompileDocumentSelector() succeeded without a notebook filter")
+	}
+}
+
+func TestMatchNotebookDocumentFilter(t *testing.T) {
+	t.Parallel()
+
+	notebook := &NotebookDocument{URI: "untitled:Untitled-1.ipynb", NotebookType: "jupyter-notebook"}
+	scheme := "untitled"
+	tests := map[string]struct {
+		filter NotebookDocumentFilterNotebook
+		want   int
+	}{
+		"success: notebook type string":    {filter: String("jupyter-notebook"), want: SelectorExactMatch},
+		"success: wildcard type string":    {filter: String("*"), want: SelectorWildcardMatch},
+		"success: scheme filter":           {filter: &NotebookDocumentFilterScheme{Scheme: "untitled"}, want: SelectorExactMatch},
+		"success: type and scheme filter":  {filter: &NotebookDocumentFilterNotebookType{NotebookType: "*", Scheme: &scheme}, want: SelectorExactMatch},
+		"success: pattern filter mismatch": {filter: &NotebookDocumentFilterPattern{Pattern: Pattern("**/*.py")}, want: SelectorNoMatch},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := MatchNotebookDocumentFilter(tt.filter, notebook); got != tt.want {
+				t.Errorf("MatchNotebookDocumentFilter() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
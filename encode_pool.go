@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"slices"
+	"sync"
+)
+
+// encodeBufInitCap is the initial capacity of a pooled encode buffer, enough
+// for a typical hover or completion response without growing.
+const encodeBufInitCap = 4 << 10
+
+// encodeBufMaxCap bounds the capacity of buffers returned to the pool, so an
+// occasional huge workspace/symbol result does not stay pinned for the life of
+// the process.
+const encodeBufMaxCap = 1 << 20
+
+// encodeBufPool recycles the scratch buffers payloads and frames are encoded
+// into.
+var encodeBufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, encodeBufInitCap)
+		return &b
+	},
+}
+
+// getEncodeBuf returns an empty buffer from the pool.
+func getEncodeBuf() *[]byte {
+	bp := encodeBufPool.Get().(*[]byte)
+	*bp = (*bp)[:0]
+	return bp
+}
+
+// putEncodeBuf returns bp to the pool unless it has outgrown encodeBufMaxCap.
+func putEncodeBuf(bp *[]byte) {
+	if cap(*bp) > encodeBufMaxCap {
+		return
+	}
+	encodeBufPool.Put(bp)
+}
+
+// marshalPooled encodes v like [Marshal], growing the output in a pooled
+// scratch buffer so the result is allocated once at its final size.
+func marshalPooled(v any) ([]byte, error) {
+	bp := getEncodeBuf()
+	defer putEncodeBuf(bp)
+	b, err := AppendMarshal(*bp, v)
+	if err != nil {
+		return nil, err
+	}
+	*bp = b
+	return slices.Clone(b), nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build !lsp_proposed
+
+package protocol
+
+import (
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+func (x CodeAction) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	if err := enc.WriteToken(jsontext.String(`title`)); err != nil {
+		return err
+	}
+	if err := enc.WriteToken(jsontext.String(x.Title)); err != nil {
+		return err
+	}
+	if x.Kind != nil {
+		if err := enc.WriteToken(jsontext.String(`kind`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.Kind); err != nil {
+			return err
+		}
+	}
+	if len(x.Diagnostics) > 0 {
+		if err := enc.WriteToken(jsontext.String(`diagnostics`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.Diagnostics); err != nil {
+			return err
+		}
+	}
+	if x.IsPreferred != nil {
+		if err := enc.WriteToken(jsontext.String(`isPreferred`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.IsPreferred); err != nil {
+			return err
+		}
+	}
+	if !isZeroGeneratedCodeActionDisabled(x.Disabled) {
+		if err := enc.WriteToken(jsontext.String(`disabled`)); err != nil {
+			return err
+		}
+		if err := x.Disabled.MarshalJSONTo(enc); err != nil {
+			return err
+		}
+	}
+	if x.Edit != nil {
+		if err := enc.WriteToken(jsontext.String(`edit`)); err != nil {
+			return err
+		}
+		if err := x.Edit.MarshalJSONTo(enc); err != nil {
+			return err
+		}
+	}
+	if !isZeroCommand(x.Command) {
+		if err := enc.WriteToken(jsontext.String(`command`)); err != nil {
+			return err
+		}
+		if err := x.Command.MarshalJSONTo(enc); err != nil {
+			return err
+		}
+	}
+	if len(x.Data) > 0 {
+		if err := enc.WriteToken(jsontext.String(`data`)); err != nil {
+			return err
+		}
+		if err := enc.WriteValue(x.Data); err != nil {
+			return err
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
+
+func (x CodeActionClientCapabilities) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	if x.DynamicRegistration != nil {
+		if err := enc.WriteToken(jsontext.String(`dynamicRegistration`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.DynamicRegistration); err != nil {
+			return err
+		}
+	}
+	if !isZeroGeneratedClientCodeActionLiteralOptions(x.CodeActionLiteralSupport) {
+		if err := enc.WriteToken(jsontext.String(`codeActionLiteralSupport`)); err != nil {
+			return err
+		}
+		if err := x.CodeActionLiteralSupport.MarshalJSONTo(enc); err != nil {
+			return err
+		}
+	}
+	if x.IsPreferredSupport != nil {
+		if err := enc.WriteToken(jsontext.String(`isPreferredSupport`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.IsPreferredSupport); err != nil {
+			return err
+		}
+	}
+	if x.DisabledSupport != nil {
+		if err := enc.WriteToken(jsontext.String(`disabledSupport`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.DisabledSupport); err != nil {
+			return err
+		}
+	}
+	if x.DataSupport != nil {
+		if err := enc.WriteToken(jsontext.String(`dataSupport`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.DataSupport); err != nil {
+			return err
+		}
+	}
+	if !isZeroGeneratedClientCodeActionResolveOptions(x.ResolveSupport) {
+		if err := enc.WriteToken(jsontext.String(`resolveSupport`)); err != nil {
+			return err
+		}
+		if err := x.ResolveSupport.MarshalJSONTo(enc); err != nil {
+			return err
+		}
+	}
+	if x.HonorsChangeAnnotations != nil {
+		if err := enc.WriteToken(jsontext.String(`honorsChangeAnnotations`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.HonorsChangeAnnotations); err != nil {
+			return err
+		}
+	}
+	if x.DocumentationSupport != nil {
+		if err := enc.WriteToken(jsontext.String(`documentationSupport`)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, x.DocumentationSupport); err != nil {
+			return err
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
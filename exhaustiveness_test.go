@@ -11,13 +11,13 @@ import (
 )
 
 // TestEveryMethodRouted asserts that every LSP method constant declared in the
-// generated registry (metamodel_messages.gen.go) is handled by a dispatch case in
-// the hand-written transport (server.go or client.go), except $/cancelRequest
-// which is special-cased in CancelHandler.
+// generated registry (metamodel_messages.gen.go) is handled by a case of the
+// generated serverDispatch or clientDispatch (dispatch.gen.go), except
+// $/cancelRequest which is special-cased in CancelHandler.
 //
-// This locks the registry<->dispatch invariant: when the meta-model adds a
-// method and the generated registry grows, this test fails until the hand-port
-// adds a matching dispatch case, so a spec method cannot be silently unrouted.
+// This locks the registry<->dispatch invariant: both files are rendered from
+// the same meta-model, so a method that the generator's dispatch table drops
+// fails here instead of being silently unrouted.
 func TestEveryMethodRouted(t *testing.T) {
 	t.Parallel()
 
@@ -33,15 +33,16 @@ func TestEveryMethodRouted(t *testing.T) {
 		t.Fatal("parsed zero method constants from metamodel_messages.gen.go")
 	}
 
-	var dispatch strings.Builder
-	for _, f := range []string{"server.go", "client.go", "handler.go"} {
-		b, err := os.ReadFile(f)
-		if err != nil {
-			t.Fatalf("read %s: %v", f, err)
-		}
-		dispatch.Write(b)
+	b, err := os.ReadFile("dispatch.gen.go")
+	if err != nil {
+		t.Fatalf("read dispatch: %v", err)
+	}
+	// Only the dispatch switches count; UnmarshalParams/UnmarshalResult name
+	// every method regardless of routing.
+	_, src, ok := strings.Cut(string(b), "func serverDispatch(")
+	if !ok {
+		t.Fatal("dispatch.gen.go does not declare serverDispatch")
 	}
-	src := dispatch.String()
 
 	// $/cancelRequest is intercepted by CancelHandler before dispatch.
 	const cancelSpecialCase = "MethodCancelRequest"
@@ -53,7 +54,7 @@ func TestEveryMethodRouted(t *testing.T) {
 			continue
 		}
 		if !strings.Contains(src, "case "+name+":") {
-			t.Errorf("method constant %s has no dispatch case in server.go/client.go", name)
+			t.Errorf("method constant %s has no case in serverDispatch/clientDispatch", name)
 			continue
 		}
 		routed++
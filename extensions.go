@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// Extensions routes non-standard methods, such as a language server's own
+// "rust-analyzer/expandMacro" or a client's custom notifications, to typed
+// handlers registered with [HandleRequest] and [HandleNotification]. Params
+// are decoded with [Unmarshal] and results encoded by the connection's codec,
+// so an extension handler looks like a generated [Server] or [Client] method.
+//
+// Wire the registry into a connection with [WithExtensions], or wrap a handler
+// chain with [Extensions.Handler]. It works the same on either side of the
+// connection. The zero value is ready to use, and handlers may be registered
+// while the connection is serving.
+type Extensions struct {
+	mu       sync.RWMutex
+	handlers map[string]extensionHandler
+}
+
+// extensionHandler decodes raw params and invokes a registered handler.
+type extensionHandler func(ctx context.Context, params jsonrpc2.RawMessage) (any, error)
+
+// HandleRequest registers fn as the handler for requests to method. The
+// request params are decoded into a new P; a call without params passes the
+// zero P. The result is sent as the response.
+//
+// It panics if method is empty, is a standard LSP method, or already has a
+// handler.
+func HandleRequest[P, R any](x *Extensions, method string, fn func(ctx context.Context, params *P) (R, error)) {
+	x.register(method, func(ctx context.Context, raw jsonrpc2.RawMessage) (any, error) {
+		params, err := decodeExtensionParams[P](raw)
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(ctx, params)
+	})
+}
+
+// HandleNotification registers fn as the handler for notifications of method.
+// Params are decoded as by [HandleRequest]. If a peer sends method as a
+// request, fn still runs and the response carries a null result.
+//
+// It panics under the same conditions as [HandleRequest].
+func HandleNotification[P any](x *Extensions, method string, fn func(ctx context.Context, params *P) error) {
+	x.register(method, func(ctx context.Context, raw jsonrpc2.RawMessage) (any, error) {
+		params, err := decodeExtensionParams[P](raw)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fn(ctx, params)
+	})
+}
+
+func (x *Extensions) register(method string, h extensionHandler) {
+	if method == "" {
+		panic("protocol: extension method is empty")
+	}
+	if _, ok := methodIndex()[method]; ok {
+		panic(fmt.Sprintf("protocol: %s is a standard LSP method", method))
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if _, ok := x.handlers[method]; ok {
+		panic(fmt.Sprintf("protocol: multiple registrations for %s", method))
+	}
+	if x.handlers == nil {
+		x.handlers = make(map[string]extensionHandler)
+	}
+	x.handlers[method] = h
+}
+
+// Handler returns a [jsonrpc2.Handler] that serves the registered extension
+// methods and passes every other request to next.
+func (x *Extensions) Handler(next jsonrpc2.Handler) jsonrpc2.Handler {
+	return func(ctx context.Context, req *jsonrpc2.Request) (any, error) {
+		x.mu.RLock()
+		h, ok := x.handlers[req.Method()]
+		x.mu.RUnlock()
+		if !ok {
+			return next(ctx, req)
+		}
+		if ctx.Err() != nil {
+			return nil, ErrRequestCancelled
+		}
+
+		return h(ctx, req.Params())
+	}
+}
+
+// decodeExtensionParams decodes raw into a new P. Unmarshal copies what it
+// keeps, so the result does not borrow the transport frame.
+func decodeExtensionParams[P any](raw jsonrpc2.RawMessage) (*P, error) {
+	params := new(P)
+	if len(raw) == 0 {
+		return params, nil
+	}
+	if err := Unmarshal(raw, params); err != nil {
+		return nil, replyParseError(err)
+	}
+
+	return params, nil
+}
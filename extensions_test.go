@@ -0,0 +1,124 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+type expandMacroParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+type expandedMacro struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+func TestExtensionsOverConnection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	var serverExt, clientExt Extensions
+	HandleRequest(&serverExt, "rust-analyzer/expandMacro", func(_ context.Context, p *expandMacroParams) (*expandedMacro, error) {
+		return &expandedMacro{Name: string(p.TextDocument.URI), Expansion: "println!"}, nil
+	})
+	pinged := make(chan int, 1)
+	HandleNotification(&serverExt, "custom/ping", func(_ context.Context, p *struct {
+		Seq int `json:"seq"`
+	},
+	) error {
+		pinged <- p.Seq
+		return nil
+	})
+	HandleRequest(&clientExt, "custom/status", func(context.Context, *struct{}) (string, error) {
+		return "idle", nil
+	})
+
+	a, b := net.Pipe()
+	_, serverConn, _ := NewServer(ctx, &UnimplementedServer{}, NewStream(a), WithExtensions(&serverExt))
+	defer func() { _ = serverConn.Close() }()
+	_, clientConn, _ := NewClient(ctx, &UnimplementedClient{}, NewStream(b), WithExtensions(&clientExt))
+	defer func() { _ = clientConn.Close() }()
+
+	params := &expandMacroParams{TextDocument: TextDocumentIdentifier{URI: "file:///lib.rs"}, Position: Position{Line: 3}}
+	var expanded expandedMacro
+	if _, err := clientConn.Call(ctx, "rust-analyzer/expandMacro", params, &expanded); err != nil {
+		t.Fatalf("Call(expandMacro): %v", err)
+	}
+	if want := (expandedMacro{Name: "file:///lib.rs", Expansion: "println!"}); expanded != want {
+		t.Fatalf("expandMacro result = %+v, want %+v", expanded, want)
+	}
+
+	if err := clientConn.Notify(ctx, "custom/ping", map[string]int{"seq": 7}); err != nil {
+		t.Fatalf("Notify(ping): %v", err)
+	}
+	select {
+	case seq := <-pinged:
+		if seq != 7 {
+			t.Fatalf("ping seq = %d, want 7", seq)
+		}
+	case <-ctx.Done():
+		t.Fatalf("ping was not handled: %v", ctx.Err())
+	}
+
+	var status string
+	if _, err := serverConn.Call(ctx, "custom/status", nil, &status); err != nil {
+		t.Fatalf("Call(status): %v", err)
+	}
+	if status != "idle" {
+		t.Fatalf("status = %q, want %q", status, "idle")
+	}
+
+	_, err := clientConn.Call(ctx, "rust-analyzer/expandMacro", jsonrpc2.RawMessage(`{"position":"bad"}`), nil)
+	if !errors.Is(err, jsonrpc2.ErrParse) {
+		t.Fatalf("Call(malformed params) error = %v, want wrapping %v", err, jsonrpc2.ErrParse)
+	}
+
+	// Unregistered methods still fall through to Server.Request.
+	_, err = clientConn.Call(ctx, "custom/unregistered", map[string]int{}, nil)
+	if !errors.Is(err, jsonrpc2.ErrMethodNotFound) {
+		t.Fatalf("Call(unregistered) error = %v, want wrapping %v", err, jsonrpc2.ErrMethodNotFound)
+	}
+}
+
+func TestExtensionsRegisterPanics(t *testing.T) {
+	t.Parallel()
+
+	noop := func(context.Context, *struct{}) error { return nil }
+	tests := map[string]struct {
+		method string
+		before func(x *Extensions)
+	}{
+		"error: empty method":    {method: ""},
+		"error: standard method": {method: MethodTextDocumentHover},
+		"error: duplicate registration": {
+			method: "custom/ping",
+			before: func(x *Extensions) { HandleNotification(x, "custom/ping", noop) },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var x Extensions
+			if tt.before != nil {
+				tt.before(&x)
+			}
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("HandleNotification(%q) did not panic", tt.method)
+				}
+			}()
+			HandleNotification(&x, tt.method, noop)
+		})
+	}
+}
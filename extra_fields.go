@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// Types named by genlsp's -extra-fields flag, none by default, carry an Extra
+// LSPObject holding the JSON members they do not declare. The helpers below
+// fill it from the byte walkers and write it back from both encoders, always
+// after the declared members and in key order, so output is deterministic and
+// the append and streaming encoders stay byte-identical. An Extra key that
+// names a declared member would duplicate it, so both encoders refuse it.
+
+// dvExtraMember consumes the value of the undeclared member key at raw[i:]
+// and records it in extra. The value aliases raw, like every raw value the
+// byte walkers decode; a later member with the same name replaces it.
+func dvExtraMember(raw, key []byte, i int, extra *LSPObject) (int, error) {
+	val, n, err := dvValue(raw, i)
+	if err != nil {
+		return n, err
+	}
+	name := string(key)
+	if bytes.IndexByte(key, '\\') >= 0 {
+		unquoted, err := jsontext.AppendUnquote(nil, append(append([]byte{'"'}, key...), '"'))
+		if err != nil {
+			return i, err
+		}
+		name = string(unquoted)
+	}
+	if *extra == nil {
+		*extra = LSPObject{}
+	}
+	(*extra)[name] = jsontext.Value(val)
+	return n, nil
+}
+
+// extraMemberNames returns the keys of extra in the order they are encoded,
+// or an error if one names a member of the object shape declared. A nil
+// declared declares no members.
+func extraMemberNames(extra LSPObject, declared *shape) ([]string, error) {
+	var fields []shapeField
+	if declared != nil {
+		fields = declared.fields
+	}
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		for _, f := range fields {
+			if f.name == name {
+				return nil, fmt.Errorf("protocol: %s.Extra holds declared member %q", declared.name, name)
+			}
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// encodeExtraMembersTo writes the members of extra to enc, which must be
+// inside an object of the shape declared.
+func encodeExtraMembersTo(enc *jsontext.Encoder, extra LSPObject, declared *shape) error {
+	if len(extra) == 0 {
+		return nil
+	}
+	names, err := extraMemberNames(extra, declared)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := enc.WriteToken(jsontext.String(name)); err != nil {
+			return err
+		}
+		v := extra[name]
+		if v == nil {
+			v = jsontext.Value(nullLiteral)
+		}
+		if err := enc.WriteValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendExtraMembers appends the members of extra to the object of the shape
+// declared being written to dst, with first tracking the member separator as
+// for appendObjectName.
+func appendExtraMembers(dst []byte, first *bool, extra LSPObject, declared *shape) ([]byte, error) {
+	if len(extra) == 0 {
+		return dst, nil
+	}
+	names, err := extraMemberNames(extra, declared)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if *first {
+			*first = false
+		} else {
+			dst = append(dst, ',')
+		}
+		dst = appendJSONString(dst, name)
+		dst = append(dst, ':')
+		if dst, err = appendRawJSONValue(dst, extra[name]); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-json-experiment/json/jsontext"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+// No generated type carries an Extra field by default, so these tests drive
+// the helpers the generated walkers and encoders call for one that does.
+
+func TestDvExtraMember(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		members [][2]string // raw key and raw value, in input order
+		want    LSPObject
+	}{
+		"success: values are kept raw": {
+			members: [][2]string{{"vendor", `{"rank":[1,2]}`}, {"future", `true`}},
+			want:    LSPObject{"future": jsontext.Value(`true`), "vendor": jsontext.Value(`{"rank":[1,2]}`)},
+		},
+		"success: escaped member names are unescaped": {
+			members: [][2]string{{`x-sort`, `"1"`}},
+			want:    LSPObject{"x-sort": jsontext.Value(`"1"`)},
+		},
+		"success: a later member with the same name wins": {
+			members: [][2]string{{"x", `1`}, {"x", `2`}},
+			want:    LSPObject{"x": jsontext.Value(`2`)},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var extra LSPObject
+			for _, m := range tt.members {
+				raw := []byte(" " + m[1] + ",")
+				n, err := dvExtraMember(raw, []byte(m[0]), 1, &extra)
+				if err != nil {
+					t.Fatalf("dvExtraMember(%s) error = %v", m[0], err)
+				}
+				if want := 1 + len(m[1]); n != want {
+					t.Errorf("dvExtraMember(%s) = %d, want %d", m[0], n, want)
+				}
+			}
+			if diff := gocmp.Diff(tt.want, extra); diff != "" {
+				t.Errorf("Extra mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtraMembers(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		declared *shape
+		extra    LSPObject
+		want     string
+		wantErr  bool
+	}{
+		"success: members follow the declared ones in key order": {
+			declared: shapeCompletionItem,
+			extra:    LSPObject{"vendor": jsontext.Value(`{"rank":[1,2]}`), "future": jsontext.Value(`true`)},
+			want:     `{"label":"a","future":true,"vendor":{"rank":[1,2]}}`,
+		},
+		"success: nil values are written as null": {
+			declared: shapeCompletionItem,
+			extra:    LSPObject{"z": nil, "y": jsontext.Value(`"q"`)},
+			want:     `{"label":"a","y":"q","z":null}`,
+		},
+		"success: no members": {
+			declared: shapeCompletionItem,
+			want:     `{"label":"a"}`,
+		},
+		"error: a declared member would be duplicated": {
+			declared: shapeCompletionItem,
+			extra:    LSPObject{"label": jsontext.Value(`"dup"`)},
+			wantErr:  true,
+		},
+		"error: an inherited member would be duplicated": {
+			declared: shapeCallHierarchyIncomingCallsParams,
+			extra:    LSPObject{"workDoneToken": jsontext.Value(`1`)},
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first := false
+			appended, appendErr := appendExtraMembers([]byte(`{"label":"a"`), &first, tt.extra, tt.declared)
+
+			var buf bytes.Buffer
+			enc := jsontext.NewEncoder(&buf, wireOptions)
+			streamErr := enc.WriteToken(jsontext.BeginObject)
+			for _, tok := range []jsontext.Token{jsontext.String("label"), jsontext.String("a")} {
+				if streamErr == nil {
+					streamErr = enc.WriteToken(tok)
+				}
+			}
+			if streamErr == nil {
+				streamErr = encodeExtraMembersTo(enc, tt.extra, tt.declared)
+			}
+			if streamErr == nil {
+				streamErr = enc.WriteToken(jsontext.EndObject)
+			}
+
+			if tt.wantErr {
+				if appendErr == nil {
+					t.Errorf("appendExtraMembers() = %s, want error", appended)
+				}
+				if streamErr == nil {
+					t.Errorf("encodeExtraMembersTo() = %s, want error", buf.Bytes())
+				}
+				return
+			}
+			if appendErr != nil || streamErr != nil {
+				t.Fatalf("appendExtraMembers() error = %v, encodeExtraMembersTo() error = %v", appendErr, streamErr)
+			}
+			if got := string(append(appended, '}')); got != tt.want {
+				t.Errorf("appendExtraMembers() = %s, want %s", got, tt.want)
+			}
+			if got := string(bytes.TrimSpace(buf.Bytes())); got != tt.want {
+				t.Errorf("encodeExtraMembersTo() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"io"
+	"sync"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// FrameWriter writes JSON-RPC messages with LSP payloads to an [io.Writer] in
+// the Content-Length framing of [jsonrpc2.NewStream]. Each message is encoded
+// with the generated encoders straight into a pooled buffer, framed there, and
+// handed to the writer in one Write, so steady-state writes do not allocate.
+// It suits servers and proxies that answer on a raw stream without a
+// [jsonrpc2.Conn].
+//
+// A FrameWriter is safe for concurrent use; writes are serialized so frames
+// never interleave.
+type FrameWriter struct {
+	mu    sync.Mutex
+	w     io.Writer
+	codec lspCodec
+}
+
+// NewFrameWriter returns a FrameWriter writing to w. Of the connection
+// options, only [WithVersion] applies: it pins the writer to a protocol
+// version as it does a connection, encoding payloads with [MarshalVersion]
+// (which allocates) and refusing calls and notifications of newer methods.
+func NewFrameWriter(w io.Writer, opts ...ConnOption) *FrameWriter {
+	var o connOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &FrameWriter{w: w, codec: o.codec}
+}
+
+// WriteCall writes a call of method with params and returns the number of
+// bytes written. Nil or null params are omitted, as by [jsonrpc2.Conn].
+func (fw *FrameWriter) WriteCall(id jsonrpc2.ID, method string, params any) (int64, error) {
+	if err := fw.codec.checkMethod(method); err != nil {
+		return 0, err
+	}
+	return fw.write(params, true, func(dst []byte, payload jsonrpc2.RawMessage) []byte {
+		return jsonrpc2.AppendCall(dst, id, method, payload)
+	})
+}
+
+// WriteNotification writes a notification of method with params and returns
+// the number of bytes written.
+func (fw *FrameWriter) WriteNotification(method string, params any) (int64, error) {
+	if err := fw.codec.checkMethod(method); err != nil {
+		return 0, err
+	}
+	return fw.write(params, true, func(dst []byte, payload jsonrpc2.RawMessage) []byte {
+		return jsonrpc2.AppendNotification(dst, method, payload)
+	})
+}
+
+// WriteResponse writes the response to call id and returns the number of
+// bytes written. A non-nil err is sent as the error member and result is
+// ignored.
+func (fw *FrameWriter) WriteResponse(id jsonrpc2.ID, result any, err error) (int64, error) {
+	if err != nil {
+		result = nil
+	}
+	return fw.write(result, false, func(dst []byte, payload jsonrpc2.RawMessage) []byte {
+		return jsonrpc2.AppendResponse(dst, id, payload, err)
+	})
+}
+
+// frameHeaderReserve is the room left ahead of a message body for its header.
+const frameHeaderReserve = len("Content-Length: ") + 20 + len("\r\n\r\n")
+
+// frameHeaderPad fills the header reserve before the header is known.
+var frameHeaderPad [frameHeaderReserve]byte
+
+// write encodes v, lets appendEnvelope wrap it in its JSON-RPC envelope, and
+// writes the framed message. omitNull drops a nil or null payload, the params
+// convention.
+func (fw *FrameWriter) write(v any, omitNull bool, appendEnvelope func(dst []byte, payload jsonrpc2.RawMessage) []byte) (int64, error) {
+	pp := getEncodeBuf()
+	defer putEncodeBuf(pp)
+	var payload jsonrpc2.RawMessage
+	if v != nil {
+		b, err := fw.codec.appendPayload(*pp, v)
+		if err != nil {
+			return 0, err
+		}
+		*pp = b
+		if !omitNull || string(b) != nullLiteral {
+			payload = b
+		}
+	}
+
+	// The body is encoded after the reserve, and the header is then written
+	// right-aligned into it, so the frame is one contiguous slice.
+	fp := getEncodeBuf()
+	defer putEncodeBuf(fp)
+	buf := appendEnvelope(append((*fp)[:0], frameHeaderPad[:]...), payload)
+	*fp = buf
+	frame := frameInPlace(buf)
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	n, err := fw.w.Write(frame)
+	return int64(n), err
+}
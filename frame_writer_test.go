@@ -0,0 +1,140 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// bufferRWC is an in-memory [io.ReadWriteCloser] for framing comparisons.
+type bufferRWC struct{ bytes.Buffer }
+
+func (*bufferRWC) Close() error { return nil }
+
+func TestFrameWriter(t *testing.T) {
+	t.Parallel()
+
+	hover := &Hover{Contents: &MarkupContent{Kind: MarkupKindMarkdown, Value: "doc"}}
+	hoverJSON, err := Marshal(hover)
+	if err != nil {
+		t.Fatal(err)
+	}
+	respErr := jsonrpc2.NewError(jsonrpc2.InvalidParams, "bad")
+
+	tests := map[string]struct {
+		write func(fw *FrameWriter) (int64, error)
+		want  jsonrpc2.Message
+	}{
+		"success: call": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteCall(jsonrpc2.NewNumberID(1), MethodTextDocumentHover, &HoverParams{})
+			},
+			want: jsonrpc2.NewCall(jsonrpc2.NewNumberID(1), MethodTextDocumentHover, mustMarshal(t, &HoverParams{})),
+		},
+		"success: call without params": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteCall(jsonrpc2.NewStringID("s"), MethodShutdown, nil)
+			},
+			want: jsonrpc2.NewCall(jsonrpc2.NewStringID("s"), MethodShutdown, nil),
+		},
+		"success: notification": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteNotification(MethodExit, jsonrpc2.RawMessage("null"))
+			},
+			want: jsonrpc2.NewNotification(MethodExit, nil),
+		},
+		"success: response": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteResponse(jsonrpc2.NewNumberID(7), hover, nil)
+			},
+			want: jsonrpc2.NewResponse(jsonrpc2.NewNumberID(7), hoverJSON, nil),
+		},
+		"success: null response": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteResponse(jsonrpc2.NewNumberID(7), nil, nil)
+			},
+			want: jsonrpc2.NewResponse(jsonrpc2.NewNumberID(7), nil, nil),
+		},
+		"success: error response": {
+			write: func(fw *FrameWriter) (int64, error) {
+				return fw.WriteResponse(jsonrpc2.NewNumberID(7), hover, respErr)
+			},
+			want: jsonrpc2.NewResponse(jsonrpc2.NewNumberID(7), nil, respErr),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got, want bufferRWC
+			n, err := tt.write(NewFrameWriter(&got))
+			if err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			if n != int64(got.Len()) {
+				t.Errorf("write returned %d, wrote %d bytes", n, got.Len())
+			}
+			if _, err := jsonrpc2.NewStream(&want).Write(t.Context(), tt.want); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != want.String() {
+				t.Errorf("frame = %q\nwant    %q", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestFrameWriterMarshalError(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	_, err := NewFrameWriter(&out).WriteResponse(jsonrpc2.NewNumberID(1), make(chan int), nil)
+	if err == nil {
+		t.Fatal("WriteResponse() succeeded for an unencodable result")
+	}
+	if out.Len() != 0 {
+		t.Errorf("WriteResponse() wrote %q after failing", out.String())
+	}
+}
+
+func TestFrameWriterVersion(t *testing.T) {
+	t.Parallel()
+
+	var got, want bufferRWC
+	fw := NewFrameWriter(&got, WithVersion(Version316, VersionStrip))
+	item := &CompletionItem{Label: "fmt", TextEditText: NewOptional("fmt")}
+	if _, err := fw.WriteResponse(jsonrpc2.NewNumberID(1), item, nil); err != nil {
+		t.Fatalf("WriteResponse: %v", err)
+	}
+	resp := jsonrpc2.NewResponse(jsonrpc2.NewNumberID(1), jsonrpc2.RawMessage(`{"label":"fmt"}`), nil)
+	if _, err := jsonrpc2.NewStream(&want).Write(t.Context(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != want.String() {
+		t.Errorf("frame = %q\nwant    %q", got.String(), want.String())
+	}
+
+	got.Reset()
+	_, err := fw.WriteCall(jsonrpc2.NewNumberID(2), MethodTextDocumentInlayHint, &InlayHintParams{})
+	if !errors.Is(err, ErrVersionUnsupported) {
+		t.Errorf("WriteCall(inlayHint) error = %v, want wrapping %v", err, ErrVersionUnsupported)
+	}
+	if got.Len() != 0 {
+		t.Errorf("WriteCall(inlayHint) wrote %q after failing", got.String())
+	}
+}
+
+func mustMarshal(t *testing.T, v any) jsonrpc2.RawMessage {
+	t.Helper()
+
+	b, err := Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
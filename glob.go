@@ -0,0 +1,338 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.lsp.dev/uri"
+)
+
+// Glob is a compiled glob pattern in the dialect the specification gives for
+// [Pattern] and [FileOperationPattern.Glob]:
+//
+//   - `*` matches zero or more characters in a path segment
+//   - `?` matches one character in a path segment
+//   - `**` as a whole segment matches any number of path segments, including
+//     none; inside a segment it behaves like `*`
+//   - `{a,b}` matches any of the comma-separated sub-patterns, which may nest
+//     and may span segments
+//   - `[...]` matches one character of a set or range in a path segment, and
+//     `[!...]` (or `[^...]`) one character outside it
+//
+// Every other character, including `\`, matches itself. Paths use `/` as the
+// separator, as URI paths do. A Glob is safe for concurrent use.
+type Glob struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// CompileGlob compiles pattern, matching letters without regard to case when
+// ignoreCase is set. It reports an unterminated `{` or `[`, a `/` inside
+// `[...]`, and an invalid character range.
+func CompileGlob(pattern string, ignoreCase bool) (*Glob, error) {
+	expr, err := globRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("protocol: glob %q: %w", pattern, err)
+	}
+	if ignoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("protocol: glob %q: %w", pattern, err)
+	}
+	return &Glob{pattern: pattern, re: re}, nil
+}
+
+// Match reports whether path matches the whole pattern.
+func (g *Glob) Match(path string) bool {
+	return g.re.MatchString(path)
+}
+
+// String returns the pattern g was compiled from.
+func (g *Glob) String() string {
+	return g.pattern
+}
+
+// globRegexp translates an LSP glob into an anchored regular expression.
+func globRegexp(p string) (string, error) {
+	var b strings.Builder
+	b.WriteByte('^')
+	depth := 0
+	// patternEnd reports whether the pattern or the current group
+	// alternative ends at j; segmentEnd whether a path segment does.
+	patternEnd := func(j int) bool {
+		return j == len(p) || depth > 0 && (p[j] == ',' || p[j] == '}')
+	}
+	segmentEnd := func(j int) bool {
+		return patternEnd(j) || p[j] == '/'
+	}
+	for i := 0; i < len(p); {
+		switch c := p[i]; c {
+		case '*':
+			j := i + 1
+			for j < len(p) && p[j] == '*' {
+				j++
+			}
+			segmentStart := i == 0 || p[i-1] == '/' || depth > 0 && (p[i-1] == '{' || p[i-1] == ',')
+			switch {
+			case j-i < 2 || !segmentStart || !segmentEnd(j):
+				b.WriteString(`[^/]*`)
+			case j < len(p) && p[j] == '/':
+				// "**/" matches any run of leading segments, including none.
+				b.WriteString(`(?:.*/)?`)
+				j++
+			default:
+				b.WriteString(`.*`)
+			}
+			i = j
+		case '/':
+			if j := i + 3; strings.HasPrefix(p[i:], "/**") && patternEnd(j) {
+				// A trailing "/**" also matches the directory itself.
+				b.WriteString(`(?:/.*)?`)
+				i = j
+				continue
+			}
+			b.WriteByte('/')
+			i++
+		case '?':
+			b.WriteString(`[^/]`)
+			i++
+		case '[':
+			n, err := appendGlobClass(&b, p, i)
+			if err != nil {
+				return "", err
+			}
+			i = n
+		case '{':
+			depth++
+			b.WriteString(`(?:`)
+			i++
+		case ',', '}':
+			switch {
+			case depth == 0:
+				b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+			case c == ',':
+				b.WriteByte('|')
+			default:
+				depth--
+				b.WriteByte(')')
+			}
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+			i++
+		}
+	}
+	if depth > 0 {
+		return "", errors.New("unterminated {")
+	}
+	b.WriteByte('$')
+	return b.String(), nil
+}
+
+// appendGlobClass translates the character class starting at p[i] and
+// returns the index after it. A `]` right after the opening bracket (and
+// its negation) is a member rather than the end of the class.
+func appendGlobClass(b *strings.Builder, p string, i int) (int, error) {
+	j := i + 1
+	negate := j < len(p) && (p[j] == '!' || p[j] == '^')
+	if negate {
+		j++
+	}
+	start := j
+	if j < len(p) && p[j] == ']' {
+		j++
+	}
+	for j < len(p) && p[j] != ']' {
+		j++
+	}
+	if j == len(p) {
+		return 0, fmt.Errorf("unterminated [ at offset %d", i)
+	}
+	set := p[start:j]
+	if strings.IndexByte(set, '/') >= 0 {
+		return 0, fmt.Errorf("/ in character class at offset %d", i)
+	}
+	b.WriteByte('[')
+	if negate {
+		b.WriteString(`^/`)
+	}
+	for k := range len(set) {
+		if c := set[k]; c == '\\' || c == '[' || c == ']' || c == '^' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(set[k])
+	}
+	b.WriteByte(']')
+	return j + 1, nil
+}
+
+// GlobMatcher matches URIs against a compiled [GlobPattern]. A [Pattern] is
+// matched against the whole decoded path of the URI; a [RelativePattern]
+// against the part of the path below its base URI, for URIs with the base's
+// scheme and authority only.
+type GlobMatcher struct {
+	glob     *Glob
+	relative bool
+	base     uri.Components
+}
+
+// CompileGlobPattern compiles p, matching case-sensitively.
+func CompileGlobPattern(p GlobPattern) (*GlobMatcher, error) {
+	switch p := p.(type) {
+	case Pattern:
+		g, err := CompileGlob(string(p), false)
+		if err != nil {
+			return nil, err
+		}
+		return &GlobMatcher{glob: g}, nil
+	case *RelativePattern:
+		var base uri.URI
+		switch b := p.BaseURI.(type) {
+		case *WorkspaceFolder:
+			base = b.URI
+		case URI:
+			base = uri.URI(b)
+		default:
+			return nil, fmt.Errorf("protocol: relative pattern %q has no base URI", p.Pattern)
+		}
+		g, err := CompileGlob(string(p.Pattern), false)
+		if err != nil {
+			return nil, err
+		}
+		m := &GlobMatcher{glob: g, relative: true, base: base.Components()}
+		m.base.Path = strings.TrimSuffix(m.base.Path, "/")
+		return m, nil
+	default:
+		return nil, fmt.Errorf("protocol: unsupported glob pattern %T", p)
+	}
+}
+
+// MatchURI reports whether u matches the pattern.
+func (m *GlobMatcher) MatchURI(u uri.URI) bool {
+	if !m.relative {
+		return m.glob.Match(u.Path())
+	}
+	c := u.Components()
+	if c.Scheme != m.base.Scheme || c.Authority != m.base.Authority {
+		return false
+	}
+	rel, ok := strings.CutPrefix(c.Path, m.base.Path+"/")
+	return ok && m.glob.Match(rel)
+}
+
+// WatchedFilesFilter selects the [FileEvent] values of a
+// workspace/didChangeWatchedFiles notification that any of a set of
+// [FileSystemWatcher] registrations asked for, by pattern and [WatchKind].
+type WatchedFilesFilter struct {
+	watchers []compiledWatcher
+}
+
+type compiledWatcher struct {
+	matcher *GlobMatcher
+	kind    WatchKind
+}
+
+// CompileFileSystemWatchers compiles the patterns of watchers.
+func CompileFileSystemWatchers(watchers []FileSystemWatcher) (*WatchedFilesFilter, error) {
+	f := &WatchedFilesFilter{watchers: make([]compiledWatcher, 0, len(watchers))}
+	for _, w := range watchers {
+		m, err := CompileGlobPattern(w.GlobPattern)
+		if err != nil {
+			return nil, err
+		}
+		kind := w.Kind
+		if kind == 0 {
+			kind = WatchKindCreate | WatchKindChange | WatchKindDelete
+		}
+		f.watchers = append(f.watchers, compiledWatcher{matcher: m, kind: kind})
+	}
+	return f, nil
+}
+
+// Match reports whether a watcher covers ev.
+func (f *WatchedFilesFilter) Match(ev FileEvent) bool {
+	var kind WatchKind
+	switch ev.Type {
+	case FileChangeTypeCreated:
+		kind = WatchKindCreate
+	case FileChangeTypeChanged:
+		kind = WatchKindChange
+	case FileChangeTypeDeleted:
+		kind = WatchKindDelete
+	}
+	for _, w := range f.watchers {
+		if w.kind&kind != 0 && w.matcher.MatchURI(ev.URI) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the events a watcher covers, reusing the backing array of
+// events.
+func (f *WatchedFilesFilter) Filter(events []FileEvent) []FileEvent {
+	out := events[:0]
+	for _, ev := range events {
+		if f.Match(ev) {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
+
+// FileOperationMatcher decides whether a file operation on a URI concerns a
+// set of [FileOperationFilter] values, as registered in
+// [FileOperationRegistrationOptions]: the URI must have the filter's scheme,
+// if any, be a file or folder as its pattern requires, and match its glob,
+// which is matched against the decoded URI path.
+type FileOperationMatcher struct {
+	filters []compiledFileOperationFilter
+}
+
+type compiledFileOperationFilter struct {
+	scheme  *string
+	matches FileOperationPatternKind
+	glob    *Glob
+}
+
+// CompileFileOperationFilters compiles the patterns of filters.
+func CompileFileOperationFilters(filters []FileOperationFilter) (*FileOperationMatcher, error) {
+	m := &FileOperationMatcher{filters: make([]compiledFileOperationFilter, 0, len(filters))}
+	for _, f := range filters {
+		ignoreCase := f.Pattern.Options != nil && f.Pattern.Options.IgnoreCase != nil && *f.Pattern.Options.IgnoreCase
+		g, err := CompileGlob(f.Pattern.Glob, ignoreCase)
+		if err != nil {
+			return nil, err
+		}
+		m.filters = append(m.filters, compiledFileOperationFilter{scheme: f.Scheme, matches: f.Pattern.Matches, glob: g})
+	}
+	return m, nil
+}
+
+// Match reports whether an operation on u, a folder when isFolder is set,
+// concerns any of the filters.
+func (m *FileOperationMatcher) Match(u uri.URI, isFolder bool) bool {
+	var path string
+	for _, f := range m.filters {
+		if f.scheme != nil && *f.scheme != u.Scheme() {
+			continue
+		}
+		if f.matches == FileOperationPatternKindFile && isFolder || f.matches == FileOperationPatternKindFolder && !isFolder {
+			continue
+		}
+		if path == "" {
+			path = u.Path()
+		}
+		if f.glob.Match(path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+
+	"go.lsp.dev/uri"
+)
+
+func TestGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern    string
+		ignoreCase bool
+		match      []string
+		noMatch    []string
+	}{
+		"success: star stays within a segment": {
+			pattern: "*.go",
+			match:   []string{"a.go", ".go", "a.b.go"},
+			noMatch: []string{"a/b.go", "a.gox", "a.GO"},
+		},
+		"success: question mark matches one character": {
+			pattern: "a?c",
+			match:   []string{"abc", "a.c"},
+			noMatch: []string{"ac", "abbc", "a/c"},
+		},
+		"success: leading globstar matches any depth, including none": {
+			pattern: "**/*.ts",
+			match:   []string{"a.ts", "src/a.ts", "/abs/src/x/a.ts"},
+			noMatch: []string{"a.tsx", "src/a.js"},
+		},
+		"success: middle globstar": {
+			pattern: "src/**/test/*.go",
+			match:   []string{"src/test/a.go", "src/x/y/test/a.go"},
+			noMatch: []string{"src/test/x/a.go", "srcx/test/a.go"},
+		},
+		"success: trailing globstar includes the directory": {
+			pattern: "node_modules/**",
+			match:   []string{"node_modules", "node_modules/", "node_modules/a/b.js"},
+			noMatch: []string{"node_modulesx", "x/node_modules/a"},
+		},
+		"success: lone globstar matches everything": {
+			pattern: "**",
+			match:   []string{"", "a", "/a/b/c"},
+		},
+		"success: globstar inside a segment is a star": {
+			pattern: "a**b",
+			match:   []string{"ab", "axxb"},
+			noMatch: []string{"a/b"},
+		},
+		"success: group alternatives": {
+			pattern: "**/*.{ts,js}",
+			match:   []string{"a.ts", "x/a.js"},
+			noMatch: []string{"a.tsx", "a.", "a.{ts,js}"},
+		},
+		"success: nested groups spanning segments": {
+			pattern: "{src/**,lib/{a,b}}/*.go",
+			match:   []string{"src/x.go", "src/p/q/x.go", "lib/a/x.go", "lib/b/x.go"},
+			noMatch: []string{"lib/c/x.go", "lib/x.go"},
+		},
+		"success: globstar as a group alternative": {
+			pattern: "{**/*.md,docs}",
+			match:   []string{"x/y.md", "docs"},
+			noMatch: []string{"docs/x"},
+		},
+		"success: commas and closing braces outside groups are literal": {
+			pattern: "a,b}",
+			match:   []string{"a,b}"},
+		},
+		"success: character range": {
+			pattern: "example.[0-9]",
+			match:   []string{"example.0", "example.9"},
+			noMatch: []string{"example.a", "example.10"},
+		},
+		"success: negated character range": {
+			pattern: "example.[!0-9]",
+			match:   []string{"example.a", "example.-"},
+			noMatch: []string{"example.0", "example./"},
+		},
+		"success: caret negation and bracket members": {
+			pattern: "[^]a][]^]",
+			match:   []string{"b]", "x^"},
+			noMatch: []string{"a]", "]]", "bb"},
+		},
+		"success: regexp metacharacters are literal": {
+			pattern: `a+(b)|c$.\d`,
+			match:   []string{`a+(b)|c$.\d`},
+			noMatch: []string{"aa(b)|c$.d"},
+		},
+		"success: non-ASCII characters": {
+			pattern: "日本/?.txt",
+			match:   []string{"日本/語.txt"},
+			noMatch: []string{"日本/語語.txt"},
+		},
+		"success: ignore case": {
+			pattern:    "**/*.GO",
+			ignoreCase: true,
+			match:      []string{"a/b.go", "B.Go"},
+			noMatch:    []string{"b.gox"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := CompileGlob(tt.pattern, tt.ignoreCase)
+			if err != nil {
+				t.Fatalf("CompileGlob(%q) error = %v", tt.pattern, err)
+			}
+			for _, path := range tt.match {
+				if !g.Match(path) {
+					t.Errorf("%q does not match %q", tt.pattern, path)
+				}
+			}
+			for _, path := range tt.noMatch {
+				if g.Match(path) {
+					t.Errorf("%q matches %q", tt.pattern, path)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileGlobErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"error: unterminated group": "*.{ts,js",
+		"error: unterminated class": "a[bc",
+		"error: separator in class": "a[/]b",
+		"error: reversed range":     "[z-a]",
+	}
+	for name, pattern := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := CompileGlob(pattern, false); err == nil {
+				t.Errorf("CompileGlob(%q) succeeded", pattern)
+			}
+		})
+	}
+}
+
+func TestGlobMatcherMatchURI(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern GlobPattern
+		match   []string
+		noMatch []string
+	}{
+		"success: pattern matches the whole path": {
+			pattern: Pattern("**/*.go"),
+			match:   []string{"file:///w/a.go", "untitled:/x.go"},
+			noMatch: []string{"file:///w/a.mod"},
+		},
+		"success: pattern sees the decoded path": {
+			pattern: Pattern("**/a b.go"),
+			match:   []string{"file:///w/a%20b.go"},
+		},
+		"success: relative to a URI": {
+			pattern: &RelativePattern{BaseURI: URI("file:///w/mod"), Pattern: "*.go"},
+			match:   []string{"file:///w/mod/a.go"},
+			noMatch: []string{"file:///w/mod/sub/a.go", "file:///w/module/a.go", "file:///w/a.go", "untitled:/w/mod/a.go"},
+		},
+		"success: relative to a workspace folder with a trailing slash": {
+			pattern: &RelativePattern{BaseURI: &WorkspaceFolder{URI: "file:///w/", Name: "w"}, Pattern: "**/go.mod"},
+			match:   []string{"file:///w/go.mod", "file:///w/x/go.mod"},
+			noMatch: []string{"file:///v/go.mod", "file://host/w/go.mod"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := CompileGlobPattern(tt.pattern)
+			if err != nil {
+				t.Fatalf("CompileGlobPattern() error = %v", err)
+			}
+			for _, u := range tt.match {
+				if !m.MatchURI(uri.MustParse(u)) {
+					t.Errorf("%v does not match %s", tt.pattern, u)
+				}
+			}
+			for _, u := range tt.noMatch {
+				if m.MatchURI(uri.MustParse(u)) {
+					t.Errorf("%v matches %s", tt.pattern, u)
+				}
+			}
+		})
+	}
+
+	if _, err := CompileGlobPattern(&RelativePattern{Pattern: "*"}); err == nil {
+		t.Error("CompileGlobPattern() succeeded without a base URI")
+	}
+}
+
+func TestWatchedFilesFilter(t *testing.T) {
+	t.Parallel()
+
+	f, err := CompileFileSystemWatchers([]FileSystemWatcher{
+		{GlobPattern: Pattern("**/*.go")},
+		{GlobPattern: &RelativePattern{BaseURI: URI("file:///w"), Pattern: "go.{mod,sum}"}, Kind: WatchKindChange},
+	})
+	if err != nil {
+		t.Fatalf("CompileFileSystemWatchers() error = %v", err)
+	}
+	events := []FileEvent{
+		{URI: "file:///w/a.go", Type: FileChangeTypeDeleted},
+		{URI: "file:///w/go.mod", Type: FileChangeTypeChanged},
+		{URI: "file:///w/go.sum", Type: FileChangeTypeCreated},
+		{URI: "file:///w/README.md", Type: FileChangeTypeChanged},
+	}
+	got := f.Filter(events)
+	if len(got) != 2 || got[0].URI != "file:///w/a.go" || got[1].URI != "file:///w/go.mod" {
+		t.Errorf("Filter() = %v, want the a.go deletion and the go.mod change", got)
+	}
+}
+
+func TestFileOperationMatcher(t *testing.T) {
+	t.Parallel()
+
+	file, ignoreCase := "file", true
+	m, err := CompileFileOperationFilters([]FileOperationFilter{
+		{Scheme: &file, Pattern: FileOperationPattern{Glob: "**/*.go", Matches: FileOperationPatternKindFile}},
+		{Pattern: FileOperationPattern{Glob: "**/vendor", Matches: FileOperationPatternKindFolder}},
+		{Pattern: FileOperationPattern{Glob: "**/*.md", Options: &FileOperationPatternOptions{IgnoreCase: &ignoreCase}}},
+	})
+	if err != nil {
+		t.Fatalf("CompileFileOperationFilters() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		uri      string
+		isFolder bool
+		want     bool
+	}{
+		"success: file of the filter scheme":        {uri: "file:///w/a.go", want: true},
+		"success: other scheme is skipped":          {uri: "untitled:/w/a.go", want: false},
+		"success: file pattern ignores folders":     {uri: "file:///w/pkg.go", isFolder: true, want: false},
+		"success: folder pattern matches a folder":  {uri: "file:///w/vendor", isFolder: true, want: true},
+		"success: folder pattern ignores files":     {uri: "file:///w/vendor", want: false},
+		"success: ignore case applies per filter":   {uri: "file:///w/README.MD", want: true},
+		"success: undefined kind matches folders":   {uri: "file:///w/doc.md", isFolder: true, want: true},
+		"success: no filter matches other patterns": {uri: "file:///w/a.rs", want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := m.Match(uri.MustParse(tt.uri), tt.isFolder); got != tt.want {
+				t.Errorf("Match(%s, %t) = %t, want %t", tt.uri, tt.isFolder, got, tt.want)
+			}
+		})
+	}
+}
@@ -209,7 +209,7 @@ func TestServerHandlerCustomFallbackRetainsOwnedMethodAndParams(t *testing.T) {
 
 	serverEnd, clientEnd := net.Pipe()
 	server := &fallbackRecordingServer{}
-	_, serverConn, _ := NewServer(ctx, server, jsonrpc2.NewStream(serverEnd))
+	_, serverConn, _ := NewServer(ctx, server, NewStream(serverEnd))
 	clientStream := jsonrpc2.NewStream(clientEnd)
 	t.Cleanup(func() {
 		if err := clientStream.Close(); err != nil {
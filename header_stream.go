@@ -0,0 +1,177 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// maxContentLength caps the Content-Length a frame may declare, as
+// [jsonrpc2.NewStream] does, so a peer cannot force a huge body buffer.
+const maxContentLength = 1 << 30
+
+// NewStream returns a [jsonrpc2.Stream] over conn with the Content-Length
+// framing of the base protocol, interchangeable with [jsonrpc2.NewStream] on
+// either end. Each outgoing message is framed as [FrameWriter] frames it:
+// the envelope is encoded into a pooled buffer behind a reserved header, the
+// header is written right-aligned into the reserve, and the frame goes out in
+// one Write. Headers are parsed in place from the read buffer, so neither
+// direction allocates per message in steady state.
+//
+// [NewServer] and [NewClient] take any stream; pass them one made by
+// NewStream to get this framing on the connection path.
+func NewStream(conn io.ReadWriteCloser) jsonrpc2.Stream {
+	return &stream{conn: conn, in: bufio.NewReader(conn)}
+}
+
+// stream implements [jsonrpc2.Stream], and the ReadFrame and WriteFrame
+// methods jsonrpc2.Conn uses for raw frames and batches.
+type stream struct {
+	conn io.ReadWriteCloser
+	in   *bufio.Reader
+	body []byte // reused body buffer; a read frame is valid until the next
+
+	mu sync.Mutex // serializes writes so frames never interleave
+}
+
+// Read implements [jsonrpc2.Stream].
+func (s *stream) Read(ctx context.Context) (jsonrpc2.Message, int64, error) {
+	body, n, err := s.ReadFrame(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg, err := jsonrpc2.DecodeMessage(body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return msg, n, nil
+}
+
+// ReadFrame returns the body of the next frame. It is valid until the next
+// read.
+func (s *stream) ReadFrame(ctx context.Context) ([]byte, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+	length, header, err := s.readHeader()
+	if err != nil {
+		return nil, 0, err
+	}
+	if cap(s.body) < length {
+		s.body = make([]byte, length)
+	}
+	body := s.body[:length]
+	if _, err := io.ReadFull(s.in, body); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+	return body, int64(header + length), nil
+}
+
+// readHeader reads a header block and returns its Content-Length and size.
+// Lines are parsed in the reader's buffer; a line longer than the buffer is
+// an invalid header.
+func (s *stream) readHeader() (length, size int, err error) {
+	length = -1
+	for {
+		line, err := s.in.ReadSlice('\n')
+		if err != nil {
+			switch {
+			case errors.Is(err, io.EOF) && size == 0 && len(line) == 0:
+				return 0, 0, io.EOF
+			case errors.Is(err, io.EOF):
+				return 0, 0, io.ErrUnexpectedEOF
+			case errors.Is(err, bufio.ErrBufferFull):
+				return 0, 0, jsonrpc2.ErrInvalidHeader
+			}
+			return 0, 0, err
+		}
+		size += len(line)
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			break
+		}
+		name, value, ok := bytes.Cut(line, []byte(":"))
+		if !ok {
+			return 0, 0, jsonrpc2.ErrInvalidHeader
+		}
+		if !bytes.EqualFold(bytes.TrimSpace(name), []byte("Content-Length")) {
+			continue
+		}
+		length = 0
+		value = bytes.TrimSpace(value)
+		if len(value) == 0 {
+			return 0, 0, jsonrpc2.ErrInvalidHeader
+		}
+		for _, c := range value {
+			if c < '0' || c > '9' || length > (maxContentLength-int(c-'0'))/10 {
+				return 0, 0, jsonrpc2.ErrInvalidHeader
+			}
+			length = length*10 + int(c-'0')
+		}
+	}
+	if length <= 0 {
+		return 0, 0, jsonrpc2.ErrInvalidHeader
+	}
+	return length, size, nil
+}
+
+// Write implements [jsonrpc2.Stream].
+func (s *stream) Write(ctx context.Context, msg jsonrpc2.Message) (int64, error) {
+	return s.write(ctx, func(dst []byte) []byte { return jsonrpc2.AppendMessage(dst, msg) })
+}
+
+// WriteFrame frames the encoded message or batch data and writes it.
+func (s *stream) WriteFrame(ctx context.Context, data []byte) (int64, error) {
+	return s.write(ctx, func(dst []byte) []byte { return append(dst, data...) })
+}
+
+// write frames the body appendBody appends behind the header reserve of a
+// pooled buffer and writes the frame in one Write.
+func (s *stream) write(ctx context.Context, appendBody func(dst []byte) []byte) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	bp := getEncodeBuf()
+	defer putEncodeBuf(bp)
+	buf := appendBody(append((*bp)[:0], frameHeaderPad[:]...))
+	*bp = buf
+	frame := frameInPlace(buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := s.conn.Write(frame)
+	return int64(n), err
+}
+
+// Close implements [jsonrpc2.Stream].
+func (s *stream) Close() error {
+	return s.conn.Close()
+}
+
+// frameInPlace writes the Content-Length header for the body that follows
+// the frameHeaderReserve bytes of buf right-aligned into that reserve, and
+// returns the frame: header and body as one slice of buf.
+func frameInPlace(buf []byte) []byte {
+	var hdr [frameHeaderReserve]byte
+	header := append(hdr[:0], "Content-Length: "...)
+	header = strconv.AppendInt(header, int64(len(buf)-frameHeaderReserve), 10)
+	header = append(header, "\r\n\r\n"...)
+	frame := buf[frameHeaderReserve-len(header):]
+	copy(frame, header)
+	return frame
+}
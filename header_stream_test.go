@@ -0,0 +1,167 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.lsp.dev/jsonrpc2"
+)
+
+// readCloser is an [io.ReadWriteCloser] reading from a fixed input.
+type readCloser struct {
+	io.Reader
+	io.Writer
+}
+
+func (readCloser) Close() error { return nil }
+
+func TestNewStreamWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]jsonrpc2.Message{
+		"success: call":         jsonrpc2.NewCall(jsonrpc2.NewNumberID(1), MethodTextDocumentHover, jsonrpc2.RawMessage(`{"position":{"line":1,"character":2}}`)),
+		"success: notification": jsonrpc2.NewNotification(MethodExit, nil),
+		"success: response":     jsonrpc2.NewResponse(jsonrpc2.NewStringID("r"), jsonrpc2.RawMessage(`{"contents":"doc"}`), nil),
+		"success: error":        jsonrpc2.NewResponse(jsonrpc2.NewNumberID(2), nil, jsonrpc2.NewError(jsonrpc2.InvalidParams, "bad")),
+	}
+	for name, msg := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got, want bufferRWC
+			gotN, err := NewStream(&got).Write(t.Context(), msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantN, err := jsonrpc2.NewStream(&want).Write(t.Context(), msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := gocmp.Diff(want.String(), got.String()); diff != "" {
+				t.Errorf("frame mismatch (-jsonrpc2 +protocol):\n%s", diff)
+			}
+			if gotN != wantN {
+				t.Errorf("wrote %d bytes, jsonrpc2 wrote %d", gotN, wantN)
+			}
+
+			read, n, err := NewStream(&want).Read(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != wantN {
+				t.Errorf("read %d bytes, want %d", n, wantN)
+			}
+			if diff := gocmp.Diff(string(jsonrpc2.AppendMessage(nil, msg)), string(jsonrpc2.AppendMessage(nil, read))); diff != "" {
+				t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStreamRead(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"jsonrpc":"2.0","method":"exit"}`
+	tests := map[string]struct {
+		input   string
+		want    []string
+		wantErr error
+	}{
+		"success: two frames": {
+			input: "Content-Length: 33\r\n\r\n" + body + "Content-Length: 33\r\n\r\n" + body,
+			want:  []string{body, body},
+		},
+		"success: case-insensitive name and extra headers": {
+			input: "content-length:33\r\nContent-Type: application/vscode-jsonrpc; charset=utf-8\r\n\r\n" + body,
+			want:  []string{body},
+		},
+		"success: bare line feeds": {
+			input: "Content-Length: 33\n\n" + body,
+			want:  []string{body},
+		},
+		"success: empty input": {
+			input: "",
+		},
+		"error: missing Content-Length": {
+			input:   "Content-Type: x\r\n\r\n" + body,
+			wantErr: jsonrpc2.ErrInvalidHeader,
+		},
+		"error: malformed header line": {
+			input:   "Content-Length 33\r\n\r\n" + body,
+			wantErr: jsonrpc2.ErrInvalidHeader,
+		},
+		"error: non-numeric length": {
+			input:   "Content-Length: 3x\r\n\r\n" + body,
+			wantErr: jsonrpc2.ErrInvalidHeader,
+		},
+		"error: length over the cap": {
+			input:   "Content-Length: 1073741825\r\n\r\n",
+			wantErr: jsonrpc2.ErrInvalidHeader,
+		},
+		"error: truncated header": {
+			input:   "Content-Length: 33\r\n",
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		"error: truncated body": {
+			input:   "Content-Length: 33\r\n\r\n" + body[:10],
+			wantErr: io.ErrUnexpectedEOF,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewStream(readCloser{Reader: strings.NewReader(tt.input), Writer: io.Discard})
+			var got []string
+			for {
+				msg, _, err := s.Read(t.Context())
+				if errors.Is(err, io.EOF) && tt.wantErr == nil {
+					break
+				}
+				if err != nil {
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("Read() error = %v, want %v", err, tt.wantErr)
+					}
+					break
+				}
+				got = append(got, string(jsonrpc2.AppendMessage(nil, msg)))
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("messages mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStreamWriteFrame(t *testing.T) {
+	t.Parallel()
+
+	batch := []byte(`[{"jsonrpc":"2.0","method":"exit"}]`)
+	var buf bufferRWC
+	s := NewStream(&buf)
+	fs, ok := s.(interface {
+		WriteFrame(ctx context.Context, data []byte) (int64, error)
+		ReadFrame(ctx context.Context) ([]byte, int64, error)
+	})
+	if !ok {
+		t.Fatal("stream does not implement the jsonrpc2 frame methods")
+	}
+	if _, err := fs.WriteFrame(t.Context(), batch); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := fs.ReadFrame(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, batch) {
+		t.Errorf("ReadFrame() = %s, want %s", got, batch)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"testing"
 	"time"
 
-	"go.lsp.dev/jsonrpc2"
 	"go.lsp.dev/uri"
 )
 
@@ -95,7 +94,7 @@ func TestIntegrationRoundTrip(t *testing.T) {
 
 	// Endpoint A speaks the server role: it serves *testServer requests and
 	// returns clientDispatcher, the Client used for server->client calls.
-	_, connA, clientDispatcher := NewServer(ctx, ts, jsonrpc2.NewStream(a))
+	_, connA, clientDispatcher := NewServer(ctx, ts, NewStream(a))
 	defer func() { _ = connA.Close() }()
 
 	ts.mu.Lock()
@@ -104,7 +103,7 @@ func TestIntegrationRoundTrip(t *testing.T) {
 
 	// Endpoint B speaks the client role: it serves *testClient requests and
 	// returns serverDispatcher, the Server used to drive client->server calls.
-	_, connB, serverDispatcher := NewClient(ctx, tc, jsonrpc2.NewStream(b))
+	_, connB, serverDispatcher := NewClient(ctx, tc, NewStream(b))
 	defer func() { _ = connB.Close() }()
 
 	// (2) notification reaches the server.
@@ -196,7 +195,7 @@ func TestIntegrationUnimplementedClientSurvivesNotification(t *testing.T) {
 
 	ns := &notifyingServer{}
 
-	_, connA, clientDispatcher := NewServer(ctx, ns, jsonrpc2.NewStream(a))
+	_, connA, clientDispatcher := NewServer(ctx, ns, NewStream(a))
 	defer func() { _ = connA.Close() }()
 
 	ns.mu.Lock()
@@ -206,7 +205,7 @@ func TestIntegrationUnimplementedClientSurvivesNotification(t *testing.T) {
 	// The client overrides nothing: every notification falls through to
 	// UnimplementedClient, the exact configuration that previously killed the
 	// connection on the first server notification.
-	_, connB, serverDispatcher := NewClient(ctx, UnimplementedClient{}, jsonrpc2.NewStream(b))
+	_, connB, serverDispatcher := NewClient(ctx, UnimplementedClient{}, NewStream(b))
 	defer func() { _ = connB.Close() }()
 
 	// The handler pushes window/logMessage to the client mid-request. If the
@@ -29,6 +29,10 @@ func run() error {
 	input := flag.String("input", "internal/genlsp/testdata/metaModel.json", "path to metaModel.json")
 	output := flag.String("output", ".", "output directory for generated files")
 	pkg := flag.String("pkg", "protocol", "generated package name")
+	version := flag.String("version", "3.18.0", "protocol version the input meta-model describes")
+	gate := flag.Bool("gate-proposed", true, "put proposed features behind the "+genlsp.ProposedBuildTag+" build tag")
+	extraFlag := flag.String("extra-fields", "", "comma-separated structures that preserve unknown JSON members in an Extra field (e.g. CompletionItem,Diagnostic)")
+	historyFlag := flag.String("history", "", "comma-separated version=path list of older meta-models used to date untagged items (e.g. 3.16.0=m316.json,3.17.0=m317.json)")
 	flag.Parse()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -40,7 +44,28 @@ func run() error {
 	}
 
 	g := genlsp.NewGenerator(m, *pkg)
-	files, emitErr := g.Emit()
+	if err := g.SetVersion(*version); err != nil {
+		return err
+	}
+	if *extraFlag != "" {
+		if err := g.SetExtraFields(strings.Split(*extraFlag, ",")...); err != nil {
+			return err
+		}
+	}
+	if *historyFlag != "" {
+		older, err := loadHistory(ctx, *historyFlag)
+		if err != nil {
+			return err
+		}
+		if err := g.SetHistory(*version, older...); err != nil {
+			return err
+		}
+	}
+	emit := g.Emit
+	if *gate {
+		emit = g.EmitGated
+	}
+	files, emitErr := emit()
 
 	if err := os.MkdirAll(*output, 0o755); err != nil {
 		return err
@@ -81,3 +106,20 @@ func run() error {
 	}
 	return emitErr
 }
+
+// loadHistory loads the older meta-models named by a -history flag value.
+func loadHistory(ctx context.Context, spec string) ([]genlsp.VersionedModel, error) {
+	var out []genlsp.VersionedModel
+	for entry := range strings.SplitSeq(spec, ",") {
+		version, path, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("history entry %q: want version=path", entry)
+		}
+		m, err := genlsp.Load(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("history %s: %w", version, err)
+		}
+		out = append(out, genlsp.VersionedModel{Version: version, Model: m})
+	}
+	return out, nil
+}
@@ -27,6 +27,7 @@ type renderedField struct {
 	JSONName string
 	Tag      string
 	Doc      string
+	Since    string // normalized introduction version, "" when untracked
 }
 
 // renderedStruct is a fully lowered Go struct.
@@ -51,7 +52,9 @@ type encoderCtx struct {
 	collecting   map[string]bool
 }
 
-// Emit analyses the model and returns generated files keyed by output name.
+// Emit analyses the model and returns generated files keyed by output name:
+// the Go sources and the JSON Schemas named by [SchemaFileName] and
+// [StrictSchemaFileName].
 func (g *Generator) Emit() (map[string][]byte, error) {
 	// Pre-pass: assign role/context names to anonymous unions before any use
 	// site mints a structural AOrB fallback. Priority is alias > result >
@@ -140,6 +143,26 @@ func (g *Generator) Emit() (map[string][]byte, error) {
 	add("decoders.go", g.renderByteDecoders(g.byteCtx))
 	add("encoders.go", g.renderEncoders(generatedStructs, aliases))
 	add("append_encoders.go", g.renderByteEncoders(g.byteCtx, generatedStructs))
+	add("deepcopy.go", g.renderDeepCopy(g.byteCtx, generatedStructs))
+	add("shapes.go", g.renderShapes())
+	add("union_arms.go", g.renderUnionArms())
+	specs := g.methodSpecs()
+	add("dispatch.go", g.renderDispatch(specs))
+	add("since.go", g.renderSince(generatedStructs))
+	add("server.go", renderRPCSide(specs, serverSide))
+	add("client.go", renderRPCSide(specs, clientSide))
+	add("unimplemented.go", renderUnimplemented(specs))
+	for _, strict := range []bool{false, true} {
+		name := SchemaFileName
+		if strict {
+			name = StrictSchemaFileName
+		}
+		schema, err := g.renderSchema(strict)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("%s: %w", name, err)
+		}
+		files[name] = schema
+	}
 	return files, firstErr
 }
 
@@ -186,6 +209,9 @@ func formatFile(pkg, body string) ([]byte, error) {
 // in body. Struct tags (json:"...") use a colon and are not matched.
 func detectImports(body string) []string {
 	var imports []string
+	if strings.Contains(body, "context.Context") {
+		imports = append(imports, "context")
+	}
 	if strings.Contains(body, "fmt.") {
 		imports = append(imports, "fmt")
 	}
@@ -207,6 +233,9 @@ func detectImports(body string) []string {
 	if strings.Contains(body, uriPackageQualifier+".") {
 		imports = append(imports, uriImportPath)
 	}
+	if strings.Contains(body, "jsonrpc2.") {
+		imports = append(imports, "go.lsp.dev/jsonrpc2")
+	}
 	sort.Strings(imports)
 	return imports
 }
@@ -254,6 +283,9 @@ func (g *Generator) analyzeStructures() []*renderedStruct {
 		for _, p := range s.Properties {
 			rs.Fields = append(rs.Fields, g.renderField(s.Name, p))
 		}
+		if g.extraFields[s.Name] {
+			rs.Fields = append(rs.Fields, extraField())
+		}
 		out = append(out, rs)
 	}
 	// Reorder into spec-document order. The sort is stable, so types within a
@@ -321,12 +353,17 @@ func (g *Generator) renderField(owner string, p *Property) renderedField {
 		tagExtra = ",omitzero"
 	}
 	tag := p.Name + tagExtra
+	sinceKey := ""
+	if _, ok := g.structures[owner]; ok {
+		sinceKey = owner + "." + p.Name
+	}
 	return renderedField{
 		Name:     fieldName,
 		Type:     typ,
 		JSONName: p.Name,
 		Tag:      tag,
 		Doc:      g.fieldDoc(fieldName, p),
+		Since:    g.introduced(sinceField, sinceKey, p.Since, p.SinceTags),
 	}
 }
 
@@ -726,7 +763,7 @@ func (g *Generator) renderUnionDecoder(b *strings.Builder, u *unionDecl) {
 		}
 	}
 	b.WriteString("\t}\n")
-	fmt.Fprintf(b, "\treturn fmt.Errorf(\"cannot unmarshal %%s into %s\", raw)\n", u.Name)
+	fmt.Fprintf(b, "\treturn newUnionError(%q, raw)\n", u.Name)
 	b.WriteString("}\n\n")
 }
 
@@ -1067,6 +1104,9 @@ func (g *Generator) renderStructEncoder(ctx *encoderCtx, b *strings.Builder, s *
 	for _, f := range flattenJSONFields(ctx.structByName, s, map[string]bool{}) {
 		g.renderFieldEncoder(ctx, b, &f)
 	}
+	if hasExtraField(s) {
+		fmt.Fprintf(b, "\tif err := encodeExtraMembersTo(enc, x.Extra, shape%s); err != nil {\n\t\treturn err\n\t}\n", s.Name)
+	}
 	b.WriteString("\treturn enc.WriteToken(jsontext.EndObject)\n")
 	b.WriteString("}\n\n")
 }
@@ -1091,6 +1131,9 @@ func flattenJSONFields(structByName map[string]*renderedStruct, s *renderedStruc
 		}
 	}
 	for _, f := range s.Fields {
+		if f.JSONName == "" {
+			continue // the Extra field; see hasExtraField
+		}
 		out = appendJSONField(out, &f)
 	}
 	return out
@@ -1484,6 +1527,7 @@ func (g *Generator) analyzeMessages() string {
 		result     string
 		doc        string
 		deprecated string
+		since      string
 	}
 	msgs := make([]msg, 0, len(g.model.Requests)+len(g.model.Notifications))
 
@@ -1494,6 +1538,7 @@ func (g *Generator) analyzeMessages() string {
 			params: g.paramsType(r.Params, r.Method+"Params"),
 			result: g.lowerOrNil(r.Result, r.Method+"Result"),
 			doc:    r.Documentation, deprecated: r.Deprecated,
+			since: g.introduced(sinceMethod, r.Method, r.Since, nil),
 		}
 		msgs = append(msgs, m)
 	}
@@ -1503,18 +1548,12 @@ func (g *Generator) analyzeMessages() string {
 			direction: n.MessageDirection, kind: "notification",
 			params: g.paramsType(n.Params, n.Method+"Params"),
 			doc:    n.Documentation, deprecated: n.Deprecated,
+			since: g.introduced(sinceMethod, n.Method, n.Since, nil),
 		}
 		msgs = append(msgs, m)
 	}
-	// Order messages by spec-document feature; uncategorized methods (rank 0)
-	// sort last. The sort is stable, preserving meta-model order within a feature.
-	mrank := func(m string) int {
-		if r := methodRank[m]; r != 0 {
-			return r
-		}
-		return len(specFeatures) + 1
-	}
-	sort.SliceStable(msgs, func(i, j int) bool { return mrank(msgs[i].method) < mrank(msgs[j].method) })
+	// The sort is stable, preserving meta-model order within a feature.
+	sort.SliceStable(msgs, func(i, j int) bool { return messageRank(msgs[i].method) < messageRank(msgs[j].method) })
 
 	b.WriteString("// MessageDirection indicates in which direction a message is sent.\n")
 	b.WriteString("type MessageDirection string\n\n")
@@ -1535,13 +1574,15 @@ func (g *Generator) analyzeMessages() string {
 
 	b.WriteString("// MethodInfo describes a single LSP request or notification.\n")
 	b.WriteString("type MethodInfo struct {\n")
-	b.WriteString("\tMethod string\n\tDirection MessageDirection\n\tKind string\n\tParamsType string\n\tResultType string\n}\n\n")
+	b.WriteString("\tMethod string\n\tDirection MessageDirection\n\tKind string\n\tParamsType string\n\tResultType string\n")
+	b.WriteString("\n\t// Since is the protocol version that introduced the method, \"\" when it\n")
+	b.WriteString("\t// predates every tracked version.\n\tSince string\n}\n\n")
 	b.WriteString("// Methods is the registry of every request and notification in the model.\n")
 	b.WriteString("var Methods = []MethodInfo{\n")
 	for i := range msgs {
 		m := &msgs[i]
-		fmt.Fprintf(&b, "\t{Method: %s, Direction: %q, Kind: %q, ParamsType: %q, ResultType: %q},\n",
-			m.constName, m.direction, m.kind, m.params, m.result)
+		fmt.Fprintf(&b, "\t{Method: %s, Direction: %q, Kind: %q, ParamsType: %q, ResultType: %q, Since: %q},\n",
+			m.constName, m.direction, m.kind, m.params, m.result, m.since)
 	}
 	b.WriteString("}\n")
 	return b.String()
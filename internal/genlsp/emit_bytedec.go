@@ -401,16 +401,11 @@ func renderByteSliceHelper(b *strings.Builder, c *byteDecCtx, elem string) {
 func renderUnionRootDispatch(b *strings.Builder, c *byteDecCtx) {
 	names := append([]string(nil), c.unionDecls...)
 	sort.Strings(names)
-	b.WriteString("// unmarshalUnionRoot decodes data directly into a union interface\n")
-	b.WriteString("// pointer without constructing a decoder, reporting whether v was one.\n")
-	b.WriteString("func unmarshalUnionRoot(data []byte, v any) (bool, error) {\n")
-	b.WriteString("\tvar owned []byte\n")
-	b.WriteString("\town := func() []byte {\n")
-	b.WriteString("\t\tif owned == nil {\n")
-	b.WriteString("\t\t\towned = slices.Clone(data)\n")
-	b.WriteString("\t\t}\n")
-	b.WriteString("\t\treturn owned\n")
-	b.WriteString("\t}\n")
+	b.WriteString("// unmarshalUnionRoot decodes the input returned by own directly into a union\n")
+	b.WriteString("// interface pointer without constructing a decoder, reporting whether v was\n")
+	b.WriteString("// one. own is only called for a union destination; the decoded value may\n")
+	b.WriteString("// alias the bytes it returns.\n")
+	b.WriteString("func unmarshalUnionRoot(v any, own func() []byte) (bool, error) {\n")
 	b.WriteString("\tswitch p := v.(type) {\n")
 	for _, n := range names {
 		fmt.Fprintf(b, "\tcase *%s:\n\t\treturn true, unmarshal%sValue(own(), p)\n", n, n)
@@ -459,7 +454,11 @@ func (g *Generator) renderByteWalkerMethod(b *strings.Builder, c *byteDecCtx, s
 		}
 		g.renderByteFieldCase(b, c, f)
 	}
-	b.WriteString("\t\tdefault:\n\t\t\t_, n, err := dvValue(raw, i)\n")
+	if hasExtraField(s) {
+		b.WriteString("\t\tdefault:\n\t\t\tn, err := dvExtraMember(raw, key, i, &x.Extra)\n")
+	} else {
+		b.WriteString("\t\tdefault:\n\t\t\t_, n, err := dvValue(raw, i)\n")
+	}
 	b.WriteString("\t\t\tif err != nil {\n\t\t\t\treturn n, err\n\t\t\t}\n")
 	b.WriteString("\t\t\ti = n\n")
 	b.WriteString("\t\t}\n")
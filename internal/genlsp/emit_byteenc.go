@@ -188,6 +188,9 @@ func (g *Generator) renderByteEncWriter(b *strings.Builder, e *byteEncCtx, s *re
 	for _, f := range flattenJSONFields(e.dec.structs, s, map[string]bool{}) {
 		g.renderByteEncField(b, e, &f)
 	}
+	if hasExtraField(s) {
+		fmt.Fprintf(b, "\tif dst, err = appendExtraMembers(dst, &first, x.Extra, shape%s); err != nil {\n\t\treturn nil, err\n\t}\n", s.Name)
+	}
 	b.WriteString("\treturn append(dst, '}'), nil\n}\n\n")
 
 	fmt.Fprintf(b, "// appendLSPJSON implements appendMarshaler with a pre-sized buffer.\n")
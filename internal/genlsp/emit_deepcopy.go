@@ -0,0 +1,471 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// This file emits reflection-free deep copy and equality. Every generated
+// struct gets `DeepCopy() *T` and `Equal(*T) bool` methods, named slices and
+// defined struct types get the same pair, and every union gets
+// deepCopy<Union>/equal<Union> functions switching over its arms. A copy is a
+// shallow struct assignment followed by deepCopyFields, which replaces the
+// fields that share memory (strings, pointers, slices, maps, raw JSON,
+// unions); strings are copied too so a copy never keeps a decode buffer
+// alive, which is what [Clone] promises. Equality has the semantics of
+// reflect.DeepEqual: nil and empty slices and maps differ, and raw JSON
+// compares byte-wise.
+
+// deepKind classifies a Go type expression for copy and equality.
+type deepKind int
+
+const (
+	deepScalar     deepKind = iota // immutable value: compared with ==
+	deepString                     // string-based value whose bytes are copied
+	deepStruct                     // generated struct (or a defined type over one)
+	deepNamedSlice                 // named slice with its own methods
+	deepUnion                      // sealed-interface union
+	deepRaw                        // raw JSON bytes
+	deepDiagTags                   // hand-written DiagnosticTags
+	deepPointer                    // *T
+	deepSlice                      // []T
+	deepArray                      // [N]T
+	deepMap                        // map[K]V
+	deepOptional                   // Optional[T]
+	deepNullable                   // Nullable[T]
+)
+
+// deepCopyCtx resolves type expressions against the lowered declarations.
+type deepCopyCtx struct {
+	c        *byteDecCtx
+	tupleOf  map[string]string // tuple wrapper -> underlying array expr
+	needs    map[string]bool   // struct name -> deepCopyFields has work
+	visiting map[string]bool
+	warn     func(string)
+}
+
+func (g *Generator) newDeepCopyCtx(c *byteDecCtx) *deepCopyCtx {
+	d := &deepCopyCtx{
+		c:        c,
+		tupleOf:  map[string]string{},
+		needs:    map[string]bool{},
+		visiting: map[string]bool{},
+		warn: func(msg string) {
+			g.warnings = append(g.warnings, msg)
+		},
+	}
+	for _, w := range g.tupleWrap {
+		d.tupleOf[w.Name] = w.Expr
+	}
+	return d
+}
+
+// classify returns the kind of t and, for composite kinds, its element type
+// (the value type for maps).
+func (d *deepCopyCtx) classify(t string) (deepKind, string) {
+	switch {
+	case strings.HasPrefix(t, "*"):
+		return deepPointer, t[1:]
+	case strings.HasPrefix(t, "[]"):
+		return deepSlice, t[2:]
+	case strings.HasPrefix(t, "["):
+		return deepArray, t[strings.Index(t, "]")+1:]
+	case strings.HasPrefix(t, "map["):
+		_, v := splitMapType(t)
+		return deepMap, v
+	case strings.HasPrefix(t, "Optional["):
+		return deepOptional, strings.TrimSuffix(strings.TrimPrefix(t, "Optional["), "]")
+	case strings.HasPrefix(t, "Nullable["):
+		return deepNullable, strings.TrimSuffix(strings.TrimPrefix(t, "Nullable["), "]")
+	case t == "LSPAny" || t == "jsontext.Value":
+		return deepRaw, ""
+	case t == "LSPObject":
+		return deepMap, "jsontext.Value"
+	case t == "LSPArray":
+		return deepSlice, "jsontext.Value"
+	case t == "DiagnosticTags":
+		return deepDiagTags, ""
+	case d.c.unionCanon[t] != "":
+		return deepUnion, d.c.unionCanon[t]
+	case d.c.structs[t] != nil || d.structAlias(t) != "":
+		return deepStruct, ""
+	case d.c.wrapByName[t] != "":
+		return deepNamedSlice, d.c.wrapByName[t]
+	}
+	if expr, ok := d.tupleOf[t]; ok {
+		if k, _ := d.classify(expr); k == deepArray && !d.needsCopy(expr) {
+			return deepScalar, ""
+		}
+	}
+	switch base := resolveScalar(d.c, t); {
+	case base == "string" || isURIStringType(t):
+		return deepString, ""
+	case base == "":
+		d.warn(fmt.Sprintf("deep copy: treating %s as an immutable value", t))
+	}
+	return deepScalar, ""
+}
+
+// structAlias returns the generated struct a defined type such as
+// DeclarationLink is declared over, or "".
+func (d *deepCopyCtx) structAlias(t string) string {
+	for range 8 { // bounded alias chains
+		next, ok := d.c.aliasType[t]
+		if !ok {
+			return ""
+		}
+		if d.c.structs[next] != nil {
+			return next
+		}
+		t = next
+	}
+	return ""
+}
+
+// splitMapType splits "map[K]V" into K and V.
+func splitMapType(t string) (string, string) {
+	depth := 0
+	for i := len("map"); i < len(t); i++ {
+		switch t[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return t[len("map["):i], t[i+1:]
+			}
+		}
+	}
+	return "", t
+}
+
+// needsCopy reports whether a shallow copy of a t value shares memory with
+// its source.
+func (d *deepCopyCtx) needsCopy(t string) bool {
+	switch k, elem := d.classify(t); k {
+	case deepScalar:
+		return false
+	case deepStruct:
+		if s := d.structAlias(t); s != "" {
+			t = s
+		}
+		return d.structNeedsCopy(t)
+	case deepArray, deepOptional, deepNullable:
+		return d.needsCopy(elem)
+	default:
+		return true
+	}
+}
+
+func (d *deepCopyCtx) structNeedsCopy(name string) bool {
+	if v, ok := d.needs[name]; ok {
+		return v
+	}
+	if d.visiting[name] {
+		// A cycle back to name goes through a pointer or slice, which the
+		// field on that path already reports.
+		return false
+	}
+	d.visiting[name] = true
+	defer delete(d.visiting, name)
+
+	s := d.c.structs[name]
+	needs := false
+	for _, e := range s.Embeds {
+		needs = needs || d.needsCopy(e)
+	}
+	for _, f := range s.Fields {
+		needs = needs || d.needsCopy(f.Type)
+	}
+	d.needs[name] = needs
+	return needs
+}
+
+// emitCopy emits statements that, given lv holding a shallow copy of a t
+// value, make it a deep copy. depth keeps loop variables distinct.
+func (d *deepCopyCtx) emitCopy(b *strings.Builder, t, lv, ind string, depth int) {
+	if !d.needsCopy(t) {
+		return
+	}
+	k, elem := d.classify(t)
+	switch k {
+	case deepStruct:
+		if s := d.structAlias(t); s != "" {
+			fmt.Fprintf(b, "%s(*%s)(&%s).deepCopyFields()\n", ind, s, lv)
+			return
+		}
+		fmt.Fprintf(b, "%s%s.deepCopyFields()\n", ind, lv)
+	case deepNamedSlice:
+		fmt.Fprintf(b, "%s%s = %s.DeepCopy()\n", ind, lv, lv)
+	case deepUnion:
+		fmt.Fprintf(b, "%s%s = deepCopy%s(%s)\n", ind, lv, elem, lv)
+	case deepString:
+		fmt.Fprintf(b, "%s%s = cloneString(%s)\n", ind, lv, lv)
+	case deepRaw:
+		fmt.Fprintf(b, "%s%s = slices.Clone(%s)\n", ind, lv, lv)
+	case deepDiagTags:
+		fmt.Fprintf(b, "%s%s = %s.clone()\n", ind, lv, lv)
+	case deepPointer:
+		if ek, _ := d.classify(elem); ek == deepStruct && d.structAlias(elem) == "" {
+			fmt.Fprintf(b, "%s%s = %s.DeepCopy()\n", ind, lv, lv)
+			return
+		}
+		v := fmt.Sprintf("v%d", depth)
+		fmt.Fprintf(b, "%sif %s != nil {\n", ind, lv)
+		fmt.Fprintf(b, "%s\t%s := *%s\n", ind, v, lv)
+		d.emitCopy(b, elem, v, ind+"\t", depth+1)
+		fmt.Fprintf(b, "%s\t%s = &%s\n", ind, lv, v)
+		fmt.Fprintf(b, "%s}\n", ind)
+	case deepSlice:
+		fmt.Fprintf(b, "%s%s = slices.Clone(%s)\n", ind, lv, lv)
+		d.emitElemLoop(b, elem, lv, ind, depth)
+	case deepArray:
+		d.emitElemLoop(b, elem, lv, ind, depth)
+	case deepMap:
+		// Rebuilt rather than cloned so string keys are copied as well.
+		keyType, _ := splitMapType(t)
+		if keyType == "" {
+			keyType = "string" // LSPObject
+		}
+		m, key, v := fmt.Sprintf("m%d", depth), fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		fmt.Fprintf(b, "%sif %s != nil {\n", ind, lv)
+		fmt.Fprintf(b, "%s\t%s := make(%s, len(%s))\n", ind, m, t, lv)
+		fmt.Fprintf(b, "%s\tfor %s, %s := range %s {\n", ind, key, v, lv)
+		d.emitCopy(b, keyType, key, ind+"\t\t", depth+1)
+		d.emitCopy(b, elem, v, ind+"\t\t", depth+1)
+		fmt.Fprintf(b, "%s\t\t%s[%s] = %s\n", ind, m, key, v)
+		fmt.Fprintf(b, "%s\t}\n", ind)
+		fmt.Fprintf(b, "%s\t%s = %s\n", ind, lv, m)
+		fmt.Fprintf(b, "%s}\n", ind)
+	case deepOptional, deepNullable:
+		ctor := "NewOptional"
+		if k == deepNullable {
+			ctor = "NewNullable"
+		}
+		v := fmt.Sprintf("v%d", depth)
+		fmt.Fprintf(b, "%sif %s, ok := %s.Get(); ok {\n", ind, v, lv)
+		d.emitCopy(b, elem, v, ind+"\t", depth+1)
+		fmt.Fprintf(b, "%s\t%s = %s(%s)\n", ind, lv, ctor, v)
+		fmt.Fprintf(b, "%s}\n", ind)
+	default:
+	}
+}
+
+func (d *deepCopyCtx) emitElemLoop(b *strings.Builder, elem, lv, ind string, depth int) {
+	if !d.needsCopy(elem) {
+		return
+	}
+	i := fmt.Sprintf("i%d", depth)
+	fmt.Fprintf(b, "%sfor %s := range %s {\n", ind, i, lv)
+	d.emitCopy(b, elem, fmt.Sprintf("%s[%s]", lv, i), ind+"\t", depth+1)
+	fmt.Fprintf(b, "%s}\n", ind)
+}
+
+// equalExpr returns an expression reporting whether the addressable t values
+// a and b are equal.
+func (d *deepCopyCtx) equalExpr(t, a, b string) string {
+	k, elem := d.classify(t)
+	switch k {
+	case deepStruct:
+		if s := d.structAlias(t); s != "" {
+			return fmt.Sprintf("(*%s)(%s).Equal((*%s)(%s))", s, addrOf(a), s, addrOf(b))
+		}
+		return fmt.Sprintf("%s.Equal(%s)", a, addrOf(b))
+	case deepNamedSlice:
+		return fmt.Sprintf("%s.Equal(%s)", a, arg(b))
+	case deepUnion:
+		return fmt.Sprintf("equal%s(%s, %s)", elem, arg(a), arg(b))
+	case deepRaw:
+		return fmt.Sprintf("equalRaw(%s, %s)", arg(a), arg(b))
+	case deepDiagTags:
+		return fmt.Sprintf("%s.equal(%s)", a, arg(b))
+	case deepPointer:
+		if ek, _ := d.classify(elem); ek == deepStruct && d.structAlias(elem) == "" {
+			return fmt.Sprintf("%s.Equal(%s)", a, arg(b))
+		}
+		return fmt.Sprintf("equalPtr(%s, %s, %s)", arg(a), arg(b), d.equalFunc(elem))
+	case deepSlice:
+		return fmt.Sprintf("equalSlice(%s, %s, %s)", arg(a), arg(b), d.equalFunc(elem))
+	case deepArray:
+		if !d.needsCopy(elem) {
+			return fmt.Sprintf("%s == %s", arg(a), arg(b))
+		}
+		return fmt.Sprintf("equalSlice(%s[:], %s[:], %s)", a, b, d.equalFunc(elem))
+	case deepMap:
+		return fmt.Sprintf("equalMap(%s, %s, %s)", arg(a), arg(b), d.equalFunc(elem))
+	case deepOptional:
+		return fmt.Sprintf("equalOptional(%s, %s, %s)", arg(a), arg(b), d.equalFunc(elem))
+	case deepNullable:
+		return fmt.Sprintf("equalNullable(%s, %s, %s)", arg(a), arg(b), d.equalFunc(elem))
+	default:
+		return fmt.Sprintf("%s == %s", arg(a), arg(b))
+	}
+}
+
+// equalFunc returns a func(a, b *t) bool value comparing t values.
+func (d *deepCopyCtx) equalFunc(t string) string {
+	k, _ := d.classify(t)
+	switch {
+	case k == deepScalar || k == deepString:
+		return fmt.Sprintf("equalValue[%s]", t)
+	case k == deepStruct && d.structAlias(t) == "":
+		return fmt.Sprintf("(*%s).Equal", t)
+	}
+	return fmt.Sprintf("func(a, b *%s) bool { return %s }", t, d.equalExpr(t, "(*a)", "(*b)"))
+}
+
+// arg returns e for use as a call argument, where a dereference needs no
+// parentheses.
+func arg(e string) string {
+	if rest, ok := strings.CutPrefix(e, "(*"); ok {
+		return "*" + strings.TrimSuffix(rest, ")")
+	}
+	return e
+}
+
+// addrOf returns the address of the addressable expression e.
+func addrOf(e string) string {
+	if rest, ok := strings.CutPrefix(e, "(*"); ok {
+		return strings.TrimSuffix(rest, ")")
+	}
+	return "&" + e
+}
+
+// renderDeepCopy emits the deep copy and equality methods and functions.
+func (g *Generator) renderDeepCopy(c *byteDecCtx, structs []*renderedStruct) string {
+	d := g.newDeepCopyCtx(c)
+	var b strings.Builder
+	for _, s := range structs {
+		d.renderStructDeepCopy(&b, s)
+	}
+
+	var aliases, slices []string
+	for name := range c.aliasType {
+		if d.structAlias(name) != "" {
+			aliases = append(aliases, name)
+		}
+	}
+	for name := range c.wrapByName {
+		slices = append(slices, name)
+	}
+	sort.Strings(aliases)
+	sort.Strings(slices)
+	for _, name := range aliases {
+		renderStructAliasDeepCopy(&b, name, d.structAlias(name))
+	}
+	for _, name := range slices {
+		d.renderNamedSliceDeepCopy(&b, name, c.wrapByName[name])
+	}
+	for _, name := range c.unionDecls {
+		d.renderUnionDeepCopy(&b, c.unions[name])
+	}
+
+	// Clone reaches union copies through this switch: a union's dynamic arm
+	// does not know which interface it was stored in.
+	b.WriteString("// deepCopyUnion replaces the union p points to with a deep copy and reports\n// whether p points to a union.\n")
+	b.WriteString("func deepCopyUnion(p any) bool {\n\tswitch p := p.(type) {\n")
+	for _, name := range c.unionDecls {
+		fmt.Fprintf(&b, "\tcase *%s:\n\t\t*p = deepCopy%s(*p)\n", name, name)
+	}
+	b.WriteString("\tdefault:\n\t\treturn false\n\t}\n\treturn true\n}\n")
+	return b.String()
+}
+
+func (d *deepCopyCtx) renderStructDeepCopy(b *strings.Builder, s *renderedStruct) {
+	fmt.Fprintf(b, "// DeepCopy returns a copy of x that shares no memory with it, or nil if x is\n// nil.\n")
+	fmt.Fprintf(b, "func (x *%s) DeepCopy() *%s {\n", s.Name, s.Name)
+	b.WriteString("\tif x == nil {\n\t\treturn nil\n\t}\n")
+	b.WriteString("\tout := *x\n")
+	if d.structNeedsCopy(s.Name) {
+		b.WriteString("\tout.deepCopyFields()\n")
+	}
+	b.WriteString("\treturn &out\n}\n\n")
+
+	if d.structNeedsCopy(s.Name) {
+		b.WriteString("// deepCopyFields replaces the memory x shares with the value it was\n// shallow-copied from.\n")
+		fmt.Fprintf(b, "func (x *%s) deepCopyFields() {\n", s.Name)
+		for _, e := range s.Embeds {
+			d.emitCopy(b, e, "x."+e, "\t", 0)
+		}
+		for _, f := range s.Fields {
+			d.emitCopy(b, f.Type, "x."+f.Name, "\t", 0)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("// Equal reports whether x and y hold the same value.\n")
+	fmt.Fprintf(b, "func (x *%s) Equal(y *%s) bool {\n", s.Name, s.Name)
+	b.WriteString("\tif x == nil || y == nil {\n\t\treturn x == y\n\t}\n")
+	var conds []string
+	for _, e := range s.Embeds {
+		conds = append(conds, d.equalExpr(e, "x."+e, "y."+e))
+	}
+	for _, f := range s.Fields {
+		conds = append(conds, d.equalExpr(f.Type, "x."+f.Name, "y."+f.Name))
+	}
+	if len(conds) == 0 {
+		b.WriteString("\treturn true\n}\n\n")
+		return
+	}
+	fmt.Fprintf(b, "\treturn %s\n}\n\n", strings.Join(conds, " &&\n\t\t"))
+}
+
+func renderStructAliasDeepCopy(b *strings.Builder, name, target string) {
+	fmt.Fprintf(b, "// DeepCopy returns a copy of x that shares no memory with it, or nil if x is\n// nil.\n")
+	fmt.Fprintf(b, "func (x *%s) DeepCopy() *%s {\n\treturn (*%s)((*%s)(x).DeepCopy())\n}\n\n", name, name, name, target)
+	b.WriteString("// Equal reports whether x and y hold the same value.\n")
+	fmt.Fprintf(b, "func (x *%s) Equal(y *%s) bool {\n\treturn (*%s)(x).Equal((*%s)(y))\n}\n\n", name, name, target, target)
+}
+
+func (d *deepCopyCtx) renderNamedSliceDeepCopy(b *strings.Builder, name, elem string) {
+	b.WriteString("// DeepCopy returns a copy of x that shares no memory with it.\n")
+	fmt.Fprintf(b, "func (x %s) DeepCopy() %s {\n", name, name)
+	b.WriteString("\tout := slices.Clone(x)\n")
+	d.emitElemLoop(b, elem, "out", "\t", 0)
+	b.WriteString("\treturn out\n}\n\n")
+	b.WriteString("// Equal reports whether x and y hold the same value.\n")
+	fmt.Fprintf(b, "func (x %s) Equal(y %s) bool {\n", name, name)
+	fmt.Fprintf(b, "\treturn equalSlice(x, y, %s)\n}\n\n", d.equalFunc(elem))
+}
+
+func (d *deepCopyCtx) renderUnionDeepCopy(b *strings.Builder, u *unionDecl) {
+	fmt.Fprintf(b, "// deepCopy%s returns a copy of v that shares no memory with it.\n", u.Name)
+	fmt.Fprintf(b, "func deepCopy%s(v %s) %s {\n", u.Name, u.Name, u.Name)
+	var copied []*unionMember
+	for _, m := range u.Members {
+		if d.needsCopy(m.GoType) {
+			copied = append(copied, m)
+		}
+	}
+	if len(copied) > 0 {
+		b.WriteString("\tswitch v := v.(type) {\n")
+		for _, m := range copied {
+			fmt.Fprintf(b, "\tcase %s:\n", m.GoType)
+			var arm strings.Builder
+			d.emitCopy(&arm, m.GoType, "v", "\t\t", 0)
+			// A single reassignment reads better as the return value itself.
+			if stmt, ok := strings.CutPrefix(arm.String(), "\t\tv = "); ok && strings.Count(stmt, "\n") == 1 {
+				fmt.Fprintf(b, "\t\treturn %s", stmt)
+				continue
+			}
+			b.WriteString(arm.String())
+			b.WriteString("\t\treturn v\n")
+		}
+		b.WriteString("\t}\n")
+	}
+	b.WriteString("\treturn v\n}\n\n")
+
+	fmt.Fprintf(b, "// equal%s reports whether a and b hold the same arm and value.\n", u.Name)
+	fmt.Fprintf(b, "func equal%s(a, b %s) bool {\n", u.Name, u.Name)
+	b.WriteString("\tswitch a := a.(type) {\n")
+	for _, m := range u.Members {
+		fmt.Fprintf(b, "\tcase %s:\n", m.GoType)
+		fmt.Fprintf(b, "\t\tb, ok := b.(%s)\n", m.GoType)
+		fmt.Fprintf(b, "\t\treturn ok && %s\n", d.equalExpr(m.GoType, "a", "b"))
+	}
+	b.WriteString("\t}\n\treturn a == nil && b == nil\n}\n\n")
+}
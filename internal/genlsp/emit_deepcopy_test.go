@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderDeepCopyEmission(t *testing.T) {
+	c := &byteDecCtx{
+		structs: map[string]*renderedStruct{
+			"Position": {Name: "Position", Fields: []renderedField{
+				{Name: "Line", Type: "uint32"},
+				{Name: "Character", Type: "uint32"},
+			}},
+			"CompletionItem": {Name: "CompletionItem", Fields: []renderedField{
+				{Name: "Label", Type: "string"},
+				{Name: "Kind", Type: "CompletionItemKind"},
+				{Name: "Detail", Type: "Optional[string]"},
+				{Name: "Documentation", Type: "InlayHintTooltip"},
+				{Name: "Position", Type: "*Position"},
+				{Name: "Data", Type: "LSPAny"},
+				{Name: "Extra", Type: "map[string][]Position"},
+			}},
+		},
+		enumBase:   map[string]string{"CompletionItemKind": "uint32"},
+		aliasType:  map[string]string{},
+		unions:     map[string]*unionDecl{},
+		unionCanon: map[string]string{"InlayHintTooltip": "InlayHintTooltip"},
+		wrapByName: map[string]string{},
+	}
+	c.unions["InlayHintTooltip"] = &unionDecl{Name: "InlayHintTooltip", Members: []*unionMember{
+		{GoType: "String"}, {GoType: "*Position"},
+	}}
+	c.unionDecls = []string{"InlayHintTooltip"}
+	g := &Generator{byteCtx: c}
+	got := g.renderDeepCopy(c, []*renderedStruct{c.structs["Position"], c.structs["CompletionItem"]})
+
+	for _, want := range []string{
+		"func (x *CompletionItem) DeepCopy() *CompletionItem",
+		"func (x *CompletionItem) Equal(y *CompletionItem) bool",
+		"x.Label = cloneString(x.Label)",
+		"x.Detail = NewOptional(v0)",
+		"x.Documentation = deepCopyInlayHintTooltip(x.Documentation)",
+		"x.Position = x.Position.DeepCopy()",
+		"x.Data = slices.Clone(x.Data)",
+		"m0 := make(map[string][]Position, len(x.Extra))",
+		"k0 = cloneString(k0)",
+		"v0 = slices.Clone(v0)",
+		"x.Kind == y.Kind",
+		"equalOptional(x.Detail, y.Detail, equalValue[string])",
+		"equalRaw(x.Data, y.Data)",
+		"equalMap(x.Extra, y.Extra, func(a, b *[]Position) bool { return equalSlice(*a, *b, (*Position).Equal) })",
+		"return cloneString(v)",
+		"func equalInlayHintTooltip(a, b InlayHintTooltip) bool",
+		"case *InlayHintTooltip:",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("renderDeepCopy() missing %q:\n%s", want, got)
+		}
+	}
+	// Scalar-only structs copy with a single assignment.
+	if strings.Contains(got, "func (x *Position) deepCopyFields()") {
+		t.Fatalf("renderDeepCopy() emitted deepCopyFields for a scalar-only struct:\n%s", got)
+	}
+	if len(g.warnings) > 0 {
+		t.Fatalf("renderDeepCopy() warnings: %v", g.warnings)
+	}
+}
+
+func TestGeneratedDeepCopyCoversModel(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	for _, w := range g.warnings {
+		if strings.HasPrefix(w, "deep copy:") {
+			t.Errorf("unexpected warning: %s", w)
+		}
+	}
+	src := string(files["deepcopy.gen.go"])
+	for name := range g.byteCtx.structs {
+		if !strings.Contains(src, "func (x *"+name+") DeepCopy() *"+name+" {") {
+			t.Errorf("struct %s has no DeepCopy method", name)
+		}
+		if !strings.Contains(src, "func (x *"+name+") Equal(y *"+name+") bool {") {
+			t.Errorf("struct %s has no Equal method", name)
+		}
+	}
+	for name := range g.byteCtx.wrapByName {
+		if !strings.Contains(src, "func (x "+name+") DeepCopy() "+name+" {") {
+			t.Errorf("named slice %s has no DeepCopy method", name)
+		}
+	}
+	for _, name := range g.byteCtx.unionDecls {
+		if !strings.Contains(src, "func equal"+name+"(a, b "+name+") bool {") {
+			t.Errorf("union %s has no equal function", name)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// This file emits the RPC layer from a single method table: the exported
+// UnmarshalParams/UnmarshalResult decoders, the serverDispatch and
+// clientDispatch switches, the Server and Client interfaces, the connection
+// backed dispatchers that implement them, and UnimplementedServer and
+// UnimplementedClient. All of them are rendered from the same [methodSpec]
+// list, so a method added to the meta-model gains every piece together.
+
+// methodSpec is one request or notification lowered for the RPC layer.
+type methodSpec struct {
+	Method       string
+	Const        string
+	GoName       string // method name on the Server/Client interfaces
+	Direction    MessageDirection
+	Notification bool
+
+	// Params is the Go type the params decode into ("" when the message takes
+	// none); ParamsPtr reports whether handlers receive it by pointer.
+	Params    string
+	ParamsPtr bool
+
+	// Result is the Go type a request handler returns ("" for notifications
+	// and for requests whose result is always null).
+	Result string
+}
+
+// methodNameOverrides hand-names the Go methods whose mechanical name (the
+// method path minus its namespace) is ambiguous or reads poorly: the two
+// diagnostic pulls would collide, notebook sync would collide with text
+// document sync, and a few names keep their historical plural form.
+//
+// Keyed by LSP method; revisit when metaModel.json renames a method.
+var methodNameOverrides = map[string]string{
+	"callHierarchy/incomingCalls": "IncomingCalls",
+	"callHierarchy/outgoingCalls": "OutgoingCalls",
+	"typeHierarchy/supertypes":    "Supertypes",
+	"typeHierarchy/subtypes":      "Subtypes",
+	"notebookDocument/didOpen":    "DidOpenNotebookDocument",
+	"notebookDocument/didChange":  "DidChangeNotebookDocument",
+	"notebookDocument/didSave":    "DidSaveNotebookDocument",
+	"notebookDocument/didClose":   "DidCloseNotebookDocument",
+	"completionItem/resolve":      "CompletionResolve",
+	"textDocument/foldingRange":   "FoldingRanges",
+	"workspace/symbol":            "Symbols",
+	"workspace/diagnostic":        "DiagnosticWorkspace",
+	"telemetry/event":             "Telemetry",
+}
+
+// methodNamespaces are the leading method path segments dropped when deriving
+// a Go method name (textDocument/hover -> Hover). Other leading segments, such
+// as codeLens in codeLens/resolve, stay part of the name.
+var methodNamespaces = []string{"textDocument/", "workspace/", "window/", "client/", "$/"}
+
+// goMethodName returns the Server/Client Go method name for an LSP method.
+func goMethodName(method string) string {
+	if n, ok := methodNameOverrides[method]; ok {
+		return n
+	}
+	for _, ns := range methodNamespaces {
+		if rest, ok := strings.CutPrefix(method, ns); ok {
+			return exportName(rest)
+		}
+	}
+	return exportName(method)
+}
+
+// messageRank orders messages by spec-document feature; uncategorized methods
+// (rank 0) sort last.
+func messageRank(method string) int {
+	if r := methodRank[method]; r != 0 {
+		return r
+	}
+	return len(specFeatures) + 1
+}
+
+// methodSpecs lowers every request and notification of the model, in spec
+// order. It must run after analyzeMessages so lowering reuses the names that
+// pass already registered.
+func (g *Generator) methodSpecs() []*methodSpec {
+	specs := make([]*methodSpec, 0, len(g.model.Requests)+len(g.model.Notifications))
+	for _, r := range g.model.Requests {
+		s := &methodSpec{
+			Method: r.Method, Const: methodConstName(r.Method), GoName: goMethodName(r.Method),
+			Direction: r.MessageDirection,
+		}
+		s.Params, s.ParamsPtr = g.handlerParams(r.Params, r.Method+"Params")
+		if r.Result != nil && !isNullType(r.Result) {
+			s.Result = g.handlerResult(g.lower(r.Result, r.Method+"Result"))
+		}
+		specs = append(specs, s)
+	}
+	for _, n := range g.model.Notifications {
+		s := &methodSpec{
+			Method: n.Method, Const: methodConstName(n.Method), GoName: goMethodName(n.Method),
+			Direction: n.MessageDirection, Notification: true,
+		}
+		s.Params, s.ParamsPtr = g.handlerParams(n.Params, n.Method+"Params")
+		specs = append(specs, s)
+	}
+	sort.SliceStable(specs, func(i, j int) bool { return messageRank(specs[i].Method) < messageRank(specs[j].Method) })
+	return specs
+}
+
+// isNullType reports whether t is the bare null base type (a void result).
+func isNullType(t *Type) bool {
+	return t.Kind == KindBase && BaseTypeName(t.Name) == BaseNull
+}
+
+// handlerParams lowers a message's params to the type handlers receive.
+// Structures are passed by pointer; every other shape (LSPAny) by value.
+func (g *Generator) handlerParams(p Params, hint string) (typ string, ptr bool) {
+	if len(p) == 0 {
+		return "", false
+	}
+	typ = g.paramsType(p, hint)
+	return typ, g.isStructType(typ)
+}
+
+// handlerResult returns the type a request handler returns for a lowered
+// result: structures are returned by pointer so a null result stays
+// representable, while slices, unions and LSPAny are already nilable.
+func (g *Generator) handlerResult(typ string) string {
+	if g.isStructType(typ) {
+		return "*" + typ
+	}
+	return typ
+}
+
+// isStructType reports whether a lowered Go type names a generated struct.
+func (g *Generator) isStructType(typ string) bool {
+	if _, ok := g.structures[typ]; ok {
+		return true
+	}
+	for _, d := range g.literals {
+		if d.Name == typ {
+			return true
+		}
+	}
+	for _, d := range g.ands {
+		if d.Name == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// serverBound reports whether the server handles the message.
+func (s *methodSpec) serverBound() bool {
+	return s.Direction == DirectionClientToServer || s.Direction == DirectionBoth
+}
+
+// clientBound reports whether the client handles the message.
+func (s *methodSpec) clientBound() bool {
+	return s.Direction == DirectionServerToClient || s.Direction == DirectionBoth
+}
+
+// dispatched reports whether the message routes through serverDispatch or
+// clientDispatch. $/cancelRequest is intercepted by CancelHandler instead.
+func (s *methodSpec) dispatched() bool {
+	return s.Method != "$/cancelRequest"
+}
+
+// paramsArg returns the handler's params argument type ("" for none).
+func (s *methodSpec) paramsArg() string {
+	if s.ParamsPtr {
+		return "*" + s.Params
+	}
+	return s.Params
+}
+
+// signature returns the handler method's parameter and result lists, naming
+// the parameters when named is set (interfaces and dispatchers) and leaving
+// them unnamed otherwise (UnimplementedServer/UnimplementedClient).
+func (s *methodSpec) signature(named bool) string {
+	ctx, params := "context.Context", s.paramsArg()
+	if named {
+		ctx = "ctx " + ctx
+		if params != "" {
+			params = "params " + params
+		}
+	}
+	args := ctx
+	if params != "" {
+		args += ", " + params
+	}
+	if s.Result == "" {
+		return "(" + args + ") error"
+	}
+	return "(" + args + ") (" + s.Result + ", error)"
+}
+
+// kind returns the message kind used in dispatch case comments.
+func (s *methodSpec) kind() string {
+	if s.Notification {
+		return "notification"
+	}
+	return "request"
+}
+
+// rpcSide describes one peer of the RPC layer for rendering.
+type rpcSide struct {
+	recv   string // dispatch switch parameter and dispatcher struct name
+	iface  string // handler interface name
+	bound  func(*methodSpec) bool
+	doc    string // handler interface doc comment
+	extra  string // trailing hand-specified interface methods
+	direct string // dispatcher doc phrase for the message direction
+}
+
+var (
+	serverSide = rpcSide{
+		recv: "server", iface: "Server", bound: (*methodSpec).serverBound,
+		doc: `// Server is the LSP server interface: the set of requests and notifications a
+// language server handles. Its method set is the authoritative shape implemented
+// by [UnimplementedServer].
+`,
+		// Request carries non-standard methods; see ServerHandler.
+		extra:  "\tRequest(ctx context.Context, method string, params any) (any, error)\n",
+		direct: "client->server",
+	}
+	clientSide = rpcSide{
+		recv: "client", iface: "Client", bound: (*methodSpec).clientBound,
+		doc: `// Client is the LSP client interface: the set of requests and notifications a
+// language client handles. Its method set is the authoritative shape implemented
+// by [UnimplementedClient].
+`,
+		direct: "server->client",
+	}
+)
+
+// renderRPCSide emits the handler interface of side and the dispatcher that
+// implements it over a jsonrpc2 connection.
+func renderRPCSide(specs []*methodSpec, side rpcSide) string {
+	var b strings.Builder
+	b.WriteString(side.doc)
+	fmt.Fprintf(&b, "type %s interface {\n", side.iface)
+	for _, s := range specs {
+		if side.bound(s) && s.dispatched() {
+			fmt.Fprintf(&b, "\t%s%s\n", s.GoName, s.signature(true))
+		}
+	}
+	b.WriteString(side.extra)
+	b.WriteString("}\n\n")
+
+	r := side.recv[:1]
+	fmt.Fprintf(&b, "// %s is the [%s] dispatcher: it issues %s requests and\n", side.recv, side.iface, side.direct)
+	b.WriteString("// notifications over a jsonrpc2 connection.\n")
+	fmt.Fprintf(&b, "type %s struct {\n\tjsonrpc2.Conn\n}\n\n", side.recv)
+	fmt.Fprintf(&b, "// compile-time assertion that *%s satisfies %s.\n", side.recv, side.iface)
+	fmt.Fprintf(&b, "var _ %s = (*%s)(nil)\n", side.iface, side.recv)
+	for _, s := range specs {
+		if !side.bound(s) || !s.dispatched() {
+			continue
+		}
+		params := "nil"
+		if s.Params != "" {
+			params = "params"
+		}
+		fmt.Fprintf(&b, "\nfunc (%s *%s) %s%s {\n", r, side.recv, s.GoName, s.signature(true))
+		switch {
+		case s.Notification:
+			fmt.Fprintf(&b, "\treturn %s.Conn.Notify(ctx, %s, %s)\n", r, s.Const, params)
+		case s.Result == "":
+			fmt.Fprintf(&b, "\treturn Call(ctx, %s.Conn, %s, %s, nil)\n", r, s.Const, params)
+		default:
+			fmt.Fprintf(&b, "\tvar result %s\n", s.Result)
+			fmt.Fprintf(&b, "\tif err := Call(ctx, %s.Conn, %s, %s, &result); err != nil {\n\t\treturn nil, err\n\t}\n\n", r, s.Const, params)
+			b.WriteString("\treturn result, nil\n")
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// renderUnimplemented emits UnimplementedServer and UnimplementedClient.
+func renderUnimplemented(specs []*methodSpec) string {
+	var b strings.Builder
+	for i, side := range []rpcSide{serverSide, clientSide} {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		name := "Unimplemented" + side.iface
+		fmt.Fprintf(&b, "// %s is an embeddable default implementation of the [%s]\n", name, side.iface)
+		b.WriteString(`// interface. Each un-overridden request method returns [errNotImplemented]
+// together with the zero value of its result, and each un-overridden
+// notification method returns nil (ignoring the notification), so consumers can
+// embed it and override only the methods they support without an un-overridden
+// notification tearing down the connection.
+`)
+		fmt.Fprintf(&b, "type %s struct{}\n\n", name)
+		fmt.Fprintf(&b, "// compile-time assertion that %s satisfies %s.\n", name, side.iface)
+		fmt.Fprintf(&b, "var _ %s = %s{}\n", side.iface, name)
+		for _, s := range specs {
+			if !side.bound(s) || !s.dispatched() {
+				continue
+			}
+			sig := fmt.Sprintf("func (%s) %s%s", name, s.GoName, s.signature(false))
+			switch {
+			case s.Notification:
+				fmt.Fprintf(&b, "\n%s {\n\treturn nil // %s is a notification; see errNotImplemented.\n}\n", sig, s.Method)
+			case s.Result != "":
+				fmt.Fprintf(&b, "\n%s {\n\treturn nil, errNotImplemented\n}\n", sig)
+			case s.Params == "":
+				fmt.Fprintf(&b, "\n%s { return errNotImplemented }\n", sig)
+			default:
+				fmt.Fprintf(&b, "\n%s {\n\treturn errNotImplemented\n}\n", sig)
+			}
+		}
+	}
+	return b.String()
+}
+
+// renderDispatch emits the typed message decoders and dispatch switches.
+func (g *Generator) renderDispatch(specs []*methodSpec) string {
+	var b strings.Builder
+	renderUnmarshalParams(&b, specs)
+	renderUnmarshalResult(&b, specs)
+	renderDispatchSwitch(&b, specs, serverSide)
+	renderDispatchSwitch(&b, specs, clientSide)
+	return b.String()
+}
+
+func renderUnmarshalParams(b *strings.Builder, specs []*methodSpec) {
+	b.WriteString(`// UnmarshalParams decodes data, the params of a method message, into the Go
+// type the method declares: a pointer to its params structure (e.g.
+// *HoverParams), LSPAny for untyped params, or nil for a method that takes no
+// params. An unknown method reports an error wrapping
+// [jsonrpc2.ErrMethodNotFound].
+func UnmarshalParams(method string, data []byte) (any, error) {
+	switch method {
+`)
+	for _, s := range specs {
+		fmt.Fprintf(b, "\tcase %s:\n", s.Const)
+		switch {
+		case s.Params == "":
+			b.WriteString("\t\treturn nil, nil\n")
+		default:
+			fmt.Fprintf(b, "\t\tvar params %s\n", s.Params)
+			b.WriteString("\t\tif err := Unmarshal(data, &params); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+			if s.ParamsPtr {
+				b.WriteString("\t\treturn &params, nil\n")
+			} else {
+				b.WriteString("\t\treturn params, nil\n")
+			}
+		}
+	}
+	b.WriteString("\tdefault:\n\t\treturn nil, fmt.Errorf(\"%w: %s\", jsonrpc2.ErrMethodNotFound, method)\n\t}\n}\n\n")
+}
+
+func renderUnmarshalResult(b *strings.Builder, specs []*methodSpec) {
+	b.WriteString(`// UnmarshalResult decodes data, the result of a response to a method request,
+// into the Go type the method's handler returns (e.g. *Hover,
+// DefinitionResult, []Location). Requests whose result is always null decode
+// to nil. A notification or unknown method reports an error wrapping
+// [jsonrpc2.ErrMethodNotFound].
+func UnmarshalResult(method string, data []byte) (any, error) {
+	switch method {
+`)
+	var notifications []string
+	for _, s := range specs {
+		if s.Notification {
+			notifications = append(notifications, s.Const)
+			continue
+		}
+		fmt.Fprintf(b, "\tcase %s:\n", s.Const)
+		if s.Result == "" {
+			b.WriteString("\t\treturn nil, nil\n")
+			continue
+		}
+		fmt.Fprintf(b, "\t\tvar result %s\n", s.Result)
+		b.WriteString("\t\tif err := Unmarshal(data, &result); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+		b.WriteString("\t\treturn result, nil\n")
+	}
+	if len(notifications) > 0 {
+		fmt.Fprintf(b, "\tcase %s:\n", strings.Join(notifications, ",\n\t\t"))
+		b.WriteString("\t\treturn nil, fmt.Errorf(\"%w: notification %s has no result\", jsonrpc2.ErrMethodNotFound, method)\n")
+	}
+	b.WriteString("\tdefault:\n\t\treturn nil, fmt.Errorf(\"%w: %s\", jsonrpc2.ErrMethodNotFound, method)\n\t}\n}\n\n")
+}
+
+// renderDispatchSwitch emits <recv>Dispatch, which decodes a request bound for
+// side and invokes the matching handler method.
+func renderDispatchSwitch(b *strings.Builder, specs []*methodSpec, side rpcSide) {
+	recv, iface, bound := side.recv, side.iface, side.bound
+	fmt.Fprintf(b, "// %sDispatch decodes req and invokes the matching [%s] method, reporting\n", recv, iface)
+	fmt.Fprintf(b, "// handled=true when req named a standard %s method.\n", recv)
+	fmt.Fprintf(b, "func %sDispatch(ctx context.Context, %s %s, req *jsonrpc2.Request) (result any, handled bool, err error) {\n", recv, recv, iface)
+	b.WriteString("\tif ctx.Err() != nil {\n\t\treturn nil, true, ErrRequestCancelled\n\t}\n\n")
+	b.WriteString("\tswitch req.Method() {\n")
+	first := true
+	for _, s := range specs {
+		if !bound(s) || !s.dispatched() {
+			continue
+		}
+		if !first {
+			b.WriteString("\n")
+		}
+		first = false
+		fmt.Fprintf(b, "\tcase %s: // %s\n", s.Const, s.kind())
+		args := "ctx"
+		if s.Params != "" {
+			fmt.Fprintf(b, "\t\tvar params %s\n", s.Params)
+			b.WriteString("\t\tif err := Unmarshal(req.Params(), &params); err != nil {\n\t\t\treturn nil, true, replyParseError(err)\n\t\t}\n")
+			if s.ParamsPtr {
+				args += ", &params"
+			} else {
+				args += ", params"
+			}
+		}
+		call := fmt.Sprintf("%s.%s(%s)", recv, s.GoName, args)
+		switch {
+		case s.Result != "":
+			fmt.Fprintf(b, "\t\tresp, err := %s\n\n\t\treturn resp, true, err\n", call)
+		case s.Params != "":
+			fmt.Fprintf(b, "\n\t\treturn nil, true, %s\n", call)
+		default:
+			fmt.Fprintf(b, "\t\treturn nil, true, %s\n", call)
+		}
+	}
+	b.WriteString("\n\tdefault:\n\t\treturn nil, false, nil\n\t}\n}\n\n")
+}
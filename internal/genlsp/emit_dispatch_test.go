@@ -0,0 +1,162 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoMethodName(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		want   string
+	}{
+		"success: textDocument namespace dropped": {method: "textDocument/hover", want: "Hover"},
+		"success: nested path joined":             {method: "workspace/willRenameFiles", want: "WillRenameFiles"},
+		"success: dollar namespace dropped":       {method: "$/setTrace", want: "SetTrace"},
+		"success: other namespaces kept":          {method: "codeLens/resolve", want: "CodeLensResolve"},
+		"success: bare method":                    {method: "initialize", want: "Initialize"},
+		"success: override for collision":         {method: "workspace/diagnostic", want: "DiagnosticWorkspace"},
+		"success: override for notebook sync":     {method: "notebookDocument/didOpen", want: "DidOpenNotebookDocument"},
+		"success: override keeps plural":          {method: "workspace/symbol", want: "Symbols"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := goMethodName(tt.method); got != tt.want {
+				t.Fatalf("goMethodName(%q) = %q, want %q", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethodSpecsFromModel(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("Emit: %v", err)
+	}
+	specs := g.methodSpecs()
+
+	byMethod := make(map[string]*methodSpec, len(specs))
+	goNames := map[string]map[string]string{"server": {}, "client": {}}
+	for _, s := range specs {
+		byMethod[s.Method] = s
+		for side, bound := range map[string]bool{"server": s.serverBound(), "client": s.clientBound()} {
+			if !bound {
+				continue
+			}
+			if prev, ok := goNames[side][s.GoName]; ok {
+				t.Errorf("%s Go method %s named by both %s and %s", side, s.GoName, prev, s.Method)
+			}
+			goNames[side][s.GoName] = s.Method
+		}
+	}
+
+	tests := map[string]struct {
+		method    string
+		params    string
+		paramsPtr bool
+		result    string
+	}{
+		"success: struct params and result":    {method: "textDocument/hover", params: "HoverParams", paramsPtr: true, result: "*Hover"},
+		"success: union result":                {method: "textDocument/definition", params: "DefinitionParams", paramsPtr: true, result: "DefinitionResult"},
+		"success: void request":                {method: "shutdown"},
+		"success: untyped notification":        {method: "telemetry/event", params: "LSPAny"},
+		"success: params without result":       {method: "textDocument/didOpen", params: "DidOpenTextDocumentParams", paramsPtr: true},
+		"success: no params with slice result": {method: "workspace/workspaceFolders", result: "[]WorkspaceFolder"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := byMethod[tt.method]
+			if s == nil {
+				t.Fatalf("no spec for %s", tt.method)
+			}
+			if s.Params != tt.params || s.ParamsPtr != tt.paramsPtr || s.Result != tt.result {
+				t.Fatalf("spec(%s) = params %q ptr %v result %q, want %q %v %q",
+					tt.method, s.Params, s.ParamsPtr, s.Result, tt.params, tt.paramsPtr, tt.result)
+			}
+		})
+	}
+
+	src := string(files["dispatch.gen.go"])
+	for _, want := range []string{
+		"func UnmarshalParams(method string, data []byte) (any, error)",
+		"func UnmarshalResult(method string, data []byte) (any, error)",
+		"func serverDispatch(ctx context.Context, server Server, req *jsonrpc2.Request)",
+		"func clientDispatch(ctx context.Context, client Client, req *jsonrpc2.Request)",
+		"resp, err := server.Hover(ctx, &params)",
+		"return nil, true, client.Telemetry(ctx, params)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("dispatch.gen.go missing %q", want)
+		}
+	}
+	if strings.Contains(src, "case MethodCancelRequest: // ") {
+		t.Errorf("dispatch.gen.go routes $/cancelRequest, want it left to CancelHandler")
+	}
+}
+
+func TestRenderRPCSideAndUnimplemented(t *testing.T) {
+	specs := []*methodSpec{
+		{Method: "textDocument/hover", Const: "MethodTextDocumentHover", GoName: "Hover", Direction: DirectionClientToServer, Params: "HoverParams", ParamsPtr: true, Result: "*Hover"},
+		{Method: "shutdown", Const: "MethodShutdown", GoName: "Shutdown", Direction: DirectionClientToServer},
+		{Method: "$/progress", Const: "MethodProgress", GoName: "Progress", Direction: DirectionBoth, Notification: true, Params: "ProgressParams", ParamsPtr: true},
+		{Method: "$/cancelRequest", Const: "MethodCancelRequest", GoName: "CancelRequest", Direction: DirectionBoth, Notification: true, Params: "CancelParams", ParamsPtr: true},
+		{Method: "telemetry/event", Const: "MethodTelemetryEvent", GoName: "Telemetry", Direction: DirectionServerToClient, Notification: true, Params: "LSPAny"},
+	}
+
+	tests := map[string]struct {
+		got     string
+		want    []string
+		notWant []string
+	}{
+		"success: server interface and dispatcher": {
+			got: renderRPCSide(specs, serverSide),
+			want: []string{
+				"Hover(ctx context.Context, params *HoverParams) (*Hover, error)\n",
+				"Shutdown(ctx context.Context) error\n",
+				"Progress(ctx context.Context, params *ProgressParams) error\n",
+				"Request(ctx context.Context, method string, params any) (any, error)\n}",
+				"if err := Call(ctx, s.Conn, MethodTextDocumentHover, params, &result); err != nil {",
+				"return Call(ctx, s.Conn, MethodShutdown, nil, nil)",
+				"return s.Conn.Notify(ctx, MethodProgress, params)",
+			},
+			notWant: []string{"CancelRequest", "Telemetry"},
+		},
+		"success: client interface and dispatcher": {
+			got: renderRPCSide(specs, clientSide),
+			want: []string{
+				"Telemetry(ctx context.Context, params LSPAny) error\n",
+				"return c.Conn.Notify(ctx, MethodTelemetryEvent, params)",
+				"return c.Conn.Notify(ctx, MethodProgress, params)",
+			},
+			notWant: []string{"Hover", "Request(", "CancelRequest"},
+		},
+		"success: unimplemented": {
+			got: renderUnimplemented(specs),
+			want: []string{
+				"func (UnimplementedServer) Hover(context.Context, *HoverParams) (*Hover, error) {\n\treturn nil, errNotImplemented\n}",
+				"func (UnimplementedServer) Shutdown(context.Context) error { return errNotImplemented }",
+				"func (UnimplementedClient) Telemetry(context.Context, LSPAny) error {\n\treturn nil // telemetry/event is a notification; see errNotImplemented.\n}",
+				"func (UnimplementedClient) Progress(context.Context, *ProgressParams) error {",
+			},
+			notWant: []string{"CancelRequest", "UnimplementedClient) Hover"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, want := range tt.want {
+				if !strings.Contains(tt.got, want) {
+					t.Errorf("missing %q:\n%s", want, tt.got)
+				}
+			}
+			for _, bad := range tt.notWant {
+				if strings.Contains(tt.got, bad) {
+					t.Errorf("unexpected %q:\n%s", bad, tt.got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"math"
+	"strings"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// This file emits protocol.schema.json, a JSON Schema (draft 2020-12) of the
+// protocol messages for tooling outside Go. Its $defs hold one schema per
+// named type under the Go type name, the same set strict decoding knows (see
+// emit_shapes.go), and one "<method>/params" and "<method>/result" schema per
+// method. Objects carry their inherited properties and stay open, as the
+// protocol lets peers send members it does not declare, matching
+// ValidateParams; unions become anyOf, since their arms may overlap.
+// protocol.strict.schema.json is the same document with closed objects,
+// matching what strict decoding accepts.
+
+// SchemaFileName is the name of the JSON Schema file [Generator.Emit] returns
+// alongside the Go sources.
+const SchemaFileName = "protocol.schema.json"
+
+// StrictSchemaFileName is the name of the JSON Schema file with closed
+// objects that [Generator.Emit] returns alongside [SchemaFileName].
+const StrictSchemaFileName = "protocol.strict.schema.json"
+
+// schemaDialect is the JSON Schema dialect of the emitted schema.
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// schema is a JSON Schema, or the boolean schema when boolean is set. Fields
+// are in the order they are written.
+type schema struct {
+	boolean *bool
+
+	Dialect              string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitzero"`
+	Type                 string             `json:"type,omitempty"`
+	Const                any                `json:"const,omitzero"`
+	Enum                 []jsontext.Value   `json:"enum,omitempty"`
+	Minimum              *int64             `json:"minimum,omitempty"`
+	Maximum              *int64             `json:"maximum,omitempty"`
+	Properties           schemaProperties   `json:"properties,omitzero"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *schema            `json:"propertyNames,omitempty"`
+	PrefixItems          []*schema          `json:"prefixItems,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	AnyOf                []*schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*schema `json:"$defs,omitempty"`
+}
+
+// MarshalJSONTo writes s, or true or false for a boolean schema.
+func (s *schema) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if s.boolean != nil {
+		return enc.WriteToken(jsontext.Bool(*s.boolean))
+	}
+	type plain schema // drops this method
+	return json.MarshalEncode(enc, (*plain)(s))
+}
+
+// schemaProperties is the properties keyword, kept in declaration order.
+type schemaProperties []schemaProperty
+
+type schemaProperty struct {
+	name   string
+	schema *schema
+}
+
+// MarshalJSONTo writes p as an object in declaration order.
+func (p schemaProperties) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	for _, prop := range p {
+		if err := enc.WriteToken(jsontext.String(prop.name)); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, prop.schema); err != nil {
+			return err
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
+
+// schemaFalse is the schema no value satisfies.
+func schemaFalse() *schema {
+	f := false
+	return &schema{boolean: &f}
+}
+
+// schemaRef returns a reference to the definition of name.
+func schemaRef(name string) *schema {
+	return &schema{Ref: "#/$defs/" + escapeSchemaPointer(name)}
+}
+
+// escapeSchemaPointer escapes a JSON Pointer reference token (RFC 6901).
+func escapeSchemaPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// integerSchema returns an integer schema bounded to [lo, math.MaxInt32].
+func integerSchema(lo int64) *schema {
+	hi := int64(math.MaxInt32)
+	return &schema{Type: "integer", Minimum: &lo, Maximum: &hi}
+}
+
+// renderSchema returns the JSON Schema document of the protocol, with closed
+// objects when strict is set.
+func (g *Generator) renderSchema(strict bool) ([]byte, error) {
+	g.strictSchema = strict
+	defs := map[string]*schema{}
+	for _, t := range g.modelTypes() {
+		var s *schema
+		switch {
+		case t.object:
+			s = g.objectSchema(t.props)
+		case t.enum != nil:
+			s = enumSchema(t.enum)
+		default:
+			s = g.typeSchema(t.typ)
+		}
+		describe(s, t.doc, t.deprecated)
+		defs[t.name] = s
+	}
+	for _, r := range g.model.Requests {
+		defs[r.Method+"/params"] = describe(g.paramsSchema(r.Params), r.Documentation, r.Deprecated)
+		result := &schema{Type: "null"}
+		if r.Result != nil {
+			result = g.typeSchema(r.Result)
+		}
+		defs[r.Method+"/result"] = result
+	}
+	for _, n := range g.model.Notifications {
+		defs[n.Method+"/params"] = describe(g.paramsSchema(n.Params), n.Documentation, n.Deprecated)
+	}
+
+	title := "Language Server Protocol"
+	if g.version != "" {
+		title += " " + g.version
+	}
+	if strict {
+		title += " (strict)"
+	}
+	doc := &schema{Dialect: schemaDialect, Title: title, Defs: defs}
+	out, err := json.Marshal(doc, json.Deterministic(true), jsontext.WithIndent("  "))
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// describe sets the annotations of s and returns it.
+func describe(s *schema, doc, deprecated string) *schema {
+	if s.boolean != nil {
+		return s
+	}
+	s.Description = doc
+	s.Deprecated = deprecated != ""
+	return s
+}
+
+// paramsSchema returns the schema of a method's params: null when it takes
+// none, and a tuple for several params sent positionally.
+func (g *Generator) paramsSchema(p Params) *schema {
+	switch len(p) {
+	case 0:
+		return &schema{Type: "null"}
+	case 1:
+		return g.typeSchema(p[0])
+	default:
+		return g.typeSchema(&Type{Kind: KindTuple, Items: p})
+	}
+}
+
+// objectSchema returns an object schema over props, closed when rendering
+// the strict schema.
+func (g *Generator) objectSchema(props []*Property) *schema {
+	s := &schema{Type: "object"}
+	if g.strictSchema {
+		s.AdditionalProperties = schemaFalse()
+	}
+	for _, p := range props {
+		ps := describe(g.typeSchema(p.Type), p.Documentation, p.Deprecated)
+		s.Properties = append(s.Properties, schemaProperty{name: p.Name, schema: ps})
+		if !p.Optional {
+			s.Required = append(s.Required, p.Name)
+		}
+	}
+	return s
+}
+
+// enumSchema returns the schema of an enumeration: its values when closed,
+// any value of its base type when it supports custom values.
+func enumSchema(e *Enumeration) *schema {
+	var base *schema
+	switch e.Type.Name {
+	case BaseInteger:
+		base = integerSchema(math.MinInt32)
+	case BaseUinteger:
+		base = integerSchema(0)
+	default:
+		base = &schema{Type: "string"}
+	}
+	values := &schema{}
+	for _, v := range e.Values {
+		values.Enum = append(values.Enum, v.Value)
+	}
+	if !e.SupportsCustomValues {
+		return values
+	}
+	return &schema{AnyOf: []*schema{values, base}}
+}
+
+// typeSchema returns the schema of a meta-model type.
+func (g *Generator) typeSchema(t *Type) *schema {
+	switch t.Kind {
+	case KindBase:
+		switch BaseTypeName(t.Name) {
+		case BaseInteger:
+			return integerSchema(math.MinInt32)
+		case BaseUinteger:
+			return integerSchema(0)
+		case BaseDecimal:
+			return &schema{Type: "number"}
+		case BaseBoolean:
+			return &schema{Type: "boolean"}
+		case BaseNull:
+			return &schema{Type: "null"}
+		default: // string, URI, DocumentUri, RegExp
+			return &schema{Type: "string"}
+		}
+	case KindReference:
+		switch t.Name {
+		case "LSPAny":
+			return &schema{}
+		case "LSPObject":
+			return &schema{Type: "object"}
+		case "LSPArray":
+			return &schema{Type: "array"}
+		}
+		return schemaRef(t.Name)
+	case KindArray:
+		return &schema{Type: "array", Items: g.typeSchema(t.Element)}
+	case KindMap:
+		s := &schema{Type: "object", AdditionalProperties: g.typeSchema(t.Value)}
+		if t.Key.Kind == KindReference {
+			s.PropertyNames = schemaRef(t.Key.Name)
+		}
+		return s
+	case KindAnd:
+		var fields []*Property
+		for _, it := range t.Items {
+			if s, ok := g.structures[it.Name]; ok && it.Kind == KindReference {
+				fields = mergeProperties(fields, g.structureFields(s, map[string]bool{}))
+			}
+		}
+		return g.objectSchema(fields)
+	case KindOr:
+		s := &schema{}
+		for _, it := range t.Items {
+			s.AnyOf = append(s.AnyOf, g.typeSchema(it))
+		}
+		return s
+	case KindTuple:
+		n := len(t.Items)
+		s := &schema{Type: "array", Items: schemaFalse(), MinItems: &n}
+		for _, it := range t.Items {
+			s.PrefixItems = append(s.PrefixItems, g.typeSchema(it))
+		}
+		return s
+	case KindLiteral:
+		return g.objectSchema(t.Literal.Properties)
+	case KindStringLiteral:
+		return &schema{Const: t.StringValue}
+	case KindIntegerLiteral:
+		return &schema{Const: t.IntegerValue}
+	case KindBooleanLiteral:
+		return &schema{Const: t.BooleanValue}
+	default:
+		g.warnf("schema: unhandled kind %q", t.Kind)
+		return &schema{}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+func TestRenderSchema(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	if err := g.SetVersion("3.18"); err != nil {
+		t.Fatal(err)
+	}
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	src := files[SchemaFileName]
+	var doc struct {
+		Dialect string                    `json:"$schema"`
+		Title   string                    `json:"title"`
+		Defs    map[string]jsontext.Value `json:"$defs"`
+	}
+	if err := json.Unmarshal(src, &doc); err != nil {
+		t.Fatalf("%s is not valid JSON: %v", SchemaFileName, err)
+	}
+	if doc.Dialect != schemaDialect {
+		t.Errorf("$schema = %q, want %q", doc.Dialect, schemaDialect)
+	}
+	// The title carries the version set on the generator, not the model's
+	// metaData.version.
+	if want := "Language Server Protocol 3.18.0"; doc.Title != want {
+		t.Errorf("title = %q, want %q", doc.Title, want)
+	}
+
+	tests := map[string]string{
+		// Open objects with inherited properties, required members and
+		// integer ranges.
+		"Position": `{"description":"*","type":"object","properties":{"line":{"description":"*","type":"integer","minimum":0,"maximum":2147483647},` +
+			`"character":{"description":"*","type":"integer","minimum":0,"maximum":2147483647}},"required":["line","character"]}`,
+		// Closed and open enumerations.
+		"SymbolKind":     `{"description":"*","enum":[1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23,24,25,26]}`,
+		"CodeActionKind": `{"description":"*","anyOf":[{"enum":["","quickfix","refactor","refactor.extract","refactor.inline","refactor.move","refactor.rewrite","source","source.organizeImports","source.fixAll","notebook"]},{"type":"string"}]}`,
+		// Unions under their Go names.
+		"InlayHintTooltip": `{"anyOf":[{"type":"string"},{"$ref":"#/$defs/MarkupContent"}]}`,
+		// Method params and results; methods without params take null.
+		"textDocument/hover/params": `{"$ref":"#/$defs/HoverParams","description":"*"}`,
+		"textDocument/hover/result": `{"anyOf":[{"$ref":"#/$defs/Hover"},{"type":"null"}]}`,
+		"shutdown/params":           `{"description":"*","type":"null"}`,
+		"shutdown/result":           `{"type":"null"}`,
+	}
+	for name, want := range tests {
+		got, ok := doc.Defs[name]
+		if !ok {
+			t.Errorf("$defs missing %q", name)
+			continue
+		}
+		if got := elideDescriptions(t, got); got != want {
+			t.Errorf("$defs[%q] = %s\nwant %s", name, got, want)
+		}
+	}
+	if _, ok := doc.Defs["exit/result"]; ok {
+		t.Error("notification exit has a result schema")
+	}
+
+	// Every reference resolves, and every generated type and method has a
+	// definition.
+	for _, m := range regexp.MustCompile(`"\$ref": "#/\$defs/([^"]+)"`).FindAllSubmatch(src, -1) {
+		name := strings.NewReplacer("~1", "/", "~0", "~").Replace(string(m[1]))
+		if _, ok := doc.Defs[name]; !ok {
+			t.Errorf("dangling $ref to %q", name)
+		}
+	}
+	for name := range g.byteCtx.structs {
+		if _, ok := doc.Defs[name]; !ok {
+			t.Errorf("generated struct %s has no schema", name)
+		}
+	}
+	for _, s := range g.methodSpecs() {
+		if _, ok := doc.Defs[s.Method+"/params"]; !ok {
+			t.Errorf("method %s has no params schema", s.Method)
+		}
+	}
+}
+
+func TestRenderStrictSchema(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	var open, strict struct {
+		Title string                    `json:"title"`
+		Defs  map[string]jsontext.Value `json:"$defs"`
+	}
+	if err := json.Unmarshal(files[SchemaFileName], &open); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(files[StrictSchemaFileName], &strict); err != nil {
+		t.Fatalf("%s is not valid JSON: %v", StrictSchemaFileName, err)
+	}
+	if want := "Language Server Protocol (strict)"; strict.Title != want {
+		t.Errorf("title = %q, want %q", strict.Title, want)
+	}
+	if len(strict.Defs) != len(open.Defs) {
+		t.Errorf("strict schema has %d definitions, open schema %d", len(strict.Defs), len(open.Defs))
+	}
+
+	// Objects are closed, while maps keep their value schema.
+	const position = `{"description":"*","type":"object","properties":{"line":{"description":"*","type":"integer","minimum":0,"maximum":2147483647},` +
+		`"character":{"description":"*","type":"integer","minimum":0,"maximum":2147483647}},"required":["line","character"],"additionalProperties":false}`
+	if got := elideDescriptions(t, strict.Defs["Position"]); got != position {
+		t.Errorf("$defs[%q] = %s\nwant %s", "Position", got, position)
+	}
+	if got := elideDescriptions(t, strict.Defs["WorkspaceEdit"]); !strings.Contains(got, `"additionalProperties":{"type":"array"`) {
+		t.Errorf("$defs[%q] = %s lost its map value schema", "WorkspaceEdit", got)
+	}
+	if strings.Contains(string(files[SchemaFileName]), `"additionalProperties": false`) {
+		t.Errorf("%s closes an object", SchemaFileName)
+	}
+}
+
+// descriptionRE matches a description annotation in compact JSON.
+var descriptionRE = regexp.MustCompile(`"description":"(?:[^"\\]|\\.)*"`)
+
+// elideDescriptions returns v compacted, with every description replaced by
+// "*".
+func elideDescriptions(t *testing.T, v jsontext.Value) string {
+	t.Helper()
+
+	v = v.Clone()
+	if err := v.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	return descriptionRE.ReplaceAllString(string(v), `"description":"*"`)
+}
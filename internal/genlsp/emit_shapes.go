@@ -0,0 +1,320 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-json-experiment/json"
+)
+
+// This file emits the meta-model type shapes behind strict decoding. Each
+// named type (structure, enumeration, type alias, and the synthesized
+// literal, and-merge and union types, under their Go names) becomes one
+// `var shapeX = &shape{...}` declaration, and the `shapes` index maps Go type
+// names to them. Shapes are built from the meta-model rather than the lowered
+// Go types so they keep what lowering erases: null arms, literal values,
+// integer ranges and which properties are required. Named types are
+// referenced through shapeRefTo, so recursive structures need no
+// initialization order. The methodShapes index pairs every standard method
+// with the shapes of its params and result.
+
+// modelType is a named type of the generated package as the meta-model
+// declares it: an object over props, an enumeration, or an alias of typ.
+type modelType struct {
+	name       string
+	doc        string
+	deprecated string
+	props      []*Property // objects; nil otherwise
+	object     bool
+	enum       *Enumeration
+	typ        *Type // aliases and unions
+}
+
+// modelTypes returns every named type of the generated package sorted by Go
+// name: structures, enumerations, type aliases, and the synthesized literal,
+// and-merge and union types. The first declaration of a name wins.
+func (g *Generator) modelTypes() []*modelType {
+	byName := map[string]*modelType{}
+	add := func(t *modelType) {
+		if _, ok := byName[t.name]; !ok {
+			byName[t.name] = t
+		}
+	}
+	for _, s := range g.model.Structures {
+		if !strings.HasPrefix(s.Name, "_") {
+			add(&modelType{
+				name: s.Name, doc: s.Documentation, deprecated: s.Deprecated,
+				props: g.structureFields(s, map[string]bool{}), object: true,
+			})
+		}
+	}
+	for _, e := range g.model.Enumerations {
+		add(&modelType{name: e.Name, doc: e.Documentation, deprecated: e.Deprecated, enum: e})
+	}
+	for _, a := range g.model.TypeAliases {
+		if _, ok := wellKnownAny(a.Name); ok {
+			continue // hand-written LSPAny, LSPObject and LSPArray
+		}
+		add(&modelType{name: a.Name, doc: a.Documentation, deprecated: a.Deprecated, typ: a.Type})
+	}
+	for _, sig := range g.literalOrder {
+		d := g.literals[sig]
+		add(&modelType{name: d.Name, doc: d.Lit.Documentation, props: d.Lit.Properties, object: true})
+	}
+	for _, sig := range g.andOrder {
+		d := g.ands[sig]
+		var fields []*Property
+		for _, op := range d.Operands {
+			if s, ok := g.structures[op]; ok {
+				fields = mergeProperties(fields, g.structureFields(s, map[string]bool{}))
+			}
+		}
+		add(&modelType{name: d.Name, props: fields, object: true})
+	}
+	for _, sig := range g.unionOrder {
+		u := g.unions[sig]
+		add(&modelType{name: u.Name, typ: &Type{Kind: KindOr, Items: u.Items}})
+	}
+
+	out := make([]*modelType, 0, len(byName))
+	for _, t := range byName {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
+
+// renderShapes emits the shape declarations and their index.
+func (g *Generator) renderShapes() string {
+	types := g.modelTypes()
+	var b strings.Builder
+	for _, t := range types {
+		var expr string
+		switch {
+		case t.object:
+			expr = g.objectShape(t.name, t.props)
+		case t.enum != nil:
+			expr = enumShape(t.enum)
+		default:
+			expr = g.namedShape(t.name, t.typ)
+		}
+		fmt.Fprintf(&b, "var shape%s = %s\n\n", t.name, expr)
+	}
+	b.WriteString("// shapes indexes the meta-model shape of every generated type by Go type\n// name, for strict decoding.\n")
+	b.WriteString("var shapes = map[string]*shape{\n")
+	for _, t := range types {
+		fmt.Fprintf(&b, "\t%q: shape%s,\n", t.name, t.name)
+	}
+	b.WriteString("}\n")
+	g.renderMethodShapes(&b)
+	return b.String()
+}
+
+// renderMethodShapes emits the methodShapes index of the params and result
+// shapes of every request and notification, for runtime validation.
+func (g *Generator) renderMethodShapes(b *strings.Builder) {
+	entries := map[string]string{}
+	for _, r := range g.model.Requests {
+		result := "shapeOfNull"
+		if r.Result != nil {
+			result = g.shapeExpr(r.Result)
+		}
+		entries[r.Method] = fmt.Sprintf("{%sresult: %s}", g.paramsShape(r.Params), result)
+	}
+	for _, n := range g.model.Notifications {
+		entries[n.Method] = fmt.Sprintf("{%snotification: true}", g.paramsShape(n.Params))
+	}
+	methods := make([]string, 0, len(entries))
+	for m := range entries {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+
+	b.WriteString("\n// methodShapes indexes the params and result shapes of every standard\n// method, for runtime validation.\n")
+	b.WriteString("var methodShapes = map[string]methodShape{\n")
+	for _, m := range methods {
+		fmt.Fprintf(b, "\t%q: %s,\n", m, entries[m])
+	}
+	b.WriteString("}\n")
+}
+
+// paramsShape renders the params field of a methodShape, or "" when the
+// method takes none. The meta-model allows several params, sent positionally
+// as an array.
+func (g *Generator) paramsShape(p Params) string {
+	switch len(p) {
+	case 0:
+		return ""
+	case 1:
+		return "params: " + g.shapeExpr(p[0]) + ", "
+	default:
+		return "params: " + g.shapeExpr(&Type{Kind: KindTuple, Items: p}) + ", "
+	}
+}
+
+// structureFields returns the properties of s including those it inherits
+// through extends and mixins; a property s declares itself wins.
+func (g *Generator) structureFields(s *Structure, visited map[string]bool) []*Property {
+	if visited[s.Name] {
+		return nil
+	}
+	visited[s.Name] = true
+	var out []*Property
+	for _, refs := range [...][]*Type{s.Extends, s.Mixins} {
+		for _, ref := range refs {
+			if base, ok := g.structures[ref.Name]; ok && ref.Kind == KindReference {
+				out = mergeProperties(out, g.structureFields(base, visited))
+			}
+		}
+	}
+	return mergeProperties(out, s.Properties)
+}
+
+// mergeProperties appends props to out, replacing properties of the same name.
+func mergeProperties(out, props []*Property) []*Property {
+	for _, p := range props {
+		replaced := false
+		for i, q := range out {
+			if q.Name == p.Name {
+				out[i] = p
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// objectShape renders a shapeObject over props; name is "" for inline
+// literals.
+func (g *Generator) objectShape(name string, props []*Property) string {
+	var b strings.Builder
+	b.WriteString("&shape{kind: shapeObject")
+	if name != "" {
+		fmt.Fprintf(&b, ", name: %q", name)
+	}
+	b.WriteString(", fields: []shapeField{")
+	for _, p := range props {
+		fmt.Fprintf(&b, "\n{name: %q", p.Name)
+		if p.Optional {
+			b.WriteString(", optional: true")
+		}
+		fmt.Fprintf(&b, ", typ: %s},", g.shapeExpr(p.Type))
+	}
+	if len(props) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("}}")
+	return b.String()
+}
+
+// namedShape renders t as the shape declared for name. Composite shapes carry
+// the name for messages; shared base and reference shapes do not.
+func (g *Generator) namedShape(name string, t *Type) string {
+	expr := g.shapeExpr(t)
+	if !strings.HasPrefix(expr, "&shape{kind: ") {
+		return expr
+	}
+	kind, rest, _ := strings.Cut(expr, ", ")
+	return fmt.Sprintf("%s, name: %q, %s", kind, name, rest)
+}
+
+// enumShape renders an enumeration with its values as decoded text.
+func enumShape(e *Enumeration) string {
+	base := "shapeString"
+	switch e.Type.Name {
+	case BaseInteger:
+		base = "shapeInteger"
+	case BaseUinteger:
+		base = "shapeUinteger"
+	default:
+	}
+	values := make([]string, 0, len(e.Values))
+	for _, v := range e.Values {
+		text := string(v.Value)
+		var s string
+		if json.Unmarshal(v.Value, &s) == nil {
+			text = s
+		}
+		values = append(values, strconv.Quote(text))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "&shape{kind: shapeEnum, name: %q, base: %s", e.Name, base)
+	if e.SupportsCustomValues {
+		b.WriteString(", open: true")
+	}
+	fmt.Fprintf(&b, ", values: []string{%s}}", strings.Join(values, ", "))
+	return b.String()
+}
+
+// shapeExpr renders the shape of a meta-model type.
+func (g *Generator) shapeExpr(t *Type) string {
+	switch t.Kind {
+	case KindBase:
+		switch BaseTypeName(t.Name) {
+		case BaseInteger:
+			return "shapeOfInteger"
+		case BaseUinteger:
+			return "shapeOfUinteger"
+		case BaseDecimal:
+			return "shapeOfDecimal"
+		case BaseBoolean:
+			return "shapeOfBoolean"
+		case BaseNull:
+			return "shapeOfNull"
+		default: // string, URI, DocumentUri, RegExp
+			return "shapeOfString"
+		}
+	case KindReference:
+		switch t.Name {
+		case "LSPAny":
+			return "shapeOfAny"
+		case "LSPObject":
+			return "&shape{kind: shapeMap, elem: shapeOfAny}"
+		case "LSPArray":
+			return "&shape{kind: shapeArray, elem: shapeOfAny}"
+		}
+		return fmt.Sprintf("shapeRefTo(%q)", t.Name)
+	case KindArray:
+		return fmt.Sprintf("&shape{kind: shapeArray, elem: %s}", g.shapeExpr(t.Element))
+	case KindMap:
+		return fmt.Sprintf("&shape{kind: shapeMap, elem: %s}", g.shapeExpr(t.Value))
+	case KindAnd:
+		var fields []*Property
+		for _, it := range t.Items {
+			if s, ok := g.structures[it.Name]; ok && it.Kind == KindReference {
+				fields = mergeProperties(fields, g.structureFields(s, map[string]bool{}))
+			}
+		}
+		return g.objectShape("", fields)
+	case KindOr, KindTuple:
+		kind := "shapeOr"
+		if t.Kind == KindTuple {
+			kind = "shapeTuple"
+		}
+		items := make([]string, len(t.Items))
+		for i, it := range t.Items {
+			items[i] = g.shapeExpr(it)
+		}
+		return fmt.Sprintf("&shape{kind: %s, items: []*shape{%s}}", kind, strings.Join(items, ", "))
+	case KindLiteral:
+		return g.objectShape("", t.Literal.Properties)
+	case KindStringLiteral:
+		return fmt.Sprintf("&shape{kind: shapeStringLiteral, values: []string{%q}}", t.StringValue)
+	case KindIntegerLiteral:
+		return fmt.Sprintf("&shape{kind: shapeIntegerLiteral, values: []string{%q}}", strconv.FormatInt(t.IntegerValue, 10))
+	case KindBooleanLiteral:
+		return fmt.Sprintf("&shape{kind: shapeBooleanLiteral, values: []string{%q}}", strconv.FormatBool(t.BooleanValue))
+	default:
+		g.warnf("shape: unhandled kind %q", t.Kind)
+		return "shapeOfAny"
+	}
+}
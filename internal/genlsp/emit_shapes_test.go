@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderShapes(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	src := string(files["shapes.gen.go"])
+
+	for _, want := range []string{
+		// Required and optional properties, including inherited ones.
+		`var shapeCompletionItem = &shape{kind: shapeObject, name: "CompletionItem", fields: []shapeField{`,
+		`{name: "label", typ: shapeOfString},`,
+		`{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},`,
+		// Literal values and closed versus open enumerations.
+		`{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"create"}}},`,
+		`var shapeSymbolKind = &shape{kind: shapeEnum, name: "SymbolKind", base: shapeUinteger, values: []string{"1", "2",`,
+		`var shapeCodeActionKind = &shape{kind: shapeEnum, name: "CodeActionKind", base: shapeString, open: true,`,
+		// Unions under their Go names, and raw values.
+		`var shapeInlayHintTooltip = &shape{kind: shapeOr, name: "InlayHintTooltip", items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}`,
+		`{name: "data", optional: true, typ: shapeOfAny},`,
+		"var shapeDocumentChange = &shape{kind: shapeOr",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("shapes.gen.go missing %q", want)
+		}
+	}
+	// Method params and results, aligned by gofmt; methods without params
+	// omit them.
+	flat := strings.Join(strings.Fields(src), " ")
+	for _, want := range []string{
+		`"textDocument/hover": {params: shapeRefTo("HoverParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Hover"), shapeOfNull}}},`,
+		`"shutdown": {result: shapeOfNull},`,
+		`"exit": {notification: true},`,
+	} {
+		if !strings.Contains(flat, want) {
+			t.Errorf("shapes.gen.go missing method shape %q", want)
+		}
+	}
+	for name := range g.byteCtx.structs {
+		if !strings.Contains(src, "\nvar shape"+name+" = ") {
+			t.Errorf("generated struct %s has no shape", name)
+		}
+	}
+}
@@ -410,6 +410,9 @@ func TestEmitIncludesGeneratedMarkerAndExpectedFiles(t *testing.T) {
 		}
 	}
 	for name, data := range files {
+		if name == SchemaFileName || name == StrictSchemaFileName {
+			continue
+		}
 		if !strings.HasSuffix(name, ".gen.go") {
 			t.Fatalf("generated filename %s does not end with .gen.go", name)
 		}
@@ -434,7 +437,7 @@ func TestEmitIncludesGeneratedMarkerAndExpectedFiles(t *testing.T) {
 		"func (x *ClientCapabilities) unmarshalLSP(raw []byte, i int) (int, error)",
 		"func (x *DocumentSelector) UnmarshalJSONFrom",
 		"func (x *SemanticTokensOptionsRange) UnmarshalJSONFrom",
-		"func unmarshalUnionRoot(data []byte, v any) (bool, error)",
+		"func unmarshalUnionRoot(v any, own func() []byte) (bool, error)",
 		"func unmarshalSliceCompletionItem(",
 	} {
 		if !strings.Contains(decoderFile, want) {
@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// This file emits the unionArms table behind union decode diagnostics. A
+// union decoder that rejects a value only reports the union and the value;
+// under UnmarshalOptions.DiagnoseUnions the runtime replays the value against
+// every arm listed here, recording the discriminating keys each arm checks
+// and the error its decoder returns. The table is never consulted on the
+// decode path itself.
+//
+// Each union's arms are a declaration of their own that registers itself in
+// the table, so gating proposed features splits the table entry by entry:
+// only unions whose arms differ between the builds are emitted twice.
+
+// renderUnionArms emits a registration of the arms of every union, by union
+// Go name, with arms in declaration order.
+func (g *Generator) renderUnionArms() string {
+	names := make([]string, 0, len(g.unions))
+	byName := map[string]*unionDecl{}
+	for _, sig := range g.unionOrder {
+		u := g.unions[sig]
+		names = append(names, u.Name)
+		byName[u.Name] = u
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "var unionArms%s = registerUnionArms(%q, []unionArm{\n", name, name)
+		for _, m := range byName[name].Members {
+			if m.Token == 'n' {
+				continue // null always decodes to a nil union
+			}
+			fmt.Fprintf(&b, "\t{name: %q, kind: %s", m.GoType, quoteByte(m.Token))
+			if m.KindConst != "" {
+				fmt.Fprintf(&b, ", kindConst: %q", m.KindConst)
+			}
+			required, known := m.Required, m.AllKeys
+			if m.Token == '[' {
+				required, known = m.ElemRequired, m.ElemAllKeys
+				if len(known) > 0 {
+					b.WriteString(", elem: true")
+				}
+			}
+			if len(required) > 0 {
+				fmt.Fprintf(&b, ", required: %s", quoteSlice(required))
+			}
+			if len(known) > 0 {
+				fmt.Fprintf(&b, ", known: %s", quoteSlice(known))
+			}
+			fmt.Fprintf(&b, ", decode: %s},\n", g.armDecodeFunc(m))
+		}
+		b.WriteString("})\n\n")
+	}
+	return b.String()
+}
+
+// armDecodeFunc renders a function decoding a value as the arm m alone, with
+// the decoder the union dispatcher uses for it.
+func (g *Generator) armDecodeFunc(m *unionMember) string {
+	base := strings.TrimPrefix(m.Receiver, "*")
+	if base == unionURIWrapperType {
+		return "decodeScalarArm(dvScalarURI)"
+	}
+	if under, ok := scalarWrapperBase[base]; ok {
+		return fmt.Sprintf("decodeScalarArm(dvScalar%s)", exportName(under))
+	}
+	if g.byteCtx != nil && g.byteCtx.armByteCovered(m.Receiver) {
+		return fmt.Sprintf("decodeArm[%s]", base)
+	}
+	return fmt.Sprintf("decodeArmWith[%s]", base)
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderUnionArms(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	src := string(files["union_arms.gen.go"])
+
+	for _, want := range []string{
+		// Scalar arms decode through the scalar decoders.
+		`{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},`,
+		// Object arms carry their discriminating keys and kind constant.
+		`{name: "*MarkupContent", kind: '{', required: []string{"kind", "value"}, known: []string{"kind", "value"}, decode: decodeArm[MarkupContent]},`,
+		`{name: "*CreateFile", kind: '{', kindConst: "create", required: []string{"kind", "uri"},`,
+		// Array arms check the keys of their first element.
+		`{name: "CompletionItemSlice", kind: '[', elem: true, required: []string{"label"},`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("union_arms.gen.go missing %q", want)
+		}
+	}
+	for _, u := range g.unions {
+		if !strings.Contains(src, "var unionArms"+u.Name+" = registerUnionArms(\""+u.Name+"\", []unionArm{\n") {
+			t.Errorf("union %s has no arms entry", u.Name)
+		}
+	}
+}
+
+func TestEmitGatedSplitsUnionArmsByUnion(t *testing.T) {
+	files, err := NewGenerator(loadTestModel(t), "protocol").EmitGated()
+	if err != nil {
+		t.Fatalf("EmitGated: %v", err)
+	}
+	shared := string(files["union_arms.gen.go"])
+	if !strings.Contains(shared, `registerUnionArms("TextDocumentContentChangeEvent"`) {
+		t.Error("union_arms.gen.go does not hold the arms both builds share")
+	}
+	// CodeAction gains the proposed tags member, so only the unions holding
+	// it differ between the builds.
+	for _, name := range []string{"union_arms_proposed.gen.go", "union_arms_stable.gen.go"} {
+		src := string(files[name])
+		if n := strings.Count(src, "registerUnionArms("); n == 0 || strings.Contains(src, "TextDocumentContentChangeEvent") {
+			t.Errorf("%s registers %d unions, want only those whose arms differ", name, n)
+		}
+	}
+}
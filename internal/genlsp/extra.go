@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file implements the opt-in capture of unknown JSON members. No
+// structure captures them unless named with SetExtraFields; one that is gains an Extra field holding the members its
+// JSON object carries beyond the declared ones. The byte walkers record them
+// instead of skipping them, and both encoders write them back after the
+// declared members, so a proxy can edit the known fields of a value from a
+// newer peer without dropping vendor or future-spec members.
+
+// extraFieldName is the Go name of the field holding unknown members.
+const extraFieldName = "Extra"
+
+// SetExtraFields makes the named structures preserve unknown members. Each
+// must be a public structure of the model that no other structure extends or
+// mixes in, since an embedded Extra field would capture members on behalf of
+// its embedder.
+func (g *Generator) SetExtraFields(names ...string) error {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := g.structures[name]; !ok || strings.HasPrefix(name, "_") {
+			return fmt.Errorf("extra fields: %q is not a public structure", name)
+		}
+		set[name] = true
+	}
+	for _, s := range g.model.Structures {
+		for _, refs := range [...][]*Type{s.Extends, s.Mixins} {
+			for _, ref := range refs {
+				if ref.Kind == KindReference && set[ref.Name] {
+					return fmt.Errorf("extra fields: %s is embedded by %s", ref.Name, s.Name)
+				}
+			}
+		}
+	}
+	g.extraFields = set
+	return nil
+}
+
+// extraField returns the Extra field of a structure preserving unknown
+// members. It has no JSON name, so flattenJSONFields leaves it to the
+// emitters that handle it explicitly. Its tag uses the catch-all option of
+// json v2, which the pinned version spells embed (it ignores unknown), so
+// reflection based encoding treats it the same way.
+func extraField() renderedField {
+	return renderedField{
+		Name: extraFieldName,
+		Type: "LSPObject",
+		Tag:  ",embed",
+		Doc: "// Extra holds the members of the JSON object this type does not declare,\n" +
+			"// keyed by name, so they survive decoding and re-encoding. They are\n" +
+			"// written after the declared members in key order. Encoding fails if a\n" +
+			"// key names a declared member.\n",
+	}
+}
+
+// hasExtraField reports whether s preserves unknown members.
+func hasExtraField(s *renderedStruct) bool {
+	for _, f := range s.Fields {
+		if f.Name == extraFieldName && f.JSONName == "" {
+			return true
+		}
+	}
+	return false
+}
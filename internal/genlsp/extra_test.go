@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+func TestExtraFieldsOptIn(t *testing.T) {
+	files, err := NewGenerator(loadTestModel(t), "protocol").Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	for name, src := range files {
+		if strings.Contains(string(src), "Extra LSPObject") {
+			t.Errorf("%s has an Extra field without SetExtraFields", name)
+		}
+	}
+}
+
+func TestSetExtraFields(t *testing.T) {
+	g := NewGenerator(loadTestModel(t), "protocol")
+	for _, name := range []string{"NoSuchType", "_InitializeParams", "TextDocumentPositionParams"} {
+		if err := g.SetExtraFields(name); err == nil {
+			t.Errorf("SetExtraFields(%q) succeeded", name)
+		}
+	}
+	if err := g.SetExtraFields("Hover"); err != nil {
+		t.Fatalf("SetExtraFields: %v", err)
+	}
+	files, err := g.Emit()
+	if err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	hover := string(files["hover.gen.go"])
+	if !strings.Contains(hover, "\tExtra LSPObject `json:\",embed\"`\n") {
+		t.Error("Hover has no Extra field")
+	}
+	for file, want := range map[string]string{
+		"decoders.gen.go":        "n, err := dvExtraMember(raw, key, i, &x.Extra)",
+		"encoders.gen.go":        "if err := encodeExtraMembersTo(enc, x.Extra, shapeHover); err != nil {",
+		"append_encoders.gen.go": "if dst, err = appendExtraMembers(dst, &first, x.Extra, shapeHover); err != nil {",
+		"deepcopy.gen.go":        "for k0, v0 := range x.Extra {",
+	} {
+		if got := strings.Count(string(files[file]), want); got != 1 {
+			t.Errorf("%s has %d of %q, want 1", file, got, want)
+		}
+	}
+	if strings.Contains(string(files["decoders.gen.go"]), `keyEquals(key, "")`) {
+		t.Error("Extra is decoded as a declared member")
+	}
+}
+
+func TestExtraFieldTag(t *testing.T) {
+	// A struct tagged as the generator tags Extra must capture unknown
+	// members through json v2 reflection, not encode a member named Extra.
+	typ := reflect.StructOf([]reflect.StructField{
+		{Name: "Label", Type: reflect.TypeFor[string](), Tag: `json:"label"`},
+		{Name: extraFieldName, Type: reflect.TypeFor[map[string]jsontext.Value](), Tag: reflect.StructTag(`json:"` + extraField().Tag + `"`)},
+	})
+	const input = `{"label":"a","x-vendor":1}`
+	v := reflect.New(typ)
+	if err := json.Unmarshal([]byte(input), v.Interface()); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := v.Elem().Field(1).Len(); got != 1 {
+		t.Errorf("Extra holds %d members, want 1", got)
+	}
+	out, err := json.Marshal(v.Interface())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("marshal = %s, want %s", out, input)
+	}
+}
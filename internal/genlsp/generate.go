@@ -51,6 +51,13 @@ type Generator struct {
 	unionAliases []unionAlias    // role-name aliases to a canonical union shape
 	aliasNames   map[string]bool // names reserved by unionAliases
 
+	history *history // older meta-models for introduction dating; see SetHistory
+	version string   // protocol version the model describes; see SetVersion
+
+	strictSchema bool // renderSchema is rendering closed objects
+
+	extraFields map[string]bool // structures preserving unknown members; see SetExtraFields
+
 	warnings []string
 }
 
@@ -64,6 +71,7 @@ type unionDecl struct {
 	Name    string
 	Members []*unionMember
 	Doc     string
+	Items   []*Type // the non-null meta-model arms the union was lowered from
 }
 
 // unionMember is one arm of a [unionDecl].
@@ -321,7 +329,7 @@ func (g *Generator) union(items []*Type, hint string) *unionDecl {
 		return u
 	}
 	name := g.uniqueUnionName(members)
-	u := &unionDecl{Name: name, Members: members}
+	u := &unionDecl{Name: name, Members: members, Items: items}
 	g.unions[sig] = u
 	g.unionByName[name] = u
 	g.unionOrder = append(g.unionOrder, sig)
@@ -370,7 +378,7 @@ func (g *Generator) preferUnionName(items []*Type, name, doc string) string {
 		// pass falls back to its structural AOrB name.
 		return ""
 	}
-	u := &unionDecl{Name: name, Members: members, Doc: doc}
+	u := &unionDecl{Name: name, Members: members, Doc: doc, Items: items}
 	g.unions[sig] = u
 	g.unionByName[name] = u
 	g.unionOrder = append(g.unionOrder, sig)
@@ -0,0 +1,415 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// This file gates proposed protocol features behind the lsp_proposed build tag.
+// The model is generated twice, with and without its proposed items, and the
+// two outputs are merged declaration by declaration: declarations both builds
+// agree on stay in the untagged file, and the rest land in a _proposed file
+// (lsp_proposed) or a _stable file (!lsp_proposed). Splitting after rendering
+// keeps every renderer unaware of gating, at the cost of duplicating only the
+// declarations a proposed item actually touches.
+
+// ProposedBuildTag is the build tag that opts a build into proposed features.
+const ProposedBuildTag = "lsp_proposed"
+
+// isProposed reports whether an item is proposed: flagged so by the model, or
+// introduced with a "<version> - proposed" since tag (the form the published
+// 3.18 model uses in place of the flag).
+func isProposed(proposed bool, since string) bool {
+	return proposed || strings.HasSuffix(strings.TrimSpace(since), "- proposed")
+}
+
+// WithoutProposed returns a copy of m with every proposed item removed, along
+// with properties, union arms, aliases and messages that only reach a removed
+// type. m is not modified.
+func (m *MetaModel) WithoutProposed() *MetaModel {
+	removed := map[string]bool{}
+	for _, s := range m.Structures {
+		if isProposed(s.Proposed, s.Since) {
+			removed[s.Name] = true
+		}
+	}
+	for _, e := range m.Enumerations {
+		if isProposed(e.Proposed, e.Since) {
+			removed[e.Name] = true
+		}
+	}
+	for _, a := range m.TypeAliases {
+		if isProposed(a.Proposed, a.Since) {
+			removed[a.Name] = true
+		}
+	}
+	// An alias left with no usable type is removed too, which may strand
+	// further aliases; iterate to a fixed point.
+	for changed := true; changed; {
+		changed = false
+		for _, a := range m.TypeAliases {
+			if !removed[a.Name] && pruneType(a.Type, removed) == nil {
+				removed[a.Name] = true
+				changed = true
+			}
+		}
+	}
+
+	out := &MetaModel{MetaData: m.MetaData}
+	for _, s := range m.Structures {
+		if removed[s.Name] {
+			continue
+		}
+		c := *s
+		c.Properties = pruneProperties(s.Properties, removed)
+		c.Extends = pruneTypes(s.Extends, removed)
+		c.Mixins = pruneTypes(s.Mixins, removed)
+		out.Structures = append(out.Structures, &c)
+	}
+	for _, e := range m.Enumerations {
+		if removed[e.Name] {
+			continue
+		}
+		c := *e
+		c.Values = slices.DeleteFunc(slices.Clone(e.Values), func(v *EnumerationEntry) bool {
+			return isProposed(v.Proposed, v.Since)
+		})
+		out.Enumerations = append(out.Enumerations, &c)
+	}
+	for _, a := range m.TypeAliases {
+		if removed[a.Name] {
+			continue
+		}
+		c := *a
+		c.Type = pruneType(a.Type, removed)
+		out.TypeAliases = append(out.TypeAliases, &c)
+	}
+	for _, r := range m.Requests {
+		if isProposed(r.Proposed, r.Since) || !typesAvailable(removed, r.Result, r.PartialResult, r.RegistrationOptions) ||
+			!typesAvailable(removed, r.Params...) {
+			continue
+		}
+		out.Requests = append(out.Requests, r)
+	}
+	for _, n := range m.Notifications {
+		if isProposed(n.Proposed, n.Since) || !typesAvailable(removed, n.RegistrationOptions) ||
+			!typesAvailable(removed, n.Params...) {
+			continue
+		}
+		out.Notifications = append(out.Notifications, n)
+	}
+	return out
+}
+
+// typesAvailable reports whether every non-nil t survives pruning unchanged.
+func typesAvailable(removed map[string]bool, types ...*Type) bool {
+	for _, t := range types {
+		if t != nil && pruneType(t, removed) != t {
+			return false
+		}
+	}
+	return true
+}
+
+func pruneProperties(props []*Property, removed map[string]bool) []*Property {
+	var out []*Property
+	for _, p := range props {
+		if isProposed(p.Proposed, p.Since) {
+			continue
+		}
+		t := pruneType(p.Type, removed)
+		if t == nil {
+			continue
+		}
+		if t != p.Type {
+			c := *p
+			c.Type = t
+			p = &c
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func pruneTypes(types []*Type, removed map[string]bool) []*Type {
+	var out []*Type
+	for _, t := range types {
+		if t = pruneType(t, removed); t != nil {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// pruneType returns t without the parts that reach a removed type: union arms
+// are dropped, and any other use makes the whole type unusable (nil). t itself
+// is returned when nothing changed.
+func pruneType(t *Type, removed map[string]bool) *Type {
+	switch t.Kind {
+	case KindReference:
+		if removed[t.Name] {
+			return nil
+		}
+	case KindArray:
+		e := pruneType(t.Element, removed)
+		if e == nil {
+			return nil
+		}
+		if e != t.Element {
+			c := *t
+			c.Element = e
+			return &c
+		}
+	case KindMap:
+		v := pruneType(t.Value, removed)
+		if v == nil {
+			return nil
+		}
+		if v != t.Value {
+			c := *t
+			c.Value = v
+			return &c
+		}
+	case KindOr:
+		items := pruneTypes(t.Items, removed)
+		switch {
+		case len(items) == 0:
+			return nil
+		case len(items) == 1:
+			return items[0]
+		case !slices.Equal(items, t.Items):
+			c := *t
+			c.Items = items
+			return &c
+		}
+	case KindAnd, KindTuple:
+		items := pruneTypes(t.Items, removed)
+		if len(items) != len(t.Items) {
+			return nil
+		}
+		if !slices.Equal(items, t.Items) {
+			c := *t
+			c.Items = items
+			return &c
+		}
+	case KindLiteral:
+		props := pruneProperties(t.Literal.Properties, removed)
+		if !slices.Equal(props, t.Literal.Properties) {
+			lit := *t.Literal
+			lit.Properties = props
+			c := *t
+			c.Literal = &lit
+			return &c
+		}
+	default:
+	}
+	return t
+}
+
+// EmitGated is [Generator.Emit] with proposed features gated: it renders the
+// model with and without its proposed items and returns the merged files, in
+// which whatever only one build needs carries a build constraint on
+// [ProposedBuildTag]. Gating removes proposed items from the default build
+// even if an ungated release exported them, as happened to the 3.18 code
+// action tags; use [Generator.Emit] to keep them.
+func (g *Generator) EmitGated() (map[string][]byte, error) {
+	full, err := g.Emit()
+	if err != nil {
+		return nil, err
+	}
+	sg := NewGenerator(g.model.WithoutProposed(), g.pkg)
+	sg.history = g.history
+	sg.version = g.version
+	sg.extraFields = g.extraFields
+	stable, err := sg.Emit()
+	if err != nil {
+		return nil, fmt.Errorf("stable build: %w", err)
+	}
+	return splitProposed(g.pkg, stable, full)
+}
+
+// splitProposed merges the files generated from the full model with those
+// generated from its stable subset.
+func splitProposed(pkg string, stable, full map[string][]byte) (map[string][]byte, error) {
+	out := make(map[string][]byte, len(full))
+	names := map[string]bool{}
+	for n := range stable {
+		names[n] = true
+	}
+	for n := range full {
+		names[n] = true
+	}
+	for name := range names {
+		s, f := stable[name], full[name]
+		switch {
+		case bytes.Equal(s, f):
+			out[name] = f
+		case !strings.HasSuffix(name, ".go"):
+			out[name] = f // documents the full model, proposed features included
+		case s == nil:
+			out[gatedFileName(name, "proposed")] = withBuildTag(f, ProposedBuildTag)
+		case f == nil:
+			out[gatedFileName(name, "stable")] = withBuildTag(s, "!"+ProposedBuildTag)
+		default:
+			if err := splitFile(pkg, name, s, f, out); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return out, nil
+}
+
+// gatedFileName returns name with a variant suffix before ".gen.go".
+func gatedFileName(name, variant string) string {
+	stem, _ := strings.CutSuffix(name, ".gen.go")
+	return stem + "_" + variant + ".gen.go"
+}
+
+// withBuildTag inserts a build constraint after the file's leading comments.
+func withBuildTag(src []byte, tag string) []byte {
+	i := bytes.Index(src, []byte("\npackage "))
+	if i < 0 {
+		return src
+	}
+	var b bytes.Buffer
+	b.Write(src[:i+1])
+	fmt.Fprintf(&b, "//go:build %s\n\n", tag)
+	b.Write(src[i+1:])
+	return b.Bytes()
+}
+
+// splitFile splits one file that differs between the builds into its shared
+// declarations and its per-build declarations.
+func splitFile(pkg, name string, stable, full []byte, out map[string][]byte) error {
+	sDecls, err := fileDecls(stable)
+	if err != nil {
+		return err
+	}
+	fDecls, err := fileDecls(full)
+	if err != nil {
+		return err
+	}
+	inStable := make(map[string]string, len(sDecls))
+	for _, d := range sDecls {
+		inStable[d.key] = d.text
+	}
+	shared := map[string]bool{}
+	var common, proposed strings.Builder
+	for _, d := range fDecls {
+		if t, ok := inStable[d.key]; ok && t == d.text {
+			shared[d.key] = true
+			common.WriteString(d.text)
+			common.WriteString("\n\n")
+			continue
+		}
+		proposed.WriteString(d.text)
+		proposed.WriteString("\n\n")
+	}
+	var stableOnly strings.Builder
+	for _, d := range sDecls {
+		if !shared[d.key] {
+			stableOnly.WriteString(d.text)
+			stableOnly.WriteString("\n\n")
+		}
+	}
+
+	for _, part := range []struct {
+		name, body, tag string
+	}{
+		{name, common.String(), ""},
+		{gatedFileName(name, "proposed"), proposed.String(), ProposedBuildTag},
+		{gatedFileName(name, "stable"), stableOnly.String(), "!" + ProposedBuildTag},
+	} {
+		if part.body == "" {
+			continue
+		}
+		src, err := formatFile(pkg, part.body)
+		if err != nil {
+			return err
+		}
+		if part.tag != "" {
+			src = withBuildTag(src, part.tag)
+		}
+		out[part.name] = src
+	}
+	return nil
+}
+
+// genDecl is one top-level declaration of a generated file: its identity and
+// its source, including the comments that precede it.
+type genDecl struct {
+	key  string
+	text string
+}
+
+func fileDecls(src []byte) ([]genDecl, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var decls []genDecl
+	seen := map[string]int{}
+	prevEnd := fset.Position(f.Name.End()).Offset
+	for _, d := range f.Decls {
+		end := fset.Position(d.End()).Offset
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			prevEnd = end
+			continue
+		}
+		key := declKey(d)
+		seen[key]++
+		if n := seen[key]; n > 1 {
+			key = fmt.Sprintf("%s#%d", key, n)
+		}
+		decls = append(decls, genDecl{key: key, text: strings.TrimSpace(string(src[prevEnd:end]))})
+		prevEnd = end
+	}
+	return decls, nil
+}
+
+// declKey names a declaration: "Recv.Method" for methods and the joined
+// declared names otherwise.
+func declKey(d ast.Decl) string {
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil || len(d.Recv.List) == 0 {
+			return d.Name.Name
+		}
+		return exprString(d.Recv.List[0].Type) + "." + d.Name.Name
+	case *ast.GenDecl:
+		var names []string
+		for _, s := range d.Specs {
+			switch s := s.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, n := range s.Names {
+					names = append(names, n.Name)
+				}
+			}
+		}
+		return d.Tok.String() + " " + strings.Join(names, ",")
+	}
+	return ""
+}
+
+func exprString(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	case *ast.Ident:
+		return e.Name
+	case *ast.IndexExpr:
+		return exprString(e.X) + "[" + exprString(e.Index) + "]"
+	}
+	return fmt.Sprintf("%T", e)
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWithoutProposed(t *testing.T) {
+	ref := func(name string) *Type { return &Type{Kind: KindReference, Name: name} }
+	str := &Type{Kind: KindBase, Name: string(BaseString)}
+	m := &MetaModel{
+		Structures: []*Structure{
+			{Name: "New", Since: "3.18.0 - proposed"},
+			{Name: "Thing", Properties: []*Property{
+				{Name: "name", Type: str},
+				{Name: "flagged", Type: str, Proposed: true},
+				{Name: "tagged", Type: str, Since: "3.18.0 - proposed"},
+				{Name: "uses", Type: &Type{Kind: KindArray, Element: ref("New")}},
+				{Name: "either", Type: &Type{Kind: KindOr, Items: []*Type{str, ref("New")}}},
+			}},
+		},
+		Enumerations: []*Enumeration{{Name: "Kind", Values: []*EnumerationEntry{
+			{Name: "old"},
+			{Name: "new", Proposed: true},
+		}}},
+		TypeAliases: []*TypeAlias{{Name: "NewAlias", Type: ref("New")}},
+		Requests: []*Request{
+			{Method: "thing/get", Result: ref("Thing")},
+			{Method: "new/get", Result: ref("NewAlias")},
+		},
+	}
+
+	got := m.WithoutProposed()
+
+	if len(got.Structures) != 1 || got.Structures[0].Name != "Thing" {
+		t.Fatalf("structures = %v, want only Thing", got.Structures)
+	}
+	props := got.Structures[0].Properties
+	if len(props) != 2 || props[0].Name != "name" || props[1].Name != "either" {
+		t.Fatalf("Thing properties = %v, want name and either", props)
+	}
+	if either := props[1].Type; either.Kind != KindBase {
+		t.Fatalf("either kind = %q, want the remaining base arm", either.Kind)
+	}
+	if vals := got.Enumerations[0].Values; len(vals) != 1 || vals[0].Name != "old" {
+		t.Fatalf("Kind values = %v, want only old", vals)
+	}
+	if len(got.TypeAliases) != 0 {
+		t.Fatalf("aliases = %v, want NewAlias removed with its target", got.TypeAliases)
+	}
+	if len(got.Requests) != 1 || got.Requests[0].Method != "thing/get" {
+		t.Fatalf("requests = %v, want only thing/get", got.Requests)
+	}
+	if len(m.Structures) != 2 || len(m.Structures[1].Properties) != 5 || len(m.Enumerations[0].Values) != 2 {
+		t.Fatal("WithoutProposed modified its receiver")
+	}
+}
+
+func TestSplitProposed(t *testing.T) {
+	file := func(decls ...string) []byte {
+		src, err := formatFile("protocol", strings.Join(decls, "\n\n"))
+		if err != nil {
+			t.Fatalf("formatFile: %v", err)
+		}
+		return src
+	}
+	stable := map[string][]byte{
+		"same.gen.go":  file("type Same int"),
+		"mixed.gen.go": file("// A is shared.\ntype A int", "type B struct{ X int }"),
+		"schema.json":  []byte(`{}`),
+	}
+	full := map[string][]byte{
+		"same.gen.go":  file("type Same int"),
+		"mixed.gen.go": file("// A is shared.\ntype A int", "type B struct{ X, Y int }", "type C int"),
+		"new.gen.go":   file("type New int"),
+		"schema.json":  []byte(`{"New":{}}`),
+	}
+
+	got, err := splitProposed("protocol", stable, full)
+	if err != nil {
+		t.Fatalf("splitProposed: %v", err)
+	}
+
+	tests := map[string]struct {
+		tag     string
+		want    []string
+		notWant []string
+	}{
+		"same.gen.go":           {want: []string{"type Same int"}},
+		"mixed.gen.go":          {want: []string{"// A is shared.\ntype A int"}, notWant: []string{"type B", "type C"}},
+		"mixed_proposed.gen.go": {tag: ProposedBuildTag, want: []string{"X, Y int", "type C int"}, notWant: []string{"type A"}},
+		"mixed_stable.gen.go":   {tag: "!" + ProposedBuildTag, want: []string{"X int"}, notWant: []string{"type A", "type C"}},
+		"new_proposed.gen.go":   {tag: ProposedBuildTag, want: []string{"type New int"}},
+		"schema.json":           {want: []string{`"New"`}},
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("splitProposed returned %d files, want %d: %v", len(got), len(tests), sortedFileNames(got))
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			src, ok := got[name]
+			if !ok {
+				t.Fatalf("missing %s", name)
+			}
+			hasTag := bytes.Contains(src, []byte("//go:build "+tt.tag+"\n\npackage protocol"))
+			if tt.tag != "" && !hasTag {
+				t.Fatalf("%s lacks //go:build %s:\n%s", name, tt.tag, src)
+			}
+			if tt.tag == "" && bytes.Contains(src, []byte("//go:build")) {
+				t.Fatalf("%s has a build constraint:\n%s", name, src)
+			}
+			for _, w := range tt.want {
+				if !bytes.Contains(src, []byte(w)) {
+					t.Fatalf("%s missing %q:\n%s", name, w, src)
+				}
+			}
+			for _, w := range tt.notWant {
+				if bytes.Contains(src, []byte(w)) {
+					t.Fatalf("%s unexpectedly contains %q:\n%s", name, w, src)
+				}
+			}
+		})
+	}
+}
+
+func TestEmitGatedKeepsStableBuildFreeOfProposedTypes(t *testing.T) {
+	files, err := NewGenerator(loadTestModel(t), "protocol").EmitGated()
+	if err != nil {
+		t.Fatalf("EmitGated: %v", err)
+	}
+	for name, src := range files {
+		if strings.HasSuffix(name, "_proposed.gen.go") || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if bytes.Contains(src, []byte("CodeActionTag")) {
+			t.Fatalf("%s is part of the default build but mentions CodeActionTag", name)
+		}
+	}
+	if !bytes.Contains(files["code_action_proposed.gen.go"], []byte("type CodeActionTag uint32")) {
+		t.Fatal("code_action_proposed.gen.go does not declare CodeActionTag")
+	}
+	if !bytes.Contains(files[SchemaFileName], []byte(`"CodeActionTag": {`)) {
+		t.Fatalf("%s does not document the proposed CodeActionTag", SchemaFileName)
+	}
+}
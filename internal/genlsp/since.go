@@ -0,0 +1,289 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// This file derives the protocol version that introduced each type, field and
+// method. The meta-model records it in free-form "since" prose that mixes
+// introductions ("3.16.0", "3.18.0 - proposed") with later amendments ("3.17.0
+// - support for relative patterns."); only the former name an introduction.
+// Items without a usable tag can be dated against older meta-models supplied
+// with [Generator.SetHistory].
+
+// VersionedModel is a meta-model paired with the protocol version it describes.
+// The metaData.version field of published models is not reliable (the 3.18
+// model reports 3.17.0), so the version is supplied by the caller.
+type VersionedModel struct {
+	Version string
+	Model   *MetaModel
+}
+
+// history indexes older meta-models for introduction-version inference.
+type history struct {
+	current string // version of the generated model
+	models  []historyModel
+}
+
+type historyModel struct {
+	version string
+	types   map[string]bool // structure, enumeration and alias names
+	fields  map[string]bool // "Structure.property"
+	methods map[string]bool
+}
+
+// SetVersion records the protocol version the model describes, which titles
+// the JSON Schema. The model's own metaData.version is not used, as it is not
+// reliable (see [VersionedModel]).
+func (g *Generator) SetVersion(version string) error {
+	v, ok := normalizeVersion(version)
+	if !ok {
+		return fmt.Errorf("invalid version %q", version)
+	}
+	g.version = v
+	return nil
+}
+
+// SetHistory registers older meta-models. An item the model leaves untagged is
+// then dated by the oldest registered model that declares it; an item no
+// registered model declares is dated current, the version of the generated
+// model. Items present in the oldest model stay undated, as they predate every
+// tracked version.
+func (g *Generator) SetHistory(current string, older ...VersionedModel) error {
+	cur, ok := normalizeVersion(current)
+	if !ok {
+		return fmt.Errorf("invalid current version %q", current)
+	}
+	h := &history{current: cur}
+	for _, vm := range older {
+		v, ok := normalizeVersion(vm.Version)
+		if !ok {
+			return fmt.Errorf("invalid history version %q", vm.Version)
+		}
+		h.models = append(h.models, indexHistory(v, vm.Model))
+	}
+	sort.SliceStable(h.models, func(i, j int) bool {
+		return compareVersions(h.models[i].version, h.models[j].version) < 0
+	})
+	g.history = h
+	return nil
+}
+
+func indexHistory(version string, m *MetaModel) historyModel {
+	hm := historyModel{
+		version: version,
+		types:   make(map[string]bool),
+		fields:  make(map[string]bool),
+		methods: make(map[string]bool),
+	}
+	structures := make(map[string]*Structure, len(m.Structures))
+	for _, s := range m.Structures {
+		structures[s.Name] = s
+	}
+	// Index each structure's properties together with those it inherits, so a
+	// field the generated model flattens from a private base still matches.
+	var collect func(owner string, s *Structure, seen map[string]bool)
+	collect = func(owner string, s *Structure, seen map[string]bool) {
+		if seen[s.Name] {
+			return
+		}
+		seen[s.Name] = true
+		for _, p := range s.Properties {
+			hm.fields[owner+"."+p.Name] = true
+		}
+		for _, refs := range [...][]*Type{s.Extends, s.Mixins} {
+			for _, ref := range refs {
+				if base, ok := structures[ref.Name]; ok && ref.Kind == KindReference {
+					collect(owner, base, seen)
+				}
+			}
+		}
+	}
+	for _, s := range m.Structures {
+		hm.types[s.Name] = true
+		collect(s.Name, s, map[string]bool{})
+	}
+	for _, e := range m.Enumerations {
+		hm.types[e.Name] = true
+	}
+	for _, a := range m.TypeAliases {
+		hm.types[a.Name] = true
+	}
+	for _, r := range m.Requests {
+		hm.methods[r.Method] = true
+	}
+	for _, n := range m.Notifications {
+		hm.methods[n.Method] = true
+	}
+	return hm
+}
+
+// sinceKind selects the history index an item is looked up in.
+type sinceKind int
+
+const (
+	sinceType sinceKind = iota
+	sinceField
+	sinceMethod
+)
+
+// introduced returns the normalized version that introduced the item key of
+// kind, preferring the model's since/sinceTags and falling back to history.
+// It returns "" when the item predates every tracked version. An empty key
+// (an item of a synthesized type, which older models cannot name) is dated by
+// its tags alone.
+func (g *Generator) introduced(kind sinceKind, key, since string, tags []string) string {
+	if v := introducedIn(since, tags); v != "" {
+		return v
+	}
+	h := g.history
+	if key == "" || h == nil || len(h.models) == 0 {
+		return ""
+	}
+	for i, hm := range h.models {
+		var present bool
+		switch kind {
+		case sinceType:
+			present = hm.types[key]
+		case sinceField:
+			present = hm.fields[key]
+		case sinceMethod:
+			present = hm.methods[key]
+		}
+		if !present {
+			continue
+		}
+		if i == 0 {
+			return ""
+		}
+		return hm.version
+	}
+	return h.current
+}
+
+// sinceVersionRe matches the version a since tag opens with, and the
+// remainder that tells an introduction from an amendment.
+var sinceVersionRe = regexp.MustCompile(`^(?:version\s+)?(\d+)\.(\d+)(?:\.(\d+))?\.?\s*(.*)$`)
+
+// introducedIn returns the version an item was introduced in from its since
+// tag and sinceTags, or "" when neither records an introduction. A tag is an
+// introduction when it is a bare version, optionally marked "- proposed"; any
+// other trailing prose records an amendment to an existing item.
+func introducedIn(since string, tags []string) string {
+	best := ""
+	for _, tag := range append([]string{since}, tags...) {
+		v, ok := introductionVersion(tag)
+		if ok && (best == "" || compareVersions(v, best) < 0) {
+			best = v
+		}
+	}
+	return best
+}
+
+func introductionVersion(tag string) (string, bool) {
+	m := sinceVersionRe.FindStringSubmatch(strings.TrimSpace(tag))
+	if m == nil {
+		return "", false
+	}
+	if rest := strings.TrimSpace(m[4]); rest != "" && rest != "- proposed" {
+		return "", false
+	}
+	patch := m[3]
+	if patch == "" {
+		patch = "0"
+	}
+	return m[1] + "." + m[2] + "." + patch, true
+}
+
+// normalizeVersion returns v as "major.minor.patch".
+func normalizeVersion(v string) (string, bool) {
+	m := sinceVersionRe.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil || m[4] != "" {
+		return "", false
+	}
+	patch := m[3]
+	if patch == "" {
+		patch = "0"
+	}
+	return m[1] + "." + m[2] + "." + patch, true
+}
+
+// compareVersions compares two normalized versions.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range 3 {
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// renderSince emits the type and field introduction tables. Methods carry
+// their version in the Methods registry.
+func (g *Generator) renderSince(structs []*renderedStruct) string {
+	types := map[string]string{}
+	for _, s := range g.model.Structures {
+		if strings.HasPrefix(s.Name, "_") {
+			continue
+		}
+		if v := g.introduced(sinceType, s.Name, s.Since, s.SinceTags); v != "" {
+			types[s.Name] = v
+		}
+	}
+	for _, e := range g.model.Enumerations {
+		if v := g.introduced(sinceType, e.Name, e.Since, e.SinceTags); v != "" {
+			types[e.Name] = v
+		}
+	}
+	for _, a := range g.model.TypeAliases {
+		if _, ok := wellKnownAny(a.Name); ok {
+			continue
+		}
+		if v := g.introduced(sinceType, a.Name, a.Since, a.SinceTags); v != "" {
+			types[a.Name] = v
+		}
+	}
+	fields := map[string]string{}
+	for _, s := range structs {
+		for _, f := range s.Fields {
+			if f.Since != "" {
+				fields[s.Name+"."+f.JSONName] = f.Since
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("// sinceTypes maps generated type names to the protocol version that\n")
+	b.WriteString("// introduced them. Types that predate every tracked version are absent.\n")
+	writeSinceMap(&b, "sinceTypes", types)
+	b.WriteString("\n// sinceFields maps \"Type.jsonName\" to the protocol version that introduced\n")
+	b.WriteString("// the field. Fields that predate every tracked version are absent.\n")
+	writeSinceMap(&b, "sinceFields", fields)
+	return b.String()
+}
+
+func writeSinceMap(b *strings.Builder, name string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(b, "var %s = map[string]string{\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(b, "\t%q: %q,\n", k, m[k])
+	}
+	b.WriteString("}\n")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package genlsp
+
+import "testing"
+
+func TestIntroducedIn(t *testing.T) {
+	tests := map[string]struct {
+		since string
+		tags  []string
+		want  string
+	}{
+		"success: bare version":              {since: "3.16.0", want: "3.16.0"},
+		"success: trailing period":           {since: "3.17.0.", want: "3.17.0"},
+		"success: missing patch":             {since: "3.16", want: "3.16.0"},
+		"success: version prefix":            {since: "version 3.12.0", want: "3.12.0"},
+		"success: proposed":                  {since: "3.18.0 - proposed", want: "3.18.0"},
+		"success: amendment is not an intro": {since: "3.17.0 - support for relative patterns.", want: ""},
+		"success: earliest intro tag wins": {
+			since: "3.18.0 ServerInfo type name added.",
+			tags:  []string{"3.15.0", "3.18.0 ServerInfo type name added."},
+			want:  "3.15.0",
+		},
+		"success: untagged": {want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := introducedIn(tt.since, tt.tags); got != tt.want {
+				t.Fatalf("introducedIn(%q, %q) = %q, want %q", tt.since, tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntroducedFromHistory(t *testing.T) {
+	str := &Type{Kind: KindBase, Name: string(BaseString)}
+	model := func(props ...string) *MetaModel {
+		s := &Structure{Name: "Thing"}
+		for _, p := range props {
+			s.Properties = append(s.Properties, &Property{Name: p, Type: str})
+		}
+		return &MetaModel{Structures: []*Structure{s}}
+	}
+
+	g := NewGenerator(model("a", "b", "c", "d"), "protocol")
+	if err := g.SetHistory(
+		"3.18",
+		VersionedModel{Version: "3.17.0", Model: model("a", "b", "c")},
+		VersionedModel{Version: "3.16.0", Model: model("a", "b")},
+	); err != nil {
+		t.Fatalf("SetHistory: %v", err)
+	}
+
+	tests := map[string]struct {
+		key   string
+		since string
+		want  string
+	}{
+		"success: present in oldest model":   {key: "Thing.a", want: ""},
+		"success: first seen in later model": {key: "Thing.c", want: "3.17.0"},
+		"success: only in current model":     {key: "Thing.d", want: "3.18.0"},
+		"success: tag wins over history":     {key: "Thing.c", since: "3.16.0", want: "3.16.0"},
+		"success: synthesized owner":         {key: "", want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := g.introduced(sinceField, tt.key, tt.since, nil); got != tt.want {
+				t.Fatalf("introduced(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+
+	if err := g.SetHistory("next"); err == nil {
+		t.Fatal("SetHistory(\"next\") succeeded, want invalid version error")
+	}
+}
@@ -101,14 +101,25 @@ type byteUnmarshaler interface {
 // Unescaped strings and raw JSON value fields may alias that owned per-message
 // copy, so callers may mutate or reuse data after Unmarshal returns. Retaining
 // such fields can keep the whole owned message copy live; use Clone to detach a
-// protocol value when it must outlive a much larger input message.
+// protocol value when it must outlive a much larger input message, or decode
+// with [UnmarshalOptions] to choose another [Ownership].
 func Unmarshal(data []byte, v any) error {
+	return unmarshal(data, v, false)
+}
+
+// unmarshal implements [Unmarshal]. With borrow, byte-walker destinations
+// decode data in place instead of a copy of it, so decoded strings and raw
+// values may alias data itself.
+func unmarshal(data []byte, v any, borrow bool) error {
 	if isNilUnmarshalDestination(v) {
 		return json.Unmarshal(data, v, unmarshalOptions())
 	}
 	var owned []byte
 	own := func() []byte {
-		if owned == nil {
+		switch {
+		case borrow:
+			return data
+		case owned == nil:
 			owned = slices.Clone(data)
 		}
 		return owned
@@ -116,7 +127,7 @@ func Unmarshal(data []byte, v any) error {
 	if u, ok := v.(byteUnmarshaler); ok {
 		return u.unmarshalLSPValue(own())
 	}
-	if ok, err := unmarshalUnionRoot(data, v); ok {
+	if ok, err := unmarshalUnionRoot(v, own); ok {
 		return err
 	}
 	return json.Unmarshal(data, v, unmarshalOptions())
@@ -0,0 +1,191 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import "strings"
+
+// Markup builds documentation for hovers, completion items, signatures and
+// other places the protocol accepts [MarkupContent], rendering it as
+// Markdown or as plain text depending on what the client accepts.
+//
+// Text passed to Markup is literal: Markdown metacharacters in it are
+// escaped. Block methods (Heading, CodeBlock, List) start a new block;
+// inline methods (Text, Code, Link) extend the current paragraph. The zero
+// value is an empty document.
+type Markup struct {
+	markdown strings.Builder
+	plain    strings.Builder
+	inline   bool
+}
+
+// block starts a new block in both renderings.
+func (m *Markup) block() {
+	if m.markdown.Len() > 0 {
+		m.markdown.WriteString("\n\n")
+		m.plain.WriteString("\n\n")
+	}
+	m.inline = false
+}
+
+// span continues the current paragraph, starting one if needed.
+func (m *Markup) span() {
+	if !m.inline {
+		m.block()
+		m.inline = true
+	}
+}
+
+// Heading adds a heading of the given level, clamped to 1 through 6. Line
+// breaks in text become spaces.
+func (m *Markup) Heading(level int, text string) *Markup {
+	m.block()
+	text = strings.ReplaceAll(text, "\n", " ")
+	m.markdown.WriteString(strings.Repeat("#", min(max(level, 1), 6)))
+	m.markdown.WriteByte(' ')
+	m.markdown.WriteString(EscapeMarkdown(text))
+	m.plain.WriteString(text)
+	return m
+}
+
+// Text adds text to the current paragraph.
+func (m *Markup) Text(text string) *Markup {
+	m.span()
+	m.markdown.WriteString(EscapeMarkdown(text))
+	m.plain.WriteString(text)
+	return m
+}
+
+// Code adds inline code to the current paragraph.
+func (m *Markup) Code(code string) *Markup {
+	m.span()
+	fence := strings.Repeat("`", longestRun(code, '`')+1)
+	pad := ""
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		pad = " "
+	}
+	m.markdown.WriteString(fence + pad + code + pad + fence)
+	m.plain.WriteString(code)
+	return m
+}
+
+// Link adds a link to target to the current paragraph. Plain text renders
+// it as the text followed by the target in parentheses, or the target alone
+// when text is empty or equal to it.
+func (m *Markup) Link(text, target string) *Markup {
+	m.span()
+	if text == "" {
+		text = target
+	}
+	m.markdown.WriteByte('[')
+	m.markdown.WriteString(EscapeMarkdown(text))
+	m.markdown.WriteString("](<")
+	m.markdown.WriteString(strings.NewReplacer("<", "%3C", ">", "%3E").Replace(target))
+	m.markdown.WriteString(">)")
+	if text == target {
+		m.plain.WriteString(target)
+	} else {
+		m.plain.WriteString(text + " (" + target + ")")
+	}
+	return m
+}
+
+// Paragraph ends the current paragraph, so the next inline method starts a
+// new one.
+func (m *Markup) Paragraph() *Markup {
+	m.inline = false
+	return m
+}
+
+// CodeBlock adds a fenced code block highlighted as language, which may be
+// empty. Plain text renders the code as is.
+func (m *Markup) CodeBlock(language, code string) *Markup {
+	m.block()
+	code = strings.TrimSuffix(code, "\n")
+	fence := strings.Repeat("`", max(longestRun(code, '`')+1, 3))
+	m.markdown.WriteString(fence + language + "\n" + code + "\n" + fence)
+	m.plain.WriteString(code)
+	return m
+}
+
+// List adds a bulleted list of items.
+func (m *Markup) List(items ...string) *Markup {
+	if len(items) == 0 {
+		return m
+	}
+	m.block()
+	for i, item := range items {
+		if i > 0 {
+			m.markdown.WriteByte('\n')
+			m.plain.WriteByte('\n')
+		}
+		// Continuation lines are indented to stay inside the item.
+		m.markdown.WriteString("- " + strings.ReplaceAll(EscapeMarkdown(item), "\n", "\n  "))
+		m.plain.WriteString("- " + strings.ReplaceAll(item, "\n", "\n  "))
+	}
+	return m
+}
+
+// Content renders the document as kind, which is [MarkupKindMarkdown] or
+// [MarkupKindPlainText]; any other kind renders plain text.
+func (m *Markup) Content(kind MarkupKind) *MarkupContent {
+	if kind == MarkupKindMarkdown {
+		return &MarkupContent{Kind: MarkupKindMarkdown, Value: m.markdown.String()}
+	}
+	return &MarkupContent{Kind: MarkupKindPlainText, Value: m.plain.String()}
+}
+
+// For renders the document in the format the client prefers among formats,
+// such as [HoverClientCapabilities.ContentFormat] or
+// [ClientCompletionItemOptions.DocumentationFormat]. See
+// [PreferredMarkupKind].
+func (m *Markup) For(formats []MarkupKind) *MarkupContent {
+	return m.Content(PreferredMarkupKind(formats))
+}
+
+// PreferredMarkupKind returns the first kind in formats, which a client
+// lists most preferred first, that the package renders. A client that
+// declares no known format gets [MarkupKindPlainText], which every client
+// accepts.
+func PreferredMarkupKind(formats []MarkupKind) MarkupKind {
+	for _, kind := range formats {
+		if kind == MarkupKindMarkdown || kind == MarkupKindPlainText {
+			return kind
+		}
+	}
+	return MarkupKindPlainText
+}
+
+// markdownSpecial lists the bytes [EscapeMarkdown] escapes.
+const markdownSpecial = "\\`*_{}[]()#+-.!|<>~"
+
+// EscapeMarkdown escapes the Markdown metacharacters in text with
+// backslashes so that a client renders it literally.
+func EscapeMarkdown(text string) string {
+	if !strings.ContainsAny(text, markdownSpecial) {
+		return text
+	}
+	var b strings.Builder
+	b.Grow(len(text) + len(text)/4)
+	for i := range len(text) {
+		if strings.IndexByte(markdownSpecial, text[i]) >= 0 {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+// longestRun returns the length of the longest run of c in s.
+func longestRun(s string, c byte) int {
+	longest, run := 0, 0
+	for i := range len(s) {
+		if s[i] != c {
+			run = 0
+			continue
+		}
+		run++
+		longest = max(longest, run)
+	}
+	return longest
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestMarkup(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		build    func(m *Markup)
+		markdown string
+		plain    string
+	}{
+		"success: empty": {
+			build: func(*Markup) {},
+		},
+		"success: signature hover": {
+			build: func(m *Markup) {
+				m.CodeBlock("go", "func Println(a ...any) (n int, err error)\n").
+					Text("Println formats using the default formats for its operands. See ").
+					Link("fmt.Print", "https://pkg.go.dev/fmt#Print").
+					Text(".")
+			},
+			markdown: "```go\nfunc Println(a ...any) (n int, err error)\n```\n\n" +
+				"Println formats using the default formats for its operands\\. See [fmt\\.Print](<https://pkg.go.dev/fmt#Print>)\\.",
+			plain: "func Println(a ...any) (n int, err error)\n\n" +
+				"Println formats using the default formats for its operands. See fmt.Print (https://pkg.go.dev/fmt#Print).",
+		},
+		"success: headings and paragraphs": {
+			build: func(m *Markup) {
+				m.Heading(0, "type T").Text("a *b*").Paragraph().Text("c").Heading(9, "x\ny")
+			},
+			markdown: "# type T\n\na \\*b\\*\n\nc\n\n###### x y",
+			plain:    "type T\n\na *b*\n\nc\n\nx y",
+		},
+		"success: inline code": {
+			build: func(m *Markup) {
+				m.Text("use ").Code("x_y").Text(" or ").Code("a`b").Text(" or ").Code("`q`")
+			},
+			markdown: "use `x_y` or ``a`b`` or `` `q` ``",
+			plain:    "use x_y or a`b or `q`",
+		},
+		"success: code block containing a fence": {
+			build: func(m *Markup) {
+				m.CodeBlock("", "```go\nx\n```")
+			},
+			markdown: "````\n```go\nx\n```\n````",
+			plain:    "```go\nx\n```",
+		},
+		"success: list": {
+			build: func(m *Markup) {
+				m.Text("Fields:").List("Name_1", "multi\nline").List()
+			},
+			markdown: "Fields:\n\n- Name\\_1\n- multi\n  line",
+			plain:    "Fields:\n\n- Name_1\n- multi\n  line",
+		},
+		"success: bare link": {
+			build: func(m *Markup) {
+				m.Link("", "https://go.dev/<x>")
+			},
+			markdown: "[https://go\\.dev/\\<x\\>](<https://go.dev/%3Cx%3E>)",
+			plain:    "https://go.dev/<x>",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var m Markup
+			tt.build(&m)
+			if diff := gocmp.Diff(&MarkupContent{Kind: MarkupKindMarkdown, Value: tt.markdown}, m.Content(MarkupKindMarkdown)); diff != "" {
+				t.Errorf("Content(markdown) mismatch (-want +got):\n%s", diff)
+			}
+			if diff := gocmp.Diff(&MarkupContent{Kind: MarkupKindPlainText, Value: tt.plain}, m.Content(MarkupKindPlainText)); diff != "" {
+				t.Errorf("Content(plaintext) mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreferredMarkupKind(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		formats []MarkupKind
+		want    MarkupKind
+	}{
+		"success: markdown first":      {formats: []MarkupKind{MarkupKindMarkdown, MarkupKindPlainText}, want: MarkupKindMarkdown},
+		"success: plaintext first":     {formats: []MarkupKind{MarkupKindPlainText, MarkupKindMarkdown}, want: MarkupKindPlainText},
+		"success: unknown kinds skip":  {formats: []MarkupKind{"asciidoc", MarkupKindMarkdown}, want: MarkupKindMarkdown},
+		"success: undeclared is plain": {want: MarkupKindPlainText},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := PreferredMarkupKind(tt.formats); got != tt.want {
+				t.Errorf("PreferredMarkupKind(%v) = %q, want %q", tt.formats, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkupForHover(t *testing.T) {
+	t.Parallel()
+
+	var caps ClientCapabilities
+	if err := Unmarshal([]byte(`{"textDocument":{"hover":{"contentFormat":["markdown","plaintext"]}}}`), &caps); err != nil {
+		t.Fatal(err)
+	}
+	var m Markup
+	hover := &Hover{Contents: m.Text("x < y").For(caps.TextDocument.Hover.ContentFormat)}
+	got, err := Marshal(hover)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"contents":{"kind":"markdown","value":"x \\< y"}}`; string(got) != want {
+		t.Errorf("Marshal(hover) = %s, want %s", got, want)
+	}
+	var back Hover
+	if err := Unmarshal(got, &back); err != nil {
+		t.Fatal(err)
+	}
+	if diff := gocmp.Diff(hover, &back); diff != "" {
+		t.Errorf("hover round trip mismatch (-want +got):\n%s", diff)
+	}
+}
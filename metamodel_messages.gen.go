@@ -210,103 +210,107 @@ type MethodInfo struct {
 	Kind       string
 	ParamsType string
 	ResultType string
+
+	// Since is the protocol version that introduced the method, "" when it
+	// predates every tracked version.
+	Since string
 }
 
 // Methods is the registry of every request and notification in the model.
 var Methods = []MethodInfo{
-	{Method: MethodInitialize, Direction: "clientToServer", Kind: "request", ParamsType: "InitializeParams", ResultType: "InitializeResult"},
-	{Method: MethodInitialized, Direction: "clientToServer", Kind: "notification", ParamsType: "InitializedParams", ResultType: ""},
-	{Method: MethodClientRegisterCapability, Direction: "serverToClient", Kind: "request", ParamsType: "RegistrationParams", ResultType: "LSPAny"},
-	{Method: MethodClientUnregisterCapability, Direction: "serverToClient", Kind: "request", ParamsType: "UnregistrationParams", ResultType: "LSPAny"},
-	{Method: MethodSetTrace, Direction: "clientToServer", Kind: "notification", ParamsType: "SetTraceParams", ResultType: ""},
-	{Method: MethodLogTrace, Direction: "serverToClient", Kind: "notification", ParamsType: "LogTraceParams", ResultType: ""},
-	{Method: MethodShutdown, Direction: "clientToServer", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodExit, Direction: "clientToServer", Kind: "notification", ParamsType: "", ResultType: ""},
-	{Method: MethodTextDocumentDidOpen, Direction: "clientToServer", Kind: "notification", ParamsType: "DidOpenTextDocumentParams", ResultType: ""},
-	{Method: MethodTextDocumentDidChange, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeTextDocumentParams", ResultType: ""},
-	{Method: MethodTextDocumentWillSave, Direction: "clientToServer", Kind: "notification", ParamsType: "WillSaveTextDocumentParams", ResultType: ""},
-	{Method: MethodTextDocumentWillSaveWaitUntil, Direction: "clientToServer", Kind: "request", ParamsType: "WillSaveTextDocumentParams", ResultType: "[]TextEdit"},
-	{Method: MethodTextDocumentDidSave, Direction: "clientToServer", Kind: "notification", ParamsType: "DidSaveTextDocumentParams", ResultType: ""},
-	{Method: MethodTextDocumentDidClose, Direction: "clientToServer", Kind: "notification", ParamsType: "DidCloseTextDocumentParams", ResultType: ""},
-	{Method: MethodNotebookDocumentDidOpen, Direction: "clientToServer", Kind: "notification", ParamsType: "DidOpenNotebookDocumentParams", ResultType: ""},
-	{Method: MethodNotebookDocumentDidChange, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeNotebookDocumentParams", ResultType: ""},
-	{Method: MethodNotebookDocumentDidSave, Direction: "clientToServer", Kind: "notification", ParamsType: "DidSaveNotebookDocumentParams", ResultType: ""},
-	{Method: MethodNotebookDocumentDidClose, Direction: "clientToServer", Kind: "notification", ParamsType: "DidCloseNotebookDocumentParams", ResultType: ""},
-	{Method: MethodTextDocumentDeclaration, Direction: "clientToServer", Kind: "request", ParamsType: "DeclarationParams", ResultType: "DeclarationResult"},
-	{Method: MethodTextDocumentDefinition, Direction: "clientToServer", Kind: "request", ParamsType: "DefinitionParams", ResultType: "DefinitionResult"},
-	{Method: MethodTextDocumentTypeDefinition, Direction: "clientToServer", Kind: "request", ParamsType: "TypeDefinitionParams", ResultType: "DefinitionResult"},
-	{Method: MethodTextDocumentImplementation, Direction: "clientToServer", Kind: "request", ParamsType: "ImplementationParams", ResultType: "DefinitionResult"},
-	{Method: MethodTextDocumentReferences, Direction: "clientToServer", Kind: "request", ParamsType: "ReferenceParams", ResultType: "[]Location"},
-	{Method: MethodTextDocumentPrepareCallHierarchy, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyPrepareParams", ResultType: "[]CallHierarchyItem"},
-	{Method: MethodCallHierarchyIncomingCalls, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyIncomingCallsParams", ResultType: "[]CallHierarchyIncomingCall"},
-	{Method: MethodCallHierarchyOutgoingCalls, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyOutgoingCallsParams", ResultType: "[]CallHierarchyOutgoingCall"},
-	{Method: MethodTextDocumentPrepareTypeHierarchy, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchyPrepareParams", ResultType: "[]TypeHierarchyItem"},
-	{Method: MethodTypeHierarchySupertypes, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchySupertypesParams", ResultType: "[]TypeHierarchyItem"},
-	{Method: MethodTypeHierarchySubtypes, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchySubtypesParams", ResultType: "[]TypeHierarchyItem"},
-	{Method: MethodTextDocumentDocumentHighlight, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentHighlightParams", ResultType: "[]DocumentHighlight"},
-	{Method: MethodTextDocumentDocumentLink, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentLinkParams", ResultType: "[]DocumentLink"},
-	{Method: MethodDocumentLinkResolve, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentLink", ResultType: "DocumentLink"},
-	{Method: MethodTextDocumentHover, Direction: "clientToServer", Kind: "request", ParamsType: "HoverParams", ResultType: "Hover"},
-	{Method: MethodTextDocumentCodeLens, Direction: "clientToServer", Kind: "request", ParamsType: "CodeLensParams", ResultType: "[]CodeLens"},
-	{Method: MethodCodeLensResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CodeLens", ResultType: "CodeLens"},
-	{Method: MethodWorkspaceCodeLensRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentFoldingRange, Direction: "clientToServer", Kind: "request", ParamsType: "FoldingRangeParams", ResultType: "[]FoldingRange"},
-	{Method: MethodWorkspaceFoldingRangeRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentSelectionRange, Direction: "clientToServer", Kind: "request", ParamsType: "SelectionRangeParams", ResultType: "[]SelectionRange"},
-	{Method: MethodTextDocumentDocumentSymbol, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentSymbolParams", ResultType: "DocumentSymbolResult"},
-	{Method: MethodTextDocumentSemanticTokensFull, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensParams", ResultType: "SemanticTokens"},
-	{Method: MethodTextDocumentSemanticTokensFullDelta, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensDeltaParams", ResultType: "SemanticTokensDeltaResult"},
-	{Method: MethodTextDocumentSemanticTokensRange, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensRangeParams", ResultType: "SemanticTokens"},
-	{Method: MethodWorkspaceSemanticTokensRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentInlineValue, Direction: "clientToServer", Kind: "request", ParamsType: "InlineValueParams", ResultType: "[]InlineValue"},
-	{Method: MethodWorkspaceInlineValueRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentInlayHint, Direction: "clientToServer", Kind: "request", ParamsType: "InlayHintParams", ResultType: "[]InlayHint"},
-	{Method: MethodInlayHintResolve, Direction: "clientToServer", Kind: "request", ParamsType: "InlayHint", ResultType: "InlayHint"},
-	{Method: MethodWorkspaceInlayHintRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentMoniker, Direction: "clientToServer", Kind: "request", ParamsType: "MonikerParams", ResultType: "[]Moniker"},
-	{Method: MethodTextDocumentCompletion, Direction: "clientToServer", Kind: "request", ParamsType: "CompletionParams", ResultType: "CompletionResult"},
-	{Method: MethodCompletionItemResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CompletionItem", ResultType: "CompletionItem"},
-	{Method: MethodTextDocumentDiagnostic, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentDiagnosticParams", ResultType: "DocumentDiagnosticReport"},
-	{Method: MethodWorkspaceDiagnostic, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceDiagnosticParams", ResultType: "WorkspaceDiagnosticReport"},
-	{Method: MethodWorkspaceDiagnosticRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny"},
-	{Method: MethodTextDocumentPublishDiagnostics, Direction: "serverToClient", Kind: "notification", ParamsType: "PublishDiagnosticsParams", ResultType: ""},
-	{Method: MethodTextDocumentSignatureHelp, Direction: "clientToServer", Kind: "request", ParamsType: "SignatureHelpParams", ResultType: "SignatureHelp"},
-	{Method: MethodTextDocumentCodeAction, Direction: "clientToServer", Kind: "request", ParamsType: "CodeActionParams", ResultType: "[]CommandOrCodeAction"},
-	{Method: MethodCodeActionResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CodeAction", ResultType: "CodeAction"},
-	{Method: MethodTextDocumentDocumentColor, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentColorParams", ResultType: "[]ColorInformation"},
-	{Method: MethodTextDocumentColorPresentation, Direction: "clientToServer", Kind: "request", ParamsType: "ColorPresentationParams", ResultType: "[]ColorPresentation"},
-	{Method: MethodTextDocumentFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentFormattingParams", ResultType: "[]TextEdit"},
-	{Method: MethodTextDocumentRangeFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentRangeFormattingParams", ResultType: "[]TextEdit"},
-	{Method: MethodTextDocumentRangesFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentRangesFormattingParams", ResultType: "[]TextEdit"},
-	{Method: MethodTextDocumentOnTypeFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentOnTypeFormattingParams", ResultType: "[]TextEdit"},
-	{Method: MethodTextDocumentRename, Direction: "clientToServer", Kind: "request", ParamsType: "RenameParams", ResultType: "WorkspaceEdit"},
-	{Method: MethodTextDocumentPrepareRename, Direction: "clientToServer", Kind: "request", ParamsType: "PrepareRenameParams", ResultType: "PrepareRenameResult"},
-	{Method: MethodTextDocumentLinkedEditingRange, Direction: "clientToServer", Kind: "request", ParamsType: "LinkedEditingRangeParams", ResultType: "LinkedEditingRanges"},
-	{Method: MethodTextDocumentInlineCompletion, Direction: "clientToServer", Kind: "request", ParamsType: "InlineCompletionParams", ResultType: "InlineCompletionResult"},
-	{Method: MethodWorkspaceSymbol, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceSymbolParams", ResultType: "WorkspaceSymbolResult"},
-	{Method: MethodWorkspaceSymbolResolve, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceSymbol", ResultType: "WorkspaceSymbol"},
-	{Method: MethodWorkspaceConfiguration, Direction: "serverToClient", Kind: "request", ParamsType: "ConfigurationParams", ResultType: "[]LSPAny"},
-	{Method: MethodWorkspaceDidChangeConfiguration, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeConfigurationParams", ResultType: ""},
-	{Method: MethodWorkspaceWorkspaceFolders, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "[]WorkspaceFolder"},
-	{Method: MethodWorkspaceDidChangeWorkspaceFolders, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeWorkspaceFoldersParams", ResultType: ""},
-	{Method: MethodWorkspaceWillCreateFiles, Direction: "clientToServer", Kind: "request", ParamsType: "CreateFilesParams", ResultType: "WorkspaceEdit"},
-	{Method: MethodWorkspaceWillRenameFiles, Direction: "clientToServer", Kind: "request", ParamsType: "RenameFilesParams", ResultType: "WorkspaceEdit"},
-	{Method: MethodWorkspaceWillDeleteFiles, Direction: "clientToServer", Kind: "request", ParamsType: "DeleteFilesParams", ResultType: "WorkspaceEdit"},
-	{Method: MethodWorkspaceDidCreateFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "CreateFilesParams", ResultType: ""},
-	{Method: MethodWorkspaceDidRenameFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "RenameFilesParams", ResultType: ""},
-	{Method: MethodWorkspaceDidDeleteFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "DeleteFilesParams", ResultType: ""},
-	{Method: MethodWorkspaceDidChangeWatchedFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeWatchedFilesParams", ResultType: ""},
-	{Method: MethodWorkspaceExecuteCommand, Direction: "clientToServer", Kind: "request", ParamsType: "ExecuteCommandParams", ResultType: "LSPAny"},
-	{Method: MethodWorkspaceApplyEdit, Direction: "serverToClient", Kind: "request", ParamsType: "ApplyWorkspaceEditParams", ResultType: "ApplyWorkspaceEditResult"},
-	{Method: MethodWorkspaceTextDocumentContent, Direction: "clientToServer", Kind: "request", ParamsType: "TextDocumentContentParams", ResultType: "TextDocumentContentResult"},
-	{Method: MethodWorkspaceTextDocumentContentRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "TextDocumentContentRefreshParams", ResultType: "LSPAny"},
-	{Method: MethodWindowShowMessage, Direction: "serverToClient", Kind: "notification", ParamsType: "ShowMessageParams", ResultType: ""},
-	{Method: MethodWindowShowMessageRequest, Direction: "serverToClient", Kind: "request", ParamsType: "ShowMessageRequestParams", ResultType: "MessageActionItem"},
-	{Method: MethodWindowLogMessage, Direction: "serverToClient", Kind: "notification", ParamsType: "LogMessageParams", ResultType: ""},
-	{Method: MethodWindowShowDocument, Direction: "serverToClient", Kind: "request", ParamsType: "ShowDocumentParams", ResultType: "ShowDocumentResult"},
-	{Method: MethodWindowWorkDoneProgressCreate, Direction: "serverToClient", Kind: "request", ParamsType: "WorkDoneProgressCreateParams", ResultType: "LSPAny"},
-	{Method: MethodWindowWorkDoneProgressCancel, Direction: "clientToServer", Kind: "notification", ParamsType: "WorkDoneProgressCancelParams", ResultType: ""},
-	{Method: MethodTelemetryEvent, Direction: "serverToClient", Kind: "notification", ParamsType: "LSPAny", ResultType: ""},
-	{Method: MethodProgress, Direction: "both", Kind: "notification", ParamsType: "ProgressParams", ResultType: ""},
-	{Method: MethodCancelRequest, Direction: "both", Kind: "notification", ParamsType: "CancelParams", ResultType: ""},
+	{Method: MethodInitialize, Direction: "clientToServer", Kind: "request", ParamsType: "InitializeParams", ResultType: "InitializeResult", Since: ""},
+	{Method: MethodInitialized, Direction: "clientToServer", Kind: "notification", ParamsType: "InitializedParams", ResultType: "", Since: ""},
+	{Method: MethodClientRegisterCapability, Direction: "serverToClient", Kind: "request", ParamsType: "RegistrationParams", ResultType: "LSPAny", Since: ""},
+	{Method: MethodClientUnregisterCapability, Direction: "serverToClient", Kind: "request", ParamsType: "UnregistrationParams", ResultType: "LSPAny", Since: ""},
+	{Method: MethodSetTrace, Direction: "clientToServer", Kind: "notification", ParamsType: "SetTraceParams", ResultType: "", Since: ""},
+	{Method: MethodLogTrace, Direction: "serverToClient", Kind: "notification", ParamsType: "LogTraceParams", ResultType: "", Since: ""},
+	{Method: MethodShutdown, Direction: "clientToServer", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: ""},
+	{Method: MethodExit, Direction: "clientToServer", Kind: "notification", ParamsType: "", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentDidOpen, Direction: "clientToServer", Kind: "notification", ParamsType: "DidOpenTextDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentDidChange, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeTextDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentWillSave, Direction: "clientToServer", Kind: "notification", ParamsType: "WillSaveTextDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentWillSaveWaitUntil, Direction: "clientToServer", Kind: "request", ParamsType: "WillSaveTextDocumentParams", ResultType: "[]TextEdit", Since: ""},
+	{Method: MethodTextDocumentDidSave, Direction: "clientToServer", Kind: "notification", ParamsType: "DidSaveTextDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentDidClose, Direction: "clientToServer", Kind: "notification", ParamsType: "DidCloseTextDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodNotebookDocumentDidOpen, Direction: "clientToServer", Kind: "notification", ParamsType: "DidOpenNotebookDocumentParams", ResultType: "", Since: "3.17.0"},
+	{Method: MethodNotebookDocumentDidChange, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeNotebookDocumentParams", ResultType: "", Since: ""},
+	{Method: MethodNotebookDocumentDidSave, Direction: "clientToServer", Kind: "notification", ParamsType: "DidSaveNotebookDocumentParams", ResultType: "", Since: "3.17.0"},
+	{Method: MethodNotebookDocumentDidClose, Direction: "clientToServer", Kind: "notification", ParamsType: "DidCloseNotebookDocumentParams", ResultType: "", Since: "3.17.0"},
+	{Method: MethodTextDocumentDeclaration, Direction: "clientToServer", Kind: "request", ParamsType: "DeclarationParams", ResultType: "DeclarationResult", Since: ""},
+	{Method: MethodTextDocumentDefinition, Direction: "clientToServer", Kind: "request", ParamsType: "DefinitionParams", ResultType: "DefinitionResult", Since: ""},
+	{Method: MethodTextDocumentTypeDefinition, Direction: "clientToServer", Kind: "request", ParamsType: "TypeDefinitionParams", ResultType: "DefinitionResult", Since: ""},
+	{Method: MethodTextDocumentImplementation, Direction: "clientToServer", Kind: "request", ParamsType: "ImplementationParams", ResultType: "DefinitionResult", Since: ""},
+	{Method: MethodTextDocumentReferences, Direction: "clientToServer", Kind: "request", ParamsType: "ReferenceParams", ResultType: "[]Location", Since: ""},
+	{Method: MethodTextDocumentPrepareCallHierarchy, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyPrepareParams", ResultType: "[]CallHierarchyItem", Since: "3.16.0"},
+	{Method: MethodCallHierarchyIncomingCalls, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyIncomingCallsParams", ResultType: "[]CallHierarchyIncomingCall", Since: "3.16.0"},
+	{Method: MethodCallHierarchyOutgoingCalls, Direction: "clientToServer", Kind: "request", ParamsType: "CallHierarchyOutgoingCallsParams", ResultType: "[]CallHierarchyOutgoingCall", Since: "3.16.0"},
+	{Method: MethodTextDocumentPrepareTypeHierarchy, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchyPrepareParams", ResultType: "[]TypeHierarchyItem", Since: "3.17.0"},
+	{Method: MethodTypeHierarchySupertypes, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchySupertypesParams", ResultType: "[]TypeHierarchyItem", Since: "3.17.0"},
+	{Method: MethodTypeHierarchySubtypes, Direction: "clientToServer", Kind: "request", ParamsType: "TypeHierarchySubtypesParams", ResultType: "[]TypeHierarchyItem", Since: "3.17.0"},
+	{Method: MethodTextDocumentDocumentHighlight, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentHighlightParams", ResultType: "[]DocumentHighlight", Since: ""},
+	{Method: MethodTextDocumentDocumentLink, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentLinkParams", ResultType: "[]DocumentLink", Since: ""},
+	{Method: MethodDocumentLinkResolve, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentLink", ResultType: "DocumentLink", Since: ""},
+	{Method: MethodTextDocumentHover, Direction: "clientToServer", Kind: "request", ParamsType: "HoverParams", ResultType: "Hover", Since: ""},
+	{Method: MethodTextDocumentCodeLens, Direction: "clientToServer", Kind: "request", ParamsType: "CodeLensParams", ResultType: "[]CodeLens", Since: ""},
+	{Method: MethodCodeLensResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CodeLens", ResultType: "CodeLens", Since: ""},
+	{Method: MethodWorkspaceCodeLensRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.16.0"},
+	{Method: MethodTextDocumentFoldingRange, Direction: "clientToServer", Kind: "request", ParamsType: "FoldingRangeParams", ResultType: "[]FoldingRange", Since: ""},
+	{Method: MethodWorkspaceFoldingRangeRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.18.0"},
+	{Method: MethodTextDocumentSelectionRange, Direction: "clientToServer", Kind: "request", ParamsType: "SelectionRangeParams", ResultType: "[]SelectionRange", Since: ""},
+	{Method: MethodTextDocumentDocumentSymbol, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentSymbolParams", ResultType: "DocumentSymbolResult", Since: ""},
+	{Method: MethodTextDocumentSemanticTokensFull, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensParams", ResultType: "SemanticTokens", Since: "3.16.0"},
+	{Method: MethodTextDocumentSemanticTokensFullDelta, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensDeltaParams", ResultType: "SemanticTokensDeltaResult", Since: "3.16.0"},
+	{Method: MethodTextDocumentSemanticTokensRange, Direction: "clientToServer", Kind: "request", ParamsType: "SemanticTokensRangeParams", ResultType: "SemanticTokens", Since: "3.16.0"},
+	{Method: MethodWorkspaceSemanticTokensRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.16.0"},
+	{Method: MethodTextDocumentInlineValue, Direction: "clientToServer", Kind: "request", ParamsType: "InlineValueParams", ResultType: "[]InlineValue", Since: "3.17.0"},
+	{Method: MethodWorkspaceInlineValueRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.17.0"},
+	{Method: MethodTextDocumentInlayHint, Direction: "clientToServer", Kind: "request", ParamsType: "InlayHintParams", ResultType: "[]InlayHint", Since: "3.17.0"},
+	{Method: MethodInlayHintResolve, Direction: "clientToServer", Kind: "request", ParamsType: "InlayHint", ResultType: "InlayHint", Since: "3.17.0"},
+	{Method: MethodWorkspaceInlayHintRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.17.0"},
+	{Method: MethodTextDocumentMoniker, Direction: "clientToServer", Kind: "request", ParamsType: "MonikerParams", ResultType: "[]Moniker", Since: ""},
+	{Method: MethodTextDocumentCompletion, Direction: "clientToServer", Kind: "request", ParamsType: "CompletionParams", ResultType: "CompletionResult", Since: ""},
+	{Method: MethodCompletionItemResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CompletionItem", ResultType: "CompletionItem", Since: ""},
+	{Method: MethodTextDocumentDiagnostic, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentDiagnosticParams", ResultType: "DocumentDiagnosticReport", Since: "3.17.0"},
+	{Method: MethodWorkspaceDiagnostic, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceDiagnosticParams", ResultType: "WorkspaceDiagnosticReport", Since: "3.17.0"},
+	{Method: MethodWorkspaceDiagnosticRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "LSPAny", Since: "3.17.0"},
+	{Method: MethodTextDocumentPublishDiagnostics, Direction: "serverToClient", Kind: "notification", ParamsType: "PublishDiagnosticsParams", ResultType: "", Since: ""},
+	{Method: MethodTextDocumentSignatureHelp, Direction: "clientToServer", Kind: "request", ParamsType: "SignatureHelpParams", ResultType: "SignatureHelp", Since: ""},
+	{Method: MethodTextDocumentCodeAction, Direction: "clientToServer", Kind: "request", ParamsType: "CodeActionParams", ResultType: "[]CommandOrCodeAction", Since: ""},
+	{Method: MethodCodeActionResolve, Direction: "clientToServer", Kind: "request", ParamsType: "CodeAction", ResultType: "CodeAction", Since: ""},
+	{Method: MethodTextDocumentDocumentColor, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentColorParams", ResultType: "[]ColorInformation", Since: ""},
+	{Method: MethodTextDocumentColorPresentation, Direction: "clientToServer", Kind: "request", ParamsType: "ColorPresentationParams", ResultType: "[]ColorPresentation", Since: ""},
+	{Method: MethodTextDocumentFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentFormattingParams", ResultType: "[]TextEdit", Since: ""},
+	{Method: MethodTextDocumentRangeFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentRangeFormattingParams", ResultType: "[]TextEdit", Since: ""},
+	{Method: MethodTextDocumentRangesFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentRangesFormattingParams", ResultType: "[]TextEdit", Since: "3.18.0"},
+	{Method: MethodTextDocumentOnTypeFormatting, Direction: "clientToServer", Kind: "request", ParamsType: "DocumentOnTypeFormattingParams", ResultType: "[]TextEdit", Since: ""},
+	{Method: MethodTextDocumentRename, Direction: "clientToServer", Kind: "request", ParamsType: "RenameParams", ResultType: "WorkspaceEdit", Since: ""},
+	{Method: MethodTextDocumentPrepareRename, Direction: "clientToServer", Kind: "request", ParamsType: "PrepareRenameParams", ResultType: "PrepareRenameResult", Since: ""},
+	{Method: MethodTextDocumentLinkedEditingRange, Direction: "clientToServer", Kind: "request", ParamsType: "LinkedEditingRangeParams", ResultType: "LinkedEditingRanges", Since: "3.16.0"},
+	{Method: MethodTextDocumentInlineCompletion, Direction: "clientToServer", Kind: "request", ParamsType: "InlineCompletionParams", ResultType: "InlineCompletionResult", Since: "3.18.0"},
+	{Method: MethodWorkspaceSymbol, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceSymbolParams", ResultType: "WorkspaceSymbolResult", Since: ""},
+	{Method: MethodWorkspaceSymbolResolve, Direction: "clientToServer", Kind: "request", ParamsType: "WorkspaceSymbol", ResultType: "WorkspaceSymbol", Since: "3.17.0"},
+	{Method: MethodWorkspaceConfiguration, Direction: "serverToClient", Kind: "request", ParamsType: "ConfigurationParams", ResultType: "[]LSPAny", Since: ""},
+	{Method: MethodWorkspaceDidChangeConfiguration, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeConfigurationParams", ResultType: "", Since: ""},
+	{Method: MethodWorkspaceWorkspaceFolders, Direction: "serverToClient", Kind: "request", ParamsType: "", ResultType: "[]WorkspaceFolder", Since: ""},
+	{Method: MethodWorkspaceDidChangeWorkspaceFolders, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeWorkspaceFoldersParams", ResultType: "", Since: ""},
+	{Method: MethodWorkspaceWillCreateFiles, Direction: "clientToServer", Kind: "request", ParamsType: "CreateFilesParams", ResultType: "WorkspaceEdit", Since: "3.16.0"},
+	{Method: MethodWorkspaceWillRenameFiles, Direction: "clientToServer", Kind: "request", ParamsType: "RenameFilesParams", ResultType: "WorkspaceEdit", Since: "3.16.0"},
+	{Method: MethodWorkspaceWillDeleteFiles, Direction: "clientToServer", Kind: "request", ParamsType: "DeleteFilesParams", ResultType: "WorkspaceEdit", Since: "3.16.0"},
+	{Method: MethodWorkspaceDidCreateFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "CreateFilesParams", ResultType: "", Since: "3.16.0"},
+	{Method: MethodWorkspaceDidRenameFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "RenameFilesParams", ResultType: "", Since: "3.16.0"},
+	{Method: MethodWorkspaceDidDeleteFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "DeleteFilesParams", ResultType: "", Since: "3.16.0"},
+	{Method: MethodWorkspaceDidChangeWatchedFiles, Direction: "clientToServer", Kind: "notification", ParamsType: "DidChangeWatchedFilesParams", ResultType: "", Since: ""},
+	{Method: MethodWorkspaceExecuteCommand, Direction: "clientToServer", Kind: "request", ParamsType: "ExecuteCommandParams", ResultType: "LSPAny", Since: ""},
+	{Method: MethodWorkspaceApplyEdit, Direction: "serverToClient", Kind: "request", ParamsType: "ApplyWorkspaceEditParams", ResultType: "ApplyWorkspaceEditResult", Since: ""},
+	{Method: MethodWorkspaceTextDocumentContent, Direction: "clientToServer", Kind: "request", ParamsType: "TextDocumentContentParams", ResultType: "TextDocumentContentResult", Since: "3.18.0"},
+	{Method: MethodWorkspaceTextDocumentContentRefresh, Direction: "serverToClient", Kind: "request", ParamsType: "TextDocumentContentRefreshParams", ResultType: "LSPAny", Since: "3.18.0"},
+	{Method: MethodWindowShowMessage, Direction: "serverToClient", Kind: "notification", ParamsType: "ShowMessageParams", ResultType: "", Since: ""},
+	{Method: MethodWindowShowMessageRequest, Direction: "serverToClient", Kind: "request", ParamsType: "ShowMessageRequestParams", ResultType: "MessageActionItem", Since: ""},
+	{Method: MethodWindowLogMessage, Direction: "serverToClient", Kind: "notification", ParamsType: "LogMessageParams", ResultType: "", Since: ""},
+	{Method: MethodWindowShowDocument, Direction: "serverToClient", Kind: "request", ParamsType: "ShowDocumentParams", ResultType: "ShowDocumentResult", Since: "3.16.0"},
+	{Method: MethodWindowWorkDoneProgressCreate, Direction: "serverToClient", Kind: "request", ParamsType: "WorkDoneProgressCreateParams", ResultType: "LSPAny", Since: ""},
+	{Method: MethodWindowWorkDoneProgressCancel, Direction: "clientToServer", Kind: "notification", ParamsType: "WorkDoneProgressCancelParams", ResultType: "", Since: ""},
+	{Method: MethodTelemetryEvent, Direction: "serverToClient", Kind: "notification", ParamsType: "LSPAny", ResultType: "", Since: ""},
+	{Method: MethodProgress, Direction: "both", Kind: "notification", ParamsType: "ProgressParams", ResultType: "", Since: ""},
+	{Method: MethodCancelRequest, Direction: "both", Kind: "notification", ParamsType: "CancelParams", ResultType: "", Since: ""},
 }
@@ -0,0 +1,367 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"go.lsp.dev/uri"
+)
+
+// ErrUnknownDocument reports a notification or lookup naming a notebook or
+// cell document that is not open.
+var ErrUnknownDocument = errors.New("protocol: document is not open")
+
+// NotebookStore mirrors the notebooks a client syncs with
+// notebookDocument/didOpen, didChange and didClose, including the text of
+// every cell. Call its methods from the server's notebook notification
+// handlers and read [Notebook] snapshots from anywhere.
+//
+// The zero value is ready to use and counts positions in UTF-16.
+type NotebookStore struct {
+	// Encoding is the position encoding negotiated at initialization, used
+	// to apply cell text changes; empty means UTF-16. Set it before the
+	// first notification.
+	Encoding PositionEncodingKind
+
+	mu        sync.RWMutex
+	notebooks map[uri.URI]*Notebook
+	cells     map[uri.URI]uri.URI // cell document → notebook
+}
+
+// Notebook is a snapshot of an open notebook document. The store never
+// modifies a snapshot it has handed out; later notifications produce new
+// ones. Metadata maps are shared between snapshots and must not be
+// modified.
+type Notebook struct {
+	URI          uri.URI
+	NotebookType string
+	Version      int32
+	Metadata     LSPObject
+	Cells        []NotebookCellDocument
+}
+
+// NotebookCellDocument is a notebook cell together with its text document.
+type NotebookCellDocument struct {
+	NotebookCell
+
+	LanguageID LanguageKind
+	Version    int32
+	Text       string
+}
+
+// DidOpen records the notebook params opens.
+func (s *NotebookStore) DidOpen(params *DidOpenNotebookDocumentParams) error {
+	doc := &params.NotebookDocument
+	items := make(map[uri.URI]*TextDocumentItem, len(params.CellTextDocuments))
+	for i := range params.CellTextDocuments {
+		items[params.CellTextDocuments[i].URI] = &params.CellTextDocuments[i]
+	}
+	nb := &Notebook{
+		URI:          doc.URI,
+		NotebookType: doc.NotebookType,
+		Version:      doc.Version,
+		Metadata:     doc.Metadata,
+		Cells:        make([]NotebookCellDocument, len(doc.Cells)),
+	}
+	for i, cell := range doc.Cells {
+		item, ok := items[cell.Document]
+		if !ok {
+			return fmt.Errorf("protocol: notebook %s: no text document for cell %s", doc.URI, cell.Document)
+		}
+		nb.Cells[i] = newNotebookCellDocument(cell, item)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.notebooks == nil {
+		s.notebooks = make(map[uri.URI]*Notebook)
+		s.cells = make(map[uri.URI]uri.URI)
+	}
+	if old, ok := s.notebooks[nb.URI]; ok {
+		s.unindex(old)
+	}
+	s.notebooks[nb.URI] = nb
+	s.index(nb)
+	return nil
+}
+
+// DidChange applies the changes params carries to its notebook. The
+// changes apply in order, each to the state the previous one produced; if
+// any fails, the notebook is left as it was.
+//
+// A cell moved within the notebook arrives as a deletion and an insertion
+// with no didOpen or didClose of its text document; it keeps its text.
+func (s *NotebookStore) DidChange(params *DidChangeNotebookDocumentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.notebooks[params.NotebookDocument.URI]
+	if !ok {
+		return fmt.Errorf("%w: notebook %s", ErrUnknownDocument, params.NotebookDocument.URI)
+	}
+	nb := &Notebook{
+		URI:          old.URI,
+		NotebookType: old.NotebookType,
+		Version:      params.NotebookDocument.Version,
+		Metadata:     old.Metadata,
+		Cells:        slices.Clone(old.Cells),
+	}
+	change := &params.Change
+	if change.Metadata != nil {
+		nb.Metadata = change.Metadata
+	}
+	if cells := change.Cells; cells != nil {
+		if cells.Structure != nil {
+			if err := nb.applyStructure(cells.Structure); err != nil {
+				return err
+			}
+		}
+		for _, data := range cells.Data {
+			cell := nb.cell(data.Document)
+			if cell == nil {
+				return fmt.Errorf("%w: cell %s of notebook %s", ErrUnknownDocument, data.Document, nb.URI)
+			}
+			cell.NotebookCell = data
+		}
+		for i := range cells.TextContent {
+			content := &cells.TextContent[i]
+			cell := nb.cell(content.Document.URI)
+			if cell == nil {
+				return fmt.Errorf("%w: cell %s of notebook %s", ErrUnknownDocument, content.Document.URI, nb.URI)
+			}
+			text, err := ApplyContentChanges(cell.Text, content.Changes, s.Encoding)
+			if err != nil {
+				return fmt.Errorf("protocol: cell %s: %w", cell.Document, err)
+			}
+			cell.Text = text
+			cell.Version = content.Document.Version
+		}
+	}
+
+	s.unindex(old)
+	s.notebooks[nb.URI] = nb
+	s.index(nb)
+	return nil
+}
+
+// applyStructure splices the cell array as structure describes.
+func (nb *Notebook) applyStructure(structure *NotebookDocumentCellChangeStructure) error {
+	array := &structure.Array
+	start, end := int(array.Start), int(array.Start)+int(array.DeleteCount)
+	if end > len(nb.Cells) {
+		return fmt.Errorf("protocol: notebook %s: cell change [%d:%d] out of range for %d cells", nb.URI, start, end, len(nb.Cells))
+	}
+
+	texts := make(map[uri.URI]*TextDocumentItem, len(structure.DidOpen)+int(array.DeleteCount))
+	for i := start; i < end; i++ {
+		cell := &nb.Cells[i]
+		texts[cell.Document] = &TextDocumentItem{URI: cell.Document, LanguageID: cell.LanguageID, Version: cell.Version, Text: cell.Text}
+	}
+	for i := range structure.DidOpen {
+		texts[structure.DidOpen[i].URI] = &structure.DidOpen[i]
+	}
+	inserted := make([]NotebookCellDocument, len(array.Cells))
+	for i, cell := range array.Cells {
+		item, ok := texts[cell.Document]
+		if !ok {
+			return fmt.Errorf("protocol: notebook %s: no text document for cell %s", nb.URI, cell.Document)
+		}
+		inserted[i] = newNotebookCellDocument(cell, item)
+	}
+	nb.Cells = slices.Replace(nb.Cells, start, end, inserted...)
+	return nil
+}
+
+// DidClose forgets the notebook params closes and its cells.
+func (s *NotebookStore) DidClose(params *DidCloseNotebookDocumentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nb, ok := s.notebooks[params.NotebookDocument.URI]
+	if !ok {
+		return fmt.Errorf("%w: notebook %s", ErrUnknownDocument, params.NotebookDocument.URI)
+	}
+	s.unindex(nb)
+	delete(s.notebooks, nb.URI)
+	return nil
+}
+
+// Notebook returns the current snapshot of the notebook u.
+func (s *NotebookStore) Notebook(u uri.URI) (*Notebook, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	nb, ok := s.notebooks[u]
+	return nb, ok
+}
+
+// CellNotebook returns the current snapshot of the notebook containing the
+// cell whose text document is cell.
+func (s *NotebookStore) CellNotebook(cell uri.URI) (*Notebook, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.cells[cell]
+	if !ok {
+		return nil, false
+	}
+	return s.notebooks[u], true
+}
+
+func (s *NotebookStore) index(nb *Notebook) {
+	for i := range nb.Cells {
+		s.cells[nb.Cells[i].Document] = nb.URI
+	}
+}
+
+func (s *NotebookStore) unindex(nb *Notebook) {
+	for i := range nb.Cells {
+		delete(s.cells, nb.Cells[i].Document)
+	}
+}
+
+func newNotebookCellDocument(cell NotebookCell, item *TextDocumentItem) NotebookCellDocument {
+	return NotebookCellDocument{NotebookCell: cell, LanguageID: item.LanguageID, Version: item.Version, Text: item.Text}
+}
+
+// Cell returns the cell whose text document is document.
+func (nb *Notebook) Cell(document uri.URI) (*NotebookCellDocument, bool) {
+	cell := nb.cell(document)
+	return cell, cell != nil
+}
+
+func (nb *Notebook) cell(document uri.URI) *NotebookCellDocument {
+	for i := range nb.Cells {
+		if nb.Cells[i].Document == document {
+			return &nb.Cells[i]
+		}
+	}
+	return nil
+}
+
+// NotebookView is a virtual text document concatenating cells of a
+// notebook, for analyzers that want the whole notebook as one source. Each
+// cell starts on a new line, so a character offset means the same in the
+// view and in the cell under any position encoding.
+type NotebookView struct {
+	// Text is the concatenated text; each cell ends with a line break.
+	Text string
+
+	spans []notebookViewSpan
+}
+
+// notebookViewSpan places the lines of one cell in a view.
+type notebookViewSpan struct {
+	document uri.URI
+	line     uint32 // first line of the cell in the view
+	lines    uint32 // lines the cell occupies
+	tail     string // last line of a cell not ending in a line break
+	open     bool   // the view added a line break after the cell
+}
+
+// View concatenates, in notebook order, the cells include reports true
+// for. A nil include selects the code cells.
+func (nb *Notebook) View(include func(cell *NotebookCellDocument) bool) *NotebookView {
+	if include == nil {
+		include = func(cell *NotebookCellDocument) bool { return cell.Kind == NotebookCellKindCode }
+	}
+	var (
+		b    strings.Builder
+		view NotebookView
+		line uint32
+	)
+	for i := range nb.Cells {
+		cell := &nb.Cells[i]
+		if !include(cell) {
+			continue
+		}
+		text := cell.Text
+		span := notebookViewSpan{document: cell.Document, line: line}
+		if !strings.HasSuffix(text, "\n") && !strings.HasSuffix(text, "\r") {
+			span.tail = text[strings.LastIndexAny(text, "\r\n")+1:]
+			span.open = true
+			text += "\n"
+		}
+		lines := uint32(strings.Count(text, "\n") + strings.Count(text, "\r") - strings.Count(text, "\r\n"))
+		span.lines = lines
+		view.spans = append(view.spans, span)
+		b.WriteString(text)
+		line += lines
+	}
+	view.Text = b.String()
+	return &view
+}
+
+// CellPosition maps a position in the view to the cell containing it and
+// the position within that cell. It reports false for a position past the
+// last cell.
+func (v *NotebookView) CellPosition(pos Position) (uri.URI, Position, bool) {
+	span := v.span(pos.Line)
+	if span == nil {
+		return "", Position{}, false
+	}
+	return span.document, Position{Line: pos.Line - span.line, Character: pos.Character}, true
+}
+
+// ViewPosition maps a position in the cell whose text document is
+// document to the view. It reports false for a cell not in the view.
+func (v *NotebookView) ViewPosition(document uri.URI, pos Position) (Position, bool) {
+	for _, span := range v.spans {
+		if span.document == document {
+			return Position{Line: span.line + pos.Line, Character: pos.Character}, true
+		}
+	}
+	return Position{}, false
+}
+
+// CellLocation maps a range in the view to a location in one cell, such as
+// for a diagnostic an analyzer reported on the view. It reports false when
+// the range does not lie within one cell. A range ending at the start of
+// the line after a cell, as one covering whole lines does, ends in that
+// cell: at the start of the line after its last line break or, for a cell
+// whose text has no final line break, at the end of its text, measured in
+// code units of encoding.
+func (v *NotebookView) CellLocation(r Range, encoding PositionEncodingKind) (Location, bool) {
+	span := v.span(r.Start.Line)
+	if span == nil || r.End.Line < r.Start.Line {
+		return Location{}, false
+	}
+	next := span.line + span.lines
+	if r.End.Line >= next && (r.End.Line != next || r.End.Character != 0) {
+		return Location{}, false
+	}
+	end := Position{Line: r.End.Line - span.line, Character: r.End.Character}
+	if r.End.Line == next && span.open {
+		end = Position{Line: span.lines - 1}
+		for tail := span.tail; tail != ""; {
+			r, size := utf8.DecodeRuneInString(tail)
+			end.Character += positionUnits(r, size, encoding)
+			tail = tail[size:]
+		}
+	}
+	return Location{URI: span.document, Range: Range{
+		Start: Position{Line: r.Start.Line - span.line, Character: r.Start.Character},
+		End:   end,
+	}}, true
+}
+
+// span returns the span holding line of the view, or nil past the last
+// cell.
+func (v *NotebookView) span(line uint32) *notebookViewSpan {
+	i, ok := slices.BinarySearchFunc(v.spans, line, func(span notebookViewSpan, line uint32) int {
+		switch {
+		case line < span.line:
+			return 1
+		case line >= span.line+span.lines:
+			return -1
+		}
+		return 0
+	})
+	if !ok {
+		return nil
+	}
+	return &v.spans[i]
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.lsp.dev/uri"
+)
+
+const testNotebookURI uri.URI = "file:///nb.ipynb"
+
+func testCellURI(id string) uri.URI {
+	return uri.URI("vscode-notebook-cell:/nb.ipynb#" + id)
+}
+
+// openTestNotebook opens a notebook with a code cell, a markup cell and a
+// second code cell.
+func openTestNotebook(t *testing.T) *NotebookStore {
+	t.Helper()
+
+	var s NotebookStore
+	err := s.DidOpen(&DidOpenNotebookDocumentParams{
+		NotebookDocument: NotebookDocument{
+			URI:          testNotebookURI,
+			NotebookType: "jupyter-notebook",
+			Version:      1,
+			Cells: []NotebookCell{
+				{Kind: NotebookCellKindCode, Document: testCellURI("a")},
+				{Kind: NotebookCellKindMarkup, Document: testCellURI("m")},
+				{Kind: NotebookCellKindCode, Document: testCellURI("b")},
+			},
+		},
+		CellTextDocuments: []TextDocumentItem{
+			{URI: testCellURI("a"), LanguageID: "python", Version: 1, Text: "import os\nx = 1"},
+			{URI: testCellURI("m"), LanguageID: "markdown", Version: 1, Text: "# Title\n"},
+			{URI: testCellURI("b"), LanguageID: "python", Version: 1, Text: "print(x)\n"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DidOpen() error = %v", err)
+	}
+	return &s
+}
+
+// cellTexts returns the document and text of each cell in order.
+func cellTexts(nb *Notebook) [][2]string {
+	var got [][2]string
+	for _, cell := range nb.Cells {
+		got = append(got, [2]string{string(cell.Document), cell.Text})
+	}
+	return got
+}
+
+func TestNotebookStoreDidChange(t *testing.T) {
+	t.Parallel()
+
+	s := openTestNotebook(t)
+	before, _ := s.Notebook(testNotebookURI)
+
+	// Replace the markup cell with a new cell c, moving cell b after it:
+	// b arrives as a deletion and an insertion without didOpen.
+	err := s.DidChange(&DidChangeNotebookDocumentParams{
+		NotebookDocument: VersionedNotebookDocumentIdentifier{URI: testNotebookURI, Version: 2},
+		Change: NotebookDocumentChangeEvent{
+			Metadata: LSPObject{"kernel": LSPAny(`"python3"`)},
+			Cells: &NotebookDocumentCellChanges{
+				Structure: &NotebookDocumentCellChangeStructure{
+					Array: NotebookCellArrayChange{Start: 1, DeleteCount: 2, Cells: []NotebookCell{
+						{Kind: NotebookCellKindCode, Document: testCellURI("c")},
+						{Kind: NotebookCellKindCode, Document: testCellURI("b")},
+					}},
+					DidOpen:  []TextDocumentItem{{URI: testCellURI("c"), LanguageID: "python", Version: 1, Text: "y = 2\n"}},
+					DidClose: []TextDocumentIdentifier{{URI: testCellURI("m")}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DidChange(structure) error = %v", err)
+	}
+	err = s.DidChange(&DidChangeNotebookDocumentParams{
+		NotebookDocument: VersionedNotebookDocumentIdentifier{URI: testNotebookURI, Version: 3},
+		Change: NotebookDocumentChangeEvent{
+			Cells: &NotebookDocumentCellChanges{
+				Data: []NotebookCell{{Kind: NotebookCellKindCode, Document: testCellURI("c"), ExecutionSummary: &ExecutionSummary{ExecutionOrder: 4}}},
+				TextContent: []NotebookDocumentCellContentChanges{{
+					Document: VersionedTextDocumentIdentifier{TextDocumentIdentifier: TextDocumentIdentifier{URI: testCellURI("a")}, Version: 2},
+					Changes: []TextDocumentContentChangeEvent{&TextDocumentContentChangePartial{
+						Range: Range{Start: Position{Line: 1, Character: 4}, End: Position{Line: 1, Character: 5}},
+						Text:  "42",
+					}},
+				}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DidChange(content) error = %v", err)
+	}
+
+	nb, ok := s.Notebook(testNotebookURI)
+	if !ok {
+		t.Fatal("Notebook() not found")
+	}
+	want := [][2]string{
+		{string(testCellURI("a")), "import os\nx = 42"},
+		{string(testCellURI("c")), "y = 2\n"},
+		{string(testCellURI("b")), "print(x)\n"},
+	}
+	if diff := gocmp.Diff(want, cellTexts(nb)); diff != "" {
+		t.Errorf("cells mismatch (-want +got):\n%s", diff)
+	}
+	if nb.Version != 3 || string(nb.Metadata["kernel"]) != `"python3"` {
+		t.Errorf("Notebook() version %d metadata %v, want 3 and the new kernel", nb.Version, nb.Metadata)
+	}
+	if cell, _ := nb.Cell(testCellURI("a")); cell.Version != 2 {
+		t.Errorf("cell a version = %d, want 2", cell.Version)
+	}
+	if cell, _ := nb.Cell(testCellURI("c")); cell.ExecutionSummary == nil || cell.ExecutionSummary.ExecutionOrder != 4 {
+		t.Errorf("cell c execution summary = %+v, want order 4", cell.ExecutionSummary)
+	}
+
+	// Earlier snapshots are unchanged.
+	if diff := gocmp.Diff([][2]string{
+		{string(testCellURI("a")), "import os\nx = 1"},
+		{string(testCellURI("m")), "# Title\n"},
+		{string(testCellURI("b")), "print(x)\n"},
+	}, cellTexts(before)); diff != "" {
+		t.Errorf("earlier snapshot changed (-want +got):\n%s", diff)
+	}
+
+	if _, ok := s.CellNotebook(testCellURI("m")); ok {
+		t.Error("CellNotebook(closed cell) found a notebook")
+	}
+	if got, ok := s.CellNotebook(testCellURI("c")); !ok || got != nb {
+		t.Errorf("CellNotebook(c) = %v, %t, want the current snapshot", got, ok)
+	}
+
+	if err := s.DidClose(&DidCloseNotebookDocumentParams{NotebookDocument: NotebookDocumentIdentifier{URI: testNotebookURI}}); err != nil {
+		t.Fatalf("DidClose() error = %v", err)
+	}
+	if _, ok := s.CellNotebook(testCellURI("a")); ok {
+		t.Error("CellNotebook() found a cell of a closed notebook")
+	}
+}
+
+func TestNotebookStoreDidChangeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params  *DidChangeNotebookDocumentParams
+		wantErr error
+	}{
+		"error: unknown notebook": {
+			params:  &DidChangeNotebookDocumentParams{NotebookDocument: VersionedNotebookDocumentIdentifier{URI: "file:///other.ipynb"}},
+			wantErr: ErrUnknownDocument,
+		},
+		"error: unknown cell": {
+			params: &DidChangeNotebookDocumentParams{
+				NotebookDocument: VersionedNotebookDocumentIdentifier{URI: testNotebookURI, Version: 2},
+				Change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentCellChanges{
+					TextContent: []NotebookDocumentCellContentChanges{{Document: VersionedTextDocumentIdentifier{TextDocumentIdentifier: TextDocumentIdentifier{URI: testCellURI("z")}}}},
+				}},
+			},
+			wantErr: ErrUnknownDocument,
+		},
+		"error: splice out of range": {
+			params: &DidChangeNotebookDocumentParams{
+				NotebookDocument: VersionedNotebookDocumentIdentifier{URI: testNotebookURI, Version: 2},
+				Change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentCellChanges{
+					Structure: &NotebookDocumentCellChangeStructure{Array: NotebookCellArrayChange{Start: 2, DeleteCount: 2}},
+				}},
+			},
+		},
+		"error: inserted cell without text": {
+			params: &DidChangeNotebookDocumentParams{
+				NotebookDocument: VersionedNotebookDocumentIdentifier{URI: testNotebookURI, Version: 2},
+				Change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentCellChanges{
+					Structure: &NotebookDocumentCellChangeStructure{Array: NotebookCellArrayChange{Cells: []NotebookCell{{Document: testCellURI("z")}}}},
+				}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := openTestNotebook(t)
+			before, _ := s.Notebook(testNotebookURI)
+			err := s.DidChange(tt.params)
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DidChange() error = %v, want %v", err, tt.wantErr)
+			}
+			if after, _ := s.Notebook(testNotebookURI); after != before {
+				t.Error("failed DidChange() replaced the notebook")
+			}
+		})
+	}
+}
+
+func TestNotebookView(t *testing.T) {
+	t.Parallel()
+
+	s := openTestNotebook(t)
+	nb, _ := s.Notebook(testNotebookURI)
+	view := nb.View(nil)
+	if want := "import os\nx = 1\nprint(x)\n"; view.Text != want {
+		t.Fatalf("View().Text = %q, want %q", view.Text, want)
+	}
+
+	positions := map[string]struct {
+		view Position
+		cell uri.URI
+		pos  Position
+	}{
+		"success: first cell":  {view: Position{Line: 1, Character: 4}, cell: testCellURI("a"), pos: Position{Line: 1, Character: 4}},
+		"success: second cell": {view: Position{Line: 2, Character: 6}, cell: testCellURI("b"), pos: Position{Character: 6}},
+	}
+	for name, tt := range positions {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cell, pos, ok := view.CellPosition(tt.view)
+			if !ok || cell != tt.cell || pos != tt.pos {
+				t.Errorf("CellPosition(%v) = %s, %v, %t, want %s, %v", tt.view, cell, pos, ok, tt.cell, tt.pos)
+			}
+			back, ok := view.ViewPosition(tt.cell, tt.pos)
+			if !ok || back != tt.view {
+				t.Errorf("ViewPosition(%s, %v) = %v, %t, want %v", tt.cell, tt.pos, back, ok, tt.view)
+			}
+		})
+	}
+
+	if _, _, ok := view.CellPosition(Position{Line: 3}); ok {
+		t.Error("CellPosition(past the last cell) reported a cell")
+	}
+	if _, ok := view.ViewPosition(testCellURI("m"), Position{}); ok {
+		t.Error("ViewPosition(markup cell) reported a position")
+	}
+
+	locations := map[string]struct {
+		r    Range
+		want *Location
+	}{
+		"success: within a cell": {
+			r:    Range{Start: Position{Line: 1}, End: Position{Line: 1, Character: 5}},
+			want: &Location{URI: testCellURI("a"), Range: Range{Start: Position{Line: 1}, End: Position{Line: 1, Character: 5}}},
+		},
+		"success: whole lines up to the next cell": {
+			r:    Range{Start: Position{Line: 2}, End: Position{Line: 3}},
+			want: &Location{URI: testCellURI("b"), Range: Range{End: Position{Line: 1}}},
+		},
+		"success: whole lines of a cell without a final line break": {
+			r:    Range{Start: Position{Line: 0}, End: Position{Line: 2}},
+			want: &Location{URI: testCellURI("a"), Range: Range{End: Position{Line: 1, Character: 5}}},
+		},
+		"error: across cells": {
+			r: Range{Start: Position{Line: 1}, End: Position{Line: 2, Character: 1}},
+		},
+	}
+	for name, tt := range locations {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := view.CellLocation(tt.r, PositionEncodingKindUTF16)
+			if tt.want == nil {
+				if ok {
+					t.Errorf("CellLocation(%v) = %v, want none", tt.r, got)
+				}
+				return
+			}
+			if diff := gocmp.Diff(*tt.want, got); !ok || diff != "" {
+				t.Errorf("CellLocation(%v) mismatch (-want +got):\n%s", tt.r, diff)
+			}
+		})
+	}
+}
+
+func TestNotebookViewCellLocationEncoding(t *testing.T) {
+	t.Parallel()
+
+	nb := &Notebook{Cells: []NotebookCellDocument{{
+		NotebookCell: NotebookCell{Kind: NotebookCellKindCode, Document: testCellURI("a")},
+		Text:         "s = 1\nt = \"é😀\"",
+	}}}
+	view := nb.View(nil)
+	tests := map[string]struct {
+		encoding PositionEncodingKind
+		want     uint32
+	}{
+		"success: utf-8":  {encoding: PositionEncodingKindUTF8, want: 12},
+		"success: utf-16": {encoding: PositionEncodingKindUTF16, want: 9},
+		"success: utf-32": {encoding: PositionEncodingKindUTF32, want: 8},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := view.CellLocation(Range{End: Position{Line: 2}}, tt.encoding)
+			if want := (Position{Line: 1, Character: tt.want}); !ok || got.Range.End != want {
+				t.Errorf("CellLocation() end = %v, %t, want %v", got.Range.End, ok, want)
+			}
+		})
+	}
+}
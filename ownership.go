@@ -0,0 +1,181 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"unsafe"
+)
+
+// Ownership selects what the strings and raw JSON values of a decoded value
+// may share memory with.
+type Ownership int
+
+const (
+	// OwnershipCopy decodes from one private copy of the input, which decoded
+	// strings and raw values may alias. The caller may reuse the input as soon
+	// as decoding returns. This is what [Unmarshal] does.
+	OwnershipCopy Ownership = iota
+
+	// OwnershipBorrow decodes the caller's input in place, without copying it:
+	// decoded strings and raw values may alias the input, which must then stay
+	// unmodified for as long as the value is in use. Use it when the caller owns
+	// the buffer's lifetime, such as a server that decodes each frame into a
+	// fresh buffer it never reuses.
+	OwnershipBorrow
+
+	// OwnershipDetach gives every decoded string and raw value its own memory,
+	// so retaining any part of the value keeps nothing else alive. It suits
+	// values kept in long-lived caches, and is cheaper than decoding and then
+	// calling [Clone].
+	OwnershipDetach
+)
+
+// UnmarshalOptions configures decoding beyond what [Unmarshal] offers. The
+// zero value decodes exactly as Unmarshal does.
+type UnmarshalOptions struct {
+	// Ownership selects how the decoded value relates to the input memory.
+	Ownership Ownership
+
+	// Strict validates the input against the meta-model before decoding and
+	// fails with a [*ValidationError] listing every unknown member, missing
+	// required property, type mismatch and invalid enum value, each with a
+	// JSON Pointer to its location. Duplicate member names and invalid UTF-8,
+	// which [Unmarshal] tolerates, are reported too; they end validation at
+	// the first occurrence. Strict decoding is meant for conformance tests and
+	// costs a second pass over the input.
+	Strict bool
+
+	// DiagnoseUnions explains a value no arm of a union type accepts: the
+	// [*UnionError] decoding fails with lists, for each arm, the keys the
+	// union dispatcher checks, those missing or unknown, and the error
+	// decoding the value as that arm alone. It is meant for debugging peers
+	// that send near-miss payloads, and costs nothing until decoding fails.
+	DiagnoseUnions bool
+}
+
+// Unmarshal decodes data into v as [Unmarshal] does, under o.Ownership and,
+// when set, o.Strict and o.DiagnoseUnions.
+func (o UnmarshalOptions) Unmarshal(data []byte, v any) error {
+	err := o.unmarshal(data, v)
+	if err != nil && o.DiagnoseUnions {
+		diagnoseUnions(err)
+	}
+	return err
+}
+
+func (o UnmarshalOptions) unmarshal(data []byte, v any) error {
+	if o.Strict {
+		if err := validateStrict(data, v); err != nil {
+			return err
+		}
+	}
+	switch o.Ownership {
+	case OwnershipBorrow:
+		return unmarshal(data, v, true)
+	case OwnershipDetach:
+		// Every string is copied out afterwards, so the walkers may read data in
+		// place rather than from a copy that would be discarded anyway.
+		if err := unmarshal(data, v, true); err != nil {
+			return err
+		}
+		Detach(v)
+		return nil
+	default:
+		return unmarshal(data, v, false)
+	}
+}
+
+// NewDecoder returns a [Decoder] reading from r that decodes under o. A
+// Decoder reuses its read buffer, so it treats [OwnershipBorrow] as
+// [OwnershipCopy].
+func (o UnmarshalOptions) NewDecoder(r io.Reader) *Decoder {
+	d := NewDecoder(r)
+	d.opts = o
+	if o.Ownership == OwnershipBorrow {
+		d.opts.Ownership = OwnershipCopy
+	}
+	return d
+}
+
+// Detach gives every string and raw JSON value reachable from v its own
+// memory, in place, so the value no longer shares memory with the input it
+// was decoded from (see [OwnershipDetach]). v must be a pointer; other values
+// are left as they are.
+func Detach(v any) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return
+	}
+	detachValue(rv.Elem())
+}
+
+// detachValue copies the strings and byte slices reachable from the
+// addressable value v.
+func detachValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() > 0 {
+			v.SetString(strings.Clone(v.String()))
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			detachValue(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if e := v.Elem(); e.Kind() == reflect.Pointer {
+			detachValue(e)
+		} else {
+			// Values stored in an interface are immutable; detach a copy and
+			// store it back.
+			c := reflect.New(e.Type()).Elem()
+			c.Set(e)
+			detachValue(c)
+			v.Set(c)
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(slices.Clone(v.Bytes()))
+			return
+		}
+		for i := range v.Len() {
+			detachValue(v.Index(i))
+		}
+	case reflect.Array:
+		for i := range v.Len() {
+			detachValue(v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			k := reflect.New(iter.Key().Type()).Elem()
+			k.Set(iter.Key())
+			detachValue(k)
+			e := reflect.New(iter.Value().Type()).Elem()
+			e.Set(iter.Value())
+			detachValue(e)
+			// Assigning an equal string key replaces the stored key too.
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			f := v.Field(i)
+			if !f.CanSet() {
+				// Optional and Nullable keep their value unexported.
+				f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+			}
+			detachValue(f)
+		}
+	default:
+	}
+}
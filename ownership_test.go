@@ -0,0 +1,113 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// aliases reports whether s points into buf.
+func aliases(s string, buf []byte) bool {
+	if s == "" || len(buf) == 0 {
+		return false
+	}
+	p := uintptr(unsafe.Pointer(unsafe.StringData(s)))
+	start := uintptr(unsafe.Pointer(&buf[0]))
+	return p >= start && p < start+uintptr(len(buf))
+}
+
+func TestUnmarshalOptionsOwnership(t *testing.T) {
+	t.Parallel()
+
+	const input = `{"label":"fmt","textEditText":"fmt.Println","documentation":"prints","data":{"k":"v"}}`
+
+	tests := map[string]struct {
+		ownership   Ownership
+		wantAliases bool
+	}{
+		"success: copy does not alias the input":   {ownership: OwnershipCopy},
+		"success: borrow aliases the input":        {ownership: OwnershipBorrow, wantAliases: true},
+		"success: detach does not alias the input": {ownership: OwnershipDetach},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := []byte(input)
+			var got CompletionItem
+			if err := (UnmarshalOptions{Ownership: tt.ownership}).Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			doc, ok := got.Documentation.(String)
+			if !ok {
+				t.Fatalf("Documentation = %T, want String", got.Documentation)
+			}
+			editText, _ := got.TextEditText.Get()
+			if got.Label != "fmt" || editText != "fmt.Println" || doc != "prints" {
+				t.Fatalf("decoded %+v", got)
+			}
+			if a := aliases(got.Label, data); a != tt.wantAliases {
+				t.Fatalf("Label aliases input = %v, want %v", a, tt.wantAliases)
+			}
+			if tt.wantAliases {
+				return
+			}
+			for field, s := range map[string]string{
+				"textEditText":  editText,
+				"documentation": string(doc),
+				"data":          unsafe.String(unsafe.SliceData(got.Data), len(got.Data)),
+			} {
+				if aliases(s, data) {
+					t.Fatalf("%s aliases the input", field)
+				}
+			}
+		})
+	}
+}
+
+func TestDetach(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`[{"label":"a","labelDetails":{"detail":"(x)"}},{"label":"b","tags":[1]}]`)
+	var items []CompletionItem
+	if err := unmarshal(data, &items, true); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	Detach(&items)
+
+	for _, it := range items {
+		if aliases(it.Label, data) {
+			t.Fatalf("Label %q still aliases the input", it.Label)
+		}
+	}
+	if d := items[0].LabelDetails.Detail; d == nil || *d != "(x)" || aliases(*d, data) {
+		t.Fatalf("LabelDetails.Detail = %v, want a detached (x)", d)
+	}
+
+	// Mutating the input no longer affects the value.
+	copy(data, strings.Repeat("!", len(data)))
+	if items[0].Label != "a" || items[1].Label != "b" {
+		t.Fatalf("labels = %q, %q after mutating the input", items[0].Label, items[1].Label)
+	}
+}
+
+func TestDecoderOwnership(t *testing.T) {
+	t.Parallel()
+
+	d := UnmarshalOptions{Ownership: OwnershipBorrow}.NewDecoder(strings.NewReader(`{"label":"a"} {"label":"b"}`))
+	var first, second CompletionItem
+	if err := d.Decode(&first); err != nil {
+		t.Fatalf("Decode first: %v", err)
+	}
+	if err := d.Decode(&second); err != nil {
+		t.Fatalf("Decode second: %v", err)
+	}
+	// A borrowing Decoder would have let the second read overwrite the first
+	// label in the shared read buffer.
+	if first.Label != "a" || second.Label != "b" {
+		t.Fatalf("labels = %q, %q, want a, b", first.Label, second.Label)
+	}
+}
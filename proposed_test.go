@@ -0,0 +1,30 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build lsp_proposed
+
+package protocol
+
+import "testing"
+
+func TestProposedCodeActionTags(t *testing.T) {
+	t.Parallel()
+
+	const in = `{"title":"Fix","tags":[1]}`
+
+	var action CodeAction
+	if err := Unmarshal([]byte(in), &action); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(action.Tags) != 1 || action.Tags[0] != CodeActionTagLLMGenerated {
+		t.Fatalf("Tags = %v, want [CodeActionTagLLMGenerated]", action.Tags)
+	}
+
+	out, err := Marshal(&action)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if g, w := canon(t, out), canon(t, []byte(in)); g != w {
+		t.Fatalf("Marshal() = %s, want %s", g, w)
+	}
+}
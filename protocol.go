@@ -9,32 +9,133 @@ import (
 	"go.lsp.dev/jsonrpc2"
 )
 
+// ConnOption configures a connection built by [NewServer] or [NewClient],
+// or a [FrameWriter].
+type ConnOption func(*connOptions)
+
+type connOptions struct {
+	codec      lspCodec
+	extensions *Extensions
+	validate   bool
+}
+
+// WithVersion pins the connection to protocol version, for peers that only
+// speak an older revision of the specification. Outgoing params and results
+// are encoded with [MarshalVersion] under policy, and outgoing requests and
+// notifications for methods introduced after version fail with an error
+// wrapping [ErrVersionUnsupported] regardless of policy, since a method cannot
+// be stripped.
+func WithVersion(version string, policy VersionPolicy) ConnOption {
+	return func(o *connOptions) {
+		o.codec.version = version
+		o.codec.policy = policy
+	}
+}
+
+// WithExtensions serves the non-standard methods registered on x ahead of the
+// connection's [Server] or [Client]; other non-standard methods still reach
+// [Server.Request] or the method-not-found fallback.
+func WithExtensions(x *Extensions) ConnOption {
+	return func(o *connOptions) {
+		o.extensions = x
+	}
+}
+
+// WithValidation checks the params of incoming standard methods against the
+// meta-model before they reach the connection's [Server] or [Client], as
+// [ValidationHandler] does. Members the meta-model does not declare pass.
+func WithValidation() ConnOption {
+	return func(o *connOptions) {
+		o.validate = true
+	}
+}
+
+// newConn builds the jsonrpc2 connection for stream configured by opts, and
+// returns the options for wiring its handler.
+func newConn(stream jsonrpc2.Stream, opts []ConnOption) (jsonrpc2.Conn, *connOptions) {
+	o := &connOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	conn := jsonrpc2.NewConn(stream, jsonrpc2.WithCodec(o.codec))
+	if o.codec.version != "" {
+		return &versionConn{Conn: conn, version: o.codec.version}, o
+	}
+
+	return conn, o
+}
+
+// handler wraps the handler serving the standard methods with the standard
+// middleware chain, any registered extensions and validation.
+func (o *connOptions) handler(handler jsonrpc2.Handler) jsonrpc2.Handler {
+	if o.extensions != nil {
+		handler = o.extensions.Handler(handler)
+	}
+	if o.validate {
+		handler = ValidationHandler(handler)
+	}
+
+	return Handlers(handler)
+}
+
 // NewServer returns the context in which the [Client] dispatcher is embedded, the
 // jsonrpc2 connection, and that [Client]. The connection serves the supplied
-// [Server] and is wired with the union-aware [lspCodec].
+// [Server] and is wired with the union-aware [lspCodec]. Build stream with
+// [NewStream] unless the transport needs another framing.
 //
 //nolint:unparam // returned context mirrors NewClient and is part of the stable symmetric API; callers may embed and reuse it
-func NewServer(ctx context.Context, server Server, stream jsonrpc2.Stream) (context.Context, jsonrpc2.Conn, Client) {
-	conn := jsonrpc2.NewConn(stream, jsonrpc2.WithCodec(lspCodec{}))
+func NewServer(ctx context.Context, server Server, stream jsonrpc2.Stream, opts ...ConnOption) (context.Context, jsonrpc2.Conn, Client) {
+	conn, o := newConn(stream, opts)
 	client := ClientDispatcher(conn)
 	ctx = WithClient(ctx, client)
 
-	conn.Go(ctx, Handlers(ServerHandler(server, jsonrpc2.MethodNotFoundHandler)))
+	conn.Go(ctx, o.handler(ServerHandler(server, jsonrpc2.MethodNotFoundHandler)))
 
 	return ctx, conn, client
 }
 
 // NewClient returns the context in which the [Client] is embedded, the jsonrpc2
 // connection, and the [Server] dispatcher. The connection serves the supplied
-// [Client] and is wired with the union-aware [lspCodec].
+// [Client] and is wired with the union-aware [lspCodec]. Build stream with
+// [NewStream] unless the transport needs another framing.
 //
 //nolint:unparam // returned context mirrors NewServer and is part of the stable symmetric API; callers may embed and reuse it
-func NewClient(ctx context.Context, client Client, stream jsonrpc2.Stream) (context.Context, jsonrpc2.Conn, Server) {
+func NewClient(ctx context.Context, client Client, stream jsonrpc2.Stream, opts ...ConnOption) (context.Context, jsonrpc2.Conn, Server) {
 	ctx = WithClient(ctx, client)
 
-	conn := jsonrpc2.NewConn(stream, jsonrpc2.WithCodec(lspCodec{}))
-	conn.Go(ctx, Handlers(ClientHandler(client, jsonrpc2.MethodNotFoundHandler)))
+	conn, o := newConn(stream, opts)
+	conn.Go(ctx, o.handler(ClientHandler(client, jsonrpc2.MethodNotFoundHandler)))
 	server := ServerDispatcher(conn)
 
 	return ctx, conn, server
 }
+
+// versionConn is a [jsonrpc2.Conn] pinned to a protocol version: it refuses to
+// send methods the peer's version does not define.
+type versionConn struct {
+	jsonrpc2.Conn
+
+	version string
+}
+
+// Call implements [jsonrpc2.Conn].
+func (c *versionConn) Call(ctx context.Context, method string, params, result any) (jsonrpc2.ID, error) {
+	if err := c.check(method); err != nil {
+		return jsonrpc2.ID{}, err
+	}
+
+	return c.Conn.Call(ctx, method, params, result)
+}
+
+// Notify implements [jsonrpc2.Conn].
+func (c *versionConn) Notify(ctx context.Context, method string, params any) error {
+	if err := c.check(method); err != nil {
+		return err
+	}
+
+	return c.Conn.Notify(ctx, method, params)
+}
+
+func (c *versionConn) check(method string) error {
+	return lspCodec{version: c.version}.checkMethod(method)
+}
@@ -0,0 +1,2548 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+package protocol
+
+var shapeAnnotatedTextEdit = &shape{kind: shapeObject, name: "AnnotatedTextEdit", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "newText", typ: shapeOfString},
+	{name: "annotationId", typ: shapeRefTo("ChangeAnnotationIdentifier")},
+}}
+
+var shapeApplyKind = &shape{kind: shapeEnum, name: "ApplyKind", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeApplyWorkspaceEditParams = &shape{kind: shapeObject, name: "ApplyWorkspaceEditParams", fields: []shapeField{
+	{name: "label", optional: true, typ: shapeOfString},
+	{name: "edit", typ: shapeRefTo("WorkspaceEdit")},
+	{name: "metadata", optional: true, typ: shapeRefTo("WorkspaceEditMetadata")},
+}}
+
+var shapeApplyWorkspaceEditResult = &shape{kind: shapeObject, name: "ApplyWorkspaceEditResult", fields: []shapeField{
+	{name: "applied", typ: shapeOfBoolean},
+	{name: "failureReason", optional: true, typ: shapeOfString},
+	{name: "failedChange", optional: true, typ: shapeOfUinteger},
+}}
+
+var shapeBaseSymbolInformation = &shape{kind: shapeObject, name: "BaseSymbolInformation", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "containerName", optional: true, typ: shapeOfString},
+}}
+
+var shapeCallHierarchyClientCapabilities = &shape{kind: shapeObject, name: "CallHierarchyClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCallHierarchyIncomingCall = &shape{kind: shapeObject, name: "CallHierarchyIncomingCall", fields: []shapeField{
+	{name: "from", typ: shapeRefTo("CallHierarchyItem")},
+	{name: "fromRanges", typ: &shape{kind: shapeArray, elem: shapeRefTo("Range")}},
+}}
+
+var shapeCallHierarchyIncomingCallsParams = &shape{kind: shapeObject, name: "CallHierarchyIncomingCallsParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "item", typ: shapeRefTo("CallHierarchyItem")},
+}}
+
+var shapeCallHierarchyItem = &shape{kind: shapeObject, name: "CallHierarchyItem", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "detail", optional: true, typ: shapeOfString},
+	{name: "uri", typ: shapeOfString},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "selectionRange", typ: shapeRefTo("Range")},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeCallHierarchyOptions = &shape{kind: shapeObject, name: "CallHierarchyOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCallHierarchyOutgoingCall = &shape{kind: shapeObject, name: "CallHierarchyOutgoingCall", fields: []shapeField{
+	{name: "to", typ: shapeRefTo("CallHierarchyItem")},
+	{name: "fromRanges", typ: &shape{kind: shapeArray, elem: shapeRefTo("Range")}},
+}}
+
+var shapeCallHierarchyOutgoingCallsParams = &shape{kind: shapeObject, name: "CallHierarchyOutgoingCallsParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "item", typ: shapeRefTo("CallHierarchyItem")},
+}}
+
+var shapeCallHierarchyPrepareParams = &shape{kind: shapeObject, name: "CallHierarchyPrepareParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeCallHierarchyProvider = &shape{kind: shapeOr, name: "CallHierarchyProvider", items: []*shape{shapeOfBoolean, shapeRefTo("CallHierarchyOptions"), shapeRefTo("CallHierarchyRegistrationOptions")}}
+
+var shapeCallHierarchyRegistrationOptions = &shape{kind: shapeObject, name: "CallHierarchyRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeCancelParams = &shape{kind: shapeObject, name: "CancelParams", fields: []shapeField{
+	{name: "id", typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfString}}},
+}}
+
+var shapeChangeAnnotation = &shape{kind: shapeObject, name: "ChangeAnnotation", fields: []shapeField{
+	{name: "label", typ: shapeOfString},
+	{name: "needsConfirmation", optional: true, typ: shapeOfBoolean},
+	{name: "description", optional: true, typ: shapeOfString},
+}}
+
+var shapeChangeAnnotationIdentifier = shapeOfString
+
+var shapeChangeAnnotationsSupportOptions = &shape{kind: shapeObject, name: "ChangeAnnotationsSupportOptions", fields: []shapeField{
+	{name: "groupsOnLabel", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeChangeNotifications = &shape{kind: shapeOr, name: "ChangeNotifications", items: []*shape{shapeOfString, shapeOfBoolean}}
+
+var shapeClientCapabilities = &shape{kind: shapeObject, name: "ClientCapabilities", fields: []shapeField{
+	{name: "workspace", optional: true, typ: shapeRefTo("WorkspaceClientCapabilities")},
+	{name: "textDocument", optional: true, typ: shapeRefTo("TextDocumentClientCapabilities")},
+	{name: "notebookDocument", optional: true, typ: shapeRefTo("NotebookDocumentClientCapabilities")},
+	{name: "window", optional: true, typ: shapeRefTo("WindowClientCapabilities")},
+	{name: "general", optional: true, typ: shapeRefTo("GeneralClientCapabilities")},
+	{name: "experimental", optional: true, typ: shapeOfAny},
+}}
+
+var shapeClientCodeActionKindOptions = &shape{kind: shapeObject, name: "ClientCodeActionKindOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKind")}},
+}}
+
+var shapeClientCodeActionLiteralOptions = &shape{kind: shapeObject, name: "ClientCodeActionLiteralOptions", fields: []shapeField{
+	{name: "codeActionKind", typ: shapeRefTo("ClientCodeActionKindOptions")},
+}}
+
+var shapeClientCodeActionResolveOptions = &shape{kind: shapeObject, name: "ClientCodeActionResolveOptions", fields: []shapeField{
+	{name: "properties", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeClientCodeLensResolveOptions = &shape{kind: shapeObject, name: "ClientCodeLensResolveOptions", fields: []shapeField{
+	{name: "properties", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeClientCompletionItemInsertTextModeOptions = &shape{kind: shapeObject, name: "ClientCompletionItemInsertTextModeOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("InsertTextMode")}},
+}}
+
+var shapeClientCompletionItemOptions = &shape{kind: shapeObject, name: "ClientCompletionItemOptions", fields: []shapeField{
+	{name: "snippetSupport", optional: true, typ: shapeOfBoolean},
+	{name: "commitCharactersSupport", optional: true, typ: shapeOfBoolean},
+	{name: "documentationFormat", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("MarkupKind")}},
+	{name: "deprecatedSupport", optional: true, typ: shapeOfBoolean},
+	{name: "preselectSupport", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("CompletionItemTagOptions")},
+	{name: "insertReplaceSupport", optional: true, typ: shapeOfBoolean},
+	{name: "resolveSupport", optional: true, typ: shapeRefTo("ClientCompletionItemResolveOptions")},
+	{name: "insertTextModeSupport", optional: true, typ: shapeRefTo("ClientCompletionItemInsertTextModeOptions")},
+	{name: "labelDetailsSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientCompletionItemOptionsKind = &shape{kind: shapeObject, name: "ClientCompletionItemOptionsKind", fields: []shapeField{
+	{name: "valueSet", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CompletionItemKind")}},
+}}
+
+var shapeClientCompletionItemResolveOptions = &shape{kind: shapeObject, name: "ClientCompletionItemResolveOptions", fields: []shapeField{
+	{name: "properties", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeClientDiagnosticsTagOptions = &shape{kind: shapeObject, name: "ClientDiagnosticsTagOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("DiagnosticTag")}},
+}}
+
+var shapeClientFoldingRangeKindOptions = &shape{kind: shapeObject, name: "ClientFoldingRangeKindOptions", fields: []shapeField{
+	{name: "valueSet", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("FoldingRangeKind")}},
+}}
+
+var shapeClientFoldingRangeOptions = &shape{kind: shapeObject, name: "ClientFoldingRangeOptions", fields: []shapeField{
+	{name: "collapsedText", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientInfo = &shape{kind: shapeObject, name: "ClientInfo", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "version", optional: true, typ: shapeOfString},
+}}
+
+var shapeClientInlayHintResolveOptions = &shape{kind: shapeObject, name: "ClientInlayHintResolveOptions", fields: []shapeField{
+	{name: "properties", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeClientSemanticTokensRequestFullDelta = &shape{kind: shapeObject, name: "ClientSemanticTokensRequestFullDelta", fields: []shapeField{
+	{name: "delta", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientSemanticTokensRequestOptions = &shape{kind: shapeObject, name: "ClientSemanticTokensRequestOptions", fields: []shapeField{
+	{name: "range", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, &shape{kind: shapeObject, fields: []shapeField{}}}}},
+	{name: "full", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("ClientSemanticTokensRequestFullDelta")}}},
+}}
+
+var shapeClientSemanticTokensRequestOptionsFull = &shape{kind: shapeOr, name: "ClientSemanticTokensRequestOptionsFull", items: []*shape{shapeOfBoolean, shapeRefTo("ClientSemanticTokensRequestFullDelta")}}
+
+var shapeClientSemanticTokensRequestOptionsRange = &shape{kind: shapeOr, name: "ClientSemanticTokensRequestOptionsRange", items: []*shape{shapeOfBoolean, &shape{kind: shapeObject, fields: []shapeField{}}}}
+
+var shapeClientShowMessageActionItemOptions = &shape{kind: shapeObject, name: "ClientShowMessageActionItemOptions", fields: []shapeField{
+	{name: "additionalPropertiesSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientSignatureInformationOptions = &shape{kind: shapeObject, name: "ClientSignatureInformationOptions", fields: []shapeField{
+	{name: "documentationFormat", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("MarkupKind")}},
+	{name: "parameterInformation", optional: true, typ: shapeRefTo("ClientSignatureParameterInformationOptions")},
+	{name: "activeParameterSupport", optional: true, typ: shapeOfBoolean},
+	{name: "noActiveParameterSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientSignatureParameterInformationOptions = &shape{kind: shapeObject, name: "ClientSignatureParameterInformationOptions", fields: []shapeField{
+	{name: "labelOffsetSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeClientSymbolKindOptions = &shape{kind: shapeObject, name: "ClientSymbolKindOptions", fields: []shapeField{
+	{name: "valueSet", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolKind")}},
+}}
+
+var shapeClientSymbolResolveOptions = &shape{kind: shapeObject, name: "ClientSymbolResolveOptions", fields: []shapeField{
+	{name: "properties", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeClientSymbolTagOptions = &shape{kind: shapeObject, name: "ClientSymbolTagOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+}}
+
+var shapeCodeActionContext = &shape{kind: shapeObject, name: "CodeActionContext", fields: []shapeField{
+	{name: "diagnostics", typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+	{name: "only", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKind")}},
+	{name: "triggerKind", optional: true, typ: shapeRefTo("CodeActionTriggerKind")},
+}}
+
+var shapeCodeActionDisabled = &shape{kind: shapeObject, name: "CodeActionDisabled", fields: []shapeField{
+	{name: "reason", typ: shapeOfString},
+}}
+
+var shapeCodeActionKind = &shape{kind: shapeEnum, name: "CodeActionKind", base: shapeString, open: true, values: []string{"", "quickfix", "refactor", "refactor.extract", "refactor.inline", "refactor.move", "refactor.rewrite", "source", "source.organizeImports", "source.fixAll", "notebook"}}
+
+var shapeCodeActionKindDocumentation = &shape{kind: shapeObject, name: "CodeActionKindDocumentation", fields: []shapeField{
+	{name: "kind", typ: shapeRefTo("CodeActionKind")},
+	{name: "command", typ: shapeRefTo("Command")},
+}}
+
+var shapeCodeActionOptions = &shape{kind: shapeObject, name: "CodeActionOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "codeActionKinds", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKind")}},
+	{name: "documentation", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKindDocumentation")}},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCodeActionParams = &shape{kind: shapeObject, name: "CodeActionParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "context", typ: shapeRefTo("CodeActionContext")},
+}}
+
+var shapeCodeActionProvider = &shape{kind: shapeOr, name: "CodeActionProvider", items: []*shape{shapeOfBoolean, shapeRefTo("CodeActionOptions")}}
+
+var shapeCodeActionRegistrationOptions = &shape{kind: shapeObject, name: "CodeActionRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "codeActionKinds", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKind")}},
+	{name: "documentation", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionKindDocumentation")}},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCodeActionTriggerKind = &shape{kind: shapeEnum, name: "CodeActionTriggerKind", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeCodeDescription = &shape{kind: shapeObject, name: "CodeDescription", fields: []shapeField{
+	{name: "href", typ: shapeOfString},
+}}
+
+var shapeCodeLens = &shape{kind: shapeObject, name: "CodeLens", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "command", optional: true, typ: shapeRefTo("Command")},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeCodeLensClientCapabilities = &shape{kind: shapeObject, name: "CodeLensClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "resolveSupport", optional: true, typ: shapeRefTo("ClientCodeLensResolveOptions")},
+}}
+
+var shapeCodeLensOptions = &shape{kind: shapeObject, name: "CodeLensOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCodeLensParams = &shape{kind: shapeObject, name: "CodeLensParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeCodeLensRegistrationOptions = &shape{kind: shapeObject, name: "CodeLensRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCodeLensWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "CodeLensWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeColor = &shape{kind: shapeObject, name: "Color", fields: []shapeField{
+	{name: "red", typ: shapeOfDecimal},
+	{name: "green", typ: shapeOfDecimal},
+	{name: "blue", typ: shapeOfDecimal},
+	{name: "alpha", typ: shapeOfDecimal},
+}}
+
+var shapeColorInformation = &shape{kind: shapeObject, name: "ColorInformation", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "color", typ: shapeRefTo("Color")},
+}}
+
+var shapeColorPresentation = &shape{kind: shapeObject, name: "ColorPresentation", fields: []shapeField{
+	{name: "label", typ: shapeOfString},
+	{name: "textEdit", optional: true, typ: shapeRefTo("TextEdit")},
+	{name: "additionalTextEdits", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}},
+}}
+
+var shapeColorPresentationParams = &shape{kind: shapeObject, name: "ColorPresentationParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "color", typ: shapeRefTo("Color")},
+	{name: "range", typ: shapeRefTo("Range")},
+}}
+
+var shapeColorProvider = &shape{kind: shapeOr, name: "ColorProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DocumentColorOptions"), shapeRefTo("DocumentColorRegistrationOptions")}}
+
+var shapeCommand = &shape{kind: shapeObject, name: "Command", fields: []shapeField{
+	{name: "title", typ: shapeOfString},
+	{name: "tooltip", optional: true, typ: shapeOfString},
+	{name: "command", typ: shapeOfString},
+	{name: "arguments", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfAny}},
+}}
+
+var shapeCommandOrCodeAction = &shape{kind: shapeOr, name: "CommandOrCodeAction", items: []*shape{shapeRefTo("Command"), shapeRefTo("CodeAction")}}
+
+var shapeCompletionClientCapabilities = &shape{kind: shapeObject, name: "CompletionClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "completionItem", optional: true, typ: shapeRefTo("ClientCompletionItemOptions")},
+	{name: "completionItemKind", optional: true, typ: shapeRefTo("ClientCompletionItemOptionsKind")},
+	{name: "insertTextMode", optional: true, typ: shapeRefTo("InsertTextMode")},
+	{name: "contextSupport", optional: true, typ: shapeOfBoolean},
+	{name: "completionList", optional: true, typ: shapeRefTo("CompletionListCapabilities")},
+}}
+
+var shapeCompletionContext = &shape{kind: shapeObject, name: "CompletionContext", fields: []shapeField{
+	{name: "triggerKind", typ: shapeRefTo("CompletionTriggerKind")},
+	{name: "triggerCharacter", optional: true, typ: shapeOfString},
+}}
+
+var shapeCompletionItem = &shape{kind: shapeObject, name: "CompletionItem", fields: []shapeField{
+	{name: "label", typ: shapeOfString},
+	{name: "labelDetails", optional: true, typ: shapeRefTo("CompletionItemLabelDetails")},
+	{name: "kind", optional: true, typ: shapeRefTo("CompletionItemKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CompletionItemTag")}},
+	{name: "detail", optional: true, typ: shapeOfString},
+	{name: "documentation", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+	{name: "deprecated", optional: true, typ: shapeOfBoolean},
+	{name: "preselect", optional: true, typ: shapeOfBoolean},
+	{name: "sortText", optional: true, typ: shapeOfString},
+	{name: "filterText", optional: true, typ: shapeOfString},
+	{name: "insertText", optional: true, typ: shapeOfString},
+	{name: "insertTextFormat", optional: true, typ: shapeRefTo("InsertTextFormat")},
+	{name: "insertTextMode", optional: true, typ: shapeRefTo("InsertTextMode")},
+	{name: "textEdit", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("TextEdit"), shapeRefTo("InsertReplaceEdit")}}},
+	{name: "textEditText", optional: true, typ: shapeOfString},
+	{name: "additionalTextEdits", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}},
+	{name: "commitCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "command", optional: true, typ: shapeRefTo("Command")},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeCompletionItemApplyKinds = &shape{kind: shapeObject, name: "CompletionItemApplyKinds", fields: []shapeField{
+	{name: "commitCharacters", optional: true, typ: shapeRefTo("ApplyKind")},
+	{name: "data", optional: true, typ: shapeRefTo("ApplyKind")},
+}}
+
+var shapeCompletionItemDefaults = &shape{kind: shapeObject, name: "CompletionItemDefaults", fields: []shapeField{
+	{name: "commitCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "editRange", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Range"), shapeRefTo("EditRangeWithInsertReplace")}}},
+	{name: "insertTextFormat", optional: true, typ: shapeRefTo("InsertTextFormat")},
+	{name: "insertTextMode", optional: true, typ: shapeRefTo("InsertTextMode")},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeCompletionItemDefaultsEditRange = &shape{kind: shapeOr, name: "CompletionItemDefaultsEditRange", items: []*shape{shapeRefTo("Range"), shapeRefTo("EditRangeWithInsertReplace")}}
+
+var shapeCompletionItemKind = &shape{kind: shapeEnum, name: "CompletionItemKind", base: shapeUinteger, values: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}}
+
+var shapeCompletionItemLabelDetails = &shape{kind: shapeObject, name: "CompletionItemLabelDetails", fields: []shapeField{
+	{name: "detail", optional: true, typ: shapeOfString},
+	{name: "description", optional: true, typ: shapeOfString},
+}}
+
+var shapeCompletionItemTag = &shape{kind: shapeEnum, name: "CompletionItemTag", base: shapeUinteger, values: []string{"1"}}
+
+var shapeCompletionItemTagOptions = &shape{kind: shapeObject, name: "CompletionItemTagOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("CompletionItemTag")}},
+}}
+
+var shapeCompletionItemTextEdit = &shape{kind: shapeOr, name: "CompletionItemTextEdit", items: []*shape{shapeRefTo("TextEdit"), shapeRefTo("InsertReplaceEdit")}}
+
+var shapeCompletionList = &shape{kind: shapeObject, name: "CompletionList", fields: []shapeField{
+	{name: "isIncomplete", typ: shapeOfBoolean},
+	{name: "itemDefaults", optional: true, typ: shapeRefTo("CompletionItemDefaults")},
+	{name: "applyKind", optional: true, typ: shapeRefTo("CompletionItemApplyKinds")},
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("CompletionItem")}},
+}}
+
+var shapeCompletionListCapabilities = &shape{kind: shapeObject, name: "CompletionListCapabilities", fields: []shapeField{
+	{name: "itemDefaults", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "applyKindSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCompletionOptions = &shape{kind: shapeObject, name: "CompletionOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "triggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "allCommitCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+	{name: "completionItem", optional: true, typ: shapeRefTo("ServerCompletionItemOptions")},
+}}
+
+var shapeCompletionParams = &shape{kind: shapeObject, name: "CompletionParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "context", optional: true, typ: shapeRefTo("CompletionContext")},
+}}
+
+var shapeCompletionRegistrationOptions = &shape{kind: shapeObject, name: "CompletionRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "triggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "allCommitCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+	{name: "completionItem", optional: true, typ: shapeRefTo("ServerCompletionItemOptions")},
+}}
+
+var shapeCompletionResult = &shape{kind: shapeOr, name: "CompletionResult", items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CompletionItem")}, shapeRefTo("CompletionList")}}
+
+var shapeCompletionTriggerKind = &shape{kind: shapeEnum, name: "CompletionTriggerKind", base: shapeUinteger, values: []string{"1", "2", "3"}}
+
+var shapeConfigurationItem = &shape{kind: shapeObject, name: "ConfigurationItem", fields: []shapeField{
+	{name: "scopeUri", optional: true, typ: shapeOfString},
+	{name: "section", optional: true, typ: shapeOfString},
+}}
+
+var shapeConfigurationParams = &shape{kind: shapeObject, name: "ConfigurationParams", fields: []shapeField{
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("ConfigurationItem")}},
+}}
+
+var shapeCreateFile = &shape{kind: shapeObject, name: "CreateFile", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"create"}}},
+	{name: "annotationId", optional: true, typ: shapeRefTo("ChangeAnnotationIdentifier")},
+	{name: "uri", typ: shapeOfString},
+	{name: "options", optional: true, typ: shapeRefTo("CreateFileOptions")},
+}}
+
+var shapeCreateFileOptions = &shape{kind: shapeObject, name: "CreateFileOptions", fields: []shapeField{
+	{name: "overwrite", optional: true, typ: shapeOfBoolean},
+	{name: "ignoreIfExists", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeCreateFilesParams = &shape{kind: shapeObject, name: "CreateFilesParams", fields: []shapeField{
+	{name: "files", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileCreate")}},
+}}
+
+var shapeDeclaration = &shape{kind: shapeOr, name: "Declaration", items: []*shape{shapeRefTo("Location"), &shape{kind: shapeArray, elem: shapeRefTo("Location")}}}
+
+var shapeDeclarationClientCapabilities = &shape{kind: shapeObject, name: "DeclarationClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "linkSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDeclarationLink = shapeRefTo("LocationLink")
+
+var shapeDeclarationOptions = &shape{kind: shapeObject, name: "DeclarationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDeclarationParams = &shape{kind: shapeObject, name: "DeclarationParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeDeclarationProvider = &shape{kind: shapeOr, name: "DeclarationProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DeclarationOptions"), shapeRefTo("DeclarationRegistrationOptions")}}
+
+var shapeDeclarationRegistrationOptions = &shape{kind: shapeObject, name: "DeclarationRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeDeclarationResult = &shape{kind: shapeOr, name: "DeclarationResult", items: []*shape{shapeRefTo("Location"), &shape{kind: shapeArray, elem: shapeRefTo("Location")}, &shape{kind: shapeArray, elem: shapeRefTo("DeclarationLink")}}}
+
+var shapeDefinition = &shape{kind: shapeOr, name: "Definition", items: []*shape{shapeRefTo("Location"), &shape{kind: shapeArray, elem: shapeRefTo("Location")}}}
+
+var shapeDefinitionClientCapabilities = &shape{kind: shapeObject, name: "DefinitionClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "linkSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDefinitionLink = shapeRefTo("LocationLink")
+
+var shapeDefinitionOptions = &shape{kind: shapeObject, name: "DefinitionOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDefinitionParams = &shape{kind: shapeObject, name: "DefinitionParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeDefinitionProvider = &shape{kind: shapeOr, name: "DefinitionProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DefinitionOptions")}}
+
+var shapeDefinitionRegistrationOptions = &shape{kind: shapeObject, name: "DefinitionRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDefinitionResult = &shape{kind: shapeOr, name: "DefinitionResult", items: []*shape{shapeRefTo("Location"), &shape{kind: shapeArray, elem: shapeRefTo("Location")}, &shape{kind: shapeArray, elem: shapeRefTo("DefinitionLink")}}}
+
+var shapeDeleteFile = &shape{kind: shapeObject, name: "DeleteFile", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"delete"}}},
+	{name: "annotationId", optional: true, typ: shapeRefTo("ChangeAnnotationIdentifier")},
+	{name: "uri", typ: shapeOfString},
+	{name: "options", optional: true, typ: shapeRefTo("DeleteFileOptions")},
+}}
+
+var shapeDeleteFileOptions = &shape{kind: shapeObject, name: "DeleteFileOptions", fields: []shapeField{
+	{name: "recursive", optional: true, typ: shapeOfBoolean},
+	{name: "ignoreIfNotExists", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDeleteFilesParams = &shape{kind: shapeObject, name: "DeleteFilesParams", fields: []shapeField{
+	{name: "files", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileDelete")}},
+}}
+
+var shapeDiagnostic = &shape{kind: shapeObject, name: "Diagnostic", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "severity", optional: true, typ: shapeRefTo("DiagnosticSeverity")},
+	{name: "code", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfString}}},
+	{name: "codeDescription", optional: true, typ: shapeRefTo("CodeDescription")},
+	{name: "source", optional: true, typ: shapeOfString},
+	{name: "message", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("DiagnosticTag")}},
+	{name: "relatedInformation", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("DiagnosticRelatedInformation")}},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeDiagnosticClientCapabilities = &shape{kind: shapeObject, name: "DiagnosticClientCapabilities", fields: []shapeField{
+	{name: "relatedInformation", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("ClientDiagnosticsTagOptions")},
+	{name: "codeDescriptionSupport", optional: true, typ: shapeOfBoolean},
+	{name: "dataSupport", optional: true, typ: shapeOfBoolean},
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "relatedDocumentSupport", optional: true, typ: shapeOfBoolean},
+	{name: "markupMessageSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDiagnosticOptions = &shape{kind: shapeObject, name: "DiagnosticOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "identifier", optional: true, typ: shapeOfString},
+	{name: "interFileDependencies", typ: shapeOfBoolean},
+	{name: "workspaceDiagnostics", typ: shapeOfBoolean},
+}}
+
+var shapeDiagnosticProvider = &shape{kind: shapeOr, name: "DiagnosticProvider", items: []*shape{shapeRefTo("DiagnosticOptions"), shapeRefTo("DiagnosticRegistrationOptions")}}
+
+var shapeDiagnosticRegistrationOptions = &shape{kind: shapeObject, name: "DiagnosticRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "identifier", optional: true, typ: shapeOfString},
+	{name: "interFileDependencies", typ: shapeOfBoolean},
+	{name: "workspaceDiagnostics", typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeDiagnosticRelatedInformation = &shape{kind: shapeObject, name: "DiagnosticRelatedInformation", fields: []shapeField{
+	{name: "location", typ: shapeRefTo("Location")},
+	{name: "message", typ: shapeOfString},
+}}
+
+var shapeDiagnosticServerCancellationData = &shape{kind: shapeObject, name: "DiagnosticServerCancellationData", fields: []shapeField{
+	{name: "retriggerRequest", typ: shapeOfBoolean},
+}}
+
+var shapeDiagnosticSeverity = &shape{kind: shapeEnum, name: "DiagnosticSeverity", base: shapeUinteger, values: []string{"1", "2", "3", "4"}}
+
+var shapeDiagnosticTag = &shape{kind: shapeEnum, name: "DiagnosticTag", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeDiagnosticWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "DiagnosticWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDiagnosticsCapabilities = &shape{kind: shapeObject, name: "DiagnosticsCapabilities", fields: []shapeField{
+	{name: "relatedInformation", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("ClientDiagnosticsTagOptions")},
+	{name: "codeDescriptionSupport", optional: true, typ: shapeOfBoolean},
+	{name: "dataSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDidChangeConfigurationClientCapabilities = &shape{kind: shapeObject, name: "DidChangeConfigurationClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDidChangeConfigurationParams = &shape{kind: shapeObject, name: "DidChangeConfigurationParams", fields: []shapeField{
+	{name: "settings", typ: shapeOfAny},
+}}
+
+var shapeDidChangeConfigurationRegistrationOptions = &shape{kind: shapeObject, name: "DidChangeConfigurationRegistrationOptions", fields: []shapeField{
+	{name: "section", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, &shape{kind: shapeArray, elem: shapeOfString}}}},
+}}
+
+var shapeDidChangeConfigurationRegistrationOptionsSection = &shape{kind: shapeOr, name: "DidChangeConfigurationRegistrationOptionsSection", items: []*shape{shapeOfString, &shape{kind: shapeArray, elem: shapeOfString}}}
+
+var shapeDidChangeNotebookDocumentParams = &shape{kind: shapeObject, name: "DidChangeNotebookDocumentParams", fields: []shapeField{
+	{name: "notebookDocument", typ: shapeRefTo("VersionedNotebookDocumentIdentifier")},
+	{name: "change", typ: shapeRefTo("NotebookDocumentChangeEvent")},
+}}
+
+var shapeDidChangeTextDocumentParams = &shape{kind: shapeObject, name: "DidChangeTextDocumentParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("VersionedTextDocumentIdentifier")},
+	{name: "contentChanges", typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentContentChangeEvent")}},
+}}
+
+var shapeDidChangeWatchedFilesClientCapabilities = &shape{kind: shapeObject, name: "DidChangeWatchedFilesClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "relativePatternSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDidChangeWatchedFilesParams = &shape{kind: shapeObject, name: "DidChangeWatchedFilesParams", fields: []shapeField{
+	{name: "changes", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileEvent")}},
+}}
+
+var shapeDidChangeWatchedFilesRegistrationOptions = &shape{kind: shapeObject, name: "DidChangeWatchedFilesRegistrationOptions", fields: []shapeField{
+	{name: "watchers", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileSystemWatcher")}},
+}}
+
+var shapeDidChangeWorkspaceFoldersParams = &shape{kind: shapeObject, name: "DidChangeWorkspaceFoldersParams", fields: []shapeField{
+	{name: "event", typ: shapeRefTo("WorkspaceFoldersChangeEvent")},
+}}
+
+var shapeDidCloseNotebookDocumentParams = &shape{kind: shapeObject, name: "DidCloseNotebookDocumentParams", fields: []shapeField{
+	{name: "notebookDocument", typ: shapeRefTo("NotebookDocumentIdentifier")},
+	{name: "cellTextDocuments", typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentIdentifier")}},
+}}
+
+var shapeDidCloseTextDocumentParams = &shape{kind: shapeObject, name: "DidCloseTextDocumentParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeDidOpenNotebookDocumentParams = &shape{kind: shapeObject, name: "DidOpenNotebookDocumentParams", fields: []shapeField{
+	{name: "notebookDocument", typ: shapeRefTo("NotebookDocument")},
+	{name: "cellTextDocuments", typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentItem")}},
+}}
+
+var shapeDidOpenTextDocumentParams = &shape{kind: shapeObject, name: "DidOpenTextDocumentParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentItem")},
+}}
+
+var shapeDidSaveNotebookDocumentParams = &shape{kind: shapeObject, name: "DidSaveNotebookDocumentParams", fields: []shapeField{
+	{name: "notebookDocument", typ: shapeRefTo("NotebookDocumentIdentifier")},
+}}
+
+var shapeDidSaveTextDocumentParams = &shape{kind: shapeObject, name: "DidSaveTextDocumentParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "text", optional: true, typ: shapeOfString},
+}}
+
+var shapeDocumentChange = &shape{kind: shapeOr, name: "DocumentChange", items: []*shape{shapeRefTo("TextDocumentEdit"), shapeRefTo("CreateFile"), shapeRefTo("RenameFile"), shapeRefTo("DeleteFile")}}
+
+var shapeDocumentColorClientCapabilities = &shape{kind: shapeObject, name: "DocumentColorClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentColorOptions = &shape{kind: shapeObject, name: "DocumentColorOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentColorParams = &shape{kind: shapeObject, name: "DocumentColorParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeDocumentColorRegistrationOptions = &shape{kind: shapeObject, name: "DocumentColorRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeDocumentDiagnosticParams = &shape{kind: shapeObject, name: "DocumentDiagnosticParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "identifier", optional: true, typ: shapeOfString},
+	{name: "previousResultId", optional: true, typ: shapeOfString},
+}}
+
+var shapeDocumentDiagnosticReport = &shape{kind: shapeOr, name: "DocumentDiagnosticReport", items: []*shape{shapeRefTo("RelatedFullDocumentDiagnosticReport"), shapeRefTo("RelatedUnchangedDocumentDiagnosticReport")}}
+
+var shapeDocumentDiagnosticReportKind = &shape{kind: shapeEnum, name: "DocumentDiagnosticReportKind", base: shapeString, values: []string{"full", "unchanged"}}
+
+var shapeDocumentDiagnosticReportPartialResult = &shape{kind: shapeObject, name: "DocumentDiagnosticReportPartialResult", fields: []shapeField{
+	{name: "relatedDocuments", typ: &shape{kind: shapeMap, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("FullDocumentDiagnosticReport"), shapeRefTo("UnchangedDocumentDiagnosticReport")}}}},
+}}
+
+var shapeDocumentFilter = &shape{kind: shapeOr, name: "DocumentFilter", items: []*shape{shapeRefTo("TextDocumentFilter"), shapeRefTo("NotebookCellTextDocumentFilter")}}
+
+var shapeDocumentFormattingClientCapabilities = &shape{kind: shapeObject, name: "DocumentFormattingClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentFormattingOptions = &shape{kind: shapeObject, name: "DocumentFormattingOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentFormattingParams = &shape{kind: shapeObject, name: "DocumentFormattingParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "options", typ: shapeRefTo("FormattingOptions")},
+}}
+
+var shapeDocumentFormattingProvider = &shape{kind: shapeOr, name: "DocumentFormattingProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DocumentFormattingOptions")}}
+
+var shapeDocumentFormattingRegistrationOptions = &shape{kind: shapeObject, name: "DocumentFormattingRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentHighlight = &shape{kind: shapeObject, name: "DocumentHighlight", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "kind", optional: true, typ: shapeRefTo("DocumentHighlightKind")},
+}}
+
+var shapeDocumentHighlightClientCapabilities = &shape{kind: shapeObject, name: "DocumentHighlightClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentHighlightKind = &shape{kind: shapeEnum, name: "DocumentHighlightKind", base: shapeUinteger, values: []string{"1", "2", "3"}}
+
+var shapeDocumentHighlightOptions = &shape{kind: shapeObject, name: "DocumentHighlightOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentHighlightParams = &shape{kind: shapeObject, name: "DocumentHighlightParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeDocumentHighlightProvider = &shape{kind: shapeOr, name: "DocumentHighlightProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DocumentHighlightOptions")}}
+
+var shapeDocumentHighlightRegistrationOptions = &shape{kind: shapeObject, name: "DocumentHighlightRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentLink = &shape{kind: shapeObject, name: "DocumentLink", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "target", optional: true, typ: shapeOfString},
+	{name: "tooltip", optional: true, typ: shapeOfString},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeDocumentLinkClientCapabilities = &shape{kind: shapeObject, name: "DocumentLinkClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "tooltipSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentLinkOptions = &shape{kind: shapeObject, name: "DocumentLinkOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentLinkParams = &shape{kind: shapeObject, name: "DocumentLinkParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeDocumentLinkRegistrationOptions = &shape{kind: shapeObject, name: "DocumentLinkRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentOnTypeFormattingClientCapabilities = &shape{kind: shapeObject, name: "DocumentOnTypeFormattingClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentOnTypeFormattingOptions = &shape{kind: shapeObject, name: "DocumentOnTypeFormattingOptions", fields: []shapeField{
+	{name: "firstTriggerCharacter", typ: shapeOfString},
+	{name: "moreTriggerCharacter", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeDocumentOnTypeFormattingParams = &shape{kind: shapeObject, name: "DocumentOnTypeFormattingParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "ch", typ: shapeOfString},
+	{name: "options", typ: shapeRefTo("FormattingOptions")},
+}}
+
+var shapeDocumentOnTypeFormattingRegistrationOptions = &shape{kind: shapeObject, name: "DocumentOnTypeFormattingRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "firstTriggerCharacter", typ: shapeOfString},
+	{name: "moreTriggerCharacter", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeDocumentRangeFormattingClientCapabilities = &shape{kind: shapeObject, name: "DocumentRangeFormattingClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "rangesSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentRangeFormattingOptions = &shape{kind: shapeObject, name: "DocumentRangeFormattingOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "rangesSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentRangeFormattingParams = &shape{kind: shapeObject, name: "DocumentRangeFormattingParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "options", typ: shapeRefTo("FormattingOptions")},
+}}
+
+var shapeDocumentRangeFormattingProvider = &shape{kind: shapeOr, name: "DocumentRangeFormattingProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DocumentRangeFormattingOptions")}}
+
+var shapeDocumentRangeFormattingRegistrationOptions = &shape{kind: shapeObject, name: "DocumentRangeFormattingRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "rangesSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentRangesFormattingParams = &shape{kind: shapeObject, name: "DocumentRangesFormattingParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "ranges", typ: &shape{kind: shapeArray, elem: shapeRefTo("Range")}},
+	{name: "options", typ: shapeRefTo("FormattingOptions")},
+}}
+
+var shapeDocumentSelector = &shape{kind: shapeArray, name: "DocumentSelector", elem: shapeRefTo("DocumentFilter")}
+
+var shapeDocumentSymbol = &shape{kind: shapeObject, name: "DocumentSymbol", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "detail", optional: true, typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "deprecated", optional: true, typ: shapeOfBoolean},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "selectionRange", typ: shapeRefTo("Range")},
+	{name: "children", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("DocumentSymbol")}},
+}}
+
+var shapeDocumentSymbolClientCapabilities = &shape{kind: shapeObject, name: "DocumentSymbolClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "symbolKind", optional: true, typ: shapeRefTo("ClientSymbolKindOptions")},
+	{name: "hierarchicalDocumentSymbolSupport", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("ClientSymbolTagOptions")},
+	{name: "labelSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeDocumentSymbolOptions = &shape{kind: shapeObject, name: "DocumentSymbolOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "label", optional: true, typ: shapeOfString},
+}}
+
+var shapeDocumentSymbolParams = &shape{kind: shapeObject, name: "DocumentSymbolParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeDocumentSymbolProvider = &shape{kind: shapeOr, name: "DocumentSymbolProvider", items: []*shape{shapeOfBoolean, shapeRefTo("DocumentSymbolOptions")}}
+
+var shapeDocumentSymbolRegistrationOptions = &shape{kind: shapeObject, name: "DocumentSymbolRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "label", optional: true, typ: shapeOfString},
+}}
+
+var shapeDocumentSymbolResult = &shape{kind: shapeOr, name: "DocumentSymbolResult", items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("SymbolInformation")}, &shape{kind: shapeArray, elem: shapeRefTo("DocumentSymbol")}}}
+
+var shapeEditRangeWithInsertReplace = &shape{kind: shapeObject, name: "EditRangeWithInsertReplace", fields: []shapeField{
+	{name: "insert", typ: shapeRefTo("Range")},
+	{name: "replace", typ: shapeRefTo("Range")},
+}}
+
+var shapeErrorCodes = &shape{kind: shapeEnum, name: "ErrorCodes", base: shapeInteger, open: true, values: []string{"-32700", "-32600", "-32601", "-32602", "-32603", "-32002", "-32001"}}
+
+var shapeExecuteCommandClientCapabilities = &shape{kind: shapeObject, name: "ExecuteCommandClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeExecuteCommandOptions = &shape{kind: shapeObject, name: "ExecuteCommandOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "commands", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeExecuteCommandParams = &shape{kind: shapeObject, name: "ExecuteCommandParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "command", typ: shapeOfString},
+	{name: "arguments", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfAny}},
+}}
+
+var shapeExecuteCommandRegistrationOptions = &shape{kind: shapeObject, name: "ExecuteCommandRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "commands", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeExecutionSummary = &shape{kind: shapeObject, name: "ExecutionSummary", fields: []shapeField{
+	{name: "executionOrder", typ: shapeOfUinteger},
+	{name: "success", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFailureHandlingKind = &shape{kind: shapeEnum, name: "FailureHandlingKind", base: shapeString, values: []string{"abort", "transactional", "textOnlyTransactional", "undo"}}
+
+var shapeFileChangeType = &shape{kind: shapeEnum, name: "FileChangeType", base: shapeUinteger, values: []string{"1", "2", "3"}}
+
+var shapeFileCreate = &shape{kind: shapeObject, name: "FileCreate", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeFileDelete = &shape{kind: shapeObject, name: "FileDelete", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeFileEvent = &shape{kind: shapeObject, name: "FileEvent", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "type", typ: shapeRefTo("FileChangeType")},
+}}
+
+var shapeFileOperationClientCapabilities = &shape{kind: shapeObject, name: "FileOperationClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "didCreate", optional: true, typ: shapeOfBoolean},
+	{name: "willCreate", optional: true, typ: shapeOfBoolean},
+	{name: "didRename", optional: true, typ: shapeOfBoolean},
+	{name: "willRename", optional: true, typ: shapeOfBoolean},
+	{name: "didDelete", optional: true, typ: shapeOfBoolean},
+	{name: "willDelete", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFileOperationFilter = &shape{kind: shapeObject, name: "FileOperationFilter", fields: []shapeField{
+	{name: "scheme", optional: true, typ: shapeOfString},
+	{name: "pattern", typ: shapeRefTo("FileOperationPattern")},
+}}
+
+var shapeFileOperationOptions = &shape{kind: shapeObject, name: "FileOperationOptions", fields: []shapeField{
+	{name: "didCreate", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+	{name: "willCreate", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+	{name: "didRename", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+	{name: "willRename", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+	{name: "didDelete", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+	{name: "willDelete", optional: true, typ: shapeRefTo("FileOperationRegistrationOptions")},
+}}
+
+var shapeFileOperationPattern = &shape{kind: shapeObject, name: "FileOperationPattern", fields: []shapeField{
+	{name: "glob", typ: shapeOfString},
+	{name: "matches", optional: true, typ: shapeRefTo("FileOperationPatternKind")},
+	{name: "options", optional: true, typ: shapeRefTo("FileOperationPatternOptions")},
+}}
+
+var shapeFileOperationPatternKind = &shape{kind: shapeEnum, name: "FileOperationPatternKind", base: shapeString, values: []string{"file", "folder"}}
+
+var shapeFileOperationPatternOptions = &shape{kind: shapeObject, name: "FileOperationPatternOptions", fields: []shapeField{
+	{name: "ignoreCase", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFileOperationRegistrationOptions = &shape{kind: shapeObject, name: "FileOperationRegistrationOptions", fields: []shapeField{
+	{name: "filters", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileOperationFilter")}},
+}}
+
+var shapeFileRename = &shape{kind: shapeObject, name: "FileRename", fields: []shapeField{
+	{name: "oldUri", typ: shapeOfString},
+	{name: "newUri", typ: shapeOfString},
+}}
+
+var shapeFileSystemWatcher = &shape{kind: shapeObject, name: "FileSystemWatcher", fields: []shapeField{
+	{name: "globPattern", typ: shapeRefTo("GlobPattern")},
+	{name: "kind", optional: true, typ: shapeRefTo("WatchKind")},
+}}
+
+var shapeFoldingRange = &shape{kind: shapeObject, name: "FoldingRange", fields: []shapeField{
+	{name: "startLine", typ: shapeOfUinteger},
+	{name: "startCharacter", optional: true, typ: shapeOfUinteger},
+	{name: "endLine", typ: shapeOfUinteger},
+	{name: "endCharacter", optional: true, typ: shapeOfUinteger},
+	{name: "kind", optional: true, typ: shapeRefTo("FoldingRangeKind")},
+	{name: "collapsedText", optional: true, typ: shapeOfString},
+}}
+
+var shapeFoldingRangeClientCapabilities = &shape{kind: shapeObject, name: "FoldingRangeClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "rangeLimit", optional: true, typ: shapeOfUinteger},
+	{name: "lineFoldingOnly", optional: true, typ: shapeOfBoolean},
+	{name: "foldingRangeKind", optional: true, typ: shapeRefTo("ClientFoldingRangeKindOptions")},
+	{name: "foldingRange", optional: true, typ: shapeRefTo("ClientFoldingRangeOptions")},
+}}
+
+var shapeFoldingRangeKind = &shape{kind: shapeEnum, name: "FoldingRangeKind", base: shapeString, open: true, values: []string{"comment", "imports", "region"}}
+
+var shapeFoldingRangeOptions = &shape{kind: shapeObject, name: "FoldingRangeOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFoldingRangeParams = &shape{kind: shapeObject, name: "FoldingRangeParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeFoldingRangeProvider = &shape{kind: shapeOr, name: "FoldingRangeProvider", items: []*shape{shapeOfBoolean, shapeRefTo("FoldingRangeOptions"), shapeRefTo("FoldingRangeRegistrationOptions")}}
+
+var shapeFoldingRangeRegistrationOptions = &shape{kind: shapeObject, name: "FoldingRangeRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeFoldingRangeWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "FoldingRangeWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFormattingOptions = &shape{kind: shapeObject, name: "FormattingOptions", fields: []shapeField{
+	{name: "tabSize", typ: shapeOfUinteger},
+	{name: "insertSpaces", typ: shapeOfBoolean},
+	{name: "trimTrailingWhitespace", optional: true, typ: shapeOfBoolean},
+	{name: "insertFinalNewline", optional: true, typ: shapeOfBoolean},
+	{name: "trimFinalNewlines", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeFullDocumentDiagnosticReport = &shape{kind: shapeObject, name: "FullDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"full"}}},
+	{name: "resultId", optional: true, typ: shapeOfString},
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+}}
+
+var shapeFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport = &shape{kind: shapeOr, name: "FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport", items: []*shape{shapeRefTo("FullDocumentDiagnosticReport"), shapeRefTo("UnchangedDocumentDiagnosticReport")}}
+
+var shapeGeneralClientCapabilities = &shape{kind: shapeObject, name: "GeneralClientCapabilities", fields: []shapeField{
+	{name: "staleRequestSupport", optional: true, typ: shapeRefTo("StaleRequestSupportOptions")},
+	{name: "regularExpressions", optional: true, typ: shapeRefTo("RegularExpressionsClientCapabilities")},
+	{name: "markdown", optional: true, typ: shapeRefTo("MarkdownClientCapabilities")},
+	{name: "positionEncodings", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("PositionEncodingKind")}},
+}}
+
+var shapeGlobPattern = &shape{kind: shapeOr, name: "GlobPattern", items: []*shape{shapeRefTo("Pattern"), shapeRefTo("RelativePattern")}}
+
+var shapeHover = &shape{kind: shapeObject, name: "Hover", fields: []shapeField{
+	{name: "contents", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("MarkupContent"), shapeRefTo("MarkedString"), &shape{kind: shapeArray, elem: shapeRefTo("MarkedString")}}}},
+	{name: "range", optional: true, typ: shapeRefTo("Range")},
+}}
+
+var shapeHoverClientCapabilities = &shape{kind: shapeObject, name: "HoverClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "contentFormat", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("MarkupKind")}},
+}}
+
+var shapeHoverContents = &shape{kind: shapeOr, name: "HoverContents", items: []*shape{shapeRefTo("MarkupContent"), shapeOfString, shapeRefTo("MarkedStringWithLanguage"), &shape{kind: shapeArray, elem: shapeRefTo("MarkedString")}}}
+
+var shapeHoverOptions = &shape{kind: shapeObject, name: "HoverOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeHoverParams = &shape{kind: shapeObject, name: "HoverParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeHoverProvider = &shape{kind: shapeOr, name: "HoverProvider", items: []*shape{shapeOfBoolean, shapeRefTo("HoverOptions")}}
+
+var shapeHoverRegistrationOptions = &shape{kind: shapeObject, name: "HoverRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeImplementationClientCapabilities = &shape{kind: shapeObject, name: "ImplementationClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "linkSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeImplementationOptions = &shape{kind: shapeObject, name: "ImplementationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeImplementationParams = &shape{kind: shapeObject, name: "ImplementationParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeImplementationProvider = &shape{kind: shapeOr, name: "ImplementationProvider", items: []*shape{shapeOfBoolean, shapeRefTo("ImplementationOptions"), shapeRefTo("ImplementationRegistrationOptions")}}
+
+var shapeImplementationRegistrationOptions = &shape{kind: shapeObject, name: "ImplementationRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeInitializeError = &shape{kind: shapeObject, name: "InitializeError", fields: []shapeField{
+	{name: "retry", typ: shapeOfBoolean},
+}}
+
+var shapeInitializeParams = &shape{kind: shapeObject, name: "InitializeParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "processId", typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfNull}}},
+	{name: "clientInfo", optional: true, typ: shapeRefTo("ClientInfo")},
+	{name: "locale", optional: true, typ: shapeOfString},
+	{name: "rootPath", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeOfNull}}},
+	{name: "rootUri", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeOfNull}}},
+	{name: "capabilities", typ: shapeRefTo("ClientCapabilities")},
+	{name: "initializationOptions", optional: true, typ: shapeOfAny},
+	{name: "trace", optional: true, typ: shapeRefTo("TraceValue")},
+	{name: "workspaceFolders", optional: true, typ: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("WorkspaceFolder")}, shapeOfNull}}},
+}}
+
+var shapeInitializeResult = &shape{kind: shapeObject, name: "InitializeResult", fields: []shapeField{
+	{name: "capabilities", typ: shapeRefTo("ServerCapabilities")},
+	{name: "serverInfo", optional: true, typ: shapeRefTo("ServerInfo")},
+}}
+
+var shapeInitializedParams = &shape{kind: shapeObject, name: "InitializedParams", fields: []shapeField{}}
+
+var shapeInlayHint = &shape{kind: shapeObject, name: "InlayHint", fields: []shapeField{
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "label", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, &shape{kind: shapeArray, elem: shapeRefTo("InlayHintLabelPart")}}}},
+	{name: "kind", optional: true, typ: shapeRefTo("InlayHintKind")},
+	{name: "textEdits", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}},
+	{name: "tooltip", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+	{name: "paddingLeft", optional: true, typ: shapeOfBoolean},
+	{name: "paddingRight", optional: true, typ: shapeOfBoolean},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeInlayHintClientCapabilities = &shape{kind: shapeObject, name: "InlayHintClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "resolveSupport", optional: true, typ: shapeRefTo("ClientInlayHintResolveOptions")},
+}}
+
+var shapeInlayHintKind = &shape{kind: shapeEnum, name: "InlayHintKind", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeInlayHintLabel = &shape{kind: shapeOr, name: "InlayHintLabel", items: []*shape{shapeOfString, &shape{kind: shapeArray, elem: shapeRefTo("InlayHintLabelPart")}}}
+
+var shapeInlayHintLabelPart = &shape{kind: shapeObject, name: "InlayHintLabelPart", fields: []shapeField{
+	{name: "value", typ: shapeOfString},
+	{name: "tooltip", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+	{name: "location", optional: true, typ: shapeRefTo("Location")},
+	{name: "command", optional: true, typ: shapeRefTo("Command")},
+}}
+
+var shapeInlayHintOptions = &shape{kind: shapeObject, name: "InlayHintOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlayHintParams = &shape{kind: shapeObject, name: "InlayHintParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "range", typ: shapeRefTo("Range")},
+}}
+
+var shapeInlayHintProvider = &shape{kind: shapeOr, name: "InlayHintProvider", items: []*shape{shapeOfBoolean, shapeRefTo("InlayHintOptions"), shapeRefTo("InlayHintRegistrationOptions")}}
+
+var shapeInlayHintRegistrationOptions = &shape{kind: shapeObject, name: "InlayHintRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeInlayHintTooltip = &shape{kind: shapeOr, name: "InlayHintTooltip", items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}
+
+var shapeInlayHintWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "InlayHintWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlineCompletionClientCapabilities = &shape{kind: shapeObject, name: "InlineCompletionClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlineCompletionContext = &shape{kind: shapeObject, name: "InlineCompletionContext", fields: []shapeField{
+	{name: "triggerKind", typ: shapeRefTo("InlineCompletionTriggerKind")},
+	{name: "selectedCompletionInfo", optional: true, typ: shapeRefTo("SelectedCompletionInfo")},
+}}
+
+var shapeInlineCompletionItem = &shape{kind: shapeObject, name: "InlineCompletionItem", fields: []shapeField{
+	{name: "insertText", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("StringValue")}}},
+	{name: "filterText", optional: true, typ: shapeOfString},
+	{name: "range", optional: true, typ: shapeRefTo("Range")},
+	{name: "command", optional: true, typ: shapeRefTo("Command")},
+}}
+
+var shapeInlineCompletionItemInsertText = &shape{kind: shapeOr, name: "InlineCompletionItemInsertText", items: []*shape{shapeOfString, shapeRefTo("StringValue")}}
+
+var shapeInlineCompletionList = &shape{kind: shapeObject, name: "InlineCompletionList", fields: []shapeField{
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("InlineCompletionItem")}},
+}}
+
+var shapeInlineCompletionOptions = &shape{kind: shapeObject, name: "InlineCompletionOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlineCompletionParams = &shape{kind: shapeObject, name: "InlineCompletionParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "context", typ: shapeRefTo("InlineCompletionContext")},
+}}
+
+var shapeInlineCompletionProvider = &shape{kind: shapeOr, name: "InlineCompletionProvider", items: []*shape{shapeOfBoolean, shapeRefTo("InlineCompletionOptions")}}
+
+var shapeInlineCompletionRegistrationOptions = &shape{kind: shapeObject, name: "InlineCompletionRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeInlineCompletionResult = &shape{kind: shapeOr, name: "InlineCompletionResult", items: []*shape{shapeRefTo("InlineCompletionList"), &shape{kind: shapeArray, elem: shapeRefTo("InlineCompletionItem")}}}
+
+var shapeInlineCompletionTriggerKind = &shape{kind: shapeEnum, name: "InlineCompletionTriggerKind", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeInlineValue = &shape{kind: shapeOr, name: "InlineValue", items: []*shape{shapeRefTo("InlineValueText"), shapeRefTo("InlineValueVariableLookup"), shapeRefTo("InlineValueEvaluatableExpression")}}
+
+var shapeInlineValueClientCapabilities = &shape{kind: shapeObject, name: "InlineValueClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlineValueContext = &shape{kind: shapeObject, name: "InlineValueContext", fields: []shapeField{
+	{name: "frameId", typ: shapeOfInteger},
+	{name: "stoppedLocation", typ: shapeRefTo("Range")},
+}}
+
+var shapeInlineValueEvaluatableExpression = &shape{kind: shapeObject, name: "InlineValueEvaluatableExpression", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "expression", optional: true, typ: shapeOfString},
+}}
+
+var shapeInlineValueOptions = &shape{kind: shapeObject, name: "InlineValueOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInlineValueParams = &shape{kind: shapeObject, name: "InlineValueParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "context", typ: shapeRefTo("InlineValueContext")},
+}}
+
+var shapeInlineValueProvider = &shape{kind: shapeOr, name: "InlineValueProvider", items: []*shape{shapeOfBoolean, shapeRefTo("InlineValueOptions"), shapeRefTo("InlineValueRegistrationOptions")}}
+
+var shapeInlineValueRegistrationOptions = &shape{kind: shapeObject, name: "InlineValueRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeInlineValueText = &shape{kind: shapeObject, name: "InlineValueText", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeInlineValueVariableLookup = &shape{kind: shapeObject, name: "InlineValueVariableLookup", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "variableName", optional: true, typ: shapeOfString},
+	{name: "caseSensitiveLookup", typ: shapeOfBoolean},
+}}
+
+var shapeInlineValueWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "InlineValueWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeInsertReplaceEdit = &shape{kind: shapeObject, name: "InsertReplaceEdit", fields: []shapeField{
+	{name: "newText", typ: shapeOfString},
+	{name: "insert", typ: shapeRefTo("Range")},
+	{name: "replace", typ: shapeRefTo("Range")},
+}}
+
+var shapeInsertTextFormat = &shape{kind: shapeEnum, name: "InsertTextFormat", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeInsertTextMode = &shape{kind: shapeEnum, name: "InsertTextMode", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeLSPErrorCodes = &shape{kind: shapeEnum, name: "LSPErrorCodes", base: shapeInteger, open: true, values: []string{"-32803", "-32802", "-32801", "-32800"}}
+
+var shapeLanguageKind = &shape{kind: shapeEnum, name: "LanguageKind", base: shapeString, open: true, values: []string{"abap", "bat", "bibtex", "clojure", "coffeescript", "c", "cpp", "csharp", "css", "d", "pascal", "diff", "dart", "dockerfile", "elixir", "erlang", "fsharp", "git-commit", "git-rebase", "go", "groovy", "handlebars", "haskell", "html", "ini", "java", "javascript", "javascriptreact", "json", "latex", "less", "lua", "makefile", "markdown", "objective-c", "objective-cpp", "pascal", "perl", "perl6", "php", "plaintext", "powershell", "jade", "python", "r", "razor", "ruby", "rust", "scss", "sass", "scala", "shaderlab", "shellscript", "sql", "swift", "typescript", "typescriptreact", "tex", "vb", "xml", "xsl", "yaml"}}
+
+var shapeLinkedEditingRangeClientCapabilities = &shape{kind: shapeObject, name: "LinkedEditingRangeClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeLinkedEditingRangeOptions = &shape{kind: shapeObject, name: "LinkedEditingRangeOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeLinkedEditingRangeParams = &shape{kind: shapeObject, name: "LinkedEditingRangeParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeLinkedEditingRangeProvider = &shape{kind: shapeOr, name: "LinkedEditingRangeProvider", items: []*shape{shapeOfBoolean, shapeRefTo("LinkedEditingRangeOptions"), shapeRefTo("LinkedEditingRangeRegistrationOptions")}}
+
+var shapeLinkedEditingRangeRegistrationOptions = &shape{kind: shapeObject, name: "LinkedEditingRangeRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeLinkedEditingRanges = &shape{kind: shapeObject, name: "LinkedEditingRanges", fields: []shapeField{
+	{name: "ranges", typ: &shape{kind: shapeArray, elem: shapeRefTo("Range")}},
+	{name: "wordPattern", optional: true, typ: shapeOfString},
+}}
+
+var shapeLocation = &shape{kind: shapeObject, name: "Location", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "range", typ: shapeRefTo("Range")},
+}}
+
+var shapeLocationLink = &shape{kind: shapeObject, name: "LocationLink", fields: []shapeField{
+	{name: "originSelectionRange", optional: true, typ: shapeRefTo("Range")},
+	{name: "targetUri", typ: shapeOfString},
+	{name: "targetRange", typ: shapeRefTo("Range")},
+	{name: "targetSelectionRange", typ: shapeRefTo("Range")},
+}}
+
+var shapeLocationUriOnly = &shape{kind: shapeObject, name: "LocationUriOnly", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeLogMessageParams = &shape{kind: shapeObject, name: "LogMessageParams", fields: []shapeField{
+	{name: "type", typ: shapeRefTo("MessageType")},
+	{name: "message", typ: shapeOfString},
+}}
+
+var shapeLogTraceParams = &shape{kind: shapeObject, name: "LogTraceParams", fields: []shapeField{
+	{name: "message", typ: shapeOfString},
+	{name: "verbose", optional: true, typ: shapeOfString},
+}}
+
+var shapeMarkdownClientCapabilities = &shape{kind: shapeObject, name: "MarkdownClientCapabilities", fields: []shapeField{
+	{name: "parser", typ: shapeOfString},
+	{name: "version", optional: true, typ: shapeOfString},
+	{name: "allowedTags", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeMarkedString = &shape{kind: shapeOr, name: "MarkedString", items: []*shape{shapeOfString, shapeRefTo("MarkedStringWithLanguage")}}
+
+var shapeMarkedStringWithLanguage = &shape{kind: shapeObject, name: "MarkedStringWithLanguage", fields: []shapeField{
+	{name: "language", typ: shapeOfString},
+	{name: "value", typ: shapeOfString},
+}}
+
+var shapeMarkupContent = &shape{kind: shapeObject, name: "MarkupContent", fields: []shapeField{
+	{name: "kind", typ: shapeRefTo("MarkupKind")},
+	{name: "value", typ: shapeOfString},
+}}
+
+var shapeMarkupKind = &shape{kind: shapeEnum, name: "MarkupKind", base: shapeString, values: []string{"plaintext", "markdown"}}
+
+var shapeMessageActionItem = &shape{kind: shapeObject, name: "MessageActionItem", fields: []shapeField{
+	{name: "title", typ: shapeOfString},
+}}
+
+var shapeMessageType = &shape{kind: shapeEnum, name: "MessageType", base: shapeUinteger, values: []string{"1", "2", "3", "4", "5"}}
+
+var shapeMoniker = &shape{kind: shapeObject, name: "Moniker", fields: []shapeField{
+	{name: "scheme", typ: shapeOfString},
+	{name: "identifier", typ: shapeOfString},
+	{name: "unique", typ: shapeRefTo("UniquenessLevel")},
+	{name: "kind", optional: true, typ: shapeRefTo("MonikerKind")},
+}}
+
+var shapeMonikerClientCapabilities = &shape{kind: shapeObject, name: "MonikerClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeMonikerKind = &shape{kind: shapeEnum, name: "MonikerKind", base: shapeString, values: []string{"import", "export", "local"}}
+
+var shapeMonikerOptions = &shape{kind: shapeObject, name: "MonikerOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeMonikerParams = &shape{kind: shapeObject, name: "MonikerParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeMonikerProvider = &shape{kind: shapeOr, name: "MonikerProvider", items: []*shape{shapeOfBoolean, shapeRefTo("MonikerOptions"), shapeRefTo("MonikerRegistrationOptions")}}
+
+var shapeMonikerRegistrationOptions = &shape{kind: shapeObject, name: "MonikerRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeNotebookCell = &shape{kind: shapeObject, name: "NotebookCell", fields: []shapeField{
+	{name: "kind", typ: shapeRefTo("NotebookCellKind")},
+	{name: "document", typ: shapeOfString},
+	{name: "metadata", optional: true, typ: &shape{kind: shapeMap, elem: shapeOfAny}},
+	{name: "executionSummary", optional: true, typ: shapeRefTo("ExecutionSummary")},
+}}
+
+var shapeNotebookCellArrayChange = &shape{kind: shapeObject, name: "NotebookCellArrayChange", fields: []shapeField{
+	{name: "start", typ: shapeOfUinteger},
+	{name: "deleteCount", typ: shapeOfUinteger},
+	{name: "cells", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookCell")}},
+}}
+
+var shapeNotebookCellKind = &shape{kind: shapeEnum, name: "NotebookCellKind", base: shapeUinteger, values: []string{"1", "2"}}
+
+var shapeNotebookCellLanguage = &shape{kind: shapeObject, name: "NotebookCellLanguage", fields: []shapeField{
+	{name: "language", typ: shapeOfString},
+}}
+
+var shapeNotebookCellTextDocumentFilter = &shape{kind: shapeObject, name: "NotebookCellTextDocumentFilter", fields: []shapeField{
+	{name: "notebook", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("NotebookDocumentFilter")}}},
+	{name: "language", optional: true, typ: shapeOfString},
+}}
+
+var shapeNotebookDocument = &shape{kind: shapeObject, name: "NotebookDocument", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "notebookType", typ: shapeOfString},
+	{name: "version", typ: shapeOfInteger},
+	{name: "metadata", optional: true, typ: &shape{kind: shapeMap, elem: shapeOfAny}},
+	{name: "cells", typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookCell")}},
+}}
+
+var shapeNotebookDocumentCellChangeStructure = &shape{kind: shapeObject, name: "NotebookDocumentCellChangeStructure", fields: []shapeField{
+	{name: "array", typ: shapeRefTo("NotebookCellArrayChange")},
+	{name: "didOpen", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentItem")}},
+	{name: "didClose", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentIdentifier")}},
+}}
+
+var shapeNotebookDocumentCellChanges = &shape{kind: shapeObject, name: "NotebookDocumentCellChanges", fields: []shapeField{
+	{name: "structure", optional: true, typ: shapeRefTo("NotebookDocumentCellChangeStructure")},
+	{name: "data", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookCell")}},
+	{name: "textContent", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookDocumentCellContentChanges")}},
+}}
+
+var shapeNotebookDocumentCellContentChanges = &shape{kind: shapeObject, name: "NotebookDocumentCellContentChanges", fields: []shapeField{
+	{name: "document", typ: shapeRefTo("VersionedTextDocumentIdentifier")},
+	{name: "changes", typ: &shape{kind: shapeArray, elem: shapeRefTo("TextDocumentContentChangeEvent")}},
+}}
+
+var shapeNotebookDocumentChangeEvent = &shape{kind: shapeObject, name: "NotebookDocumentChangeEvent", fields: []shapeField{
+	{name: "metadata", optional: true, typ: &shape{kind: shapeMap, elem: shapeOfAny}},
+	{name: "cells", optional: true, typ: shapeRefTo("NotebookDocumentCellChanges")},
+}}
+
+var shapeNotebookDocumentClientCapabilities = &shape{kind: shapeObject, name: "NotebookDocumentClientCapabilities", fields: []shapeField{
+	{name: "synchronization", typ: shapeRefTo("NotebookDocumentSyncClientCapabilities")},
+}}
+
+var shapeNotebookDocumentFilter = &shape{kind: shapeOr, name: "NotebookDocumentFilter", items: []*shape{shapeRefTo("NotebookDocumentFilterNotebookType"), shapeRefTo("NotebookDocumentFilterScheme"), shapeRefTo("NotebookDocumentFilterPattern")}}
+
+var shapeNotebookDocumentFilterNotebook = &shape{kind: shapeOr, name: "NotebookDocumentFilterNotebook", items: []*shape{shapeOfString, shapeRefTo("NotebookDocumentFilterNotebookType"), shapeRefTo("NotebookDocumentFilterScheme"), shapeRefTo("NotebookDocumentFilterPattern")}}
+
+var shapeNotebookDocumentFilterNotebookType = &shape{kind: shapeObject, name: "NotebookDocumentFilterNotebookType", fields: []shapeField{
+	{name: "notebookType", typ: shapeOfString},
+	{name: "scheme", optional: true, typ: shapeOfString},
+	{name: "pattern", optional: true, typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeNotebookDocumentFilterPattern = &shape{kind: shapeObject, name: "NotebookDocumentFilterPattern", fields: []shapeField{
+	{name: "notebookType", optional: true, typ: shapeOfString},
+	{name: "scheme", optional: true, typ: shapeOfString},
+	{name: "pattern", typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeNotebookDocumentFilterScheme = &shape{kind: shapeObject, name: "NotebookDocumentFilterScheme", fields: []shapeField{
+	{name: "notebookType", optional: true, typ: shapeOfString},
+	{name: "scheme", typ: shapeOfString},
+	{name: "pattern", optional: true, typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeNotebookDocumentFilterWithCells = &shape{kind: shapeObject, name: "NotebookDocumentFilterWithCells", fields: []shapeField{
+	{name: "notebook", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("NotebookDocumentFilter")}}},
+	{name: "cells", typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookCellLanguage")}},
+}}
+
+var shapeNotebookDocumentFilterWithNotebook = &shape{kind: shapeObject, name: "NotebookDocumentFilterWithNotebook", fields: []shapeField{
+	{name: "notebook", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("NotebookDocumentFilter")}}},
+	{name: "cells", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("NotebookCellLanguage")}},
+}}
+
+var shapeNotebookDocumentIdentifier = &shape{kind: shapeObject, name: "NotebookDocumentIdentifier", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeNotebookDocumentSync = &shape{kind: shapeOr, name: "NotebookDocumentSync", items: []*shape{shapeRefTo("NotebookDocumentSyncOptions"), shapeRefTo("NotebookDocumentSyncRegistrationOptions")}}
+
+var shapeNotebookDocumentSyncClientCapabilities = &shape{kind: shapeObject, name: "NotebookDocumentSyncClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "executionSummarySupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeNotebookDocumentSyncOptions = &shape{kind: shapeObject, name: "NotebookDocumentSyncOptions", fields: []shapeField{
+	{name: "notebookSelector", typ: &shape{kind: shapeArray, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("NotebookDocumentFilterWithNotebook"), shapeRefTo("NotebookDocumentFilterWithCells")}}}},
+	{name: "save", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeNotebookDocumentSyncRegistrationOptions = &shape{kind: shapeObject, name: "NotebookDocumentSyncRegistrationOptions", fields: []shapeField{
+	{name: "notebookSelector", typ: &shape{kind: shapeArray, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("NotebookDocumentFilterWithNotebook"), shapeRefTo("NotebookDocumentFilterWithCells")}}}},
+	{name: "save", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeNotebookSelector = &shape{kind: shapeOr, name: "NotebookSelector", items: []*shape{shapeRefTo("NotebookDocumentFilterWithNotebook"), shapeRefTo("NotebookDocumentFilterWithCells")}}
+
+var shapeOptionalVersionedTextDocumentIdentifier = &shape{kind: shapeObject, name: "OptionalVersionedTextDocumentIdentifier", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "version", typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfNull}}},
+}}
+
+var shapeParameterInformation = &shape{kind: shapeObject, name: "ParameterInformation", fields: []shapeField{
+	{name: "label", typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, &shape{kind: shapeTuple, items: []*shape{shapeOfUinteger, shapeOfUinteger}}}}},
+	{name: "documentation", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+}}
+
+var shapeParameterInformationLabel = &shape{kind: shapeOr, name: "ParameterInformationLabel", items: []*shape{shapeOfString, &shape{kind: shapeTuple, items: []*shape{shapeOfUinteger, shapeOfUinteger}}}}
+
+var shapePartialResultParams = &shape{kind: shapeObject, name: "PartialResultParams", fields: []shapeField{
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapePattern = shapeOfString
+
+var shapePosition = &shape{kind: shapeObject, name: "Position", fields: []shapeField{
+	{name: "line", typ: shapeOfUinteger},
+	{name: "character", typ: shapeOfUinteger},
+}}
+
+var shapePositionEncodingKind = &shape{kind: shapeEnum, name: "PositionEncodingKind", base: shapeString, open: true, values: []string{"utf-8", "utf-16", "utf-32"}}
+
+var shapePrepareRenameDefaultBehavior = &shape{kind: shapeObject, name: "PrepareRenameDefaultBehavior", fields: []shapeField{
+	{name: "defaultBehavior", typ: shapeOfBoolean},
+}}
+
+var shapePrepareRenameParams = &shape{kind: shapeObject, name: "PrepareRenameParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapePrepareRenamePlaceholder = &shape{kind: shapeObject, name: "PrepareRenamePlaceholder", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "placeholder", typ: shapeOfString},
+}}
+
+var shapePrepareRenameResult = &shape{kind: shapeOr, name: "PrepareRenameResult", items: []*shape{shapeRefTo("Range"), shapeRefTo("PrepareRenamePlaceholder"), shapeRefTo("PrepareRenameDefaultBehavior")}}
+
+var shapePrepareSupportDefaultBehavior = &shape{kind: shapeEnum, name: "PrepareSupportDefaultBehavior", base: shapeUinteger, values: []string{"1"}}
+
+var shapePreviousResultId = &shape{kind: shapeObject, name: "PreviousResultId", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "value", typ: shapeOfString},
+}}
+
+var shapeProgressParams = &shape{kind: shapeObject, name: "ProgressParams", fields: []shapeField{
+	{name: "token", typ: shapeRefTo("ProgressToken")},
+	{name: "value", typ: shapeOfAny},
+}}
+
+var shapeProgressToken = &shape{kind: shapeOr, name: "ProgressToken", items: []*shape{shapeOfInteger, shapeOfString}}
+
+var shapePublishDiagnosticsClientCapabilities = &shape{kind: shapeObject, name: "PublishDiagnosticsClientCapabilities", fields: []shapeField{
+	{name: "relatedInformation", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("ClientDiagnosticsTagOptions")},
+	{name: "codeDescriptionSupport", optional: true, typ: shapeOfBoolean},
+	{name: "dataSupport", optional: true, typ: shapeOfBoolean},
+	{name: "versionSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapePublishDiagnosticsParams = &shape{kind: shapeObject, name: "PublishDiagnosticsParams", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "version", optional: true, typ: shapeOfInteger},
+	{name: "diagnostics", typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+}}
+
+var shapeRange = &shape{kind: shapeObject, name: "Range", fields: []shapeField{
+	{name: "start", typ: shapeRefTo("Position")},
+	{name: "end", typ: shapeRefTo("Position")},
+}}
+
+var shapeReferenceClientCapabilities = &shape{kind: shapeObject, name: "ReferenceClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeReferenceContext = &shape{kind: shapeObject, name: "ReferenceContext", fields: []shapeField{
+	{name: "includeDeclaration", typ: shapeOfBoolean},
+}}
+
+var shapeReferenceOptions = &shape{kind: shapeObject, name: "ReferenceOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeReferenceParams = &shape{kind: shapeObject, name: "ReferenceParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "context", typ: shapeRefTo("ReferenceContext")},
+}}
+
+var shapeReferenceRegistrationOptions = &shape{kind: shapeObject, name: "ReferenceRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeReferencesProvider = &shape{kind: shapeOr, name: "ReferencesProvider", items: []*shape{shapeOfBoolean, shapeRefTo("ReferenceOptions")}}
+
+var shapeRegistration = &shape{kind: shapeObject, name: "Registration", fields: []shapeField{
+	{name: "id", typ: shapeOfString},
+	{name: "method", typ: shapeOfString},
+	{name: "registerOptions", optional: true, typ: shapeOfAny},
+}}
+
+var shapeRegistrationParams = &shape{kind: shapeObject, name: "RegistrationParams", fields: []shapeField{
+	{name: "registrations", typ: &shape{kind: shapeArray, elem: shapeRefTo("Registration")}},
+}}
+
+var shapeRegularExpressionEngineKind = shapeOfString
+
+var shapeRegularExpressionsClientCapabilities = &shape{kind: shapeObject, name: "RegularExpressionsClientCapabilities", fields: []shapeField{
+	{name: "engine", typ: shapeRefTo("RegularExpressionEngineKind")},
+	{name: "version", optional: true, typ: shapeOfString},
+}}
+
+var shapeRelatedFullDocumentDiagnosticReport = &shape{kind: shapeObject, name: "RelatedFullDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"full"}}},
+	{name: "resultId", optional: true, typ: shapeOfString},
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+	{name: "relatedDocuments", optional: true, typ: &shape{kind: shapeMap, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("FullDocumentDiagnosticReport"), shapeRefTo("UnchangedDocumentDiagnosticReport")}}}},
+}}
+
+var shapeRelatedUnchangedDocumentDiagnosticReport = &shape{kind: shapeObject, name: "RelatedUnchangedDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"unchanged"}}},
+	{name: "resultId", typ: shapeOfString},
+	{name: "relatedDocuments", optional: true, typ: &shape{kind: shapeMap, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("FullDocumentDiagnosticReport"), shapeRefTo("UnchangedDocumentDiagnosticReport")}}}},
+}}
+
+var shapeRelativePattern = &shape{kind: shapeObject, name: "RelativePattern", fields: []shapeField{
+	{name: "baseUri", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("WorkspaceFolder"), shapeOfString}}},
+	{name: "pattern", typ: shapeRefTo("Pattern")},
+}}
+
+var shapeRelativePatternBaseURI = &shape{kind: shapeOr, name: "RelativePatternBaseURI", items: []*shape{shapeRefTo("WorkspaceFolder"), shapeOfString}}
+
+var shapeRenameClientCapabilities = &shape{kind: shapeObject, name: "RenameClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "prepareSupport", optional: true, typ: shapeOfBoolean},
+	{name: "prepareSupportDefaultBehavior", optional: true, typ: shapeRefTo("PrepareSupportDefaultBehavior")},
+	{name: "honorsChangeAnnotations", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeRenameFile = &shape{kind: shapeObject, name: "RenameFile", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"rename"}}},
+	{name: "annotationId", optional: true, typ: shapeRefTo("ChangeAnnotationIdentifier")},
+	{name: "oldUri", typ: shapeOfString},
+	{name: "newUri", typ: shapeOfString},
+	{name: "options", optional: true, typ: shapeRefTo("RenameFileOptions")},
+}}
+
+var shapeRenameFileOptions = &shape{kind: shapeObject, name: "RenameFileOptions", fields: []shapeField{
+	{name: "overwrite", optional: true, typ: shapeOfBoolean},
+	{name: "ignoreIfExists", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeRenameFilesParams = &shape{kind: shapeObject, name: "RenameFilesParams", fields: []shapeField{
+	{name: "files", typ: &shape{kind: shapeArray, elem: shapeRefTo("FileRename")}},
+}}
+
+var shapeRenameOptions = &shape{kind: shapeObject, name: "RenameOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "prepareProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeRenameParams = &shape{kind: shapeObject, name: "RenameParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "newName", typ: shapeOfString},
+}}
+
+var shapeRenameProvider = &shape{kind: shapeOr, name: "RenameProvider", items: []*shape{shapeOfBoolean, shapeRefTo("RenameOptions")}}
+
+var shapeRenameRegistrationOptions = &shape{kind: shapeObject, name: "RenameRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "prepareProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeResourceOperation = &shape{kind: shapeObject, name: "ResourceOperation", fields: []shapeField{
+	{name: "kind", typ: shapeOfString},
+	{name: "annotationId", optional: true, typ: shapeRefTo("ChangeAnnotationIdentifier")},
+}}
+
+var shapeResourceOperationKind = &shape{kind: shapeEnum, name: "ResourceOperationKind", base: shapeString, values: []string{"create", "rename", "delete"}}
+
+var shapeSaveOptions = &shape{kind: shapeObject, name: "SaveOptions", fields: []shapeField{
+	{name: "includeText", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSelectedCompletionInfo = &shape{kind: shapeObject, name: "SelectedCompletionInfo", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeSelectionRange = &shape{kind: shapeObject, name: "SelectionRange", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "parent", optional: true, typ: shapeRefTo("SelectionRange")},
+}}
+
+var shapeSelectionRangeClientCapabilities = &shape{kind: shapeObject, name: "SelectionRangeClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSelectionRangeOptions = &shape{kind: shapeObject, name: "SelectionRangeOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSelectionRangeParams = &shape{kind: shapeObject, name: "SelectionRangeParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "positions", typ: &shape{kind: shapeArray, elem: shapeRefTo("Position")}},
+}}
+
+var shapeSelectionRangeProvider = &shape{kind: shapeOr, name: "SelectionRangeProvider", items: []*shape{shapeOfBoolean, shapeRefTo("SelectionRangeOptions"), shapeRefTo("SelectionRangeRegistrationOptions")}}
+
+var shapeSelectionRangeRegistrationOptions = &shape{kind: shapeObject, name: "SelectionRangeRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeSemanticTokenModifiers = &shape{kind: shapeEnum, name: "SemanticTokenModifiers", base: shapeString, open: true, values: []string{"declaration", "definition", "readonly", "static", "deprecated", "abstract", "async", "modification", "documentation", "defaultLibrary"}}
+
+var shapeSemanticTokenTypes = &shape{kind: shapeEnum, name: "SemanticTokenTypes", base: shapeString, open: true, values: []string{"namespace", "type", "class", "enum", "interface", "struct", "typeParameter", "parameter", "variable", "property", "enumMember", "event", "function", "method", "macro", "keyword", "modifier", "comment", "string", "number", "regexp", "operator", "decorator", "label"}}
+
+var shapeSemanticTokens = &shape{kind: shapeObject, name: "SemanticTokens", fields: []shapeField{
+	{name: "resultId", optional: true, typ: shapeOfString},
+	{name: "data", typ: &shape{kind: shapeArray, elem: shapeOfUinteger}},
+}}
+
+var shapeSemanticTokensClientCapabilities = &shape{kind: shapeObject, name: "SemanticTokensClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "requests", typ: shapeRefTo("ClientSemanticTokensRequestOptions")},
+	{name: "tokenTypes", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "tokenModifiers", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "formats", typ: &shape{kind: shapeArray, elem: shapeRefTo("TokenFormat")}},
+	{name: "overlappingTokenSupport", optional: true, typ: shapeOfBoolean},
+	{name: "multilineTokenSupport", optional: true, typ: shapeOfBoolean},
+	{name: "serverCancelSupport", optional: true, typ: shapeOfBoolean},
+	{name: "augmentsSyntaxTokens", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSemanticTokensDelta = &shape{kind: shapeObject, name: "SemanticTokensDelta", fields: []shapeField{
+	{name: "resultId", optional: true, typ: shapeOfString},
+	{name: "edits", typ: &shape{kind: shapeArray, elem: shapeRefTo("SemanticTokensEdit")}},
+}}
+
+var shapeSemanticTokensDeltaParams = &shape{kind: shapeObject, name: "SemanticTokensDeltaParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "previousResultId", typ: shapeOfString},
+}}
+
+var shapeSemanticTokensDeltaPartialResult = &shape{kind: shapeObject, name: "SemanticTokensDeltaPartialResult", fields: []shapeField{
+	{name: "edits", typ: &shape{kind: shapeArray, elem: shapeRefTo("SemanticTokensEdit")}},
+}}
+
+var shapeSemanticTokensDeltaResult = &shape{kind: shapeOr, name: "SemanticTokensDeltaResult", items: []*shape{shapeRefTo("SemanticTokens"), shapeRefTo("SemanticTokensDelta")}}
+
+var shapeSemanticTokensEdit = &shape{kind: shapeObject, name: "SemanticTokensEdit", fields: []shapeField{
+	{name: "start", typ: shapeOfUinteger},
+	{name: "deleteCount", typ: shapeOfUinteger},
+	{name: "data", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfUinteger}},
+}}
+
+var shapeSemanticTokensFullDelta = &shape{kind: shapeObject, name: "SemanticTokensFullDelta", fields: []shapeField{
+	{name: "delta", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSemanticTokensLegend = &shape{kind: shapeObject, name: "SemanticTokensLegend", fields: []shapeField{
+	{name: "tokenTypes", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "tokenModifiers", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeSemanticTokensOptions = &shape{kind: shapeObject, name: "SemanticTokensOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "legend", typ: shapeRefTo("SemanticTokensLegend")},
+	{name: "range", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, &shape{kind: shapeObject, fields: []shapeField{}}}}},
+	{name: "full", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("SemanticTokensFullDelta")}}},
+}}
+
+var shapeSemanticTokensOptionsFull = &shape{kind: shapeOr, name: "SemanticTokensOptionsFull", items: []*shape{shapeOfBoolean, shapeRefTo("SemanticTokensFullDelta")}}
+
+var shapeSemanticTokensOptionsRange = &shape{kind: shapeObject, name: "SemanticTokensOptionsRange", fields: []shapeField{}}
+
+var shapeSemanticTokensParams = &shape{kind: shapeObject, name: "SemanticTokensParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+}}
+
+var shapeSemanticTokensPartialResult = &shape{kind: shapeObject, name: "SemanticTokensPartialResult", fields: []shapeField{
+	{name: "data", typ: &shape{kind: shapeArray, elem: shapeOfUinteger}},
+}}
+
+var shapeSemanticTokensProvider = &shape{kind: shapeOr, name: "SemanticTokensProvider", items: []*shape{shapeRefTo("SemanticTokensOptions"), shapeRefTo("SemanticTokensRegistrationOptions")}}
+
+var shapeSemanticTokensRangeParams = &shape{kind: shapeObject, name: "SemanticTokensRangeParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "range", typ: shapeRefTo("Range")},
+}}
+
+var shapeSemanticTokensRegistrationOptions = &shape{kind: shapeObject, name: "SemanticTokensRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "legend", typ: shapeRefTo("SemanticTokensLegend")},
+	{name: "range", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, &shape{kind: shapeObject, fields: []shapeField{}}}}},
+	{name: "full", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("SemanticTokensFullDelta")}}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeSemanticTokensWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "SemanticTokensWorkspaceClientCapabilities", fields: []shapeField{
+	{name: "refreshSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeServerCapabilities = &shape{kind: shapeObject, name: "ServerCapabilities", fields: []shapeField{
+	{name: "positionEncoding", optional: true, typ: shapeRefTo("PositionEncodingKind")},
+	{name: "textDocumentSync", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("TextDocumentSyncOptions"), shapeRefTo("TextDocumentSyncKind")}}},
+	{name: "notebookDocumentSync", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("NotebookDocumentSyncOptions"), shapeRefTo("NotebookDocumentSyncRegistrationOptions")}}},
+	{name: "completionProvider", optional: true, typ: shapeRefTo("CompletionOptions")},
+	{name: "hoverProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("HoverOptions")}}},
+	{name: "signatureHelpProvider", optional: true, typ: shapeRefTo("SignatureHelpOptions")},
+	{name: "declarationProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DeclarationOptions"), shapeRefTo("DeclarationRegistrationOptions")}}},
+	{name: "definitionProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DefinitionOptions")}}},
+	{name: "typeDefinitionProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("TypeDefinitionOptions"), shapeRefTo("TypeDefinitionRegistrationOptions")}}},
+	{name: "implementationProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("ImplementationOptions"), shapeRefTo("ImplementationRegistrationOptions")}}},
+	{name: "referencesProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("ReferenceOptions")}}},
+	{name: "documentHighlightProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DocumentHighlightOptions")}}},
+	{name: "documentSymbolProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DocumentSymbolOptions")}}},
+	{name: "codeActionProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("CodeActionOptions")}}},
+	{name: "codeLensProvider", optional: true, typ: shapeRefTo("CodeLensOptions")},
+	{name: "documentLinkProvider", optional: true, typ: shapeRefTo("DocumentLinkOptions")},
+	{name: "colorProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DocumentColorOptions"), shapeRefTo("DocumentColorRegistrationOptions")}}},
+	{name: "workspaceSymbolProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("WorkspaceSymbolOptions")}}},
+	{name: "documentFormattingProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DocumentFormattingOptions")}}},
+	{name: "documentRangeFormattingProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("DocumentRangeFormattingOptions")}}},
+	{name: "documentOnTypeFormattingProvider", optional: true, typ: shapeRefTo("DocumentOnTypeFormattingOptions")},
+	{name: "renameProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("RenameOptions")}}},
+	{name: "foldingRangeProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("FoldingRangeOptions"), shapeRefTo("FoldingRangeRegistrationOptions")}}},
+	{name: "selectionRangeProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("SelectionRangeOptions"), shapeRefTo("SelectionRangeRegistrationOptions")}}},
+	{name: "executeCommandProvider", optional: true, typ: shapeRefTo("ExecuteCommandOptions")},
+	{name: "callHierarchyProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("CallHierarchyOptions"), shapeRefTo("CallHierarchyRegistrationOptions")}}},
+	{name: "linkedEditingRangeProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("LinkedEditingRangeOptions"), shapeRefTo("LinkedEditingRangeRegistrationOptions")}}},
+	{name: "semanticTokensProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("SemanticTokensOptions"), shapeRefTo("SemanticTokensRegistrationOptions")}}},
+	{name: "monikerProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("MonikerOptions"), shapeRefTo("MonikerRegistrationOptions")}}},
+	{name: "typeHierarchyProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("TypeHierarchyOptions"), shapeRefTo("TypeHierarchyRegistrationOptions")}}},
+	{name: "inlineValueProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("InlineValueOptions"), shapeRefTo("InlineValueRegistrationOptions")}}},
+	{name: "inlayHintProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("InlayHintOptions"), shapeRefTo("InlayHintRegistrationOptions")}}},
+	{name: "diagnosticProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DiagnosticOptions"), shapeRefTo("DiagnosticRegistrationOptions")}}},
+	{name: "inlineCompletionProvider", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("InlineCompletionOptions")}}},
+	{name: "workspace", optional: true, typ: shapeRefTo("WorkspaceOptions")},
+	{name: "experimental", optional: true, typ: shapeOfAny},
+}}
+
+var shapeServerCompletionItemOptions = &shape{kind: shapeObject, name: "ServerCompletionItemOptions", fields: []shapeField{
+	{name: "labelDetailsSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeServerInfo = &shape{kind: shapeObject, name: "ServerInfo", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "version", optional: true, typ: shapeOfString},
+}}
+
+var shapeSetTraceParams = &shape{kind: shapeObject, name: "SetTraceParams", fields: []shapeField{
+	{name: "value", typ: shapeRefTo("TraceValue")},
+}}
+
+var shapeShowDocumentClientCapabilities = &shape{kind: shapeObject, name: "ShowDocumentClientCapabilities", fields: []shapeField{
+	{name: "support", typ: shapeOfBoolean},
+}}
+
+var shapeShowDocumentParams = &shape{kind: shapeObject, name: "ShowDocumentParams", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "external", optional: true, typ: shapeOfBoolean},
+	{name: "takeFocus", optional: true, typ: shapeOfBoolean},
+	{name: "selection", optional: true, typ: shapeRefTo("Range")},
+}}
+
+var shapeShowDocumentResult = &shape{kind: shapeObject, name: "ShowDocumentResult", fields: []shapeField{
+	{name: "success", typ: shapeOfBoolean},
+}}
+
+var shapeShowMessageParams = &shape{kind: shapeObject, name: "ShowMessageParams", fields: []shapeField{
+	{name: "type", typ: shapeRefTo("MessageType")},
+	{name: "message", typ: shapeOfString},
+}}
+
+var shapeShowMessageRequestClientCapabilities = &shape{kind: shapeObject, name: "ShowMessageRequestClientCapabilities", fields: []shapeField{
+	{name: "messageActionItem", optional: true, typ: shapeRefTo("ClientShowMessageActionItemOptions")},
+}}
+
+var shapeShowMessageRequestParams = &shape{kind: shapeObject, name: "ShowMessageRequestParams", fields: []shapeField{
+	{name: "type", typ: shapeRefTo("MessageType")},
+	{name: "message", typ: shapeOfString},
+	{name: "actions", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("MessageActionItem")}},
+}}
+
+var shapeSignatureHelp = &shape{kind: shapeObject, name: "SignatureHelp", fields: []shapeField{
+	{name: "signatures", typ: &shape{kind: shapeArray, elem: shapeRefTo("SignatureInformation")}},
+	{name: "activeSignature", optional: true, typ: shapeOfUinteger},
+	{name: "activeParameter", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfUinteger, shapeOfNull}}},
+}}
+
+var shapeSignatureHelpClientCapabilities = &shape{kind: shapeObject, name: "SignatureHelpClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "signatureInformation", optional: true, typ: shapeRefTo("ClientSignatureInformationOptions")},
+	{name: "contextSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeSignatureHelpContext = &shape{kind: shapeObject, name: "SignatureHelpContext", fields: []shapeField{
+	{name: "triggerKind", typ: shapeRefTo("SignatureHelpTriggerKind")},
+	{name: "triggerCharacter", optional: true, typ: shapeOfString},
+	{name: "isRetrigger", typ: shapeOfBoolean},
+	{name: "activeSignatureHelp", optional: true, typ: shapeRefTo("SignatureHelp")},
+}}
+
+var shapeSignatureHelpOptions = &shape{kind: shapeObject, name: "SignatureHelpOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "triggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "retriggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeSignatureHelpParams = &shape{kind: shapeObject, name: "SignatureHelpParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "context", optional: true, typ: shapeRefTo("SignatureHelpContext")},
+}}
+
+var shapeSignatureHelpRegistrationOptions = &shape{kind: shapeObject, name: "SignatureHelpRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "triggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "retriggerCharacters", optional: true, typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeSignatureHelpTriggerKind = &shape{kind: shapeEnum, name: "SignatureHelpTriggerKind", base: shapeUinteger, values: []string{"1", "2", "3"}}
+
+var shapeSignatureInformation = &shape{kind: shapeObject, name: "SignatureInformation", fields: []shapeField{
+	{name: "label", typ: shapeOfString},
+	{name: "documentation", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeRefTo("MarkupContent")}}},
+	{name: "parameters", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("ParameterInformation")}},
+	{name: "activeParameter", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfUinteger, shapeOfNull}}},
+}}
+
+var shapeSnippetTextEdit = &shape{kind: shapeObject, name: "SnippetTextEdit", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "snippet", typ: shapeRefTo("StringValue")},
+	{name: "annotationId", optional: true, typ: shapeRefTo("ChangeAnnotationIdentifier")},
+}}
+
+var shapeStaleRequestSupportOptions = &shape{kind: shapeObject, name: "StaleRequestSupportOptions", fields: []shapeField{
+	{name: "cancel", typ: shapeOfBoolean},
+	{name: "retryOnContentModified", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeStaticRegistrationOptions = &shape{kind: shapeObject, name: "StaticRegistrationOptions", fields: []shapeField{
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeStringValue = &shape{kind: shapeObject, name: "StringValue", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"snippet"}}},
+	{name: "value", typ: shapeOfString},
+}}
+
+var shapeSymbolInformation = &shape{kind: shapeObject, name: "SymbolInformation", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "containerName", optional: true, typ: shapeOfString},
+	{name: "deprecated", optional: true, typ: shapeOfBoolean},
+	{name: "location", typ: shapeRefTo("Location")},
+}}
+
+var shapeSymbolKind = &shape{kind: shapeEnum, name: "SymbolKind", base: shapeUinteger, values: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26"}}
+
+var shapeSymbolTag = &shape{kind: shapeEnum, name: "SymbolTag", base: shapeUinteger, values: []string{"1"}}
+
+var shapeTextDocumentChangeRegistrationOptions = &shape{kind: shapeObject, name: "TextDocumentChangeRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "syncKind", typ: shapeRefTo("TextDocumentSyncKind")},
+}}
+
+var shapeTextDocumentClientCapabilities = &shape{kind: shapeObject, name: "TextDocumentClientCapabilities", fields: []shapeField{
+	{name: "synchronization", optional: true, typ: shapeRefTo("TextDocumentSyncClientCapabilities")},
+	{name: "filters", optional: true, typ: shapeRefTo("TextDocumentFilterClientCapabilities")},
+	{name: "completion", optional: true, typ: shapeRefTo("CompletionClientCapabilities")},
+	{name: "hover", optional: true, typ: shapeRefTo("HoverClientCapabilities")},
+	{name: "signatureHelp", optional: true, typ: shapeRefTo("SignatureHelpClientCapabilities")},
+	{name: "declaration", optional: true, typ: shapeRefTo("DeclarationClientCapabilities")},
+	{name: "definition", optional: true, typ: shapeRefTo("DefinitionClientCapabilities")},
+	{name: "typeDefinition", optional: true, typ: shapeRefTo("TypeDefinitionClientCapabilities")},
+	{name: "implementation", optional: true, typ: shapeRefTo("ImplementationClientCapabilities")},
+	{name: "references", optional: true, typ: shapeRefTo("ReferenceClientCapabilities")},
+	{name: "documentHighlight", optional: true, typ: shapeRefTo("DocumentHighlightClientCapabilities")},
+	{name: "documentSymbol", optional: true, typ: shapeRefTo("DocumentSymbolClientCapabilities")},
+	{name: "codeAction", optional: true, typ: shapeRefTo("CodeActionClientCapabilities")},
+	{name: "codeLens", optional: true, typ: shapeRefTo("CodeLensClientCapabilities")},
+	{name: "documentLink", optional: true, typ: shapeRefTo("DocumentLinkClientCapabilities")},
+	{name: "colorProvider", optional: true, typ: shapeRefTo("DocumentColorClientCapabilities")},
+	{name: "formatting", optional: true, typ: shapeRefTo("DocumentFormattingClientCapabilities")},
+	{name: "rangeFormatting", optional: true, typ: shapeRefTo("DocumentRangeFormattingClientCapabilities")},
+	{name: "onTypeFormatting", optional: true, typ: shapeRefTo("DocumentOnTypeFormattingClientCapabilities")},
+	{name: "rename", optional: true, typ: shapeRefTo("RenameClientCapabilities")},
+	{name: "foldingRange", optional: true, typ: shapeRefTo("FoldingRangeClientCapabilities")},
+	{name: "selectionRange", optional: true, typ: shapeRefTo("SelectionRangeClientCapabilities")},
+	{name: "publishDiagnostics", optional: true, typ: shapeRefTo("PublishDiagnosticsClientCapabilities")},
+	{name: "callHierarchy", optional: true, typ: shapeRefTo("CallHierarchyClientCapabilities")},
+	{name: "semanticTokens", optional: true, typ: shapeRefTo("SemanticTokensClientCapabilities")},
+	{name: "linkedEditingRange", optional: true, typ: shapeRefTo("LinkedEditingRangeClientCapabilities")},
+	{name: "moniker", optional: true, typ: shapeRefTo("MonikerClientCapabilities")},
+	{name: "typeHierarchy", optional: true, typ: shapeRefTo("TypeHierarchyClientCapabilities")},
+	{name: "inlineValue", optional: true, typ: shapeRefTo("InlineValueClientCapabilities")},
+	{name: "inlayHint", optional: true, typ: shapeRefTo("InlayHintClientCapabilities")},
+	{name: "diagnostic", optional: true, typ: shapeRefTo("DiagnosticClientCapabilities")},
+	{name: "inlineCompletion", optional: true, typ: shapeRefTo("InlineCompletionClientCapabilities")},
+}}
+
+var shapeTextDocumentContentChangeEvent = &shape{kind: shapeOr, name: "TextDocumentContentChangeEvent", items: []*shape{shapeRefTo("TextDocumentContentChangePartial"), shapeRefTo("TextDocumentContentChangeWholeDocument")}}
+
+var shapeTextDocumentContentChangePartial = &shape{kind: shapeObject, name: "TextDocumentContentChangePartial", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "rangeLength", optional: true, typ: shapeOfUinteger},
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeTextDocumentContentChangeWholeDocument = &shape{kind: shapeObject, name: "TextDocumentContentChangeWholeDocument", fields: []shapeField{
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeTextDocumentContentClientCapabilities = &shape{kind: shapeObject, name: "TextDocumentContentClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTextDocumentContentOptions = &shape{kind: shapeObject, name: "TextDocumentContentOptions", fields: []shapeField{
+	{name: "schemes", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+}}
+
+var shapeTextDocumentContentParams = &shape{kind: shapeObject, name: "TextDocumentContentParams", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeTextDocumentContentRefreshParams = &shape{kind: shapeObject, name: "TextDocumentContentRefreshParams", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeTextDocumentContentRegistrationOptions = &shape{kind: shapeObject, name: "TextDocumentContentRegistrationOptions", fields: []shapeField{
+	{name: "schemes", typ: &shape{kind: shapeArray, elem: shapeOfString}},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeTextDocumentContentResult = &shape{kind: shapeObject, name: "TextDocumentContentResult", fields: []shapeField{
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeTextDocumentEdit = &shape{kind: shapeObject, name: "TextDocumentEdit", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("OptionalVersionedTextDocumentIdentifier")},
+	{name: "edits", typ: &shape{kind: shapeArray, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("TextEdit"), shapeRefTo("AnnotatedTextEdit"), shapeRefTo("SnippetTextEdit")}}}},
+}}
+
+var shapeTextDocumentEditElement = &shape{kind: shapeOr, name: "TextDocumentEditElement", items: []*shape{shapeRefTo("TextEdit"), shapeRefTo("AnnotatedTextEdit"), shapeRefTo("SnippetTextEdit")}}
+
+var shapeTextDocumentFilter = &shape{kind: shapeOr, name: "TextDocumentFilter", items: []*shape{shapeRefTo("TextDocumentFilterLanguage"), shapeRefTo("TextDocumentFilterScheme"), shapeRefTo("TextDocumentFilterPattern")}}
+
+var shapeTextDocumentFilterClientCapabilities = &shape{kind: shapeObject, name: "TextDocumentFilterClientCapabilities", fields: []shapeField{
+	{name: "relativePatternSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTextDocumentFilterLanguage = &shape{kind: shapeObject, name: "TextDocumentFilterLanguage", fields: []shapeField{
+	{name: "language", typ: shapeOfString},
+	{name: "scheme", optional: true, typ: shapeOfString},
+	{name: "pattern", optional: true, typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeTextDocumentFilterPattern = &shape{kind: shapeObject, name: "TextDocumentFilterPattern", fields: []shapeField{
+	{name: "language", optional: true, typ: shapeOfString},
+	{name: "scheme", optional: true, typ: shapeOfString},
+	{name: "pattern", typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeTextDocumentFilterScheme = &shape{kind: shapeObject, name: "TextDocumentFilterScheme", fields: []shapeField{
+	{name: "language", optional: true, typ: shapeOfString},
+	{name: "scheme", typ: shapeOfString},
+	{name: "pattern", optional: true, typ: shapeRefTo("GlobPattern")},
+}}
+
+var shapeTextDocumentIdentifier = &shape{kind: shapeObject, name: "TextDocumentIdentifier", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeTextDocumentItem = &shape{kind: shapeObject, name: "TextDocumentItem", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "languageId", typ: shapeRefTo("LanguageKind")},
+	{name: "version", typ: shapeOfInteger},
+	{name: "text", typ: shapeOfString},
+}}
+
+var shapeTextDocumentPositionParams = &shape{kind: shapeObject, name: "TextDocumentPositionParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+}}
+
+var shapeTextDocumentRegistrationOptions = &shape{kind: shapeObject, name: "TextDocumentRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+}}
+
+var shapeTextDocumentSaveReason = &shape{kind: shapeEnum, name: "TextDocumentSaveReason", base: shapeUinteger, values: []string{"1", "2", "3"}}
+
+var shapeTextDocumentSaveRegistrationOptions = &shape{kind: shapeObject, name: "TextDocumentSaveRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "includeText", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTextDocumentSync = &shape{kind: shapeOr, name: "TextDocumentSync", items: []*shape{shapeRefTo("TextDocumentSyncOptions"), shapeRefTo("TextDocumentSyncKind")}}
+
+var shapeTextDocumentSyncClientCapabilities = &shape{kind: shapeObject, name: "TextDocumentSyncClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "willSave", optional: true, typ: shapeOfBoolean},
+	{name: "willSaveWaitUntil", optional: true, typ: shapeOfBoolean},
+	{name: "didSave", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTextDocumentSyncKind = &shape{kind: shapeEnum, name: "TextDocumentSyncKind", base: shapeUinteger, values: []string{"0", "1", "2"}}
+
+var shapeTextDocumentSyncOptions = &shape{kind: shapeObject, name: "TextDocumentSyncOptions", fields: []shapeField{
+	{name: "openClose", optional: true, typ: shapeOfBoolean},
+	{name: "change", optional: true, typ: shapeRefTo("TextDocumentSyncKind")},
+	{name: "willSave", optional: true, typ: shapeOfBoolean},
+	{name: "willSaveWaitUntil", optional: true, typ: shapeOfBoolean},
+	{name: "save", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfBoolean, shapeRefTo("SaveOptions")}}},
+}}
+
+var shapeTextDocumentSyncOptionsSave = &shape{kind: shapeOr, name: "TextDocumentSyncOptionsSave", items: []*shape{shapeOfBoolean, shapeRefTo("SaveOptions")}}
+
+var shapeTextEdit = &shape{kind: shapeObject, name: "TextEdit", fields: []shapeField{
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "newText", typ: shapeOfString},
+}}
+
+var shapeTokenFormat = &shape{kind: shapeEnum, name: "TokenFormat", base: shapeString, values: []string{"relative"}}
+
+var shapeTraceValue = &shape{kind: shapeEnum, name: "TraceValue", base: shapeString, values: []string{"off", "messages", "verbose"}}
+
+var shapeTypeDefinitionClientCapabilities = &shape{kind: shapeObject, name: "TypeDefinitionClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "linkSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTypeDefinitionOptions = &shape{kind: shapeObject, name: "TypeDefinitionOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTypeDefinitionParams = &shape{kind: shapeObject, name: "TypeDefinitionParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeTypeDefinitionProvider = &shape{kind: shapeOr, name: "TypeDefinitionProvider", items: []*shape{shapeOfBoolean, shapeRefTo("TypeDefinitionOptions"), shapeRefTo("TypeDefinitionRegistrationOptions")}}
+
+var shapeTypeDefinitionRegistrationOptions = &shape{kind: shapeObject, name: "TypeDefinitionRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeTypeHierarchyClientCapabilities = &shape{kind: shapeObject, name: "TypeHierarchyClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTypeHierarchyItem = &shape{kind: shapeObject, name: "TypeHierarchyItem", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "detail", optional: true, typ: shapeOfString},
+	{name: "uri", typ: shapeOfString},
+	{name: "range", typ: shapeRefTo("Range")},
+	{name: "selectionRange", typ: shapeRefTo("Range")},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeTypeHierarchyOptions = &shape{kind: shapeObject, name: "TypeHierarchyOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeTypeHierarchyPrepareParams = &shape{kind: shapeObject, name: "TypeHierarchyPrepareParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "position", typ: shapeRefTo("Position")},
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeTypeHierarchyProvider = &shape{kind: shapeOr, name: "TypeHierarchyProvider", items: []*shape{shapeOfBoolean, shapeRefTo("TypeHierarchyOptions"), shapeRefTo("TypeHierarchyRegistrationOptions")}}
+
+var shapeTypeHierarchyRegistrationOptions = &shape{kind: shapeObject, name: "TypeHierarchyRegistrationOptions", fields: []shapeField{
+	{name: "documentSelector", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("DocumentSelector"), shapeOfNull}}},
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "id", optional: true, typ: shapeOfString},
+}}
+
+var shapeTypeHierarchySubtypesParams = &shape{kind: shapeObject, name: "TypeHierarchySubtypesParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "item", typ: shapeRefTo("TypeHierarchyItem")},
+}}
+
+var shapeTypeHierarchySupertypesParams = &shape{kind: shapeObject, name: "TypeHierarchySupertypesParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "item", typ: shapeRefTo("TypeHierarchyItem")},
+}}
+
+var shapeUnchangedDocumentDiagnosticReport = &shape{kind: shapeObject, name: "UnchangedDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"unchanged"}}},
+	{name: "resultId", typ: shapeOfString},
+}}
+
+var shapeUniquenessLevel = &shape{kind: shapeEnum, name: "UniquenessLevel", base: shapeString, values: []string{"document", "project", "group", "scheme", "global"}}
+
+var shapeUnregistration = &shape{kind: shapeObject, name: "Unregistration", fields: []shapeField{
+	{name: "id", typ: shapeOfString},
+	{name: "method", typ: shapeOfString},
+}}
+
+var shapeUnregistrationParams = &shape{kind: shapeObject, name: "UnregistrationParams", fields: []shapeField{
+	{name: "unregisterations", typ: &shape{kind: shapeArray, elem: shapeRefTo("Unregistration")}},
+}}
+
+var shapeVersionedNotebookDocumentIdentifier = &shape{kind: shapeObject, name: "VersionedNotebookDocumentIdentifier", fields: []shapeField{
+	{name: "version", typ: shapeOfInteger},
+	{name: "uri", typ: shapeOfString},
+}}
+
+var shapeVersionedTextDocumentIdentifier = &shape{kind: shapeObject, name: "VersionedTextDocumentIdentifier", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "version", typ: shapeOfInteger},
+}}
+
+var shapeWatchKind = &shape{kind: shapeEnum, name: "WatchKind", base: shapeUinteger, open: true, values: []string{"1", "2", "4"}}
+
+var shapeWillSaveTextDocumentParams = &shape{kind: shapeObject, name: "WillSaveTextDocumentParams", fields: []shapeField{
+	{name: "textDocument", typ: shapeRefTo("TextDocumentIdentifier")},
+	{name: "reason", typ: shapeRefTo("TextDocumentSaveReason")},
+}}
+
+var shapeWindowClientCapabilities = &shape{kind: shapeObject, name: "WindowClientCapabilities", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "showMessage", optional: true, typ: shapeRefTo("ShowMessageRequestClientCapabilities")},
+	{name: "showDocument", optional: true, typ: shapeRefTo("ShowDocumentClientCapabilities")},
+}}
+
+var shapeWorkDoneProgressBegin = &shape{kind: shapeObject, name: "WorkDoneProgressBegin", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"begin"}}},
+	{name: "title", typ: shapeOfString},
+	{name: "cancellable", optional: true, typ: shapeOfBoolean},
+	{name: "message", optional: true, typ: shapeOfString},
+	{name: "percentage", optional: true, typ: shapeOfUinteger},
+}}
+
+var shapeWorkDoneProgressCancelParams = &shape{kind: shapeObject, name: "WorkDoneProgressCancelParams", fields: []shapeField{
+	{name: "token", typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeWorkDoneProgressCreateParams = &shape{kind: shapeObject, name: "WorkDoneProgressCreateParams", fields: []shapeField{
+	{name: "token", typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeWorkDoneProgressEnd = &shape{kind: shapeObject, name: "WorkDoneProgressEnd", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"end"}}},
+	{name: "message", optional: true, typ: shapeOfString},
+}}
+
+var shapeWorkDoneProgressOptions = &shape{kind: shapeObject, name: "WorkDoneProgressOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeWorkDoneProgressParams = &shape{kind: shapeObject, name: "WorkDoneProgressParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+}}
+
+var shapeWorkDoneProgressReport = &shape{kind: shapeObject, name: "WorkDoneProgressReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"report"}}},
+	{name: "cancellable", optional: true, typ: shapeOfBoolean},
+	{name: "message", optional: true, typ: shapeOfString},
+	{name: "percentage", optional: true, typ: shapeOfUinteger},
+}}
+
+var shapeWorkspaceClientCapabilities = &shape{kind: shapeObject, name: "WorkspaceClientCapabilities", fields: []shapeField{
+	{name: "applyEdit", optional: true, typ: shapeOfBoolean},
+	{name: "workspaceEdit", optional: true, typ: shapeRefTo("WorkspaceEditClientCapabilities")},
+	{name: "didChangeConfiguration", optional: true, typ: shapeRefTo("DidChangeConfigurationClientCapabilities")},
+	{name: "didChangeWatchedFiles", optional: true, typ: shapeRefTo("DidChangeWatchedFilesClientCapabilities")},
+	{name: "symbol", optional: true, typ: shapeRefTo("WorkspaceSymbolClientCapabilities")},
+	{name: "executeCommand", optional: true, typ: shapeRefTo("ExecuteCommandClientCapabilities")},
+	{name: "workspaceFolders", optional: true, typ: shapeOfBoolean},
+	{name: "configuration", optional: true, typ: shapeOfBoolean},
+	{name: "semanticTokens", optional: true, typ: shapeRefTo("SemanticTokensWorkspaceClientCapabilities")},
+	{name: "codeLens", optional: true, typ: shapeRefTo("CodeLensWorkspaceClientCapabilities")},
+	{name: "fileOperations", optional: true, typ: shapeRefTo("FileOperationClientCapabilities")},
+	{name: "inlineValue", optional: true, typ: shapeRefTo("InlineValueWorkspaceClientCapabilities")},
+	{name: "inlayHint", optional: true, typ: shapeRefTo("InlayHintWorkspaceClientCapabilities")},
+	{name: "diagnostics", optional: true, typ: shapeRefTo("DiagnosticWorkspaceClientCapabilities")},
+	{name: "foldingRange", optional: true, typ: shapeRefTo("FoldingRangeWorkspaceClientCapabilities")},
+	{name: "textDocumentContent", optional: true, typ: shapeRefTo("TextDocumentContentClientCapabilities")},
+}}
+
+var shapeWorkspaceDiagnosticParams = &shape{kind: shapeObject, name: "WorkspaceDiagnosticParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "identifier", optional: true, typ: shapeOfString},
+	{name: "previousResultIds", typ: &shape{kind: shapeArray, elem: shapeRefTo("PreviousResultId")}},
+}}
+
+var shapeWorkspaceDiagnosticReport = &shape{kind: shapeObject, name: "WorkspaceDiagnosticReport", fields: []shapeField{
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceDocumentDiagnosticReport")}},
+}}
+
+var shapeWorkspaceDiagnosticReportPartialResult = &shape{kind: shapeObject, name: "WorkspaceDiagnosticReportPartialResult", fields: []shapeField{
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceDocumentDiagnosticReport")}},
+}}
+
+var shapeWorkspaceDocumentDiagnosticReport = &shape{kind: shapeOr, name: "WorkspaceDocumentDiagnosticReport", items: []*shape{shapeRefTo("WorkspaceFullDocumentDiagnosticReport"), shapeRefTo("WorkspaceUnchangedDocumentDiagnosticReport")}}
+
+var shapeWorkspaceEdit = &shape{kind: shapeObject, name: "WorkspaceEdit", fields: []shapeField{
+	{name: "changes", optional: true, typ: &shape{kind: shapeMap, elem: &shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}}},
+	{name: "documentChanges", optional: true, typ: &shape{kind: shapeArray, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("TextDocumentEdit"), shapeRefTo("CreateFile"), shapeRefTo("RenameFile"), shapeRefTo("DeleteFile")}}}},
+	{name: "changeAnnotations", optional: true, typ: &shape{kind: shapeMap, elem: shapeRefTo("ChangeAnnotation")}},
+}}
+
+var shapeWorkspaceEditClientCapabilities = &shape{kind: shapeObject, name: "WorkspaceEditClientCapabilities", fields: []shapeField{
+	{name: "documentChanges", optional: true, typ: shapeOfBoolean},
+	{name: "resourceOperations", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("ResourceOperationKind")}},
+	{name: "failureHandling", optional: true, typ: shapeRefTo("FailureHandlingKind")},
+	{name: "normalizesLineEndings", optional: true, typ: shapeOfBoolean},
+	{name: "changeAnnotationSupport", optional: true, typ: shapeRefTo("ChangeAnnotationsSupportOptions")},
+	{name: "metadataSupport", optional: true, typ: shapeOfBoolean},
+	{name: "snippetEditSupport", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeWorkspaceEditMetadata = &shape{kind: shapeObject, name: "WorkspaceEditMetadata", fields: []shapeField{
+	{name: "isRefactoring", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeWorkspaceFolder = &shape{kind: shapeObject, name: "WorkspaceFolder", fields: []shapeField{
+	{name: "uri", typ: shapeOfString},
+	{name: "name", typ: shapeOfString},
+}}
+
+var shapeWorkspaceFoldersChangeEvent = &shape{kind: shapeObject, name: "WorkspaceFoldersChangeEvent", fields: []shapeField{
+	{name: "added", typ: &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceFolder")}},
+	{name: "removed", typ: &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceFolder")}},
+}}
+
+var shapeWorkspaceFoldersInitializeParams = &shape{kind: shapeObject, name: "WorkspaceFoldersInitializeParams", fields: []shapeField{
+	{name: "workspaceFolders", optional: true, typ: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("WorkspaceFolder")}, shapeOfNull}}},
+}}
+
+var shapeWorkspaceFoldersServerCapabilities = &shape{kind: shapeObject, name: "WorkspaceFoldersServerCapabilities", fields: []shapeField{
+	{name: "supported", optional: true, typ: shapeOfBoolean},
+	{name: "changeNotifications", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeOfString, shapeOfBoolean}}},
+}}
+
+var shapeWorkspaceFullDocumentDiagnosticReport = &shape{kind: shapeObject, name: "WorkspaceFullDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"full"}}},
+	{name: "resultId", optional: true, typ: shapeOfString},
+	{name: "items", typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+	{name: "uri", typ: shapeOfString},
+	{name: "version", typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfNull}}},
+}}
+
+var shapeWorkspaceOptions = &shape{kind: shapeObject, name: "WorkspaceOptions", fields: []shapeField{
+	{name: "workspaceFolders", optional: true, typ: shapeRefTo("WorkspaceFoldersServerCapabilities")},
+	{name: "fileOperations", optional: true, typ: shapeRefTo("FileOperationOptions")},
+	{name: "textDocumentContent", optional: true, typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("TextDocumentContentOptions"), shapeRefTo("TextDocumentContentRegistrationOptions")}}},
+}}
+
+var shapeWorkspaceOptionsTextDocumentContent = &shape{kind: shapeOr, name: "WorkspaceOptionsTextDocumentContent", items: []*shape{shapeRefTo("TextDocumentContentOptions"), shapeRefTo("TextDocumentContentRegistrationOptions")}}
+
+var shapeWorkspaceSymbol = &shape{kind: shapeObject, name: "WorkspaceSymbol", fields: []shapeField{
+	{name: "name", typ: shapeOfString},
+	{name: "kind", typ: shapeRefTo("SymbolKind")},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("SymbolTag")}},
+	{name: "containerName", optional: true, typ: shapeOfString},
+	{name: "location", typ: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Location"), shapeRefTo("LocationUriOnly")}}},
+	{name: "data", optional: true, typ: shapeOfAny},
+}}
+
+var shapeWorkspaceSymbolClientCapabilities = &shape{kind: shapeObject, name: "WorkspaceSymbolClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "symbolKind", optional: true, typ: shapeRefTo("ClientSymbolKindOptions")},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("ClientSymbolTagOptions")},
+	{name: "resolveSupport", optional: true, typ: shapeRefTo("ClientSymbolResolveOptions")},
+}}
+
+var shapeWorkspaceSymbolLocation = &shape{kind: shapeOr, name: "WorkspaceSymbolLocation", items: []*shape{shapeRefTo("Location"), shapeRefTo("LocationUriOnly")}}
+
+var shapeWorkspaceSymbolOptions = &shape{kind: shapeObject, name: "WorkspaceSymbolOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeWorkspaceSymbolParams = &shape{kind: shapeObject, name: "WorkspaceSymbolParams", fields: []shapeField{
+	{name: "workDoneToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "partialResultToken", optional: true, typ: shapeRefTo("ProgressToken")},
+	{name: "query", typ: shapeOfString},
+}}
+
+var shapeWorkspaceSymbolProvider = &shape{kind: shapeOr, name: "WorkspaceSymbolProvider", items: []*shape{shapeOfBoolean, shapeRefTo("WorkspaceSymbolOptions")}}
+
+var shapeWorkspaceSymbolRegistrationOptions = &shape{kind: shapeObject, name: "WorkspaceSymbolRegistrationOptions", fields: []shapeField{
+	{name: "workDoneProgress", optional: true, typ: shapeOfBoolean},
+	{name: "resolveProvider", optional: true, typ: shapeOfBoolean},
+}}
+
+var shapeWorkspaceSymbolResult = &shape{kind: shapeOr, name: "WorkspaceSymbolResult", items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("SymbolInformation")}, &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceSymbol")}}}
+
+var shapeWorkspaceUnchangedDocumentDiagnosticReport = &shape{kind: shapeObject, name: "WorkspaceUnchangedDocumentDiagnosticReport", fields: []shapeField{
+	{name: "kind", typ: &shape{kind: shapeStringLiteral, values: []string{"unchanged"}}},
+	{name: "resultId", typ: shapeOfString},
+	{name: "uri", typ: shapeOfString},
+	{name: "version", typ: &shape{kind: shapeOr, items: []*shape{shapeOfInteger, shapeOfNull}}},
+}}
+
+// methodShapes indexes the params and result shapes of every standard
+// method, for runtime validation.
+var methodShapes = map[string]methodShape{
+	"$/cancelRequest":                        {params: shapeRefTo("CancelParams"), notification: true},
+	"$/logTrace":                             {params: shapeRefTo("LogTraceParams"), notification: true},
+	"$/progress":                             {params: shapeRefTo("ProgressParams"), notification: true},
+	"$/setTrace":                             {params: shapeRefTo("SetTraceParams"), notification: true},
+	"callHierarchy/incomingCalls":            {params: shapeRefTo("CallHierarchyIncomingCallsParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CallHierarchyIncomingCall")}, shapeOfNull}}},
+	"callHierarchy/outgoingCalls":            {params: shapeRefTo("CallHierarchyOutgoingCallsParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CallHierarchyOutgoingCall")}, shapeOfNull}}},
+	"client/registerCapability":              {params: shapeRefTo("RegistrationParams"), result: shapeOfNull},
+	"client/unregisterCapability":            {params: shapeRefTo("UnregistrationParams"), result: shapeOfNull},
+	"codeAction/resolve":                     {params: shapeRefTo("CodeAction"), result: shapeRefTo("CodeAction")},
+	"codeLens/resolve":                       {params: shapeRefTo("CodeLens"), result: shapeRefTo("CodeLens")},
+	"completionItem/resolve":                 {params: shapeRefTo("CompletionItem"), result: shapeRefTo("CompletionItem")},
+	"documentLink/resolve":                   {params: shapeRefTo("DocumentLink"), result: shapeRefTo("DocumentLink")},
+	"exit":                                   {notification: true},
+	"initialize":                             {params: shapeRefTo("InitializeParams"), result: shapeRefTo("InitializeResult")},
+	"initialized":                            {params: shapeRefTo("InitializedParams"), notification: true},
+	"inlayHint/resolve":                      {params: shapeRefTo("InlayHint"), result: shapeRefTo("InlayHint")},
+	"notebookDocument/didChange":             {params: shapeRefTo("DidChangeNotebookDocumentParams"), notification: true},
+	"notebookDocument/didClose":              {params: shapeRefTo("DidCloseNotebookDocumentParams"), notification: true},
+	"notebookDocument/didOpen":               {params: shapeRefTo("DidOpenNotebookDocumentParams"), notification: true},
+	"notebookDocument/didSave":               {params: shapeRefTo("DidSaveNotebookDocumentParams"), notification: true},
+	"shutdown":                               {result: shapeOfNull},
+	"telemetry/event":                        {params: shapeOfAny, notification: true},
+	"textDocument/codeAction":                {params: shapeRefTo("CodeActionParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Command"), shapeRefTo("CodeAction")}}}, shapeOfNull}}},
+	"textDocument/codeLens":                  {params: shapeRefTo("CodeLensParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CodeLens")}, shapeOfNull}}},
+	"textDocument/colorPresentation":         {params: shapeRefTo("ColorPresentationParams"), result: &shape{kind: shapeArray, elem: shapeRefTo("ColorPresentation")}},
+	"textDocument/completion":                {params: shapeRefTo("CompletionParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CompletionItem")}, shapeRefTo("CompletionList"), shapeOfNull}}},
+	"textDocument/declaration":               {params: shapeRefTo("DeclarationParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Declaration"), &shape{kind: shapeArray, elem: shapeRefTo("DeclarationLink")}, shapeOfNull}}},
+	"textDocument/definition":                {params: shapeRefTo("DefinitionParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Definition"), &shape{kind: shapeArray, elem: shapeRefTo("DefinitionLink")}, shapeOfNull}}},
+	"textDocument/diagnostic":                {params: shapeRefTo("DocumentDiagnosticParams"), result: shapeRefTo("DocumentDiagnosticReport")},
+	"textDocument/didChange":                 {params: shapeRefTo("DidChangeTextDocumentParams"), notification: true},
+	"textDocument/didClose":                  {params: shapeRefTo("DidCloseTextDocumentParams"), notification: true},
+	"textDocument/didOpen":                   {params: shapeRefTo("DidOpenTextDocumentParams"), notification: true},
+	"textDocument/didSave":                   {params: shapeRefTo("DidSaveTextDocumentParams"), notification: true},
+	"textDocument/documentColor":             {params: shapeRefTo("DocumentColorParams"), result: &shape{kind: shapeArray, elem: shapeRefTo("ColorInformation")}},
+	"textDocument/documentHighlight":         {params: shapeRefTo("DocumentHighlightParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("DocumentHighlight")}, shapeOfNull}}},
+	"textDocument/documentLink":              {params: shapeRefTo("DocumentLinkParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("DocumentLink")}, shapeOfNull}}},
+	"textDocument/documentSymbol":            {params: shapeRefTo("DocumentSymbolParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("SymbolInformation")}, &shape{kind: shapeArray, elem: shapeRefTo("DocumentSymbol")}, shapeOfNull}}},
+	"textDocument/foldingRange":              {params: shapeRefTo("FoldingRangeParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("FoldingRange")}, shapeOfNull}}},
+	"textDocument/formatting":                {params: shapeRefTo("DocumentFormattingParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}, shapeOfNull}}},
+	"textDocument/hover":                     {params: shapeRefTo("HoverParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Hover"), shapeOfNull}}},
+	"textDocument/implementation":            {params: shapeRefTo("ImplementationParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Definition"), &shape{kind: shapeArray, elem: shapeRefTo("DefinitionLink")}, shapeOfNull}}},
+	"textDocument/inlayHint":                 {params: shapeRefTo("InlayHintParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("InlayHint")}, shapeOfNull}}},
+	"textDocument/inlineCompletion":          {params: shapeRefTo("InlineCompletionParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("InlineCompletionList"), &shape{kind: shapeArray, elem: shapeRefTo("InlineCompletionItem")}, shapeOfNull}}},
+	"textDocument/inlineValue":               {params: shapeRefTo("InlineValueParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("InlineValue")}, shapeOfNull}}},
+	"textDocument/linkedEditingRange":        {params: shapeRefTo("LinkedEditingRangeParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("LinkedEditingRanges"), shapeOfNull}}},
+	"textDocument/moniker":                   {params: shapeRefTo("MonikerParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("Moniker")}, shapeOfNull}}},
+	"textDocument/onTypeFormatting":          {params: shapeRefTo("DocumentOnTypeFormattingParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}, shapeOfNull}}},
+	"textDocument/prepareCallHierarchy":      {params: shapeRefTo("CallHierarchyPrepareParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("CallHierarchyItem")}, shapeOfNull}}},
+	"textDocument/prepareRename":             {params: shapeRefTo("PrepareRenameParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("PrepareRenameResult"), shapeOfNull}}},
+	"textDocument/prepareTypeHierarchy":      {params: shapeRefTo("TypeHierarchyPrepareParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TypeHierarchyItem")}, shapeOfNull}}},
+	"textDocument/publishDiagnostics":        {params: shapeRefTo("PublishDiagnosticsParams"), notification: true},
+	"textDocument/rangeFormatting":           {params: shapeRefTo("DocumentRangeFormattingParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}, shapeOfNull}}},
+	"textDocument/rangesFormatting":          {params: shapeRefTo("DocumentRangesFormattingParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}, shapeOfNull}}},
+	"textDocument/references":                {params: shapeRefTo("ReferenceParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("Location")}, shapeOfNull}}},
+	"textDocument/rename":                    {params: shapeRefTo("RenameParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("WorkspaceEdit"), shapeOfNull}}},
+	"textDocument/selectionRange":            {params: shapeRefTo("SelectionRangeParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("SelectionRange")}, shapeOfNull}}},
+	"textDocument/semanticTokens/full":       {params: shapeRefTo("SemanticTokensParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("SemanticTokens"), shapeOfNull}}},
+	"textDocument/semanticTokens/full/delta": {params: shapeRefTo("SemanticTokensDeltaParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("SemanticTokens"), shapeRefTo("SemanticTokensDelta"), shapeOfNull}}},
+	"textDocument/semanticTokens/range":      {params: shapeRefTo("SemanticTokensRangeParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("SemanticTokens"), shapeOfNull}}},
+	"textDocument/signatureHelp":             {params: shapeRefTo("SignatureHelpParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("SignatureHelp"), shapeOfNull}}},
+	"textDocument/typeDefinition":            {params: shapeRefTo("TypeDefinitionParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("Definition"), &shape{kind: shapeArray, elem: shapeRefTo("DefinitionLink")}, shapeOfNull}}},
+	"textDocument/willSave":                  {params: shapeRefTo("WillSaveTextDocumentParams"), notification: true},
+	"textDocument/willSaveWaitUntil":         {params: shapeRefTo("WillSaveTextDocumentParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TextEdit")}, shapeOfNull}}},
+	"typeHierarchy/subtypes":                 {params: shapeRefTo("TypeHierarchySubtypesParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TypeHierarchyItem")}, shapeOfNull}}},
+	"typeHierarchy/supertypes":               {params: shapeRefTo("TypeHierarchySupertypesParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("TypeHierarchyItem")}, shapeOfNull}}},
+	"window/logMessage":                      {params: shapeRefTo("LogMessageParams"), notification: true},
+	"window/showDocument":                    {params: shapeRefTo("ShowDocumentParams"), result: shapeRefTo("ShowDocumentResult")},
+	"window/showMessage":                     {params: shapeRefTo("ShowMessageParams"), notification: true},
+	"window/showMessageRequest":              {params: shapeRefTo("ShowMessageRequestParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("MessageActionItem"), shapeOfNull}}},
+	"window/workDoneProgress/cancel":         {params: shapeRefTo("WorkDoneProgressCancelParams"), notification: true},
+	"window/workDoneProgress/create":         {params: shapeRefTo("WorkDoneProgressCreateParams"), result: shapeOfNull},
+	"workspace/applyEdit":                    {params: shapeRefTo("ApplyWorkspaceEditParams"), result: shapeRefTo("ApplyWorkspaceEditResult")},
+	"workspace/codeLens/refresh":             {result: shapeOfNull},
+	"workspace/configuration":                {params: shapeRefTo("ConfigurationParams"), result: &shape{kind: shapeArray, elem: shapeOfAny}},
+	"workspace/diagnostic":                   {params: shapeRefTo("WorkspaceDiagnosticParams"), result: shapeRefTo("WorkspaceDiagnosticReport")},
+	"workspace/diagnostic/refresh":           {result: shapeOfNull},
+	"workspace/didChangeConfiguration":       {params: shapeRefTo("DidChangeConfigurationParams"), notification: true},
+	"workspace/didChangeWatchedFiles":        {params: shapeRefTo("DidChangeWatchedFilesParams"), notification: true},
+	"workspace/didChangeWorkspaceFolders":    {params: shapeRefTo("DidChangeWorkspaceFoldersParams"), notification: true},
+	"workspace/didCreateFiles":               {params: shapeRefTo("CreateFilesParams"), notification: true},
+	"workspace/didDeleteFiles":               {params: shapeRefTo("DeleteFilesParams"), notification: true},
+	"workspace/didRenameFiles":               {params: shapeRefTo("RenameFilesParams"), notification: true},
+	"workspace/executeCommand":               {params: shapeRefTo("ExecuteCommandParams"), result: &shape{kind: shapeOr, items: []*shape{shapeOfAny, shapeOfNull}}},
+	"workspace/foldingRange/refresh":         {result: shapeOfNull},
+	"workspace/inlayHint/refresh":            {result: shapeOfNull},
+	"workspace/inlineValue/refresh":          {result: shapeOfNull},
+	"workspace/semanticTokens/refresh":       {result: shapeOfNull},
+	"workspace/symbol":                       {params: shapeRefTo("WorkspaceSymbolParams"), result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("SymbolInformation")}, &shape{kind: shapeArray, elem: shapeRefTo("WorkspaceSymbol")}, shapeOfNull}}},
+	"workspace/textDocumentContent":          {params: shapeRefTo("TextDocumentContentParams"), result: shapeRefTo("TextDocumentContentResult")},
+	"workspace/textDocumentContent/refresh":  {params: shapeRefTo("TextDocumentContentRefreshParams"), result: shapeOfNull},
+	"workspace/willCreateFiles":              {params: shapeRefTo("CreateFilesParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("WorkspaceEdit"), shapeOfNull}}},
+	"workspace/willDeleteFiles":              {params: shapeRefTo("DeleteFilesParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("WorkspaceEdit"), shapeOfNull}}},
+	"workspace/willRenameFiles":              {params: shapeRefTo("RenameFilesParams"), result: &shape{kind: shapeOr, items: []*shape{shapeRefTo("WorkspaceEdit"), shapeOfNull}}},
+	"workspace/workspaceFolders":             {result: &shape{kind: shapeOr, items: []*shape{&shape{kind: shapeArray, elem: shapeRefTo("WorkspaceFolder")}, shapeOfNull}}},
+	"workspaceSymbol/resolve":                {params: shapeRefTo("WorkspaceSymbol"), result: shapeRefTo("WorkspaceSymbol")},
+}
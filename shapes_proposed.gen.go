@@ -0,0 +1,545 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+var shapeCodeAction = &shape{kind: shapeObject, name: "CodeAction", fields: []shapeField{
+	{name: "title", typ: shapeOfString},
+	{name: "kind", optional: true, typ: shapeRefTo("CodeActionKind")},
+	{name: "diagnostics", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("Diagnostic")}},
+	{name: "isPreferred", optional: true, typ: shapeOfBoolean},
+	{name: "disabled", optional: true, typ: shapeRefTo("CodeActionDisabled")},
+	{name: "edit", optional: true, typ: shapeRefTo("WorkspaceEdit")},
+	{name: "command", optional: true, typ: shapeRefTo("Command")},
+	{name: "data", optional: true, typ: shapeOfAny},
+	{name: "tags", optional: true, typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionTag")}},
+}}
+
+var shapeCodeActionClientCapabilities = &shape{kind: shapeObject, name: "CodeActionClientCapabilities", fields: []shapeField{
+	{name: "dynamicRegistration", optional: true, typ: shapeOfBoolean},
+	{name: "codeActionLiteralSupport", optional: true, typ: shapeRefTo("ClientCodeActionLiteralOptions")},
+	{name: "isPreferredSupport", optional: true, typ: shapeOfBoolean},
+	{name: "disabledSupport", optional: true, typ: shapeOfBoolean},
+	{name: "dataSupport", optional: true, typ: shapeOfBoolean},
+	{name: "resolveSupport", optional: true, typ: shapeRefTo("ClientCodeActionResolveOptions")},
+	{name: "honorsChangeAnnotations", optional: true, typ: shapeOfBoolean},
+	{name: "documentationSupport", optional: true, typ: shapeOfBoolean},
+	{name: "tagSupport", optional: true, typ: shapeRefTo("CodeActionTagOptions")},
+}}
+
+var shapeCodeActionTag = &shape{kind: shapeEnum, name: "CodeActionTag", base: shapeUinteger, values: []string{"1"}}
+
+var shapeCodeActionTagOptions = &shape{kind: shapeObject, name: "CodeActionTagOptions", fields: []shapeField{
+	{name: "valueSet", typ: &shape{kind: shapeArray, elem: shapeRefTo("CodeActionTag")}},
+}}
+
+// shapes indexes the meta-model shape of every generated type by Go type
+// name, for strict decoding.
+var shapes = map[string]*shape{
+	"AnnotatedTextEdit":                                shapeAnnotatedTextEdit,
+	"ApplyKind":                                        shapeApplyKind,
+	"ApplyWorkspaceEditParams":                         shapeApplyWorkspaceEditParams,
+	"ApplyWorkspaceEditResult":                         shapeApplyWorkspaceEditResult,
+	"BaseSymbolInformation":                            shapeBaseSymbolInformation,
+	"CallHierarchyClientCapabilities":                  shapeCallHierarchyClientCapabilities,
+	"CallHierarchyIncomingCall":                        shapeCallHierarchyIncomingCall,
+	"CallHierarchyIncomingCallsParams":                 shapeCallHierarchyIncomingCallsParams,
+	"CallHierarchyItem":                                shapeCallHierarchyItem,
+	"CallHierarchyOptions":                             shapeCallHierarchyOptions,
+	"CallHierarchyOutgoingCall":                        shapeCallHierarchyOutgoingCall,
+	"CallHierarchyOutgoingCallsParams":                 shapeCallHierarchyOutgoingCallsParams,
+	"CallHierarchyPrepareParams":                       shapeCallHierarchyPrepareParams,
+	"CallHierarchyProvider":                            shapeCallHierarchyProvider,
+	"CallHierarchyRegistrationOptions":                 shapeCallHierarchyRegistrationOptions,
+	"CancelParams":                                     shapeCancelParams,
+	"ChangeAnnotation":                                 shapeChangeAnnotation,
+	"ChangeAnnotationIdentifier":                       shapeChangeAnnotationIdentifier,
+	"ChangeAnnotationsSupportOptions":                  shapeChangeAnnotationsSupportOptions,
+	"ChangeNotifications":                              shapeChangeNotifications,
+	"ClientCapabilities":                               shapeClientCapabilities,
+	"ClientCodeActionKindOptions":                      shapeClientCodeActionKindOptions,
+	"ClientCodeActionLiteralOptions":                   shapeClientCodeActionLiteralOptions,
+	"ClientCodeActionResolveOptions":                   shapeClientCodeActionResolveOptions,
+	"ClientCodeLensResolveOptions":                     shapeClientCodeLensResolveOptions,
+	"ClientCompletionItemInsertTextModeOptions":        shapeClientCompletionItemInsertTextModeOptions,
+	"ClientCompletionItemOptions":                      shapeClientCompletionItemOptions,
+	"ClientCompletionItemOptionsKind":                  shapeClientCompletionItemOptionsKind,
+	"ClientCompletionItemResolveOptions":               shapeClientCompletionItemResolveOptions,
+	"ClientDiagnosticsTagOptions":                      shapeClientDiagnosticsTagOptions,
+	"ClientFoldingRangeKindOptions":                    shapeClientFoldingRangeKindOptions,
+	"ClientFoldingRangeOptions":                        shapeClientFoldingRangeOptions,
+	"ClientInfo":                                       shapeClientInfo,
+	"ClientInlayHintResolveOptions":                    shapeClientInlayHintResolveOptions,
+	"ClientSemanticTokensRequestFullDelta":             shapeClientSemanticTokensRequestFullDelta,
+	"ClientSemanticTokensRequestOptions":               shapeClientSemanticTokensRequestOptions,
+	"ClientSemanticTokensRequestOptionsFull":           shapeClientSemanticTokensRequestOptionsFull,
+	"ClientSemanticTokensRequestOptionsRange":          shapeClientSemanticTokensRequestOptionsRange,
+	"ClientShowMessageActionItemOptions":               shapeClientShowMessageActionItemOptions,
+	"ClientSignatureInformationOptions":                shapeClientSignatureInformationOptions,
+	"ClientSignatureParameterInformationOptions":       shapeClientSignatureParameterInformationOptions,
+	"ClientSymbolKindOptions":                          shapeClientSymbolKindOptions,
+	"ClientSymbolResolveOptions":                       shapeClientSymbolResolveOptions,
+	"ClientSymbolTagOptions":                           shapeClientSymbolTagOptions,
+	"CodeAction":                                       shapeCodeAction,
+	"CodeActionClientCapabilities":                     shapeCodeActionClientCapabilities,
+	"CodeActionContext":                                shapeCodeActionContext,
+	"CodeActionDisabled":                               shapeCodeActionDisabled,
+	"CodeActionKind":                                   shapeCodeActionKind,
+	"CodeActionKindDocumentation":                      shapeCodeActionKindDocumentation,
+	"CodeActionOptions":                                shapeCodeActionOptions,
+	"CodeActionParams":                                 shapeCodeActionParams,
+	"CodeActionProvider":                               shapeCodeActionProvider,
+	"CodeActionRegistrationOptions":                    shapeCodeActionRegistrationOptions,
+	"CodeActionTag":                                    shapeCodeActionTag,
+	"CodeActionTagOptions":                             shapeCodeActionTagOptions,
+	"CodeActionTriggerKind":                            shapeCodeActionTriggerKind,
+	"CodeDescription":                                  shapeCodeDescription,
+	"CodeLens":                                         shapeCodeLens,
+	"CodeLensClientCapabilities":                       shapeCodeLensClientCapabilities,
+	"CodeLensOptions":                                  shapeCodeLensOptions,
+	"CodeLensParams":                                   shapeCodeLensParams,
+	"CodeLensRegistrationOptions":                      shapeCodeLensRegistrationOptions,
+	"CodeLensWorkspaceClientCapabilities":              shapeCodeLensWorkspaceClientCapabilities,
+	"Color":                                            shapeColor,
+	"ColorInformation":                                 shapeColorInformation,
+	"ColorPresentation":                                shapeColorPresentation,
+	"ColorPresentationParams":                          shapeColorPresentationParams,
+	"ColorProvider":                                    shapeColorProvider,
+	"Command":                                          shapeCommand,
+	"CommandOrCodeAction":                              shapeCommandOrCodeAction,
+	"CompletionClientCapabilities":                     shapeCompletionClientCapabilities,
+	"CompletionContext":                                shapeCompletionContext,
+	"CompletionItem":                                   shapeCompletionItem,
+	"CompletionItemApplyKinds":                         shapeCompletionItemApplyKinds,
+	"CompletionItemDefaults":                           shapeCompletionItemDefaults,
+	"CompletionItemDefaultsEditRange":                  shapeCompletionItemDefaultsEditRange,
+	"CompletionItemKind":                               shapeCompletionItemKind,
+	"CompletionItemLabelDetails":                       shapeCompletionItemLabelDetails,
+	"CompletionItemTag":                                shapeCompletionItemTag,
+	"CompletionItemTagOptions":                         shapeCompletionItemTagOptions,
+	"CompletionItemTextEdit":                           shapeCompletionItemTextEdit,
+	"CompletionList":                                   shapeCompletionList,
+	"CompletionListCapabilities":                       shapeCompletionListCapabilities,
+	"CompletionOptions":                                shapeCompletionOptions,
+	"CompletionParams":                                 shapeCompletionParams,
+	"CompletionRegistrationOptions":                    shapeCompletionRegistrationOptions,
+	"CompletionResult":                                 shapeCompletionResult,
+	"CompletionTriggerKind":                            shapeCompletionTriggerKind,
+	"ConfigurationItem":                                shapeConfigurationItem,
+	"ConfigurationParams":                              shapeConfigurationParams,
+	"CreateFile":                                       shapeCreateFile,
+	"CreateFileOptions":                                shapeCreateFileOptions,
+	"CreateFilesParams":                                shapeCreateFilesParams,
+	"Declaration":                                      shapeDeclaration,
+	"DeclarationClientCapabilities":                    shapeDeclarationClientCapabilities,
+	"DeclarationLink":                                  shapeDeclarationLink,
+	"DeclarationOptions":                               shapeDeclarationOptions,
+	"DeclarationParams":                                shapeDeclarationParams,
+	"DeclarationProvider":                              shapeDeclarationProvider,
+	"DeclarationRegistrationOptions":                   shapeDeclarationRegistrationOptions,
+	"DeclarationResult":                                shapeDeclarationResult,
+	"Definition":                                       shapeDefinition,
+	"DefinitionClientCapabilities":                     shapeDefinitionClientCapabilities,
+	"DefinitionLink":                                   shapeDefinitionLink,
+	"DefinitionOptions":                                shapeDefinitionOptions,
+	"DefinitionParams":                                 shapeDefinitionParams,
+	"DefinitionProvider":                               shapeDefinitionProvider,
+	"DefinitionRegistrationOptions":                    shapeDefinitionRegistrationOptions,
+	"DefinitionResult":                                 shapeDefinitionResult,
+	"DeleteFile":                                       shapeDeleteFile,
+	"DeleteFileOptions":                                shapeDeleteFileOptions,
+	"DeleteFilesParams":                                shapeDeleteFilesParams,
+	"Diagnostic":                                       shapeDiagnostic,
+	"DiagnosticClientCapabilities":                     shapeDiagnosticClientCapabilities,
+	"DiagnosticOptions":                                shapeDiagnosticOptions,
+	"DiagnosticProvider":                               shapeDiagnosticProvider,
+	"DiagnosticRegistrationOptions":                    shapeDiagnosticRegistrationOptions,
+	"DiagnosticRelatedInformation":                     shapeDiagnosticRelatedInformation,
+	"DiagnosticServerCancellationData":                 shapeDiagnosticServerCancellationData,
+	"DiagnosticSeverity":                               shapeDiagnosticSeverity,
+	"DiagnosticTag":                                    shapeDiagnosticTag,
+	"DiagnosticWorkspaceClientCapabilities":            shapeDiagnosticWorkspaceClientCapabilities,
+	"DiagnosticsCapabilities":                          shapeDiagnosticsCapabilities,
+	"DidChangeConfigurationClientCapabilities":         shapeDidChangeConfigurationClientCapabilities,
+	"DidChangeConfigurationParams":                     shapeDidChangeConfigurationParams,
+	"DidChangeConfigurationRegistrationOptions":        shapeDidChangeConfigurationRegistrationOptions,
+	"DidChangeConfigurationRegistrationOptionsSection": shapeDidChangeConfigurationRegistrationOptionsSection,
+	"DidChangeNotebookDocumentParams":                  shapeDidChangeNotebookDocumentParams,
+	"DidChangeTextDocumentParams":                      shapeDidChangeTextDocumentParams,
+	"DidChangeWatchedFilesClientCapabilities":          shapeDidChangeWatchedFilesClientCapabilities,
+	"DidChangeWatchedFilesParams":                      shapeDidChangeWatchedFilesParams,
+	"DidChangeWatchedFilesRegistrationOptions":         shapeDidChangeWatchedFilesRegistrationOptions,
+	"DidChangeWorkspaceFoldersParams":                  shapeDidChangeWorkspaceFoldersParams,
+	"DidCloseNotebookDocumentParams":                   shapeDidCloseNotebookDocumentParams,
+	"DidCloseTextDocumentParams":                       shapeDidCloseTextDocumentParams,
+	"DidOpenNotebookDocumentParams":                    shapeDidOpenNotebookDocumentParams,
+	"DidOpenTextDocumentParams":                        shapeDidOpenTextDocumentParams,
+	"DidSaveNotebookDocumentParams":                    shapeDidSaveNotebookDocumentParams,
+	"DidSaveTextDocumentParams":                        shapeDidSaveTextDocumentParams,
+	"DocumentChange":                                   shapeDocumentChange,
+	"DocumentColorClientCapabilities":                  shapeDocumentColorClientCapabilities,
+	"DocumentColorOptions":                             shapeDocumentColorOptions,
+	"DocumentColorParams":                              shapeDocumentColorParams,
+	"DocumentColorRegistrationOptions":                 shapeDocumentColorRegistrationOptions,
+	"DocumentDiagnosticParams":                         shapeDocumentDiagnosticParams,
+	"DocumentDiagnosticReport":                         shapeDocumentDiagnosticReport,
+	"DocumentDiagnosticReportKind":                     shapeDocumentDiagnosticReportKind,
+	"DocumentDiagnosticReportPartialResult":            shapeDocumentDiagnosticReportPartialResult,
+	"DocumentFilter":                                   shapeDocumentFilter,
+	"DocumentFormattingClientCapabilities":             shapeDocumentFormattingClientCapabilities,
+	"DocumentFormattingOptions":                        shapeDocumentFormattingOptions,
+	"DocumentFormattingParams":                         shapeDocumentFormattingParams,
+	"DocumentFormattingProvider":                       shapeDocumentFormattingProvider,
+	"DocumentFormattingRegistrationOptions":            shapeDocumentFormattingRegistrationOptions,
+	"DocumentHighlight":                                shapeDocumentHighlight,
+	"DocumentHighlightClientCapabilities":              shapeDocumentHighlightClientCapabilities,
+	"DocumentHighlightKind":                            shapeDocumentHighlightKind,
+	"DocumentHighlightOptions":                         shapeDocumentHighlightOptions,
+	"DocumentHighlightParams":                          shapeDocumentHighlightParams,
+	"DocumentHighlightProvider":                        shapeDocumentHighlightProvider,
+	"DocumentHighlightRegistrationOptions":             shapeDocumentHighlightRegistrationOptions,
+	"DocumentLink":                                     shapeDocumentLink,
+	"DocumentLinkClientCapabilities":                   shapeDocumentLinkClientCapabilities,
+	"DocumentLinkOptions":                              shapeDocumentLinkOptions,
+	"DocumentLinkParams":                               shapeDocumentLinkParams,
+	"DocumentLinkRegistrationOptions":                  shapeDocumentLinkRegistrationOptions,
+	"DocumentOnTypeFormattingClientCapabilities":       shapeDocumentOnTypeFormattingClientCapabilities,
+	"DocumentOnTypeFormattingOptions":                  shapeDocumentOnTypeFormattingOptions,
+	"DocumentOnTypeFormattingParams":                   shapeDocumentOnTypeFormattingParams,
+	"DocumentOnTypeFormattingRegistrationOptions":      shapeDocumentOnTypeFormattingRegistrationOptions,
+	"DocumentRangeFormattingClientCapabilities":        shapeDocumentRangeFormattingClientCapabilities,
+	"DocumentRangeFormattingOptions":                   shapeDocumentRangeFormattingOptions,
+	"DocumentRangeFormattingParams":                    shapeDocumentRangeFormattingParams,
+	"DocumentRangeFormattingProvider":                  shapeDocumentRangeFormattingProvider,
+	"DocumentRangeFormattingRegistrationOptions":       shapeDocumentRangeFormattingRegistrationOptions,
+	"DocumentRangesFormattingParams":                   shapeDocumentRangesFormattingParams,
+	"DocumentSelector":                                 shapeDocumentSelector,
+	"DocumentSymbol":                                   shapeDocumentSymbol,
+	"DocumentSymbolClientCapabilities":                 shapeDocumentSymbolClientCapabilities,
+	"DocumentSymbolOptions":                            shapeDocumentSymbolOptions,
+	"DocumentSymbolParams":                             shapeDocumentSymbolParams,
+	"DocumentSymbolProvider":                           shapeDocumentSymbolProvider,
+	"DocumentSymbolRegistrationOptions":                shapeDocumentSymbolRegistrationOptions,
+	"DocumentSymbolResult":                             shapeDocumentSymbolResult,
+	"EditRangeWithInsertReplace":                       shapeEditRangeWithInsertReplace,
+	"ErrorCodes":                                       shapeErrorCodes,
+	"ExecuteCommandClientCapabilities":                 shapeExecuteCommandClientCapabilities,
+	"ExecuteCommandOptions":                            shapeExecuteCommandOptions,
+	"ExecuteCommandParams":                             shapeExecuteCommandParams,
+	"ExecuteCommandRegistrationOptions":                shapeExecuteCommandRegistrationOptions,
+	"ExecutionSummary":                                 shapeExecutionSummary,
+	"FailureHandlingKind":                              shapeFailureHandlingKind,
+	"FileChangeType":                                   shapeFileChangeType,
+	"FileCreate":                                       shapeFileCreate,
+	"FileDelete":                                       shapeFileDelete,
+	"FileEvent":                                        shapeFileEvent,
+	"FileOperationClientCapabilities":                  shapeFileOperationClientCapabilities,
+	"FileOperationFilter":                              shapeFileOperationFilter,
+	"FileOperationOptions":                             shapeFileOperationOptions,
+	"FileOperationPattern":                             shapeFileOperationPattern,
+	"FileOperationPatternKind":                         shapeFileOperationPatternKind,
+	"FileOperationPatternOptions":                      shapeFileOperationPatternOptions,
+	"FileOperationRegistrationOptions":                 shapeFileOperationRegistrationOptions,
+	"FileRename":                                       shapeFileRename,
+	"FileSystemWatcher":                                shapeFileSystemWatcher,
+	"FoldingRange":                                     shapeFoldingRange,
+	"FoldingRangeClientCapabilities":                   shapeFoldingRangeClientCapabilities,
+	"FoldingRangeKind":                                 shapeFoldingRangeKind,
+	"FoldingRangeOptions":                              shapeFoldingRangeOptions,
+	"FoldingRangeParams":                               shapeFoldingRangeParams,
+	"FoldingRangeProvider":                             shapeFoldingRangeProvider,
+	"FoldingRangeRegistrationOptions":                  shapeFoldingRangeRegistrationOptions,
+	"FoldingRangeWorkspaceClientCapabilities":          shapeFoldingRangeWorkspaceClientCapabilities,
+	"FormattingOptions":                                shapeFormattingOptions,
+	"FullDocumentDiagnosticReport":                     shapeFullDocumentDiagnosticReport,
+	"FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport": shapeFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport,
+	"GeneralClientCapabilities":                                       shapeGeneralClientCapabilities,
+	"GlobPattern":                                                     shapeGlobPattern,
+	"Hover":                                                           shapeHover,
+	"HoverClientCapabilities":                                         shapeHoverClientCapabilities,
+	"HoverContents":                                                   shapeHoverContents,
+	"HoverOptions":                                                    shapeHoverOptions,
+	"HoverParams":                                                     shapeHoverParams,
+	"HoverProvider":                                                   shapeHoverProvider,
+	"HoverRegistrationOptions":                                        shapeHoverRegistrationOptions,
+	"ImplementationClientCapabilities":                                shapeImplementationClientCapabilities,
+	"ImplementationOptions":                                           shapeImplementationOptions,
+	"ImplementationParams":                                            shapeImplementationParams,
+	"ImplementationProvider":                                          shapeImplementationProvider,
+	"ImplementationRegistrationOptions":                               shapeImplementationRegistrationOptions,
+	"InitializeError":                                                 shapeInitializeError,
+	"InitializeParams":                                                shapeInitializeParams,
+	"InitializeResult":                                                shapeInitializeResult,
+	"InitializedParams":                                               shapeInitializedParams,
+	"InlayHint":                                                       shapeInlayHint,
+	"InlayHintClientCapabilities":                                     shapeInlayHintClientCapabilities,
+	"InlayHintKind":                                                   shapeInlayHintKind,
+	"InlayHintLabel":                                                  shapeInlayHintLabel,
+	"InlayHintLabelPart":                                              shapeInlayHintLabelPart,
+	"InlayHintOptions":                                                shapeInlayHintOptions,
+	"InlayHintParams":                                                 shapeInlayHintParams,
+	"InlayHintProvider":                                               shapeInlayHintProvider,
+	"InlayHintRegistrationOptions":                                    shapeInlayHintRegistrationOptions,
+	"InlayHintTooltip":                                                shapeInlayHintTooltip,
+	"InlayHintWorkspaceClientCapabilities":                            shapeInlayHintWorkspaceClientCapabilities,
+	"InlineCompletionClientCapabilities":                              shapeInlineCompletionClientCapabilities,
+	"InlineCompletionContext":                                         shapeInlineCompletionContext,
+	"InlineCompletionItem":                                            shapeInlineCompletionItem,
+	"InlineCompletionItemInsertText":                                  shapeInlineCompletionItemInsertText,
+	"InlineCompletionList":                                            shapeInlineCompletionList,
+	"InlineCompletionOptions":                                         shapeInlineCompletionOptions,
+	"InlineCompletionParams":                                          shapeInlineCompletionParams,
+	"InlineCompletionProvider":                                        shapeInlineCompletionProvider,
+	"InlineCompletionRegistrationOptions":                             shapeInlineCompletionRegistrationOptions,
+	"InlineCompletionResult":                                          shapeInlineCompletionResult,
+	"InlineCompletionTriggerKind":                                     shapeInlineCompletionTriggerKind,
+	"InlineValue":                                                     shapeInlineValue,
+	"InlineValueClientCapabilities":                                   shapeInlineValueClientCapabilities,
+	"InlineValueContext":                                              shapeInlineValueContext,
+	"InlineValueEvaluatableExpression":                                shapeInlineValueEvaluatableExpression,
+	"InlineValueOptions":                                              shapeInlineValueOptions,
+	"InlineValueParams":                                               shapeInlineValueParams,
+	"InlineValueProvider":                                             shapeInlineValueProvider,
+	"InlineValueRegistrationOptions":                                  shapeInlineValueRegistrationOptions,
+	"InlineValueText":                                                 shapeInlineValueText,
+	"InlineValueVariableLookup":                                       shapeInlineValueVariableLookup,
+	"InlineValueWorkspaceClientCapabilities":                          shapeInlineValueWorkspaceClientCapabilities,
+	"InsertReplaceEdit":                                               shapeInsertReplaceEdit,
+	"InsertTextFormat":                                                shapeInsertTextFormat,
+	"InsertTextMode":                                                  shapeInsertTextMode,
+	"LSPErrorCodes":                                                   shapeLSPErrorCodes,
+	"LanguageKind":                                                    shapeLanguageKind,
+	"LinkedEditingRangeClientCapabilities":                            shapeLinkedEditingRangeClientCapabilities,
+	"LinkedEditingRangeOptions":                                       shapeLinkedEditingRangeOptions,
+	"LinkedEditingRangeParams":                                        shapeLinkedEditingRangeParams,
+	"LinkedEditingRangeProvider":                                      shapeLinkedEditingRangeProvider,
+	"LinkedEditingRangeRegistrationOptions":                           shapeLinkedEditingRangeRegistrationOptions,
+	"LinkedEditingRanges":                                             shapeLinkedEditingRanges,
+	"Location":                                                        shapeLocation,
+	"LocationLink":                                                    shapeLocationLink,
+	"LocationUriOnly":                                                 shapeLocationUriOnly,
+	"LogMessageParams":                                                shapeLogMessageParams,
+	"LogTraceParams":                                                  shapeLogTraceParams,
+	"MarkdownClientCapabilities":                                      shapeMarkdownClientCapabilities,
+	"MarkedString":                                                    shapeMarkedString,
+	"MarkedStringWithLanguage":                                        shapeMarkedStringWithLanguage,
+	"MarkupContent":                                                   shapeMarkupContent,
+	"MarkupKind":                                                      shapeMarkupKind,
+	"MessageActionItem":                                               shapeMessageActionItem,
+	"MessageType":                                                     shapeMessageType,
+	"Moniker":                                                         shapeMoniker,
+	"MonikerClientCapabilities":                                       shapeMonikerClientCapabilities,
+	"MonikerKind":                                                     shapeMonikerKind,
+	"MonikerOptions":                                                  shapeMonikerOptions,
+	"MonikerParams":                                                   shapeMonikerParams,
+	"MonikerProvider":                                                 shapeMonikerProvider,
+	"MonikerRegistrationOptions":                                      shapeMonikerRegistrationOptions,
+	"NotebookCell":                                                    shapeNotebookCell,
+	"NotebookCellArrayChange":                                         shapeNotebookCellArrayChange,
+	"NotebookCellKind":                                                shapeNotebookCellKind,
+	"NotebookCellLanguage":                                            shapeNotebookCellLanguage,
+	"NotebookCellTextDocumentFilter":                                  shapeNotebookCellTextDocumentFilter,
+	"NotebookDocument":                                                shapeNotebookDocument,
+	"NotebookDocumentCellChangeStructure":                             shapeNotebookDocumentCellChangeStructure,
+	"NotebookDocumentCellChanges":                                     shapeNotebookDocumentCellChanges,
+	"NotebookDocumentCellContentChanges":                              shapeNotebookDocumentCellContentChanges,
+	"NotebookDocumentChangeEvent":                                     shapeNotebookDocumentChangeEvent,
+	"NotebookDocumentClientCapabilities":                              shapeNotebookDocumentClientCapabilities,
+	"NotebookDocumentFilter":                                          shapeNotebookDocumentFilter,
+	"NotebookDocumentFilterNotebook":                                  shapeNotebookDocumentFilterNotebook,
+	"NotebookDocumentFilterNotebookType":                              shapeNotebookDocumentFilterNotebookType,
+	"NotebookDocumentFilterPattern":                                   shapeNotebookDocumentFilterPattern,
+	"NotebookDocumentFilterScheme":                                    shapeNotebookDocumentFilterScheme,
+	"NotebookDocumentFilterWithCells":                                 shapeNotebookDocumentFilterWithCells,
+	"NotebookDocumentFilterWithNotebook":                              shapeNotebookDocumentFilterWithNotebook,
+	"NotebookDocumentIdentifier":                                      shapeNotebookDocumentIdentifier,
+	"NotebookDocumentSync":                                            shapeNotebookDocumentSync,
+	"NotebookDocumentSyncClientCapabilities":                          shapeNotebookDocumentSyncClientCapabilities,
+	"NotebookDocumentSyncOptions":                                     shapeNotebookDocumentSyncOptions,
+	"NotebookDocumentSyncRegistrationOptions":                         shapeNotebookDocumentSyncRegistrationOptions,
+	"NotebookSelector":                                                shapeNotebookSelector,
+	"OptionalVersionedTextDocumentIdentifier":                         shapeOptionalVersionedTextDocumentIdentifier,
+	"ParameterInformation":                                            shapeParameterInformation,
+	"ParameterInformationLabel":                                       shapeParameterInformationLabel,
+	"PartialResultParams":                                             shapePartialResultParams,
+	"Pattern":                                                         shapePattern,
+	"Position":                                                        shapePosition,
+	"PositionEncodingKind":                                            shapePositionEncodingKind,
+	"PrepareRenameDefaultBehavior":                                    shapePrepareRenameDefaultBehavior,
+	"PrepareRenameParams":                                             shapePrepareRenameParams,
+	"PrepareRenamePlaceholder":                                        shapePrepareRenamePlaceholder,
+	"PrepareRenameResult":                                             shapePrepareRenameResult,
+	"PrepareSupportDefaultBehavior":                                   shapePrepareSupportDefaultBehavior,
+	"PreviousResultId":                                                shapePreviousResultId,
+	"ProgressParams":                                                  shapeProgressParams,
+	"ProgressToken":                                                   shapeProgressToken,
+	"PublishDiagnosticsClientCapabilities":                            shapePublishDiagnosticsClientCapabilities,
+	"PublishDiagnosticsParams":                                        shapePublishDiagnosticsParams,
+	"Range":                                                           shapeRange,
+	"ReferenceClientCapabilities":                                     shapeReferenceClientCapabilities,
+	"ReferenceContext":                                                shapeReferenceContext,
+	"ReferenceOptions":                                                shapeReferenceOptions,
+	"ReferenceParams":                                                 shapeReferenceParams,
+	"ReferenceRegistrationOptions":                                    shapeReferenceRegistrationOptions,
+	"ReferencesProvider":                                              shapeReferencesProvider,
+	"Registration":                                                    shapeRegistration,
+	"RegistrationParams":                                              shapeRegistrationParams,
+	"RegularExpressionEngineKind":                                     shapeRegularExpressionEngineKind,
+	"RegularExpressionsClientCapabilities":                            shapeRegularExpressionsClientCapabilities,
+	"RelatedFullDocumentDiagnosticReport":                             shapeRelatedFullDocumentDiagnosticReport,
+	"RelatedUnchangedDocumentDiagnosticReport":                        shapeRelatedUnchangedDocumentDiagnosticReport,
+	"RelativePattern":                                                 shapeRelativePattern,
+	"RelativePatternBaseURI":                                          shapeRelativePatternBaseURI,
+	"RenameClientCapabilities":                                        shapeRenameClientCapabilities,
+	"RenameFile":                                                      shapeRenameFile,
+	"RenameFileOptions":                                               shapeRenameFileOptions,
+	"RenameFilesParams":                                               shapeRenameFilesParams,
+	"RenameOptions":                                                   shapeRenameOptions,
+	"RenameParams":                                                    shapeRenameParams,
+	"RenameProvider":                                                  shapeRenameProvider,
+	"RenameRegistrationOptions":                                       shapeRenameRegistrationOptions,
+	"ResourceOperation":                                               shapeResourceOperation,
+	"ResourceOperationKind":                                           shapeResourceOperationKind,
+	"SaveOptions":                                                     shapeSaveOptions,
+	"SelectedCompletionInfo":                                          shapeSelectedCompletionInfo,
+	"SelectionRange":                                                  shapeSelectionRange,
+	"SelectionRangeClientCapabilities":                                shapeSelectionRangeClientCapabilities,
+	"SelectionRangeOptions":                                           shapeSelectionRangeOptions,
+	"SelectionRangeParams":                                            shapeSelectionRangeParams,
+	"SelectionRangeProvider":                                          shapeSelectionRangeProvider,
+	"SelectionRangeRegistrationOptions":                               shapeSelectionRangeRegistrationOptions,
+	"SemanticTokenModifiers":                                          shapeSemanticTokenModifiers,
+	"SemanticTokenTypes":                                              shapeSemanticTokenTypes,
+	"SemanticTokens":                                                  shapeSemanticTokens,
+	"SemanticTokensClientCapabilities":                                shapeSemanticTokensClientCapabilities,
+	"SemanticTokensDelta":                                             shapeSemanticTokensDelta,
+	"SemanticTokensDeltaParams":                                       shapeSemanticTokensDeltaParams,
+	"SemanticTokensDeltaPartialResult":                                shapeSemanticTokensDeltaPartialResult,
+	"SemanticTokensDeltaResult":                                       shapeSemanticTokensDeltaResult,
+	"SemanticTokensEdit":                                              shapeSemanticTokensEdit,
+	"SemanticTokensFullDelta":                                         shapeSemanticTokensFullDelta,
+	"SemanticTokensLegend":                                            shapeSemanticTokensLegend,
+	"SemanticTokensOptions":                                           shapeSemanticTokensOptions,
+	"SemanticTokensOptionsFull":                                       shapeSemanticTokensOptionsFull,
+	"SemanticTokensOptionsRange":                                      shapeSemanticTokensOptionsRange,
+	"SemanticTokensParams":                                            shapeSemanticTokensParams,
+	"SemanticTokensPartialResult":                                     shapeSemanticTokensPartialResult,
+	"SemanticTokensProvider":                                          shapeSemanticTokensProvider,
+	"SemanticTokensRangeParams":                                       shapeSemanticTokensRangeParams,
+	"SemanticTokensRegistrationOptions":                               shapeSemanticTokensRegistrationOptions,
+	"SemanticTokensWorkspaceClientCapabilities":                       shapeSemanticTokensWorkspaceClientCapabilities,
+	"ServerCapabilities":                                              shapeServerCapabilities,
+	"ServerCompletionItemOptions":                                     shapeServerCompletionItemOptions,
+	"ServerInfo":                                                      shapeServerInfo,
+	"SetTraceParams":                                                  shapeSetTraceParams,
+	"ShowDocumentClientCapabilities":                                  shapeShowDocumentClientCapabilities,
+	"ShowDocumentParams":                                              shapeShowDocumentParams,
+	"ShowDocumentResult":                                              shapeShowDocumentResult,
+	"ShowMessageParams":                                               shapeShowMessageParams,
+	"ShowMessageRequestClientCapabilities":                            shapeShowMessageRequestClientCapabilities,
+	"ShowMessageRequestParams":                                        shapeShowMessageRequestParams,
+	"SignatureHelp":                                                   shapeSignatureHelp,
+	"SignatureHelpClientCapabilities":                                 shapeSignatureHelpClientCapabilities,
+	"SignatureHelpContext":                                            shapeSignatureHelpContext,
+	"SignatureHelpOptions":                                            shapeSignatureHelpOptions,
+	"SignatureHelpParams":                                             shapeSignatureHelpParams,
+	"SignatureHelpRegistrationOptions":                                shapeSignatureHelpRegistrationOptions,
+	"SignatureHelpTriggerKind":                                        shapeSignatureHelpTriggerKind,
+	"SignatureInformation":                                            shapeSignatureInformation,
+	"SnippetTextEdit":                                                 shapeSnippetTextEdit,
+	"StaleRequestSupportOptions":                                      shapeStaleRequestSupportOptions,
+	"StaticRegistrationOptions":                                       shapeStaticRegistrationOptions,
+	"StringValue":                                                     shapeStringValue,
+	"SymbolInformation":                                               shapeSymbolInformation,
+	"SymbolKind":                                                      shapeSymbolKind,
+	"SymbolTag":                                                       shapeSymbolTag,
+	"TextDocumentChangeRegistrationOptions":                           shapeTextDocumentChangeRegistrationOptions,
+	"TextDocumentClientCapabilities":                                  shapeTextDocumentClientCapabilities,
+	"TextDocumentContentChangeEvent":                                  shapeTextDocumentContentChangeEvent,
+	"TextDocumentContentChangePartial":                                shapeTextDocumentContentChangePartial,
+	"TextDocumentContentChangeWholeDocument":                          shapeTextDocumentContentChangeWholeDocument,
+	"TextDocumentContentClientCapabilities":                           shapeTextDocumentContentClientCapabilities,
+	"TextDocumentContentOptions":                                      shapeTextDocumentContentOptions,
+	"TextDocumentContentParams":                                       shapeTextDocumentContentParams,
+	"TextDocumentContentRefreshParams":                                shapeTextDocumentContentRefreshParams,
+	"TextDocumentContentRegistrationOptions":                          shapeTextDocumentContentRegistrationOptions,
+	"TextDocumentContentResult":                                       shapeTextDocumentContentResult,
+	"TextDocumentEdit":                                                shapeTextDocumentEdit,
+	"TextDocumentEditElement":                                         shapeTextDocumentEditElement,
+	"TextDocumentFilter":                                              shapeTextDocumentFilter,
+	"TextDocumentFilterClientCapabilities":                            shapeTextDocumentFilterClientCapabilities,
+	"TextDocumentFilterLanguage":                                      shapeTextDocumentFilterLanguage,
+	"TextDocumentFilterPattern":                                       shapeTextDocumentFilterPattern,
+	"TextDocumentFilterScheme":                                        shapeTextDocumentFilterScheme,
+	"TextDocumentIdentifier":                                          shapeTextDocumentIdentifier,
+	"TextDocumentItem":                                                shapeTextDocumentItem,
+	"TextDocumentPositionParams":                                      shapeTextDocumentPositionParams,
+	"TextDocumentRegistrationOptions":                                 shapeTextDocumentRegistrationOptions,
+	"TextDocumentSaveReason":                                          shapeTextDocumentSaveReason,
+	"TextDocumentSaveRegistrationOptions":                             shapeTextDocumentSaveRegistrationOptions,
+	"TextDocumentSync":                                                shapeTextDocumentSync,
+	"TextDocumentSyncClientCapabilities":                              shapeTextDocumentSyncClientCapabilities,
+	"TextDocumentSyncKind":                                            shapeTextDocumentSyncKind,
+	"TextDocumentSyncOptions":                                         shapeTextDocumentSyncOptions,
+	"TextDocumentSyncOptionsSave":                                     shapeTextDocumentSyncOptionsSave,
+	"TextEdit":                                                        shapeTextEdit,
+	"TokenFormat":                                                     shapeTokenFormat,
+	"TraceValue":                                                      shapeTraceValue,
+	"TypeDefinitionClientCapabilities":                                shapeTypeDefinitionClientCapabilities,
+	"TypeDefinitionOptions":                                           shapeTypeDefinitionOptions,
+	"TypeDefinitionParams":                                            shapeTypeDefinitionParams,
+	"TypeDefinitionProvider":                                          shapeTypeDefinitionProvider,
+	"TypeDefinitionRegistrationOptions":                               shapeTypeDefinitionRegistrationOptions,
+	"TypeHierarchyClientCapabilities":                                 shapeTypeHierarchyClientCapabilities,
+	"TypeHierarchyItem":                                               shapeTypeHierarchyItem,
+	"TypeHierarchyOptions":                                            shapeTypeHierarchyOptions,
+	"TypeHierarchyPrepareParams":                                      shapeTypeHierarchyPrepareParams,
+	"TypeHierarchyProvider":                                           shapeTypeHierarchyProvider,
+	"TypeHierarchyRegistrationOptions":                                shapeTypeHierarchyRegistrationOptions,
+	"TypeHierarchySubtypesParams":                                     shapeTypeHierarchySubtypesParams,
+	"TypeHierarchySupertypesParams":                                   shapeTypeHierarchySupertypesParams,
+	"UnchangedDocumentDiagnosticReport":                               shapeUnchangedDocumentDiagnosticReport,
+	"UniquenessLevel":                                                 shapeUniquenessLevel,
+	"Unregistration":                                                  shapeUnregistration,
+	"UnregistrationParams":                                            shapeUnregistrationParams,
+	"VersionedNotebookDocumentIdentifier":                             shapeVersionedNotebookDocumentIdentifier,
+	"VersionedTextDocumentIdentifier":                                 shapeVersionedTextDocumentIdentifier,
+	"WatchKind":                                                       shapeWatchKind,
+	"WillSaveTextDocumentParams":                                      shapeWillSaveTextDocumentParams,
+	"WindowClientCapabilities":                                        shapeWindowClientCapabilities,
+	"WorkDoneProgressBegin":                                           shapeWorkDoneProgressBegin,
+	"WorkDoneProgressCancelParams":                                    shapeWorkDoneProgressCancelParams,
+	"WorkDoneProgressCreateParams":                                    shapeWorkDoneProgressCreateParams,
+	"WorkDoneProgressEnd":                                             shapeWorkDoneProgressEnd,
+	"WorkDoneProgressOptions":                                         shapeWorkDoneProgressOptions,
+	"WorkDoneProgressParams":                                          shapeWorkDoneProgressParams,
+	"WorkDoneProgressReport":                                          shapeWorkDoneProgressReport,
+	"WorkspaceClientCapabilities":                                     shapeWorkspaceClientCapabilities,
+	"WorkspaceDiagnosticParams":                                       shapeWorkspaceDiagnosticParams,
+	"WorkspaceDiagnosticReport":                                       shapeWorkspaceDiagnosticReport,
+	"WorkspaceDiagnosticReportPartialResult":                          shapeWorkspaceDiagnosticReportPartialResult,
+	"WorkspaceDocumentDiagnosticReport":                               shapeWorkspaceDocumentDiagnosticReport,
+	"WorkspaceEdit":                                                   shapeWorkspaceEdit,
+	"WorkspaceEditClientCapabilities":                                 shapeWorkspaceEditClientCapabilities,
+	"WorkspaceEditMetadata":                                           shapeWorkspaceEditMetadata,
+	"WorkspaceFolder":                                                 shapeWorkspaceFolder,
+	"WorkspaceFoldersChangeEvent":                                     shapeWorkspaceFoldersChangeEvent,
+	"WorkspaceFoldersInitializeParams":                                shapeWorkspaceFoldersInitializeParams,
+	"WorkspaceFoldersServerCapabilities":                              shapeWorkspaceFoldersServerCapabilities,
+	"WorkspaceFullDocumentDiagnosticReport":                           shapeWorkspaceFullDocumentDiagnosticReport,
+	"WorkspaceOptions":                                                shapeWorkspaceOptions,
+	"WorkspaceOptionsTextDocumentContent":                             shapeWorkspaceOptionsTextDocumentContent,
+	"WorkspaceSymbol":                                                 shapeWorkspaceSymbol,
+	"WorkspaceSymbolClientCapabilities":                               shapeWorkspaceSymbolClientCapabilities,
+	"WorkspaceSymbolLocation":                                         shapeWorkspaceSymbolLocation,
+	"WorkspaceSymbolOptions":                                          shapeWorkspaceSymbolOptions,
+	"WorkspaceSymbolParams":                                           shapeWorkspaceSymbolParams,
+	"WorkspaceSymbolProvider":                                         shapeWorkspaceSymbolProvider,
+	"WorkspaceSymbolRegistrationOptions":                              shapeWorkspaceSymbolRegistrationOptions,
+	"WorkspaceSymbolResult":                                           shapeWorkspaceSymbolResult,
+	"WorkspaceUnchangedDocumentDiagnosticReport":                      shapeWorkspaceUnchangedDocumentDiagnosticReport,
+}
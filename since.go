@@ -0,0 +1,87 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Protocol versions a connection can be pinned to with [WithVersion].
+const (
+	Version316 = "3.16.0"
+	Version317 = "3.17.0"
+	Version318 = "3.18.0"
+)
+
+// TypeSince returns the protocol version that introduced the generated type
+// name (e.g. "InlayHint" -> "3.17.0"), or "" when the type predates every
+// version the meta-model tracks.
+//
+// A type the specification only named later, such as one lifted out of an
+// inline literal in 3.18, reports that later version even though its shape
+// was on the wire earlier; use [FieldSince] to decide what a peer accepts.
+func TypeSince(name string) string {
+	return sinceTypes[name]
+}
+
+// FieldSince returns the protocol version that introduced the field with JSON
+// name field on the generated type typeName (e.g. "CompletionItem",
+// "labelDetails" -> "3.17.0"), or "" when the field predates every tracked
+// version. Fields a type inherits by embedding are reported on the embedded
+// type.
+func FieldSince(typeName, field string) string {
+	return sinceFields[typeName+"."+field]
+}
+
+// methodIndex indexes [Methods] by method name.
+var methodIndex = sync.OnceValue(func() map[string]*MethodInfo {
+	m := make(map[string]*MethodInfo, len(Methods))
+	for i := range Methods {
+		m[Methods[i].Method] = &Methods[i]
+	}
+	return m
+})
+
+// MethodSince returns the protocol version that introduced method, or "" when
+// the method predates every tracked version or is not a standard method.
+func MethodSince(method string) string {
+	if info, ok := methodIndex()[method]; ok {
+		return info.Since
+	}
+	return ""
+}
+
+// VersionAvailable reports whether an item introduced in since is part of
+// protocol version version. An empty since is always available.
+func VersionAvailable(since, version string) bool {
+	return since == "" || CompareVersions(since, version) <= 0
+}
+
+// CompareVersions compares two "major.minor[.patch]" protocol versions and
+// returns -1, 0 or +1. Missing or malformed components compare as zero.
+func CompareVersions(a, b string) int {
+	for range 3 {
+		var x, y int
+		x, a = versionComponent(a)
+		y, b = versionComponent(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// versionComponent splits the leading numeric component off v.
+func versionComponent(v string) (int, string) {
+	head, rest, _ := strings.Cut(v, ".")
+	n, _ := strconv.Atoi(head)
+
+	return n, rest
+}
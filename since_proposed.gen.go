@@ -0,0 +1,380 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+// sinceTypes maps generated type names to the protocol version that
+// introduced them. Types that predate every tracked version are absent.
+var sinceTypes = map[string]string{
+	"AnnotatedTextEdit":                          "3.16.0",
+	"ApplyKind":                                  "3.18.0",
+	"CallHierarchyClientCapabilities":            "3.16.0",
+	"CallHierarchyIncomingCall":                  "3.16.0",
+	"CallHierarchyIncomingCallsParams":           "3.16.0",
+	"CallHierarchyItem":                          "3.16.0",
+	"CallHierarchyOptions":                       "3.16.0",
+	"CallHierarchyOutgoingCall":                  "3.16.0",
+	"CallHierarchyOutgoingCallsParams":           "3.16.0",
+	"CallHierarchyPrepareParams":                 "3.16.0",
+	"CallHierarchyRegistrationOptions":           "3.16.0",
+	"ChangeAnnotation":                           "3.16.0",
+	"ChangeAnnotationsSupportOptions":            "3.18.0",
+	"ClientCodeActionKindOptions":                "3.18.0",
+	"ClientCodeActionLiteralOptions":             "3.18.0",
+	"ClientCodeActionResolveOptions":             "3.18.0",
+	"ClientCodeLensResolveOptions":               "3.18.0",
+	"ClientCompletionItemInsertTextModeOptions":  "3.18.0",
+	"ClientCompletionItemOptions":                "3.18.0",
+	"ClientCompletionItemOptionsKind":            "3.18.0",
+	"ClientCompletionItemResolveOptions":         "3.18.0",
+	"ClientDiagnosticsTagOptions":                "3.18.0",
+	"ClientFoldingRangeKindOptions":              "3.18.0",
+	"ClientFoldingRangeOptions":                  "3.18.0",
+	"ClientInfo":                                 "3.15.0",
+	"ClientInlayHintResolveOptions":              "3.18.0",
+	"ClientSemanticTokensRequestFullDelta":       "3.18.0",
+	"ClientSemanticTokensRequestOptions":         "3.18.0",
+	"ClientShowMessageActionItemOptions":         "3.18.0",
+	"ClientSignatureInformationOptions":          "3.18.0",
+	"ClientSignatureParameterInformationOptions": "3.18.0",
+	"ClientSymbolKindOptions":                    "3.18.0",
+	"ClientSymbolResolveOptions":                 "3.18.0",
+	"ClientSymbolTagOptions":                     "3.18.0",
+	"CodeActionDisabled":                         "3.18.0",
+	"CodeActionKindDocumentation":                "3.18.0",
+	"CodeActionTag":                              "3.18.0",
+	"CodeActionTagOptions":                       "3.18.0",
+	"CodeActionTriggerKind":                      "3.17.0",
+	"CodeDescription":                            "3.16.0",
+	"CodeLensWorkspaceClientCapabilities":        "3.16.0",
+	"CompletionItemApplyKinds":                   "3.18.0",
+	"CompletionItemDefaults":                     "3.17.0",
+	"CompletionItemLabelDetails":                 "3.17.0",
+	"CompletionItemTag":                          "3.15.0",
+	"CompletionItemTagOptions":                   "3.18.0",
+	"CompletionListCapabilities":                 "3.17.0",
+	"CreateFilesParams":                          "3.16.0",
+	"DeclarationClientCapabilities":              "3.14.0",
+	"DeleteFilesParams":                          "3.16.0",
+	"DiagnosticClientCapabilities":               "3.17.0",
+	"DiagnosticOptions":                          "3.17.0",
+	"DiagnosticRegistrationOptions":              "3.17.0",
+	"DiagnosticServerCancellationData":           "3.17.0",
+	"DiagnosticTag":                              "3.15.0",
+	"DiagnosticWorkspaceClientCapabilities":      "3.17.0",
+	"DidChangeNotebookDocumentParams":            "3.17.0",
+	"DidCloseNotebookDocumentParams":             "3.17.0",
+	"DidOpenNotebookDocumentParams":              "3.17.0",
+	"DidSaveNotebookDocumentParams":              "3.17.0",
+	"DocumentDiagnosticParams":                   "3.17.0",
+	"DocumentDiagnosticReport":                   "3.17.0",
+	"DocumentDiagnosticReportKind":               "3.17.0",
+	"DocumentDiagnosticReportPartialResult":      "3.17.0",
+	"DocumentRangesFormattingParams":             "3.18.0",
+	"DocumentSelector":                           "3.16.0",
+	"EditRangeWithInsertReplace":                 "3.18.0",
+	"FileCreate":                                 "3.16.0",
+	"FileDelete":                                 "3.16.0",
+	"FileOperationClientCapabilities":            "3.16.0",
+	"FileOperationFilter":                        "3.16.0",
+	"FileOperationOptions":                       "3.16.0",
+	"FileOperationPattern":                       "3.16.0",
+	"FileOperationPatternKind":                   "3.16.0",
+	"FileOperationPatternOptions":                "3.16.0",
+	"FileOperationRegistrationOptions":           "3.16.0",
+	"FileRename":                                 "3.16.0",
+	"FoldingRangeWorkspaceClientCapabilities":    "3.18.0",
+	"FullDocumentDiagnosticReport":               "3.17.0",
+	"GeneralClientCapabilities":                  "3.16.0",
+	"GlobPattern":                                "3.17.0",
+	"ImplementationClientCapabilities":           "3.6.0",
+	"InlayHint":                                  "3.17.0",
+	"InlayHintClientCapabilities":                "3.17.0",
+	"InlayHintKind":                              "3.17.0",
+	"InlayHintLabelPart":                         "3.17.0",
+	"InlayHintOptions":                           "3.17.0",
+	"InlayHintParams":                            "3.17.0",
+	"InlayHintRegistrationOptions":               "3.17.0",
+	"InlayHintWorkspaceClientCapabilities":       "3.17.0",
+	"InlineCompletionClientCapabilities":         "3.18.0",
+	"InlineCompletionContext":                    "3.18.0",
+	"InlineCompletionItem":                       "3.18.0",
+	"InlineCompletionList":                       "3.18.0",
+	"InlineCompletionOptions":                    "3.18.0",
+	"InlineCompletionParams":                     "3.18.0",
+	"InlineCompletionRegistrationOptions":        "3.18.0",
+	"InlineCompletionTriggerKind":                "3.18.0",
+	"InlineValue":                                "3.17.0",
+	"InlineValueClientCapabilities":              "3.17.0",
+	"InlineValueContext":                         "3.17.0",
+	"InlineValueEvaluatableExpression":           "3.17.0",
+	"InlineValueOptions":                         "3.17.0",
+	"InlineValueParams":                          "3.17.0",
+	"InlineValueRegistrationOptions":             "3.17.0",
+	"InlineValueText":                            "3.17.0",
+	"InlineValueVariableLookup":                  "3.17.0",
+	"InlineValueWorkspaceClientCapabilities":     "3.17.0",
+	"InsertReplaceEdit":                          "3.16.0",
+	"InsertTextMode":                             "3.16.0",
+	"LanguageKind":                               "3.18.0",
+	"LinkedEditingRangeClientCapabilities":       "3.16.0",
+	"LinkedEditingRanges":                        "3.16.0",
+	"LocationUriOnly":                            "3.18.0",
+	"MarkdownClientCapabilities":                 "3.16.0",
+	"MarkedStringWithLanguage":                   "3.18.0",
+	"Moniker":                                    "3.16.0",
+	"MonikerClientCapabilities":                  "3.16.0",
+	"MonikerKind":                                "3.16.0",
+	"NotebookCell":                               "3.17.0",
+	"NotebookCellArrayChange":                    "3.17.0",
+	"NotebookCellKind":                           "3.17.0",
+	"NotebookCellLanguage":                       "3.18.0",
+	"NotebookCellTextDocumentFilter":             "3.17.0",
+	"NotebookDocument":                           "3.17.0",
+	"NotebookDocumentCellChangeStructure":        "3.18.0",
+	"NotebookDocumentCellChanges":                "3.18.0",
+	"NotebookDocumentCellContentChanges":         "3.18.0",
+	"NotebookDocumentChangeEvent":                "3.17.0",
+	"NotebookDocumentClientCapabilities":         "3.17.0",
+	"NotebookDocumentFilter":                     "3.17.0",
+	"NotebookDocumentFilterNotebookType":         "3.18.0",
+	"NotebookDocumentFilterPattern":              "3.18.0",
+	"NotebookDocumentFilterScheme":               "3.18.0",
+	"NotebookDocumentFilterWithCells":            "3.18.0",
+	"NotebookDocumentFilterWithNotebook":         "3.18.0",
+	"NotebookDocumentIdentifier":                 "3.17.0",
+	"NotebookDocumentSyncClientCapabilities":     "3.17.0",
+	"NotebookDocumentSyncOptions":                "3.17.0",
+	"NotebookDocumentSyncRegistrationOptions":    "3.17.0",
+	"Pattern":                                    "3.17.0",
+	"PositionEncodingKind":                       "3.17.0",
+	"PrepareRenameDefaultBehavior":               "3.18.0",
+	"PrepareRenamePlaceholder":                   "3.18.0",
+	"PreviousResultId":                           "3.17.0",
+	"RegularExpressionsClientCapabilities":       "3.16.0",
+	"RelatedFullDocumentDiagnosticReport":        "3.17.0",
+	"RelatedUnchangedDocumentDiagnosticReport":   "3.17.0",
+	"RelativePattern":                            "3.17.0",
+	"RenameFilesParams":                          "3.16.0",
+	"SelectedCompletionInfo":                     "3.18.0",
+	"SemanticTokenModifiers":                     "3.16.0",
+	"SemanticTokenTypes":                         "3.16.0",
+	"SemanticTokens":                             "3.16.0",
+	"SemanticTokensClientCapabilities":           "3.16.0",
+	"SemanticTokensDelta":                        "3.16.0",
+	"SemanticTokensDeltaParams":                  "3.16.0",
+	"SemanticTokensDeltaPartialResult":           "3.16.0",
+	"SemanticTokensEdit":                         "3.16.0",
+	"SemanticTokensFullDelta":                    "3.18.0",
+	"SemanticTokensLegend":                       "3.16.0",
+	"SemanticTokensOptions":                      "3.16.0",
+	"SemanticTokensParams":                       "3.16.0",
+	"SemanticTokensPartialResult":                "3.16.0",
+	"SemanticTokensRangeParams":                  "3.16.0",
+	"SemanticTokensRegistrationOptions":          "3.16.0",
+	"SemanticTokensWorkspaceClientCapabilities":  "3.16.0",
+	"ServerCompletionItemOptions":                "3.18.0",
+	"ServerInfo":                                 "3.15.0",
+	"ShowDocumentClientCapabilities":             "3.16.0",
+	"ShowDocumentParams":                         "3.16.0",
+	"ShowDocumentResult":                         "3.16.0",
+	"SignatureHelpContext":                       "3.15.0",
+	"SignatureHelpTriggerKind":                   "3.15.0",
+	"SnippetTextEdit":                            "3.18.0",
+	"StaleRequestSupportOptions":                 "3.18.0",
+	"StringValue":                                "3.18.0",
+	"SymbolTag":                                  "3.16.0",
+	"TextDocumentContentChangePartial":           "3.18.0",
+	"TextDocumentContentChangeWholeDocument":     "3.18.0",
+	"TextDocumentContentClientCapabilities":      "3.18.0",
+	"TextDocumentContentOptions":                 "3.18.0",
+	"TextDocumentContentParams":                  "3.18.0",
+	"TextDocumentContentRefreshParams":           "3.18.0",
+	"TextDocumentContentRegistrationOptions":     "3.18.0",
+	"TextDocumentContentResult":                  "3.18.0",
+	"TextDocumentFilter":                         "3.17.0",
+	"TextDocumentFilterLanguage":                 "3.18.0",
+	"TextDocumentFilterPattern":                  "3.18.0",
+	"TextDocumentFilterScheme":                   "3.18.0",
+	"TypeHierarchyClientCapabilities":            "3.17.0",
+	"TypeHierarchyItem":                          "3.17.0",
+	"TypeHierarchyOptions":                       "3.17.0",
+	"TypeHierarchyPrepareParams":                 "3.17.0",
+	"TypeHierarchyRegistrationOptions":           "3.17.0",
+	"TypeHierarchySubtypesParams":                "3.17.0",
+	"TypeHierarchySupertypesParams":              "3.17.0",
+	"UnchangedDocumentDiagnosticReport":          "3.17.0",
+	"UniquenessLevel":                            "3.16.0",
+	"VersionedNotebookDocumentIdentifier":        "3.17.0",
+	"WorkspaceDiagnosticParams":                  "3.17.0",
+	"WorkspaceDiagnosticReport":                  "3.17.0",
+	"WorkspaceDiagnosticReportPartialResult":     "3.17.0",
+	"WorkspaceDocumentDiagnosticReport":          "3.17.0",
+	"WorkspaceEditMetadata":                      "3.18.0",
+	"WorkspaceFullDocumentDiagnosticReport":      "3.17.0",
+	"WorkspaceOptions":                           "3.18.0",
+	"WorkspaceSymbol":                            "3.17.0",
+	"WorkspaceUnchangedDocumentDiagnosticReport": "3.17.0",
+}
+
+// sinceFields maps "Type.jsonName" to the protocol version that introduced
+// the field. Fields that predate every tracked version are absent.
+var sinceFields = map[string]string{
+	"ApplyWorkspaceEditParams.metadata":                             "3.18.0",
+	"BaseSymbolInformation.tags":                                    "3.16.0",
+	"ClientCapabilities.general":                                    "3.16.0",
+	"ClientCapabilities.notebookDocument":                           "3.17.0",
+	"ClientCompletionItemOptions.insertReplaceSupport":              "3.16.0",
+	"ClientCompletionItemOptions.insertTextModeSupport":             "3.16.0",
+	"ClientCompletionItemOptions.labelDetailsSupport":               "3.17.0",
+	"ClientCompletionItemOptions.resolveSupport":                    "3.16.0",
+	"ClientCompletionItemOptions.tagSupport":                        "3.15.0",
+	"ClientFoldingRangeOptions.collapsedText":                       "3.17.0",
+	"ClientSignatureInformationOptions.activeParameterSupport":      "3.16.0",
+	"ClientSignatureInformationOptions.noActiveParameterSupport":    "3.18.0",
+	"ClientSignatureParameterInformationOptions.labelOffsetSupport": "3.14.0",
+	"CodeAction.data":        "3.16.0",
+	"CodeAction.disabled":    "3.16.0",
+	"CodeAction.isPreferred": "3.15.0",
+	"CodeAction.tags":        "3.18.0",
+	"CodeActionClientCapabilities.codeActionLiteralSupport": "3.8.0",
+	"CodeActionClientCapabilities.dataSupport":              "3.16.0",
+	"CodeActionClientCapabilities.disabledSupport":          "3.16.0",
+	"CodeActionClientCapabilities.documentationSupport":     "3.18.0",
+	"CodeActionClientCapabilities.honorsChangeAnnotations":  "3.16.0",
+	"CodeActionClientCapabilities.isPreferredSupport":       "3.15.0",
+	"CodeActionClientCapabilities.resolveSupport":           "3.16.0",
+	"CodeActionClientCapabilities.tagSupport":               "3.18.0",
+	"CodeActionContext.triggerKind":                         "3.17.0",
+	"CodeActionOptions.documentation":                       "3.18.0",
+	"CodeActionOptions.resolveProvider":                     "3.16.0",
+	"CodeLensClientCapabilities.resolveSupport":             "3.18.0",
+	"Command.tooltip": "3.18.0",
+	"CompletionClientCapabilities.completionList":                    "3.17.0",
+	"CompletionClientCapabilities.insertTextMode":                    "3.17.0",
+	"CompletionItem.insertTextMode":                                  "3.16.0",
+	"CompletionItem.labelDetails":                                    "3.17.0",
+	"CompletionItem.tags":                                            "3.15.0",
+	"CompletionItem.textEditText":                                    "3.17.0",
+	"CompletionItemApplyKinds.commitCharacters":                      "3.18.0",
+	"CompletionItemApplyKinds.data":                                  "3.18.0",
+	"CompletionItemDefaults.commitCharacters":                        "3.17.0",
+	"CompletionItemDefaults.data":                                    "3.17.0",
+	"CompletionItemDefaults.editRange":                               "3.17.0",
+	"CompletionItemDefaults.insertTextFormat":                        "3.17.0",
+	"CompletionItemDefaults.insertTextMode":                          "3.17.0",
+	"CompletionList.applyKind":                                       "3.18.0",
+	"CompletionList.itemDefaults":                                    "3.17.0",
+	"CompletionListCapabilities.applyKindSupport":                    "3.18.0",
+	"CompletionListCapabilities.itemDefaults":                        "3.17.0",
+	"CompletionOptions.allCommitCharacters":                          "3.2.0",
+	"CompletionOptions.completionItem":                               "3.17.0",
+	"DefinitionClientCapabilities.linkSupport":                       "3.14.0",
+	"Diagnostic.codeDescription":                                     "3.16.0",
+	"Diagnostic.data":                                                "3.16.0",
+	"Diagnostic.tags":                                                "3.15.0",
+	"DiagnosticClientCapabilities.markupMessageSupport":              "3.18.0",
+	"DiagnosticsCapabilities.codeDescriptionSupport":                 "3.16.0",
+	"DiagnosticsCapabilities.dataSupport":                            "3.16.0",
+	"DiagnosticsCapabilities.tagSupport":                             "3.15.0",
+	"DidChangeWatchedFilesClientCapabilities.relativePatternSupport": "3.17.0",
+	"DocumentLink.tooltip":                                           "3.15.0",
+	"DocumentLinkClientCapabilities.tooltipSupport":                  "3.15.0",
+	"DocumentRangeFormattingClientCapabilities.rangesSupport":        "3.18.0",
+	"DocumentRangeFormattingOptions.rangesSupport":                   "3.18.0",
+	"DocumentSymbol.tags":                                            "3.16.0",
+	"DocumentSymbolClientCapabilities.labelSupport":                  "3.16.0",
+	"DocumentSymbolClientCapabilities.tagSupport":                    "3.16.0",
+	"DocumentSymbolOptions.label":                                    "3.16.0",
+	"FoldingRange.collapsedText":                                     "3.17.0",
+	"FoldingRangeClientCapabilities.foldingRange":                    "3.17.0",
+	"FoldingRangeClientCapabilities.foldingRangeKind":                "3.17.0",
+	"FoldingRangeWorkspaceClientCapabilities.refreshSupport":         "3.18.0",
+	"FormattingOptions.insertFinalNewline":                           "3.15.0",
+	"FormattingOptions.trimFinalNewlines":                            "3.15.0",
+	"FormattingOptions.trimTrailingWhitespace":                       "3.15.0",
+	"GeneralClientCapabilities.markdown":                             "3.16.0",
+	"GeneralClientCapabilities.positionEncodings":                    "3.17.0",
+	"GeneralClientCapabilities.regularExpressions":                   "3.16.0",
+	"GeneralClientCapabilities.staleRequestSupport":                  "3.17.0",
+	"ImplementationClientCapabilities.linkSupport":                   "3.14.0",
+	"InitializeParams.clientInfo":                                    "3.15.0",
+	"InitializeParams.locale":                                        "3.16.0",
+	"InitializeResult.serverInfo":                                    "3.15.0",
+	"MarkdownClientCapabilities.allowedTags":                         "3.17.0",
+	"NotebookDocumentClientCapabilities.synchronization":             "3.17.0",
+	"PublishDiagnosticsClientCapabilities.versionSupport":            "3.15.0",
+	"PublishDiagnosticsParams.version":                               "3.15.0",
+	"RelatedFullDocumentDiagnosticReport.relatedDocuments":           "3.17.0",
+	"RelatedUnchangedDocumentDiagnosticReport.relatedDocuments":      "3.17.0",
+	"RenameClientCapabilities.honorsChangeAnnotations":               "3.16.0",
+	"RenameClientCapabilities.prepareSupport":                        "3.12.0",
+	"RenameClientCapabilities.prepareSupportDefaultBehavior":         "3.16.0",
+	"RenameOptions.prepareProvider":                                  "3.12.0",
+	"ResourceOperation.annotationId":                                 "3.16.0",
+	"SemanticTokensClientCapabilities.augmentsSyntaxTokens":          "3.17.0",
+	"SemanticTokensClientCapabilities.serverCancelSupport":           "3.17.0",
+	"ServerCapabilities.callHierarchyProvider":                       "3.16.0",
+	"ServerCapabilities.diagnosticProvider":                          "3.17.0",
+	"ServerCapabilities.inlayHintProvider":                           "3.17.0",
+	"ServerCapabilities.inlineCompletionProvider":                    "3.18.0",
+	"ServerCapabilities.inlineValueProvider":                         "3.17.0",
+	"ServerCapabilities.linkedEditingRangeProvider":                  "3.16.0",
+	"ServerCapabilities.monikerProvider":                             "3.16.0",
+	"ServerCapabilities.notebookDocumentSync":                        "3.17.0",
+	"ServerCapabilities.positionEncoding":                            "3.17.0",
+	"ServerCapabilities.semanticTokensProvider":                      "3.16.0",
+	"ServerCapabilities.typeHierarchyProvider":                       "3.17.0",
+	"ServerCompletionItemOptions.labelDetailsSupport":                "3.17.0",
+	"SignatureHelpClientCapabilities.contextSupport":                 "3.15.0",
+	"SignatureHelpOptions.retriggerCharacters":                       "3.15.0",
+	"SignatureHelpParams.context":                                    "3.15.0",
+	"SignatureInformation.activeParameter":                           "3.16.0",
+	"TextDocumentClientCapabilities.callHierarchy":                   "3.16.0",
+	"TextDocumentClientCapabilities.colorProvider":                   "3.6.0",
+	"TextDocumentClientCapabilities.declaration":                     "3.14.0",
+	"TextDocumentClientCapabilities.diagnostic":                      "3.17.0",
+	"TextDocumentClientCapabilities.filters":                         "3.18.0",
+	"TextDocumentClientCapabilities.foldingRange":                    "3.10.0",
+	"TextDocumentClientCapabilities.implementation":                  "3.6.0",
+	"TextDocumentClientCapabilities.inlayHint":                       "3.17.0",
+	"TextDocumentClientCapabilities.inlineCompletion":                "3.18.0",
+	"TextDocumentClientCapabilities.inlineValue":                     "3.17.0",
+	"TextDocumentClientCapabilities.linkedEditingRange":              "3.16.0",
+	"TextDocumentClientCapabilities.moniker":                         "3.16.0",
+	"TextDocumentClientCapabilities.selectionRange":                  "3.15.0",
+	"TextDocumentClientCapabilities.semanticTokens":                  "3.16.0",
+	"TextDocumentClientCapabilities.typeDefinition":                  "3.6.0",
+	"TextDocumentClientCapabilities.typeHierarchy":                   "3.17.0",
+	"TextDocumentFilterClientCapabilities.relativePatternSupport":    "3.18.0",
+	"WindowClientCapabilities.showDocument":                          "3.16.0",
+	"WindowClientCapabilities.showMessage":                           "3.16.0",
+	"WindowClientCapabilities.workDoneProgress":                      "3.15.0",
+	"WorkspaceClientCapabilities.codeLens":                           "3.16.0",
+	"WorkspaceClientCapabilities.configuration":                      "3.6.0",
+	"WorkspaceClientCapabilities.diagnostics":                        "3.17.0",
+	"WorkspaceClientCapabilities.foldingRange":                       "3.18.0",
+	"WorkspaceClientCapabilities.inlayHint":                          "3.17.0",
+	"WorkspaceClientCapabilities.inlineValue":                        "3.17.0",
+	"WorkspaceClientCapabilities.semanticTokens":                     "3.16.0",
+	"WorkspaceClientCapabilities.textDocumentContent":                "3.18.0",
+	"WorkspaceClientCapabilities.workspaceFolders":                   "3.6.0",
+	"WorkspaceEdit.changeAnnotations":                                "3.16.0",
+	"WorkspaceEditClientCapabilities.changeAnnotationSupport":        "3.16.0",
+	"WorkspaceEditClientCapabilities.failureHandling":                "3.13.0",
+	"WorkspaceEditClientCapabilities.metadataSupport":                "3.18.0",
+	"WorkspaceEditClientCapabilities.normalizesLineEndings":          "3.16.0",
+	"WorkspaceEditClientCapabilities.resourceOperations":             "3.13.0",
+	"WorkspaceEditClientCapabilities.snippetEditSupport":             "3.18.0",
+	"WorkspaceFoldersInitializeParams.workspaceFolders":              "3.6.0",
+	"WorkspaceOptions.fileOperations":                                "3.16.0",
+	"WorkspaceOptions.textDocumentContent":                           "3.18.0",
+	"WorkspaceOptions.workspaceFolders":                              "3.6.0",
+	"WorkspaceSymbolClientCapabilities.resolveSupport":               "3.17.0",
+	"WorkspaceSymbolClientCapabilities.tagSupport":                   "3.16.0",
+	"WorkspaceSymbolOptions.resolveProvider":                         "3.17.0",
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import "testing"
+
+func TestSinceTables(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		got  string
+		want string
+	}{
+		"success: type introduced in 3.17":   {got: TypeSince("InlayHint"), want: Version317},
+		"success: type predating the tables": {got: TypeSince("Position"), want: ""},
+		"success: field introduced in 3.17":  {got: FieldSince("CompletionItem", "labelDetails"), want: Version317},
+		"success: field predating the table": {got: FieldSince("CompletionItem", "label"), want: ""},
+		"success: amended field stays undated": {
+			got: FieldSince("TextDocumentEdit", "edits"), want: "",
+		},
+		"success: method introduced in 3.16": {got: MethodSince(MethodTextDocumentPrepareCallHierarchy), want: Version316},
+		"success: method predating the table": {
+			got: MethodSince(MethodTextDocumentHover), want: "",
+		},
+		"success: unknown method": {got: MethodSince("custom/thing"), want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.got != tt.want {
+				t.Fatalf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b string
+		want int
+	}{
+		"success: equal":             {a: "3.17.0", b: "3.17.0", want: 0},
+		"success: missing patch":     {a: "3.17", b: "3.17.0", want: 0},
+		"success: minor less":        {a: "3.16.0", b: "3.17.0", want: -1},
+		"success: numeric not lexic": {a: "3.9.0", b: "3.10.0", want: -1},
+		"success: patch greater":     {a: "3.17.1", b: "3.17.0", want: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Fatalf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// Decoder decodes a stream of LSP JSON values read incrementally from an
+// [io.Reader]. Unlike [Unmarshal], it never needs the whole input resident:
+// [Decoder.Decode] buffers one value at a time, and [Elements] decodes a large
+// array one element at a time, so peak memory is bounded by the largest
+// element rather than by the message.
+type Decoder struct {
+	dec  *jsontext.Decoder
+	opts UnmarshalOptions
+}
+
+// NewDecoder returns a Decoder reading from r. The wire options of [Unmarshal]
+// apply: duplicate names decode as last-wins and invalid UTF-8 is replaced.
+// Use [UnmarshalOptions.NewDecoder] to choose how decoded values own memory.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: jsontext.NewDecoder(r, wireOptions)}
+}
+
+// Decode reads the next JSON value from the stream and decodes it into v as
+// [Unmarshal] does. It returns [io.EOF] at the end of the stream.
+func (d *Decoder) Decode(v any) error {
+	raw, err := d.dec.ReadValue()
+	if err != nil {
+		return err
+	}
+
+	return d.opts.Unmarshal(raw, v)
+}
+
+// InputOffset returns the number of input bytes consumed so far.
+func (d *Decoder) InputOffset() int64 {
+	return d.dec.InputOffset()
+}
+
+// Elements returns an iterator decoding the elements of the JSON array at ptr
+// within the next value of d, one element at a time. ptr is a JSON Pointer
+// made of object member names, such as "/items" for a workspace diagnostic
+// report or "/result" for a response envelope; "" is the value itself, as for
+// a workspace/symbol result. A null at ptr yields nothing.
+//
+// Members off the path to ptr are skipped without being buffered. When the
+// iteration finishes or stops early, the rest of the value is skipped so d is
+// positioned at the next value. A decode or syntax error, or a missing array,
+// is yielded once as the final pair.
+func Elements[T any](d *Decoder, ptr string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		depth := d.dec.StackDepth()
+		found, err := d.seekArray(jsontext.Pointer(ptr))
+		if err == nil && found == arrayMissing {
+			err = fmt.Errorf("protocol: no array at %q", ptr)
+		}
+		if found != arrayFound {
+			if skipErr := d.skipTo(depth); err == nil {
+				err = skipErr
+			}
+			if err != nil {
+				yield(zero, err)
+			}
+			return
+		}
+		for d.dec.PeekKind() != ']' {
+			raw, err := d.dec.ReadValue()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			var v T
+			if err := d.opts.Unmarshal(raw, &v); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				break
+			}
+		}
+		if err := d.skipTo(depth); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// arrayState is the outcome of [Decoder.seekArray].
+type arrayState int
+
+const (
+	arrayMissing arrayState = iota // no array at the pointer
+	arrayNull                      // null at the pointer, consumed
+	arrayFound                     // array at the pointer, '[' consumed
+)
+
+// seekArray advances d to the value at ptr and enters it when it is an array.
+// Unless the array is found, d may be left inside the objects enclosing ptr.
+func (d *Decoder) seekArray(ptr jsontext.Pointer) (arrayState, error) {
+	if ptr == "" {
+		return d.enterArray()
+	}
+	if d.dec.PeekKind() != '{' {
+		return arrayMissing, d.dec.SkipValue()
+	}
+	if _, err := d.dec.ReadToken(); err != nil {
+		return arrayMissing, err
+	}
+	start := d.dec.StackDepth()
+	for d.dec.StackDepth() >= start {
+		if d.dec.PeekKind() == '}' {
+			if _, err := d.dec.ReadToken(); err != nil {
+				return arrayMissing, err
+			}
+			continue
+		}
+		if _, err := d.dec.ReadToken(); err != nil { // member name
+			return arrayMissing, err
+		}
+		p := string(d.dec.StackPointer())
+		switch {
+		case p == string(ptr):
+			return d.enterArray()
+		case strings.HasPrefix(string(ptr), p+"/") && d.dec.PeekKind() == '{':
+			if _, err := d.dec.ReadToken(); err != nil {
+				return arrayMissing, err
+			}
+		default:
+			if err := d.dec.SkipValue(); err != nil {
+				return arrayMissing, err
+			}
+		}
+	}
+
+	return arrayMissing, nil
+}
+
+// enterArray consumes the '[' of the next value, or the whole value when it
+// is not an array.
+func (d *Decoder) enterArray() (arrayState, error) {
+	switch d.dec.PeekKind() {
+	case '[':
+		if _, err := d.dec.ReadToken(); err != nil {
+			return arrayMissing, err
+		}
+		return arrayFound, nil
+	case 'n':
+		if _, err := d.dec.ReadToken(); err != nil {
+			return arrayMissing, err
+		}
+		return arrayNull, nil
+	default:
+		return arrayMissing, d.dec.SkipValue()
+	}
+}
+
+// skipTo consumes tokens until d is back at stack depth.
+func (d *Decoder) skipTo(depth int) error {
+	for d.dec.StackDepth() > depth {
+		switch d.dec.PeekKind() {
+		case '}', ']':
+			if _, err := d.dec.ReadToken(); err != nil {
+				return err
+			}
+		default:
+			if kind, n := d.dec.StackIndex(d.dec.StackDepth()); kind == '{' && n%2 == 0 {
+				if _, err := d.dec.ReadToken(); err != nil { // member name
+					return err
+				}
+			}
+			if err := d.dec.SkipValue(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
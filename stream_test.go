@@ -0,0 +1,145 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestElements(t *testing.T) {
+	t.Parallel()
+
+	const symbols = `[{"name":"a","kind":12,"location":{"uri":"file:///a.go"}},` +
+		`{"name":"b","kind":5,"location":{"uri":"file:///b.go"}}]`
+
+	tests := map[string]struct {
+		input     string
+		ptr       string
+		limit     int
+		want      []string
+		wantErr   bool
+		wantAfter string // next value once iteration is done
+	}{
+		"success: root array": {
+			input: symbols + ` "next"`, ptr: "",
+			want: []string{"a", "b"}, wantAfter: "next",
+		},
+		"success: nested member skips siblings": {
+			input: `{"jsonrpc":"2.0","id":1,"meta":{"items":[{"x":1}]},"result":{"items":` + symbols + `,"tail":[1,2]}} "next"`,
+			ptr:   "/result/items",
+			want:  []string{"a", "b"}, wantAfter: "next",
+		},
+		"success: null yields nothing": {
+			input: `{"result":null} "next"`, ptr: "/result",
+			wantAfter: "next",
+		},
+		"success: early stop skips the rest of the value": {
+			input: `{"result":` + symbols + `,"id":1} "next"`, ptr: "/result", limit: 1,
+			want: []string{"a"}, wantAfter: "next",
+		},
+		"error: missing array": {
+			input: `{"result":{"items":1}} "next"`, ptr: "/result/items",
+			wantErr: true, wantAfter: "next",
+		},
+		"error: element decode failure": {
+			input: `[{"name":"a","kind":"bad"}]`, ptr: "",
+			wantErr: true,
+		},
+		"error: truncated input": {
+			input: `{"result":[{"name":"a","kind":12,"location":{"uri":"file:///a.go"}},`, ptr: "/result",
+			want: []string{"a"}, wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewDecoder(strings.NewReader(tt.input))
+			var got []string
+			var gotErr error
+			for sym, err := range Elements[WorkspaceSymbol](d, tt.ptr) {
+				if err != nil {
+					gotErr = err
+					break
+				}
+				got = append(got, sym.Name)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("Elements() error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("Elements() = %v, want %v", got, tt.want)
+			}
+			if tt.wantAfter == "" {
+				return
+			}
+			var after string
+			if err := d.Decode(&after); err != nil {
+				t.Fatalf("Decode after Elements: %v", err)
+			}
+			if after != tt.wantAfter {
+				t.Fatalf("next value = %q, want %q", after, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func TestDecoderDecode(t *testing.T) {
+	t.Parallel()
+
+	d := NewDecoder(strings.NewReader(`{"line":1,"character":2} {"line":3,"character":4}`))
+	var got []Position
+	for {
+		var p Position
+		err := d.Decode(&p)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		got = append(got, p)
+	}
+	if want := []Position{{Line: 1, Character: 2}, {Line: 3, Character: 4}}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Decode() = %v, want %v", got, want)
+	}
+}
+
+// BenchmarkElementsWorkspaceSymbols decodes a large workspace/symbol result
+// through the streaming iterator; allocations stay per element rather than
+// growing with the message.
+func BenchmarkElementsWorkspaceSymbols(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString(`{"jsonrpc":"2.0","id":1,"result":[`)
+	for i := range 10000 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		fmt.Fprintf(&sb, `{"name":"sym%d","kind":12,"containerName":"pkg","location":{"uri":"file:///src/file%d.go"}}`, i, i%100)
+	}
+	sb.WriteString(`]}`)
+	input := sb.String()
+
+	b.SetBytes(int64(len(input)))
+	b.ReportAllocs()
+	for b.Loop() {
+		n := 0
+		for _, err := range Elements[WorkspaceSymbol](NewDecoder(strings.NewReader(input)), "/result") {
+			if err != nil {
+				b.Fatal(err)
+			}
+			n++
+		}
+		if n != 10000 {
+			b.Fatalf("decoded %d symbols, want 10000", n)
+		}
+	}
+}
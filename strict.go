@@ -0,0 +1,566 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// shapeKind classifies a [shape].
+type shapeKind uint8
+
+const (
+	shapeAny            shapeKind = iota // any JSON value (LSPAny)
+	shapeString                          // string, URI, DocumentUri, RegExp
+	shapeInteger                         // 32-bit signed integer
+	shapeUinteger                        // integer in [0, 2^31-1]
+	shapeDecimal                         // any number
+	shapeBoolean                         // true or false
+	shapeNull                            // null
+	shapeStringLiteral                   // one fixed string: values[0]
+	shapeIntegerLiteral                  // one fixed integer: values[0]
+	shapeBooleanLiteral                  // one fixed boolean: values[0]
+	shapeEnum                            // base values, restricted to values unless open
+	shapeObject                          // structure with fields
+	shapeArray                           // array of elem
+	shapeMap                             // object whose member values are elem
+	shapeTuple                           // array of exactly len(items), item-wise
+	shapeOr                              // any one of items
+	shapeRef                             // the named shape in shapes
+)
+
+// shape describes the JSON form of a protocol type as the meta-model declares
+// it. The generated shapes in shapes.gen.go reference each other by name
+// through shapeRef, so recursive types need no initialization order.
+type shape struct {
+	kind   shapeKind
+	name   string       // type name; for shapeRef the referenced type
+	base   shapeKind    // shapeEnum: shapeString, shapeInteger or shapeUinteger
+	open   bool         // shapeEnum: custom values are allowed
+	values []string     // literal value, or enum values as decoded text
+	fields []shapeField // shapeObject
+	elem   *shape       // shapeArray, shapeMap
+	items  []*shape     // shapeTuple, shapeOr
+}
+
+// shapeField is one property of a shapeObject.
+type shapeField struct {
+	name     string
+	optional bool
+	typ      *shape
+}
+
+// Shapes of the meta-model base types, shared by the generated shapes.
+var (
+	shapeOfAny      = &shape{kind: shapeAny}
+	shapeOfString   = &shape{kind: shapeString}
+	shapeOfInteger  = &shape{kind: shapeInteger}
+	shapeOfUinteger = &shape{kind: shapeUinteger}
+	shapeOfDecimal  = &shape{kind: shapeDecimal}
+	shapeOfBoolean  = &shape{kind: shapeBoolean}
+	shapeOfNull     = &shape{kind: shapeNull}
+)
+
+// shapeRefTo returns a reference to the generated shape of the named type.
+func shapeRefTo(name string) *shape {
+	return &shape{kind: shapeRef, name: name}
+}
+
+// resolve follows shapeRef references.
+func (s *shape) resolve() *shape {
+	for s.kind == shapeRef {
+		t, ok := shapes[s.name]
+		if !ok {
+			return shapeOfAny
+		}
+		s = t
+	}
+	return s
+}
+
+// String names s for violation messages.
+func (s *shape) String() string {
+	if s.name != "" {
+		return s.name
+	}
+	switch s.kind {
+	case shapeAny:
+		return "any value"
+	case shapeString:
+		return "string"
+	case shapeInteger:
+		return "integer"
+	case shapeUinteger:
+		return "uinteger"
+	case shapeDecimal:
+		return "decimal"
+	case shapeBoolean:
+		return "boolean"
+	case shapeNull:
+		return "null"
+	case shapeStringLiteral:
+		return strconv.Quote(s.values[0])
+	case shapeIntegerLiteral, shapeBooleanLiteral:
+		return s.values[0]
+	case shapeObject:
+		return "object"
+	case shapeArray:
+		return "array of " + s.elem.String()
+	case shapeMap:
+		return "map of " + s.elem.String()
+	case shapeTuple:
+		return fmt.Sprintf("%d-tuple", len(s.items))
+	case shapeOr:
+		names := make([]string, len(s.items))
+		for i, it := range s.items {
+			names[i] = it.String()
+		}
+		return strings.Join(names, " | ")
+	default:
+		return "unknown"
+	}
+}
+
+// accepts reports whether a value starting with the JSON token kind k can
+// match s, so or-shapes can tell which arms a value was meant for.
+func (s *shape) accepts(k jsontext.Kind) bool {
+	s = s.resolve()
+	switch s.kind {
+	case shapeAny:
+		return true
+	case shapeString, shapeStringLiteral:
+		return k == '"'
+	case shapeInteger, shapeUinteger, shapeDecimal, shapeIntegerLiteral:
+		return k == '0'
+	case shapeBoolean, shapeBooleanLiteral:
+		return k == 't' || k == 'f'
+	case shapeNull:
+		return k == 'n'
+	case shapeEnum:
+		return s.base.accepts(k)
+	case shapeObject, shapeMap:
+		return k == '{'
+	case shapeArray, shapeTuple:
+		return k == '['
+	case shapeOr:
+		for _, it := range s.items {
+			if it.accepts(k) {
+				return true
+			}
+		}
+	default:
+	}
+	return false
+}
+
+// accepts reports whether the base kind of an enum admits token kind k.
+func (k shapeKind) accepts(tok jsontext.Kind) bool {
+	if k == shapeString {
+		return tok == '"'
+	}
+	return tok == '0'
+}
+
+// Violation is one way a JSON payload departs from the protocol types.
+type Violation struct {
+	// Pointer locates the offending value as a JSON Pointer (RFC 6901); ""
+	// is the whole payload.
+	Pointer jsontext.Pointer
+
+	// Message describes the violation.
+	Message string
+}
+
+// String formats v as "<pointer>: <message>".
+func (v Violation) String() string {
+	return fmt.Sprintf("%q: %s", string(v.Pointer), v.Message)
+}
+
+// ValidationError reports every violation found in a payload. It is returned
+// by strict decoding (see [UnmarshalOptions.Strict]), [ValidateParams] and
+// [ValidateResult].
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	switch len(e.Violations) {
+	case 0:
+		return "protocol: invalid payload"
+	case 1:
+		return "protocol: " + e.Violations[0].String()
+	default:
+		return fmt.Sprintf("protocol: %s (and %d more)", e.Violations[0], len(e.Violations)-1)
+	}
+}
+
+// protocolPkgPath is the import path of this package, for mapping Go types
+// to their shapes.
+var protocolPkgPath = reflect.TypeFor[Position]().PkgPath()
+
+// shapeForType returns the shape the JSON form of Go type t has, or nil if t
+// is not a protocol type.
+func shapeForType(t reflect.Type) *shape {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() == protocolPkgPath {
+		if s, ok := shapes[t.Name()]; ok {
+			return s
+		}
+	}
+	if t == reflect.TypeFor[jsontext.Value]() {
+		return shapeOfAny
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return shapeOfAny
+		}
+	case reflect.String:
+		return shapeOfString
+	case reflect.Bool:
+		return shapeOfBoolean
+	case reflect.Int32:
+		return shapeOfInteger
+	case reflect.Uint32:
+		return shapeOfUinteger
+	case reflect.Float64:
+		return shapeOfDecimal
+	case reflect.Slice:
+		if elem := shapeForType(t.Elem()); elem != nil {
+			return &shape{kind: shapeArray, elem: elem}
+		}
+	case reflect.Array:
+		if elem := shapeForType(t.Elem()); elem != nil {
+			items := make([]*shape, t.Len())
+			for i := range items {
+				items[i] = elem
+			}
+			return &shape{kind: shapeTuple, items: items}
+		}
+	case reflect.Map:
+		if elem := shapeForType(t.Elem()); elem != nil && t.Key().Kind() == reflect.String {
+			return &shape{kind: shapeMap, elem: elem}
+		}
+	default:
+	}
+	return nil
+}
+
+// validateStrict checks data against the shape of the type v points to.
+func validateStrict(data []byte, v any) error {
+	s := shapeForType(reflect.TypeOf(v))
+	if s == nil {
+		return fmt.Errorf("protocol: strict decoding does not support %T", v)
+	}
+	if violations := validateShape(data, s, "", true); len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// validateShape returns the violations of data against s, with pointers
+// prefixed by ptr; members the shape does not declare are violations only
+// when strict is set. Syntax errors, duplicate member names and invalid
+// UTF-8 stop validation at the first occurrence.
+func validateShape(data []byte, s *shape, ptr string, strict bool) []Violation {
+	v := &validator{dec: jsontext.NewDecoder(bytes.NewReader(data)), strict: strict}
+	return v.walk(s, ptr)
+}
+
+// walk validates the whole input against s and returns the violations.
+func (v *validator) walk(s *shape, ptr string) []Violation {
+	if err := v.value(s, ptr); err != nil {
+		v.syntax(err, ptr)
+		return v.violations
+	}
+	if _, err := v.dec.ReadToken(); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = errors.New("unexpected data after the top-level value")
+		}
+		v.syntax(err, ptr)
+	}
+	return v.violations
+}
+
+// validator walks one JSON value against a shape. Its decoder keeps the
+// default jsontext checks, which reject duplicate member names and invalid
+// UTF-8.
+type validator struct {
+	dec        *jsontext.Decoder
+	strict     bool // report members the shape does not declare
+	violations []Violation
+
+	// rivals are the members declared by the other arms of the union whose
+	// object arm is being walked; outside strict mode they still count
+	// against the arm, so a value is not matched to an arm by ignoring a
+	// member that selects another.
+	rivals map[string]bool
+}
+
+func (v *validator) report(ptr, format string, args ...any) {
+	v.violations = append(v.violations, Violation{Pointer: jsontext.Pointer(ptr), Message: fmt.Sprintf(format, args...)})
+}
+
+// syntax records a decoder error that ends validation.
+func (v *validator) syntax(err error, ptr string) {
+	var serr *jsontext.SyntacticError
+	if errors.As(err, &serr) {
+		v.report(ptr+string(serr.JSONPointer), "%v", serr.Err)
+		return
+	}
+	v.report(ptr, "%v", err)
+}
+
+// value validates the next value against s. A returned error is a decoder
+// error after which the input cannot be walked further.
+func (v *validator) value(s *shape, ptr string) error {
+	s = s.resolve()
+	kind := v.dec.PeekKind()
+	if kind == 0 {
+		_, err := v.dec.ReadToken()
+		return err
+	}
+	if s.kind == shapeOr {
+		return v.or(s, ptr)
+	}
+	if !s.accepts(kind) {
+		v.report(ptr, "expected %s, found %s", s, kindName(kind))
+		return v.dec.SkipValue()
+	}
+	switch s.kind {
+	case shapeObject:
+		return v.object(s, ptr)
+	case shapeMap:
+		return v.members(ptr, func(string) *shape { return s.elem })
+	case shapeArray:
+		return v.array(ptr, func(int) *shape { return s.elem })
+	case shapeTuple:
+		n := 0
+		err := v.array(ptr, func(i int) *shape {
+			n++
+			if i < len(s.items) {
+				return s.items[i]
+			}
+			return nil
+		})
+		if err == nil && n != len(s.items) {
+			v.report(ptr, "expected %d elements, found %d", len(s.items), n)
+		}
+		return err
+	case shapeAny:
+		switch kind {
+		case '{':
+			return v.members(ptr, func(string) *shape { return shapeOfAny })
+		case '[':
+			return v.array(ptr, func(int) *shape { return shapeOfAny })
+		}
+	default:
+	}
+
+	raw, err := v.dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	v.scalar(s, raw, ptr)
+	return nil
+}
+
+// scalar validates a string, number or boolean value.
+func (v *validator) scalar(s *shape, raw jsontext.Value, ptr string) {
+	text := string(raw)
+	if raw.Kind() == '"' {
+		b, err := jsontext.AppendUnquote(nil, raw)
+		if err != nil {
+			v.report(ptr, "%v", err)
+			return
+		}
+		text = string(b)
+	}
+	switch s.kind {
+	case shapeInteger:
+		if _, err := strconv.ParseInt(text, 10, 32); err != nil {
+			v.report(ptr, "%s is not a 32-bit integer", raw)
+		}
+	case shapeUinteger:
+		if n, err := strconv.ParseUint(text, 10, 32); err != nil || n > math.MaxInt32 {
+			v.report(ptr, "%s is not a uinteger", raw)
+		}
+	case shapeStringLiteral, shapeIntegerLiteral, shapeBooleanLiteral:
+		if text != s.values[0] {
+			v.report(ptr, "expected %s, found %s", s, raw)
+		}
+	case shapeEnum:
+		v.scalar(&shape{kind: s.base}, raw, ptr)
+		if !s.open && !slices.Contains(s.values, text) {
+			v.report(ptr, "%s is not a %s value", raw, s.name)
+		}
+	default:
+	}
+}
+
+// object validates the members of a structure: each must be a known field,
+// and every required field must be present.
+func (v *validator) object(s *shape, ptr string) error {
+	rivals := v.rivals
+	v.rivals = nil // nested objects are not arms
+	seen := make([]bool, len(s.fields))
+	err := v.members(ptr, func(name string) *shape {
+		for i, f := range s.fields {
+			if f.name == name {
+				seen[i] = true
+				return f.typ
+			}
+		}
+		if rivals[name] {
+			v.report(ptr+"/"+escapePointerToken(name), "%q belongs to another arm, not to %s", name, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, f := range s.fields {
+		if !f.optional && !seen[i] {
+			v.report(ptr, "missing required member %q of %s", f.name, s)
+		}
+	}
+	return nil
+}
+
+// members validates each member of an object against field(name); a nil
+// shape marks an unknown member, which is skipped and, in strict mode,
+// reported.
+func (v *validator) members(ptr string, field func(name string) *shape) error {
+	if _, err := v.dec.ReadToken(); err != nil {
+		return err
+	}
+	for v.dec.PeekKind() != '}' {
+		tok, err := v.dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		name := tok.String()
+		at := ptr + "/" + escapePointerToken(name)
+		s := field(name)
+		if s == nil {
+			if v.strict {
+				v.report(at, "unknown member %q", name)
+			}
+			if err := v.dec.SkipValue(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := v.value(s, at); err != nil {
+			return err
+		}
+	}
+	_, err := v.dec.ReadToken()
+	return err
+}
+
+// array validates each element against elem(i); a nil shape reports an
+// unexpected element.
+func (v *validator) array(ptr string, elem func(i int) *shape) error {
+	if _, err := v.dec.ReadToken(); err != nil {
+		return err
+	}
+	for i := 0; v.dec.PeekKind() != ']'; i++ {
+		at := ptr + "/" + strconv.Itoa(i)
+		s := elem(i)
+		if s == nil {
+			if err := v.dec.SkipValue(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := v.value(s, at); err != nil {
+			return err
+		}
+	}
+	_, err := v.dec.ReadToken()
+	return err
+}
+
+// or validates a value against each arm of s. When no arm matches, the
+// violations of the closest arm accepting the value's JSON kind are reported,
+// since they say most precisely what is wrong; without a single closest arm
+// the value is reported as matching none.
+func (v *validator) or(s *shape, ptr string) error {
+	kind := v.dec.PeekKind()
+	raw, err := v.dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	var rivals map[string]bool
+	if !v.strict {
+		rivals = make(map[string]bool)
+		for _, arm := range s.items {
+			if arm = arm.resolve(); arm.kind == shapeObject {
+				for _, f := range arm.fields {
+					rivals[f.name] = true
+				}
+			}
+		}
+	}
+	var closest []Violation
+	tied := false
+	for _, arm := range s.items {
+		if !arm.accepts(kind) {
+			continue
+		}
+		w := &validator{dec: jsontext.NewDecoder(bytes.NewReader(raw)), strict: v.strict}
+		if arm.resolve().kind == shapeObject {
+			w.rivals = rivals
+		}
+		violations := w.walk(arm, ptr)
+		switch {
+		case len(violations) == 0:
+			return nil
+		case closest == nil || len(violations) < len(closest):
+			closest, tied = violations, false
+		case len(violations) == len(closest):
+			tied = true
+		}
+	}
+	if closest != nil && !tied {
+		v.violations = append(v.violations, closest...)
+		return nil
+	}
+	v.report(ptr, "%s matches no arm of %s", kindName(kind), s)
+	return nil
+}
+
+// kindName describes a JSON token kind for violation messages.
+func kindName(k jsontext.Kind) string {
+	switch k {
+	case '"':
+		return "string"
+	case '0':
+		return "number"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	default:
+		return "invalid value"
+	}
+}
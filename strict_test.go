@@ -0,0 +1,161 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStrictUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input string
+		want  []Violation // nil when the input conforms
+	}{
+		"success: conforming item": {
+			input: `{"label":"a","kind":3,"documentation":{"kind":"markdown","value":"x"},"data":{"any":[1,{"x":null}]}}`,
+		},
+		"success: open enum accepts a custom value": {
+			input: `{"label":"a","command":{"title":"t","command":"c"},"textEdit":{"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}},"newText":"x"}}`,
+		},
+		"error: unknown member": {
+			input: `{"label":"a","vendorExtension":true}`,
+			want:  []Violation{{Pointer: "/vendorExtension", Message: `unknown member "vendorExtension"`}},
+		},
+		"error: missing required property": {
+			input: `{"kind":1}`,
+			want:  []Violation{{Pointer: "", Message: `missing required member "label" of CompletionItem`}},
+		},
+		"error: type mismatch and invalid enum value": {
+			input: `{"label":1,"kind":99,"tags":["x"]}`,
+			want: []Violation{
+				{Pointer: "/label", Message: "expected string, found number"},
+				{Pointer: "/kind", Message: "99 is not a CompletionItemKind value"},
+				{Pointer: "/tags/0", Message: "expected CompletionItemTag, found string"},
+			},
+		},
+		"error: integer out of range": {
+			input: `{"label":"a","textEdit":{"range":{"start":{"line":-1,"character":0.5},"end":{"line":0,"character":0}},"newText":""}}`,
+			want: []Violation{
+				{Pointer: "/textEdit/range/start/line", Message: "-1 is not a uinteger"},
+				{Pointer: "/textEdit/range/start/character", Message: "0.5 is not a uinteger"},
+			},
+		},
+		"error: union arm violation is reported inside the arm": {
+			input: `{"label":"a","documentation":{"kind":"markdown"}}`,
+			want:  []Violation{{Pointer: "/documentation", Message: `missing required member "value" of MarkupContent`}},
+		},
+		"error: union reports the closest arm": {
+			input: `{"label":"a","textEdit":{"newText":"x"}}`,
+			want:  []Violation{{Pointer: "/textEdit", Message: `missing required member "range" of TextEdit`}},
+		},
+		"error: union matches no arm": {
+			input: `{"label":"a","documentation":5}`,
+			want:  []Violation{{Pointer: "/documentation", Message: "number matches no arm of string | MarkupContent"}},
+		},
+		"error: duplicate member name": {
+			input: `{"label":"a","label":"b"}`,
+			want:  []Violation{{Pointer: "/label", Message: "duplicate object member name"}},
+		},
+		"error: invalid UTF-8": {
+			input: "{\"label\":\"a\xff\"}",
+			want:  []Violation{{Pointer: "/label", Message: "invalid UTF-8"}},
+		},
+		"error: escaped pointer token": {
+			input: `{"label":"a","a/b~c":1}`,
+			want:  []Violation{{Pointer: "/a~1b~0c", Message: `unknown member "a/b~c"`}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got CompletionItem
+			err := UnmarshalOptions{Strict: true}.Unmarshal([]byte(tt.input), &got)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Unmarshal() error = %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Unmarshal() error = %v, want a *ValidationError", err)
+			}
+			if !slices.Equal(verr.Violations, tt.want) {
+				t.Fatalf("Violations = %v, want %v", verr.Violations, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictUnmarshalCorpus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func([]byte) error{
+		"completion_result.json":                strictInto[CompletionList],
+		"completion_result_array.json":          strictInto[[]CompletionItem],
+		"didchange.json":                        strictInto[DidChangeTextDocumentParams],
+		"dispatch_documentchange_create.json":   strictInto[DocumentChange],
+		"dispatch_documentchange_textedit.json": strictInto[DocumentChange],
+		"dispatch_textdocfilter_pattern.json":   strictInto[DocumentFilter],
+		"initialize_request.json":               strictInto[InitializeParams],
+		"initialize_result.json":                strictInto[InitializeResult],
+		"publish_diagnostics.json":              strictInto[PublishDiagnosticsParams],
+		"semantic_tokens.json":                  strictInto[SemanticTokens],
+		"workspace_symbol_result.json":          strictInto[WorkspaceSymbolSlice],
+		"workspace_symbol_result_info.json":     strictInto[[]SymbolInformation],
+		"dispatch_codeaction_command_obj.json":  strictInto[CodeAction],
+		"dispatch_notebook_sync_id.json":        strictInto[NotebookDocumentSyncRegistrationOptions],
+		"dispatch_docfilter_notebook.json":      strictInto[NotebookCellTextDocumentFilter],
+		"dispatch_textdocfilter_scheme.json":    strictInto[TextDocumentFilter],
+	}
+	for name, decode := range tests {
+		t.Run("success: "+name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := decode(data); err != nil {
+				t.Fatalf("strict Unmarshal: %v", err)
+			}
+		})
+	}
+}
+
+func strictInto[T any](data []byte) error {
+	var v T
+	return UnmarshalOptions{Strict: true}.Unmarshal(data, &v)
+}
+
+func TestStrictDecoder(t *testing.T) {
+	t.Parallel()
+
+	d := UnmarshalOptions{Strict: true, Ownership: OwnershipBorrow}.NewDecoder(strings.NewReader(`{"line":1,"character":2} {"line":1}`))
+	var p Position
+	if err := d.Decode(&p); err != nil {
+		t.Fatalf("Decode first: %v", err)
+	}
+	var verr *ValidationError
+	if err := d.Decode(&p); !errors.As(err, &verr) {
+		t.Fatalf("Decode second error = %v, want a *ValidationError", err)
+	}
+}
+
+func TestStrictUnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	var v struct{ X int }
+	if err := (UnmarshalOptions{Strict: true}).Unmarshal([]byte(`{"X":1}`), &v); err == nil {
+		t.Fatal("Unmarshal() error = nil for a non-protocol type")
+	}
+}
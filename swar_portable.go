@@ -0,0 +1,20 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build !(goexperiment.simd && amd64)
+
+package protocol
+
+// dvScanQuoteBackslash returns the index of the first '"' or '\\' at or after
+// i, or len(raw) when neither occurs.
+func dvScanQuoteBackslash(raw []byte, i int) int {
+	return swarScanQuoteBackslash(raw, i)
+}
+
+// dvScanStringSpecial returns the index of the first byte at or after i that
+// the zero-copy string fast path cannot consume verbatim: a quote, a
+// backslash, a control byte, or a non-ASCII byte. Returns len(raw) when the
+// run is clean to the end.
+func dvScanStringSpecial(raw []byte, i int) int {
+	return swarScanStringSpecial(raw, i)
+}
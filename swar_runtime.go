@@ -12,8 +12,10 @@ import (
 // classifiers behind the string hot paths: one primitive family answers
 // "where is the next interesting byte" eight bytes per step for the decode
 // scanners (dvString, dvStringEnd, scanString) and the encode quote fast path
-// (appendJSONString). Pure portable Go — wider amd64 kernels would slot in
-// behind the same two functions if a profile ever justifies them.
+// (appendJSONString). The SWAR kernels are pure portable Go; builds with
+// GOEXPERIMENT=simd on amd64 put 32-byte AVX2 kernels in front of them for
+// long runs (swar_simd_amd64.go), and the SWAR kernels finish the tail and
+// serve every other build and CPU (swar_portable.go).
 //
 // Correctness of the first-index guarantee: the sub-borrow in swarHasZero and
 // swarLessThan0x20 can corrupt LANES ABOVE the triggering byte (little-endian
@@ -39,9 +41,9 @@ func swarLessThan0x20(v uint64) uint64 {
 	return (v - swarLo*0x20) & ^v & swarHi
 }
 
-// dvScanQuoteBackslash returns the index of the first '"' or '\\' at or after
-// i, or len(raw) when neither occurs.
-func dvScanQuoteBackslash(raw []byte, i int) int {
+// swarScanQuoteBackslash returns the index of the first '"' or '\\' at or
+// after i, or len(raw) when neither occurs.
+func swarScanQuoteBackslash(raw []byte, i int) int {
 	for ; i+8 <= len(raw); i += 8 {
 		w := binary.LittleEndian.Uint64(raw[i:])
 		m := swarHasZero(w^(swarLo*'"')) | swarHasZero(w^(swarLo*'\\'))
@@ -57,11 +59,11 @@ func dvScanQuoteBackslash(raw []byte, i int) int {
 	return i
 }
 
-// dvScanStringSpecial returns the index of the first byte at or after i that
-// the zero-copy string fast path cannot consume verbatim: a quote, a
+// swarScanStringSpecial returns the index of the first byte at or after i
+// that the zero-copy string fast path cannot consume verbatim: a quote, a
 // backslash, a control byte, or a non-ASCII byte. Returns len(raw) when the
 // run is clean to the end.
-func dvScanStringSpecial(raw []byte, i int) int {
+func swarScanStringSpecial(raw []byte, i int) int {
 	for ; i+8 <= len(raw); i += 8 {
 		w := binary.LittleEndian.Uint64(raw[i:])
 		m := swarHasZero(w^(swarLo*'"')) | swarHasZero(w^(swarLo*'\\')) |
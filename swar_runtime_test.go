@@ -9,7 +9,7 @@ import (
 )
 
 // referenceScanQuoteBackslash is the byte-at-a-time oracle for
-// dvScanQuoteBackslash.
+// dvScanQuoteBackslash and its kernels.
 func referenceScanQuoteBackslash(raw []byte, i int) int {
 	for ; i < len(raw); i++ {
 		if c := raw[i]; c == '"' || c == '\\' {
@@ -20,7 +20,7 @@ func referenceScanQuoteBackslash(raw []byte, i int) int {
 }
 
 // referenceScanStringSpecial is the byte-at-a-time oracle for
-// dvScanStringSpecial.
+// dvScanStringSpecial and its kernels.
 func referenceScanStringSpecial(raw []byte, i int) int {
 	for ; i < len(raw); i++ {
 		c := raw[i]
@@ -31,19 +31,36 @@ func referenceScanStringSpecial(raw []byte, i int) int {
 	return i
 }
 
-// TestSWARScannersMatchReference pins the word-boundary behavior the SWAR
-// rewrite must preserve: every special byte class at every lane offset,
-// straddling loads, borrow-adjacent lanes, and truncated tails.
+// scanKernel is one implementation of a scanner checked against its oracle.
+type scanKernel struct {
+	name      string
+	scan      func(raw []byte, i int) int
+	reference func(raw []byte, i int) int
+}
+
+// scanKernels lists the dispatching scanners, which run the AVX2 kernels in
+// simd builds on capable CPUs, alongside the SWAR kernels they fall back to.
+var scanKernels = []scanKernel{
+	{"dvScanQuoteBackslash", dvScanQuoteBackslash, referenceScanQuoteBackslash},
+	{"swarScanQuoteBackslash", swarScanQuoteBackslash, referenceScanQuoteBackslash},
+	{"dvScanStringSpecial", dvScanStringSpecial, referenceScanStringSpecial},
+	{"swarScanStringSpecial", swarScanStringSpecial, referenceScanStringSpecial},
+}
+
+// TestSWARScannersMatchReference pins the word- and vector-boundary behavior
+// every kernel must preserve: every special byte class at every lane offset
+// of two vectors, straddling loads, borrow-adjacent lanes, and truncated
+// tails.
 func TestSWARScannersMatchReference(t *testing.T) {
 	t.Parallel()
 
 	classes := []byte{'"', '\\', 0x00, 0x01, 0x1F, 0x7F + 1, 0xFF, 0xE3}
-	pad := strings.Repeat("a", 40)
+	pad := strings.Repeat("a", 80)
 
-	inputs := make([][]byte, 0, len(classes)*18+10)
-	// Each special class at each offset 0..17, with clean tails.
+	inputs := make([][]byte, 0, len(classes)*66+12)
+	// Each special class at each offset 0..65, with clean tails.
 	for _, c := range classes {
-		for off := range 18 {
+		for off := range 66 {
 			b := []byte(pad[:off])
 			b = append(b, c)
 			b = append(b, pad[:11]...)
@@ -57,6 +74,9 @@ func TestSWARScannersMatchReference(t *testing.T) {
 		[]byte(pad),                      // clean run, no special byte
 		[]byte(pad[:8]),                  // exactly one word
 		[]byte(pad[:7]),                  // tail only
+		[]byte(pad[:32]),                 // exactly one vector
+		[]byte(pad[:31]+"\x80"+pad[:8]),  // non-ASCII at the last vector lane
+		[]byte(pad[:32]+"\x1f"),          // control byte in the SWAR tail
 		[]byte("\x1f\x20"),               // borrow-adjacent: control then 0x20
 		[]byte("aaaaaaa\x1f\x20aaaaaaa"), // borrow lane straddles a word edge
 		[]byte("aaaaaaaa\"after"),        // special exactly at lane 0 of word 2
@@ -66,32 +86,45 @@ func TestSWARScannersMatchReference(t *testing.T) {
 
 	for _, in := range inputs {
 		for start := 0; start <= len(in) && start <= 3; start++ {
-			if got, want := dvScanQuoteBackslash(in, start), referenceScanQuoteBackslash(in, start); got != want {
-				t.Fatalf("dvScanQuoteBackslash(%q, %d) = %d, want %d", in, start, got, want)
-			}
-			if got, want := dvScanStringSpecial(in, start), referenceScanStringSpecial(in, start); got != want {
-				t.Fatalf("dvScanStringSpecial(%q, %d) = %d, want %d", in, start, got, want)
+			for _, k := range scanKernels {
+				if got, want := k.scan(in, start), k.reference(in, start); got != want {
+					t.Fatalf("%s(%q, %d) = %d, want %d", k.name, in, start, got, want)
+				}
 			}
 		}
 	}
 }
 
-// FuzzSWARScanners proves both SWAR classifiers agree with their
-// byte-at-a-time references for arbitrary inputs and start offsets.
+// FuzzSWARScanners proves every scanner kernel agrees with its byte-at-a-time
+// reference for arbitrary inputs and start offsets.
 func FuzzSWARScanners(f *testing.F) {
 	f.Add([]byte(`{"uri":"file:///a/b.go","range":{}}`), 0)
 	f.Add([]byte("aaaaaaa\x1f\x20"), 0)
 	f.Add([]byte("plain ascii with \\\" escapes"), 3)
+	f.Add([]byte(strings.Repeat("x", 40)+"\xe6\x97\xa5\""), 1)
 
 	f.Fuzz(func(t *testing.T, raw []byte, start int) {
 		if start < 0 || start > len(raw) {
 			return
 		}
-		if got, want := dvScanQuoteBackslash(raw, start), referenceScanQuoteBackslash(raw, start); got != want {
-			t.Fatalf("dvScanQuoteBackslash(%q, %d) = %d, want %d", raw, start, got, want)
-		}
-		if got, want := dvScanStringSpecial(raw, start), referenceScanStringSpecial(raw, start); got != want {
-			t.Fatalf("dvScanStringSpecial(%q, %d) = %d, want %d", raw, start, got, want)
+		for _, k := range scanKernels {
+			if got, want := k.scan(raw, start), k.reference(raw, start); got != want {
+				t.Fatalf("%s(%q, %d) = %d, want %d", k.name, raw, start, got, want)
+			}
 		}
 	})
 }
+
+func BenchmarkScanKernels(b *testing.B) {
+	raw := []byte(strings.Repeat("documentation text ", 64) + `"`)
+	for _, k := range scanKernels {
+		b.Run(k.name, func(b *testing.B) {
+			b.SetBytes(int64(len(raw)))
+			for b.Loop() {
+				if k.scan(raw, 0) != len(raw)-1 {
+					b.Fatal("scan stopped early")
+				}
+			}
+		})
+	}
+}
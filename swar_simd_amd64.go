@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build goexperiment.simd && amd64
+
+package protocol
+
+import (
+	"math/bits"
+	"simd/archsimd"
+)
+
+// The AVX2 kernels classify 32 bytes per step and hand the last partial
+// block to the SWAR kernels. They run whenever at least one vector of input
+// is left after i. Callers pass the whole message buffer, not the string
+// being read, so even short keys and identifiers take this path unless they
+// sit in the message's last 32 bytes. For them the first vector usually
+// holds the closing quote, so the scan costs one load and compare.
+
+// simdBlock is the width of one AVX2 vector.
+const simdBlock = 32
+
+// hasAVX2 reports whether the CPU runs the AVX2 kernels.
+var hasAVX2 = archsimd.X86.AVX2()
+
+// dvScanQuoteBackslash returns the index of the first '"' or '\\' at or after
+// i, or len(raw) when neither occurs.
+func dvScanQuoteBackslash(raw []byte, i int) int {
+	if hasAVX2 && len(raw)-i >= simdBlock {
+		return avx2ScanQuoteBackslash(raw, i)
+	}
+	return swarScanQuoteBackslash(raw, i)
+}
+
+// dvScanStringSpecial returns the index of the first byte at or after i that
+// the zero-copy string fast path cannot consume verbatim: a quote, a
+// backslash, a control byte, or a non-ASCII byte. Returns len(raw) when the
+// run is clean to the end.
+func dvScanStringSpecial(raw []byte, i int) int {
+	if hasAVX2 && len(raw)-i >= simdBlock {
+		return avx2ScanStringSpecial(raw, i)
+	}
+	return swarScanStringSpecial(raw, i)
+}
+
+// avx2ScanQuoteBackslash is swarScanQuoteBackslash 32 bytes at a time.
+func avx2ScanQuoteBackslash(raw []byte, i int) int {
+	quote := archsimd.BroadcastUint8x32('"')
+	backslash := archsimd.BroadcastUint8x32('\\')
+	for ; i+simdBlock <= len(raw); i += simdBlock {
+		v := archsimd.LoadUint8x32(raw[i : i+simdBlock])
+		if m := v.Equal(quote).Or(v.Equal(backslash)).ToBits(); m != 0 {
+			return i + bits.TrailingZeros32(m)
+		}
+	}
+	return swarScanQuoteBackslash(raw, i)
+}
+
+// avx2ScanStringSpecial is swarScanStringSpecial 32 bytes at a time. Read as
+// signed, every non-ASCII byte is negative, so a single signed compare
+// against 0x20 flags control and non-ASCII bytes together.
+func avx2ScanStringSpecial(raw []byte, i int) int {
+	quote := archsimd.BroadcastUint8x32('"')
+	backslash := archsimd.BroadcastUint8x32('\\')
+	space := archsimd.BroadcastInt8x32(0x20)
+	for ; i+simdBlock <= len(raw); i += simdBlock {
+		v := archsimd.LoadUint8x32(raw[i : i+simdBlock])
+		m := v.Equal(quote).Or(v.Equal(backslash)).Or(space.Greater(v.AsInt8x32())).ToBits()
+		if m != 0 {
+			return i + bits.TrailingZeros32(m)
+		}
+	}
+	return swarScanStringSpecial(raw, i)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ApplyContentChanges applies changes, in order, to text as a
+// textDocument/didChange notification describes them and returns the new
+// text. Each change is computed on the text the previous one produced, a
+// [TextDocumentContentChangeWholeDocument] replacing it outright. Positions
+// count characters in encoding, the position encoding negotiated at
+// initialization; empty means UTF-16. See [PositionOffset] for how
+// positions past the end of a line or the text are treated.
+func ApplyContentChanges(text string, changes []TextDocumentContentChangeEvent, encoding PositionEncodingKind) (string, error) {
+	for i, change := range changes {
+		switch change := change.(type) {
+		case *TextDocumentContentChangeWholeDocument:
+			text = change.Text
+		case *TextDocumentContentChangePartial:
+			start := PositionOffset(text, change.Range.Start, encoding)
+			end := PositionOffset(text, change.Range.End, encoding)
+			if start > end {
+				return "", fmt.Errorf("protocol: content change %d: range start %d:%d is after its end %d:%d", i,
+					change.Range.Start.Line, change.Range.Start.Character, change.Range.End.Line, change.Range.End.Character)
+			}
+			text = text[:start] + change.Text + text[end:]
+		default:
+			return "", fmt.Errorf("protocol: content change %d: unexpected %T", i, change)
+		}
+	}
+	return text, nil
+}
+
+// PositionOffset returns the byte offset in text of pos, whose character
+// counts code units of encoding; empty means UTF-16. Lines end at "\n",
+// "\r\n" or "\r". As the specification asks, a character past the end of
+// its line means the end of the line; a line past the last one means the
+// end of text. A position inside a character's encoding resolves to the
+// start of that character.
+func PositionOffset(text string, pos Position, encoding PositionEncodingKind) int {
+	offset := 0
+	for range pos.Line {
+		i := strings.IndexAny(text[offset:], "\r\n")
+		if i < 0 {
+			return len(text)
+		}
+		offset += i + 1
+		if text[offset-1] == '\r' && offset < len(text) && text[offset] == '\n' {
+			offset++
+		}
+	}
+
+	units := uint32(0)
+	for offset < len(text) && units < pos.Character {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == '\r' || r == '\n' {
+			break
+		}
+		n := positionUnits(r, size, encoding)
+		if units+n > pos.Character {
+			break
+		}
+		units += n
+		offset += size
+	}
+	return offset
+}
+
+// positionUnits returns how many code units of encoding the rune r,
+// encoded in size bytes of UTF-8, counts for.
+func positionUnits(r rune, size int, encoding PositionEncodingKind) uint32 {
+	switch encoding {
+	case PositionEncodingKindUTF8:
+		return uint32(size)
+	case PositionEncodingKindUTF32:
+		return 1
+	default:
+		if r >= 0x10000 {
+			return 2
+		}
+		return 1
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"testing"
+)
+
+func TestPositionOffset(t *testing.T) {
+	t.Parallel()
+
+	const text = "a𝄞b\r\nçd\re\n"
+	tests := map[string]struct {
+		pos      Position
+		encoding PositionEncodingKind
+		want     int
+	}{
+		"success: start":                       {pos: Position{}, want: 0},
+		"success: utf-16 after surrogate pair": {pos: Position{Character: 3}, want: 5},
+		"success: utf-16 inside surrogate":     {pos: Position{Character: 2}, want: 1},
+		"success: utf-8":                       {pos: Position{Character: 5}, encoding: PositionEncodingKindUTF8, want: 5},
+		"success: utf-32":                      {pos: Position{Character: 2}, encoding: PositionEncodingKindUTF32, want: 5},
+		"success: past end of line":            {pos: Position{Character: 99}, want: 6},
+		"success: after crlf":                  {pos: Position{Line: 1, Character: 1}, want: 10},
+		"success: after cr":                    {pos: Position{Line: 2}, want: 12},
+		"success: last empty line":             {pos: Position{Line: 3}, want: len(text)},
+		"success: past last line":              {pos: Position{Line: 9, Character: 4}, want: len(text)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := PositionOffset(text, tt.pos, tt.encoding); got != tt.want {
+				t.Errorf("PositionOffset(%v, %q) = %d, want %d", tt.pos, tt.encoding, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyContentChanges(t *testing.T) {
+	t.Parallel()
+
+	rng := func(l1, c1, l2, c2 uint32) Range {
+		return Range{Start: Position{Line: l1, Character: c1}, End: Position{Line: l2, Character: c2}}
+	}
+	tests := map[string]struct {
+		text    string
+		changes []TextDocumentContentChangeEvent
+		want    string
+		wantErr bool
+	}{
+		"success: insert": {
+			text:    "fmt.Println()\n",
+			changes: []TextDocumentContentChangeEvent{&TextDocumentContentChangePartial{Range: rng(0, 12, 0, 12), Text: `"hi"`}},
+			want:    "fmt.Println(\"hi\")\n",
+		},
+		"success: sequential changes see earlier ones": {
+			text: "a\nb\n",
+			changes: []TextDocumentContentChangeEvent{
+				&TextDocumentContentChangePartial{Range: rng(0, 1, 1, 0), Text: ""},
+				&TextDocumentContentChangePartial{Range: rng(0, 0, 0, 2), Text: "c"},
+			},
+			want: "c\n",
+		},
+		"success: whole document": {
+			text: "old",
+			changes: []TextDocumentContentChangeEvent{
+				&TextDocumentContentChangePartial{Range: rng(0, 0, 0, 1), Text: "x"},
+				&TextDocumentContentChangeWholeDocument{Text: "new"},
+				&TextDocumentContentChangePartial{Range: rng(0, 3, 0, 3), Text: "!"},
+			},
+			want: "new!",
+		},
+		"error: inverted range": {
+			text:    "abc",
+			changes: []TextDocumentContentChangeEvent{&TextDocumentContentChangePartial{Range: rng(0, 2, 0, 1)}},
+			wantErr: true,
+		},
+		"error: nil change": {
+			text:    "abc",
+			changes: []TextDocumentContentChangeEvent{nil},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyContentChanges(tt.text, tt.changes, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyContentChanges() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ApplyContentChanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
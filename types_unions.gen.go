@@ -6,7 +6,6 @@
 package protocol
 
 import (
-	"fmt"
 	"github.com/go-json-experiment/json/jsontext"
 )
 
@@ -66,7 +65,8 @@ type SemanticTokensOptionsRange struct{}
 // by the client.
 type Declaration interface{ isDeclaration() }
 
-func (*Location) isDeclaration()     {}
+func (*Location) isDeclaration() {}
+
 func (LocationSlice) isDeclaration() {}
 
 func unmarshalDeclaration(dec *jsontext.Decoder, val *Declaration) error {
@@ -112,7 +112,7 @@ func unmarshalDeclarationValue(raw jsontext.Value, val *Declaration) error {
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into Declaration", raw)
+	return newUnionError("Declaration", raw)
 }
 
 // InlineValue Inline value information can be provided by different means:
@@ -124,8 +124,10 @@ func unmarshalDeclarationValue(raw jsontext.Value, val *Declaration) error {
 // Since: 3.17.0
 type InlineValue interface{ isInlineValue() }
 
-func (*InlineValueText) isInlineValue()                  {}
-func (*InlineValueVariableLookup) isInlineValue()        {}
+func (*InlineValueText) isInlineValue() {}
+
+func (*InlineValueVariableLookup) isInlineValue() {}
+
 func (*InlineValueEvaluatableExpression) isInlineValue() {}
 
 func unmarshalInlineValue(dec *jsontext.Decoder, val *InlineValue) error {
@@ -206,7 +208,7 @@ func unmarshalInlineValueValue(raw jsontext.Value, val *InlineValue) error {
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlineValue", raw)
+	return newUnionError("InlineValue", raw)
 }
 
 // DocumentDiagnosticReport The result of a document diagnostic pull request. A report can
@@ -218,7 +220,8 @@ func unmarshalInlineValueValue(raw jsontext.Value, val *InlineValue) error {
 // Since: 3.17.0
 type DocumentDiagnosticReport interface{ isDocumentDiagnosticReport() }
 
-func (*RelatedFullDocumentDiagnosticReport) isDocumentDiagnosticReport()      {}
+func (*RelatedFullDocumentDiagnosticReport) isDocumentDiagnosticReport() {}
+
 func (*RelatedUnchangedDocumentDiagnosticReport) isDocumentDiagnosticReport() {}
 
 func unmarshalDocumentDiagnosticReport(dec *jsontext.Decoder, val *DocumentDiagnosticReport) error {
@@ -254,14 +257,16 @@ func unmarshalDocumentDiagnosticReportValue(raw jsontext.Value, val *DocumentDia
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentDiagnosticReport", raw)
+	return newUnionError("DocumentDiagnosticReport", raw)
 }
 
 // PrepareRenameResult is defined by the LSP specification.
 type PrepareRenameResult interface{ isPrepareRenameResult() }
 
-func (*Range) isPrepareRenameResult()                        {}
-func (*PrepareRenamePlaceholder) isPrepareRenameResult()     {}
+func (*Range) isPrepareRenameResult() {}
+
+func (*PrepareRenamePlaceholder) isPrepareRenameResult() {}
+
 func (*PrepareRenameDefaultBehavior) isPrepareRenameResult() {}
 
 func unmarshalPrepareRenameResult(dec *jsontext.Decoder, val *PrepareRenameResult) error {
@@ -342,14 +347,15 @@ func unmarshalPrepareRenameResultValue(raw jsontext.Value, val *PrepareRenameRes
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into PrepareRenameResult", raw)
+	return newUnionError("PrepareRenameResult", raw)
 }
 
 // ProgressToken is defined by the LSP specification.
 type ProgressToken interface{ isProgressToken() }
 
 func (Integer) isProgressToken() {}
-func (String) isProgressToken()  {}
+
+func (String) isProgressToken() {}
 
 func unmarshalProgressToken(dec *jsontext.Decoder, val *ProgressToken) error {
 	raw, err := dec.ReadValue()
@@ -379,7 +385,7 @@ func unmarshalProgressTokenValue(raw jsontext.Value, val *ProgressToken) error {
 		*val = String(v)
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into ProgressToken", raw)
+	return newUnionError("ProgressToken", raw)
 }
 
 // WorkspaceDocumentDiagnosticReport A workspace diagnostic document report.
@@ -387,7 +393,8 @@ func unmarshalProgressTokenValue(raw jsontext.Value, val *ProgressToken) error {
 // Since: 3.17.0
 type WorkspaceDocumentDiagnosticReport interface{ isWorkspaceDocumentDiagnosticReport() }
 
-func (*WorkspaceFullDocumentDiagnosticReport) isWorkspaceDocumentDiagnosticReport()      {}
+func (*WorkspaceFullDocumentDiagnosticReport) isWorkspaceDocumentDiagnosticReport() {}
+
 func (*WorkspaceUnchangedDocumentDiagnosticReport) isWorkspaceDocumentDiagnosticReport() {}
 
 func unmarshalWorkspaceDocumentDiagnosticReport(dec *jsontext.Decoder, val *WorkspaceDocumentDiagnosticReport) error {
@@ -423,14 +430,15 @@ func unmarshalWorkspaceDocumentDiagnosticReportValue(raw jsontext.Value, val *Wo
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into WorkspaceDocumentDiagnosticReport", raw)
+	return newUnionError("WorkspaceDocumentDiagnosticReport", raw)
 }
 
 // TextDocumentContentChangeEvent An event describing a change to a text document. If only a text is provided
 // it is considered to be the full content of the document.
 type TextDocumentContentChangeEvent interface{ isTextDocumentContentChangeEvent() }
 
-func (*TextDocumentContentChangePartial) isTextDocumentContentChangeEvent()       {}
+func (*TextDocumentContentChangePartial) isTextDocumentContentChangeEvent() {}
+
 func (*TextDocumentContentChangeWholeDocument) isTextDocumentContentChangeEvent() {}
 
 func unmarshalTextDocumentContentChangeEvent(dec *jsontext.Decoder, val *TextDocumentContentChangeEvent) error {
@@ -490,7 +498,7 @@ func unmarshalTextDocumentContentChangeEventValue(raw jsontext.Value, val *TextD
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TextDocumentContentChangeEvent", raw)
+	return newUnionError("TextDocumentContentChangeEvent", raw)
 }
 
 // MarkedString MarkedString can be used to render human readable text. It is either a markdown string
@@ -508,7 +516,8 @@ func unmarshalTextDocumentContentChangeEventValue(raw jsontext.Value, val *TextD
 // Deprecated: use MarkupContent instead.
 type MarkedString interface{ isMarkedString() }
 
-func (String) isMarkedString()                    {}
+func (String) isMarkedString() {}
+
 func (*MarkedStringWithLanguage) isMarkedString() {}
 
 func unmarshalMarkedString(dec *jsontext.Decoder, val *MarkedString) error {
@@ -554,7 +563,7 @@ func unmarshalMarkedStringValue(raw jsontext.Value, val *MarkedString) error {
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into MarkedString", raw)
+	return newUnionError("MarkedString", raw)
 }
 
 // DocumentFilter A document filter describes a top level text document or
@@ -565,9 +574,12 @@ func unmarshalMarkedStringValue(raw jsontext.Value, val *MarkedString) error {
 // Since: 3.17.0 - support for NotebookCellTextDocumentFilter.
 type DocumentFilter interface{ isDocumentFilter() }
 
-func (*TextDocumentFilterLanguage) isDocumentFilter()     {}
-func (*TextDocumentFilterScheme) isDocumentFilter()       {}
-func (*TextDocumentFilterPattern) isDocumentFilter()      {}
+func (*TextDocumentFilterLanguage) isDocumentFilter() {}
+
+func (*TextDocumentFilterScheme) isDocumentFilter() {}
+
+func (*TextDocumentFilterPattern) isDocumentFilter() {}
+
 func (*NotebookCellTextDocumentFilter) isDocumentFilter() {}
 
 func unmarshalDocumentFilter(dec *jsontext.Decoder, val *DocumentFilter) error {
@@ -669,7 +681,7 @@ func unmarshalDocumentFilterValue(raw jsontext.Value, val *DocumentFilter) error
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentFilter", raw)
+	return newUnionError("DocumentFilter", raw)
 }
 
 // GlobPattern The glob pattern. Either a string pattern or a relative pattern.
@@ -677,7 +689,8 @@ func unmarshalDocumentFilterValue(raw jsontext.Value, val *DocumentFilter) error
 // Since: 3.17.0
 type GlobPattern interface{ isGlobPattern() }
 
-func (Pattern) isGlobPattern()          {}
+func (Pattern) isGlobPattern() {}
+
 func (*RelativePattern) isGlobPattern() {}
 
 func unmarshalGlobPattern(dec *jsontext.Decoder, val *GlobPattern) error {
@@ -723,7 +736,7 @@ func unmarshalGlobPatternValue(raw jsontext.Value, val *GlobPattern) error {
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into GlobPattern", raw)
+	return newUnionError("GlobPattern", raw)
 }
 
 // TextDocumentFilter A document filter denotes a document by different properties like
@@ -745,8 +758,10 @@ func unmarshalGlobPatternValue(raw jsontext.Value, val *GlobPattern) error {
 type TextDocumentFilter interface{ isTextDocumentFilter() }
 
 func (*TextDocumentFilterLanguage) isTextDocumentFilter() {}
-func (*TextDocumentFilterScheme) isTextDocumentFilter()   {}
-func (*TextDocumentFilterPattern) isTextDocumentFilter()  {}
+
+func (*TextDocumentFilterScheme) isTextDocumentFilter() {}
+
+func (*TextDocumentFilterPattern) isTextDocumentFilter() {}
 
 func unmarshalTextDocumentFilter(dec *jsontext.Decoder, val *TextDocumentFilter) error {
 	raw, err := dec.ReadValue()
@@ -826,7 +841,7 @@ func unmarshalTextDocumentFilterValue(raw jsontext.Value, val *TextDocumentFilte
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TextDocumentFilter", raw)
+	return newUnionError("TextDocumentFilter", raw)
 }
 
 // NotebookDocumentFilter A notebook document filter denotes a notebook document by
@@ -837,8 +852,10 @@ func unmarshalTextDocumentFilterValue(raw jsontext.Value, val *TextDocumentFilte
 type NotebookDocumentFilter interface{ isNotebookDocumentFilter() }
 
 func (*NotebookDocumentFilterNotebookType) isNotebookDocumentFilter() {}
-func (*NotebookDocumentFilterScheme) isNotebookDocumentFilter()       {}
-func (*NotebookDocumentFilterPattern) isNotebookDocumentFilter()      {}
+
+func (*NotebookDocumentFilterScheme) isNotebookDocumentFilter() {}
+
+func (*NotebookDocumentFilterPattern) isNotebookDocumentFilter() {}
 
 func unmarshalNotebookDocumentFilter(dec *jsontext.Decoder, val *NotebookDocumentFilter) error {
 	raw, err := dec.ReadValue()
@@ -918,14 +935,15 @@ func unmarshalNotebookDocumentFilterValue(raw jsontext.Value, val *NotebookDocum
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into NotebookDocumentFilter", raw)
+	return newUnionError("NotebookDocumentFilter", raw)
 }
 
 // CompletionResult is one of: CompletionItemSlice, *CompletionList.
 type CompletionResult interface{ isCompletionResult() }
 
 func (CompletionItemSlice) isCompletionResult() {}
-func (*CompletionList) isCompletionResult()     {}
+
+func (*CompletionList) isCompletionResult() {}
 
 func unmarshalCompletionResult(dec *jsontext.Decoder, val *CompletionResult) error {
 	raw, err := dec.ReadValue()
@@ -970,14 +988,16 @@ func unmarshalCompletionResultValue(raw jsontext.Value, val *CompletionResult) e
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into CompletionResult", raw)
+	return newUnionError("CompletionResult", raw)
 }
 
 // DeclarationResult is one of: *Location, LocationSlice, DeclarationLinkSlice.
 type DeclarationResult interface{ isDeclarationResult() }
 
-func (*Location) isDeclarationResult()            {}
-func (LocationSlice) isDeclarationResult()        {}
+func (*Location) isDeclarationResult() {}
+
+func (LocationSlice) isDeclarationResult() {}
+
 func (DeclarationLinkSlice) isDeclarationResult() {}
 
 func unmarshalDeclarationResult(dec *jsontext.Decoder, val *DeclarationResult) error {
@@ -1059,14 +1079,16 @@ func unmarshalDeclarationResultValue(raw jsontext.Value, val *DeclarationResult)
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DeclarationResult", raw)
+	return newUnionError("DeclarationResult", raw)
 }
 
 // DefinitionResult is one of: *Location, LocationSlice, DefinitionLinkSlice.
 type DefinitionResult interface{ isDefinitionResult() }
 
-func (*Location) isDefinitionResult()           {}
-func (LocationSlice) isDefinitionResult()       {}
+func (*Location) isDefinitionResult() {}
+
+func (LocationSlice) isDefinitionResult() {}
+
 func (DefinitionLinkSlice) isDefinitionResult() {}
 
 func unmarshalDefinitionResult(dec *jsontext.Decoder, val *DefinitionResult) error {
@@ -1148,14 +1170,15 @@ func unmarshalDefinitionResultValue(raw jsontext.Value, val *DefinitionResult) e
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DefinitionResult", raw)
+	return newUnionError("DefinitionResult", raw)
 }
 
 // DocumentSymbolResult is one of: SymbolInformationSlice, DocumentSymbolSlice.
 type DocumentSymbolResult interface{ isDocumentSymbolResult() }
 
 func (SymbolInformationSlice) isDocumentSymbolResult() {}
-func (DocumentSymbolSlice) isDocumentSymbolResult()    {}
+
+func (DocumentSymbolSlice) isDocumentSymbolResult() {}
 
 func unmarshalDocumentSymbolResult(dec *jsontext.Decoder, val *DocumentSymbolResult) error {
 	raw, err := dec.ReadValue()
@@ -1214,13 +1237,14 @@ func unmarshalDocumentSymbolResultValue(raw jsontext.Value, val *DocumentSymbolR
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentSymbolResult", raw)
+	return newUnionError("DocumentSymbolResult", raw)
 }
 
 // InlineCompletionResult is one of: *InlineCompletionList, InlineCompletionItemSlice.
 type InlineCompletionResult interface{ isInlineCompletionResult() }
 
-func (*InlineCompletionList) isInlineCompletionResult()     {}
+func (*InlineCompletionList) isInlineCompletionResult() {}
+
 func (InlineCompletionItemSlice) isInlineCompletionResult() {}
 
 func unmarshalInlineCompletionResult(dec *jsontext.Decoder, val *InlineCompletionResult) error {
@@ -1266,13 +1290,14 @@ func unmarshalInlineCompletionResultValue(raw jsontext.Value, val *InlineComplet
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlineCompletionResult", raw)
+	return newUnionError("InlineCompletionResult", raw)
 }
 
 // SemanticTokensDeltaResult is one of: *SemanticTokens, *SemanticTokensDelta.
 type SemanticTokensDeltaResult interface{ isSemanticTokensDeltaResult() }
 
-func (*SemanticTokens) isSemanticTokensDeltaResult()      {}
+func (*SemanticTokens) isSemanticTokensDeltaResult() {}
+
 func (*SemanticTokensDelta) isSemanticTokensDeltaResult() {}
 
 func unmarshalSemanticTokensDeltaResult(dec *jsontext.Decoder, val *SemanticTokensDeltaResult) error {
@@ -1332,14 +1357,15 @@ func unmarshalSemanticTokensDeltaResultValue(raw jsontext.Value, val *SemanticTo
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into SemanticTokensDeltaResult", raw)
+	return newUnionError("SemanticTokensDeltaResult", raw)
 }
 
 // WorkspaceSymbolResult is one of: SymbolInformationSlice, WorkspaceSymbolSlice.
 type WorkspaceSymbolResult interface{ isWorkspaceSymbolResult() }
 
 func (SymbolInformationSlice) isWorkspaceSymbolResult() {}
-func (WorkspaceSymbolSlice) isWorkspaceSymbolResult()   {}
+
+func (WorkspaceSymbolSlice) isWorkspaceSymbolResult() {}
 
 func unmarshalWorkspaceSymbolResult(dec *jsontext.Decoder, val *WorkspaceSymbolResult) error {
 	raw, err := dec.ReadValue()
@@ -1398,16 +1424,19 @@ func unmarshalWorkspaceSymbolResultValue(raw jsontext.Value, val *WorkspaceSymbo
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into WorkspaceSymbolResult", raw)
+	return newUnionError("WorkspaceSymbolResult", raw)
 }
 
 // DocumentChange is one of: *TextDocumentEdit, *CreateFile, *RenameFile, *DeleteFile.
 type DocumentChange interface{ isDocumentChange() }
 
 func (*TextDocumentEdit) isDocumentChange() {}
-func (*CreateFile) isDocumentChange()       {}
-func (*RenameFile) isDocumentChange()       {}
-func (*DeleteFile) isDocumentChange()       {}
+
+func (*CreateFile) isDocumentChange() {}
+
+func (*RenameFile) isDocumentChange() {}
+
+func (*DeleteFile) isDocumentChange() {}
 
 func unmarshalDocumentChange(dec *jsontext.Decoder, val *DocumentChange) error {
 	raw, err := dec.ReadValue()
@@ -1470,13 +1499,14 @@ func unmarshalDocumentChangeValue(raw jsontext.Value, val *DocumentChange) error
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentChange", raw)
+	return newUnionError("DocumentChange", raw)
 }
 
 // InlayHintLabel is one of: String, InlayHintLabelPartSlice.
 type InlayHintLabel interface{ isInlayHintLabel() }
 
-func (String) isInlayHintLabel()                  {}
+func (String) isInlayHintLabel() {}
+
 func (InlayHintLabelPartSlice) isInlayHintLabel() {}
 
 func unmarshalInlayHintLabel(dec *jsontext.Decoder, val *InlayHintLabel) error {
@@ -1507,13 +1537,14 @@ func unmarshalInlayHintLabelValue(raw jsontext.Value, val *InlayHintLabel) error
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlayHintLabel", raw)
+	return newUnionError("InlayHintLabel", raw)
 }
 
 // InlayHintTooltip is one of: String, *MarkupContent.
 type InlayHintTooltip interface{ isInlayHintTooltip() }
 
-func (String) isInlayHintTooltip()         {}
+func (String) isInlayHintTooltip() {}
+
 func (*MarkupContent) isInlayHintTooltip() {}
 
 func unmarshalInlayHintTooltip(dec *jsontext.Decoder, val *InlayHintTooltip) error {
@@ -1559,13 +1590,14 @@ func unmarshalInlayHintTooltipValue(raw jsontext.Value, val *InlayHintTooltip) e
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlayHintTooltip", raw)
+	return newUnionError("InlayHintTooltip", raw)
 }
 
 // InlineCompletionItemInsertText is one of: String, *StringValue.
 type InlineCompletionItemInsertText interface{ isInlineCompletionItemInsertText() }
 
-func (String) isInlineCompletionItemInsertText()       {}
+func (String) isInlineCompletionItemInsertText() {}
+
 func (*StringValue) isInlineCompletionItemInsertText() {}
 
 func unmarshalInlineCompletionItemInsertText(dec *jsontext.Decoder, val *InlineCompletionItemInsertText) error {
@@ -1601,13 +1633,14 @@ func unmarshalInlineCompletionItemInsertTextValue(raw jsontext.Value, val *Inlin
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlineCompletionItemInsertText", raw)
+	return newUnionError("InlineCompletionItemInsertText", raw)
 }
 
 // DidChangeConfigurationRegistrationOptionsSection is one of: String, StringSlice.
 type DidChangeConfigurationRegistrationOptionsSection interface{ isDidChangeConfigurationRegistrationOptionsSection() }
 
-func (String) isDidChangeConfigurationRegistrationOptionsSection()      {}
+func (String) isDidChangeConfigurationRegistrationOptionsSection() {}
+
 func (StringSlice) isDidChangeConfigurationRegistrationOptionsSection() {}
 
 func unmarshalDidChangeConfigurationRegistrationOptionsSection(dec *jsontext.Decoder, val *DidChangeConfigurationRegistrationOptionsSection) error {
@@ -1638,13 +1671,14 @@ func unmarshalDidChangeConfigurationRegistrationOptionsSectionValue(raw jsontext
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into DidChangeConfigurationRegistrationOptionsSection", raw)
+	return newUnionError("DidChangeConfigurationRegistrationOptionsSection", raw)
 }
 
 // CompletionItemTextEdit is one of: *TextEdit, *InsertReplaceEdit.
 type CompletionItemTextEdit interface{ isCompletionItemTextEdit() }
 
-func (*TextEdit) isCompletionItemTextEdit()          {}
+func (*TextEdit) isCompletionItemTextEdit() {}
+
 func (*InsertReplaceEdit) isCompletionItemTextEdit() {}
 
 func unmarshalCompletionItemTextEdit(dec *jsontext.Decoder, val *CompletionItemTextEdit) error {
@@ -1704,16 +1738,19 @@ func unmarshalCompletionItemTextEditValue(raw jsontext.Value, val *CompletionIte
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into CompletionItemTextEdit", raw)
+	return newUnionError("CompletionItemTextEdit", raw)
 }
 
 // HoverContents is one of: *MarkupContent, String, *MarkedStringWithLanguage, MarkedStringSlice.
 type HoverContents interface{ isHoverContents() }
 
-func (*MarkupContent) isHoverContents()            {}
-func (String) isHoverContents()                    {}
+func (*MarkupContent) isHoverContents() {}
+
+func (String) isHoverContents() {}
+
 func (*MarkedStringWithLanguage) isHoverContents() {}
-func (MarkedStringSlice) isHoverContents()         {}
+
+func (MarkedStringSlice) isHoverContents() {}
 
 func unmarshalHoverContents(dec *jsontext.Decoder, val *HoverContents) error {
 	raw, err := dec.ReadValue()
@@ -1786,13 +1823,14 @@ func unmarshalHoverContentsValue(raw jsontext.Value, val *HoverContents) error {
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into HoverContents", raw)
+	return newUnionError("HoverContents", raw)
 }
 
 // WorkspaceSymbolLocation is one of: *Location, *LocationUriOnly.
 type WorkspaceSymbolLocation interface{ isWorkspaceSymbolLocation() }
 
-func (*Location) isWorkspaceSymbolLocation()        {}
+func (*Location) isWorkspaceSymbolLocation() {}
+
 func (*LocationUriOnly) isWorkspaceSymbolLocation() {}
 
 func unmarshalWorkspaceSymbolLocation(dec *jsontext.Decoder, val *WorkspaceSymbolLocation) error {
@@ -1852,13 +1890,14 @@ func unmarshalWorkspaceSymbolLocationValue(raw jsontext.Value, val *WorkspaceSym
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into WorkspaceSymbolLocation", raw)
+	return newUnionError("WorkspaceSymbolLocation", raw)
 }
 
 // SemanticTokensOptionsFull is one of: Boolean, *SemanticTokensFullDelta.
 type SemanticTokensOptionsFull interface{ isSemanticTokensOptionsFull() }
 
-func (Boolean) isSemanticTokensOptionsFull()                  {}
+func (Boolean) isSemanticTokensOptionsFull() {}
+
 func (*SemanticTokensFullDelta) isSemanticTokensOptionsFull() {}
 
 func unmarshalSemanticTokensOptionsFull(dec *jsontext.Decoder, val *SemanticTokensOptionsFull) error {
@@ -1897,15 +1936,17 @@ func unmarshalSemanticTokensOptionsFullValue(raw jsontext.Value, val *SemanticTo
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into SemanticTokensOptionsFull", raw)
+	return newUnionError("SemanticTokensOptionsFull", raw)
 }
 
 // TextDocumentEditElement is one of: *TextEdit, *AnnotatedTextEdit, *SnippetTextEdit.
 type TextDocumentEditElement interface{ isTextDocumentEditElement() }
 
-func (*TextEdit) isTextDocumentEditElement()          {}
+func (*TextEdit) isTextDocumentEditElement() {}
+
 func (*AnnotatedTextEdit) isTextDocumentEditElement() {}
-func (*SnippetTextEdit) isTextDocumentEditElement()   {}
+
+func (*SnippetTextEdit) isTextDocumentEditElement() {}
 
 func unmarshalTextDocumentEditElement(dec *jsontext.Decoder, val *TextDocumentEditElement) error {
 	raw, err := dec.ReadValue()
@@ -1985,14 +2026,15 @@ func unmarshalTextDocumentEditElementValue(raw jsontext.Value, val *TextDocument
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TextDocumentEditElement", raw)
+	return newUnionError("TextDocumentEditElement", raw)
 }
 
 // NotebookSelector is one of: *NotebookDocumentFilterWithNotebook, *NotebookDocumentFilterWithCells.
 type NotebookSelector interface{ isNotebookSelector() }
 
 func (*NotebookDocumentFilterWithNotebook) isNotebookSelector() {}
-func (*NotebookDocumentFilterWithCells) isNotebookSelector()    {}
+
+func (*NotebookDocumentFilterWithCells) isNotebookSelector() {}
 
 func unmarshalNotebookSelector(dec *jsontext.Decoder, val *NotebookSelector) error {
 	raw, err := dec.ReadValue()
@@ -2051,14 +2093,15 @@ func unmarshalNotebookSelectorValue(raw jsontext.Value, val *NotebookSelector) e
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into NotebookSelector", raw)
+	return newUnionError("NotebookSelector", raw)
 }
 
 // TextDocumentSync is one of: *TextDocumentSyncOptions, TextDocumentSyncKind.
 type TextDocumentSync interface{ isTextDocumentSync() }
 
 func (*TextDocumentSyncOptions) isTextDocumentSync() {}
-func (TextDocumentSyncKind) isTextDocumentSync()     {}
+
+func (TextDocumentSyncKind) isTextDocumentSync() {}
 
 func unmarshalTextDocumentSync(dec *jsontext.Decoder, val *TextDocumentSync) error {
 	raw, err := dec.ReadValue()
@@ -2096,13 +2139,14 @@ func unmarshalTextDocumentSyncValue(raw jsontext.Value, val *TextDocumentSync) e
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into TextDocumentSync", raw)
+	return newUnionError("TextDocumentSync", raw)
 }
 
 // NotebookDocumentSync is one of: *NotebookDocumentSyncOptions, *NotebookDocumentSyncRegistrationOptions.
 type NotebookDocumentSync interface{ isNotebookDocumentSync() }
 
-func (*NotebookDocumentSyncOptions) isNotebookDocumentSync()             {}
+func (*NotebookDocumentSyncOptions) isNotebookDocumentSync() {}
+
 func (*NotebookDocumentSyncRegistrationOptions) isNotebookDocumentSync() {}
 
 func unmarshalNotebookDocumentSync(dec *jsontext.Decoder, val *NotebookDocumentSync) error {
@@ -2162,13 +2206,14 @@ func unmarshalNotebookDocumentSyncValue(raw jsontext.Value, val *NotebookDocumen
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into NotebookDocumentSync", raw)
+	return newUnionError("NotebookDocumentSync", raw)
 }
 
 // HoverProvider is one of: Boolean, *HoverOptions.
 type HoverProvider interface{ isHoverProvider() }
 
-func (Boolean) isHoverProvider()       {}
+func (Boolean) isHoverProvider() {}
+
 func (*HoverOptions) isHoverProvider() {}
 
 func unmarshalHoverProvider(dec *jsontext.Decoder, val *HoverProvider) error {
@@ -2207,14 +2252,16 @@ func unmarshalHoverProviderValue(raw jsontext.Value, val *HoverProvider) error {
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into HoverProvider", raw)
+	return newUnionError("HoverProvider", raw)
 }
 
 // DeclarationProvider is one of: Boolean, *DeclarationOptions, *DeclarationRegistrationOptions.
 type DeclarationProvider interface{ isDeclarationProvider() }
 
-func (Boolean) isDeclarationProvider()                         {}
-func (*DeclarationOptions) isDeclarationProvider()             {}
+func (Boolean) isDeclarationProvider() {}
+
+func (*DeclarationOptions) isDeclarationProvider() {}
+
 func (*DeclarationRegistrationOptions) isDeclarationProvider() {}
 
 func unmarshalDeclarationProvider(dec *jsontext.Decoder, val *DeclarationProvider) error {
@@ -2274,13 +2321,14 @@ func unmarshalDeclarationProviderValue(raw jsontext.Value, val *DeclarationProvi
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DeclarationProvider", raw)
+	return newUnionError("DeclarationProvider", raw)
 }
 
 // DefinitionProvider is one of: Boolean, *DefinitionOptions.
 type DefinitionProvider interface{ isDefinitionProvider() }
 
-func (Boolean) isDefinitionProvider()            {}
+func (Boolean) isDefinitionProvider() {}
+
 func (*DefinitionOptions) isDefinitionProvider() {}
 
 func unmarshalDefinitionProvider(dec *jsontext.Decoder, val *DefinitionProvider) error {
@@ -2319,14 +2367,16 @@ func unmarshalDefinitionProviderValue(raw jsontext.Value, val *DefinitionProvide
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DefinitionProvider", raw)
+	return newUnionError("DefinitionProvider", raw)
 }
 
 // TypeDefinitionProvider is one of: Boolean, *TypeDefinitionOptions, *TypeDefinitionRegistrationOptions.
 type TypeDefinitionProvider interface{ isTypeDefinitionProvider() }
 
-func (Boolean) isTypeDefinitionProvider()                            {}
-func (*TypeDefinitionOptions) isTypeDefinitionProvider()             {}
+func (Boolean) isTypeDefinitionProvider() {}
+
+func (*TypeDefinitionOptions) isTypeDefinitionProvider() {}
+
 func (*TypeDefinitionRegistrationOptions) isTypeDefinitionProvider() {}
 
 func unmarshalTypeDefinitionProvider(dec *jsontext.Decoder, val *TypeDefinitionProvider) error {
@@ -2386,14 +2436,16 @@ func unmarshalTypeDefinitionProviderValue(raw jsontext.Value, val *TypeDefinitio
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TypeDefinitionProvider", raw)
+	return newUnionError("TypeDefinitionProvider", raw)
 }
 
 // ImplementationProvider is one of: Boolean, *ImplementationOptions, *ImplementationRegistrationOptions.
 type ImplementationProvider interface{ isImplementationProvider() }
 
-func (Boolean) isImplementationProvider()                            {}
-func (*ImplementationOptions) isImplementationProvider()             {}
+func (Boolean) isImplementationProvider() {}
+
+func (*ImplementationOptions) isImplementationProvider() {}
+
 func (*ImplementationRegistrationOptions) isImplementationProvider() {}
 
 func unmarshalImplementationProvider(dec *jsontext.Decoder, val *ImplementationProvider) error {
@@ -2453,13 +2505,14 @@ func unmarshalImplementationProviderValue(raw jsontext.Value, val *Implementatio
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into ImplementationProvider", raw)
+	return newUnionError("ImplementationProvider", raw)
 }
 
 // ReferencesProvider is one of: Boolean, *ReferenceOptions.
 type ReferencesProvider interface{ isReferencesProvider() }
 
-func (Boolean) isReferencesProvider()           {}
+func (Boolean) isReferencesProvider() {}
+
 func (*ReferenceOptions) isReferencesProvider() {}
 
 func unmarshalReferencesProvider(dec *jsontext.Decoder, val *ReferencesProvider) error {
@@ -2498,13 +2551,14 @@ func unmarshalReferencesProviderValue(raw jsontext.Value, val *ReferencesProvide
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into ReferencesProvider", raw)
+	return newUnionError("ReferencesProvider", raw)
 }
 
 // DocumentHighlightProvider is one of: Boolean, *DocumentHighlightOptions.
 type DocumentHighlightProvider interface{ isDocumentHighlightProvider() }
 
-func (Boolean) isDocumentHighlightProvider()                   {}
+func (Boolean) isDocumentHighlightProvider() {}
+
 func (*DocumentHighlightOptions) isDocumentHighlightProvider() {}
 
 func unmarshalDocumentHighlightProvider(dec *jsontext.Decoder, val *DocumentHighlightProvider) error {
@@ -2543,13 +2597,14 @@ func unmarshalDocumentHighlightProviderValue(raw jsontext.Value, val *DocumentHi
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentHighlightProvider", raw)
+	return newUnionError("DocumentHighlightProvider", raw)
 }
 
 // DocumentSymbolProvider is one of: Boolean, *DocumentSymbolOptions.
 type DocumentSymbolProvider interface{ isDocumentSymbolProvider() }
 
-func (Boolean) isDocumentSymbolProvider()                {}
+func (Boolean) isDocumentSymbolProvider() {}
+
 func (*DocumentSymbolOptions) isDocumentSymbolProvider() {}
 
 func unmarshalDocumentSymbolProvider(dec *jsontext.Decoder, val *DocumentSymbolProvider) error {
@@ -2588,13 +2643,14 @@ func unmarshalDocumentSymbolProviderValue(raw jsontext.Value, val *DocumentSymbo
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentSymbolProvider", raw)
+	return newUnionError("DocumentSymbolProvider", raw)
 }
 
 // CodeActionProvider is one of: Boolean, *CodeActionOptions.
 type CodeActionProvider interface{ isCodeActionProvider() }
 
-func (Boolean) isCodeActionProvider()            {}
+func (Boolean) isCodeActionProvider() {}
+
 func (*CodeActionOptions) isCodeActionProvider() {}
 
 func unmarshalCodeActionProvider(dec *jsontext.Decoder, val *CodeActionProvider) error {
@@ -2633,14 +2689,16 @@ func unmarshalCodeActionProviderValue(raw jsontext.Value, val *CodeActionProvide
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into CodeActionProvider", raw)
+	return newUnionError("CodeActionProvider", raw)
 }
 
 // ColorProvider is one of: Boolean, *DocumentColorOptions, *DocumentColorRegistrationOptions.
 type ColorProvider interface{ isColorProvider() }
 
-func (Boolean) isColorProvider()                           {}
-func (*DocumentColorOptions) isColorProvider()             {}
+func (Boolean) isColorProvider() {}
+
+func (*DocumentColorOptions) isColorProvider() {}
+
 func (*DocumentColorRegistrationOptions) isColorProvider() {}
 
 func unmarshalColorProvider(dec *jsontext.Decoder, val *ColorProvider) error {
@@ -2700,13 +2758,14 @@ func unmarshalColorProviderValue(raw jsontext.Value, val *ColorProvider) error {
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into ColorProvider", raw)
+	return newUnionError("ColorProvider", raw)
 }
 
 // WorkspaceSymbolProvider is one of: Boolean, *WorkspaceSymbolOptions.
 type WorkspaceSymbolProvider interface{ isWorkspaceSymbolProvider() }
 
-func (Boolean) isWorkspaceSymbolProvider()                 {}
+func (Boolean) isWorkspaceSymbolProvider() {}
+
 func (*WorkspaceSymbolOptions) isWorkspaceSymbolProvider() {}
 
 func unmarshalWorkspaceSymbolProvider(dec *jsontext.Decoder, val *WorkspaceSymbolProvider) error {
@@ -2745,13 +2804,14 @@ func unmarshalWorkspaceSymbolProviderValue(raw jsontext.Value, val *WorkspaceSym
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into WorkspaceSymbolProvider", raw)
+	return newUnionError("WorkspaceSymbolProvider", raw)
 }
 
 // DocumentFormattingProvider is one of: Boolean, *DocumentFormattingOptions.
 type DocumentFormattingProvider interface{ isDocumentFormattingProvider() }
 
-func (Boolean) isDocumentFormattingProvider()                    {}
+func (Boolean) isDocumentFormattingProvider() {}
+
 func (*DocumentFormattingOptions) isDocumentFormattingProvider() {}
 
 func unmarshalDocumentFormattingProvider(dec *jsontext.Decoder, val *DocumentFormattingProvider) error {
@@ -2790,13 +2850,14 @@ func unmarshalDocumentFormattingProviderValue(raw jsontext.Value, val *DocumentF
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentFormattingProvider", raw)
+	return newUnionError("DocumentFormattingProvider", raw)
 }
 
 // DocumentRangeFormattingProvider is one of: Boolean, *DocumentRangeFormattingOptions.
 type DocumentRangeFormattingProvider interface{ isDocumentRangeFormattingProvider() }
 
-func (Boolean) isDocumentRangeFormattingProvider()                         {}
+func (Boolean) isDocumentRangeFormattingProvider() {}
+
 func (*DocumentRangeFormattingOptions) isDocumentRangeFormattingProvider() {}
 
 func unmarshalDocumentRangeFormattingProvider(dec *jsontext.Decoder, val *DocumentRangeFormattingProvider) error {
@@ -2835,13 +2896,14 @@ func unmarshalDocumentRangeFormattingProviderValue(raw jsontext.Value, val *Docu
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DocumentRangeFormattingProvider", raw)
+	return newUnionError("DocumentRangeFormattingProvider", raw)
 }
 
 // RenameProvider is one of: Boolean, *RenameOptions.
 type RenameProvider interface{ isRenameProvider() }
 
-func (Boolean) isRenameProvider()        {}
+func (Boolean) isRenameProvider() {}
+
 func (*RenameOptions) isRenameProvider() {}
 
 func unmarshalRenameProvider(dec *jsontext.Decoder, val *RenameProvider) error {
@@ -2880,14 +2942,16 @@ func unmarshalRenameProviderValue(raw jsontext.Value, val *RenameProvider) error
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into RenameProvider", raw)
+	return newUnionError("RenameProvider", raw)
 }
 
 // FoldingRangeProvider is one of: Boolean, *FoldingRangeOptions, *FoldingRangeRegistrationOptions.
 type FoldingRangeProvider interface{ isFoldingRangeProvider() }
 
-func (Boolean) isFoldingRangeProvider()                          {}
-func (*FoldingRangeOptions) isFoldingRangeProvider()             {}
+func (Boolean) isFoldingRangeProvider() {}
+
+func (*FoldingRangeOptions) isFoldingRangeProvider() {}
+
 func (*FoldingRangeRegistrationOptions) isFoldingRangeProvider() {}
 
 func unmarshalFoldingRangeProvider(dec *jsontext.Decoder, val *FoldingRangeProvider) error {
@@ -2947,14 +3011,16 @@ func unmarshalFoldingRangeProviderValue(raw jsontext.Value, val *FoldingRangePro
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into FoldingRangeProvider", raw)
+	return newUnionError("FoldingRangeProvider", raw)
 }
 
 // SelectionRangeProvider is one of: Boolean, *SelectionRangeOptions, *SelectionRangeRegistrationOptions.
 type SelectionRangeProvider interface{ isSelectionRangeProvider() }
 
-func (Boolean) isSelectionRangeProvider()                            {}
-func (*SelectionRangeOptions) isSelectionRangeProvider()             {}
+func (Boolean) isSelectionRangeProvider() {}
+
+func (*SelectionRangeOptions) isSelectionRangeProvider() {}
+
 func (*SelectionRangeRegistrationOptions) isSelectionRangeProvider() {}
 
 func unmarshalSelectionRangeProvider(dec *jsontext.Decoder, val *SelectionRangeProvider) error {
@@ -3014,14 +3080,16 @@ func unmarshalSelectionRangeProviderValue(raw jsontext.Value, val *SelectionRang
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into SelectionRangeProvider", raw)
+	return newUnionError("SelectionRangeProvider", raw)
 }
 
 // CallHierarchyProvider is one of: Boolean, *CallHierarchyOptions, *CallHierarchyRegistrationOptions.
 type CallHierarchyProvider interface{ isCallHierarchyProvider() }
 
-func (Boolean) isCallHierarchyProvider()                           {}
-func (*CallHierarchyOptions) isCallHierarchyProvider()             {}
+func (Boolean) isCallHierarchyProvider() {}
+
+func (*CallHierarchyOptions) isCallHierarchyProvider() {}
+
 func (*CallHierarchyRegistrationOptions) isCallHierarchyProvider() {}
 
 func unmarshalCallHierarchyProvider(dec *jsontext.Decoder, val *CallHierarchyProvider) error {
@@ -3081,14 +3149,16 @@ func unmarshalCallHierarchyProviderValue(raw jsontext.Value, val *CallHierarchyP
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into CallHierarchyProvider", raw)
+	return newUnionError("CallHierarchyProvider", raw)
 }
 
 // LinkedEditingRangeProvider is one of: Boolean, *LinkedEditingRangeOptions, *LinkedEditingRangeRegistrationOptions.
 type LinkedEditingRangeProvider interface{ isLinkedEditingRangeProvider() }
 
-func (Boolean) isLinkedEditingRangeProvider()                                {}
-func (*LinkedEditingRangeOptions) isLinkedEditingRangeProvider()             {}
+func (Boolean) isLinkedEditingRangeProvider() {}
+
+func (*LinkedEditingRangeOptions) isLinkedEditingRangeProvider() {}
+
 func (*LinkedEditingRangeRegistrationOptions) isLinkedEditingRangeProvider() {}
 
 func unmarshalLinkedEditingRangeProvider(dec *jsontext.Decoder, val *LinkedEditingRangeProvider) error {
@@ -3148,13 +3218,14 @@ func unmarshalLinkedEditingRangeProviderValue(raw jsontext.Value, val *LinkedEdi
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into LinkedEditingRangeProvider", raw)
+	return newUnionError("LinkedEditingRangeProvider", raw)
 }
 
 // SemanticTokensProvider is one of: *SemanticTokensOptions, *SemanticTokensRegistrationOptions.
 type SemanticTokensProvider interface{ isSemanticTokensProvider() }
 
-func (*SemanticTokensOptions) isSemanticTokensProvider()             {}
+func (*SemanticTokensOptions) isSemanticTokensProvider() {}
+
 func (*SemanticTokensRegistrationOptions) isSemanticTokensProvider() {}
 
 func unmarshalSemanticTokensProvider(dec *jsontext.Decoder, val *SemanticTokensProvider) error {
@@ -3214,14 +3285,16 @@ func unmarshalSemanticTokensProviderValue(raw jsontext.Value, val *SemanticToken
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into SemanticTokensProvider", raw)
+	return newUnionError("SemanticTokensProvider", raw)
 }
 
 // MonikerProvider is one of: Boolean, *MonikerOptions, *MonikerRegistrationOptions.
 type MonikerProvider interface{ isMonikerProvider() }
 
-func (Boolean) isMonikerProvider()                     {}
-func (*MonikerOptions) isMonikerProvider()             {}
+func (Boolean) isMonikerProvider() {}
+
+func (*MonikerOptions) isMonikerProvider() {}
+
 func (*MonikerRegistrationOptions) isMonikerProvider() {}
 
 func unmarshalMonikerProvider(dec *jsontext.Decoder, val *MonikerProvider) error {
@@ -3281,14 +3354,16 @@ func unmarshalMonikerProviderValue(raw jsontext.Value, val *MonikerProvider) err
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into MonikerProvider", raw)
+	return newUnionError("MonikerProvider", raw)
 }
 
 // TypeHierarchyProvider is one of: Boolean, *TypeHierarchyOptions, *TypeHierarchyRegistrationOptions.
 type TypeHierarchyProvider interface{ isTypeHierarchyProvider() }
 
-func (Boolean) isTypeHierarchyProvider()                           {}
-func (*TypeHierarchyOptions) isTypeHierarchyProvider()             {}
+func (Boolean) isTypeHierarchyProvider() {}
+
+func (*TypeHierarchyOptions) isTypeHierarchyProvider() {}
+
 func (*TypeHierarchyRegistrationOptions) isTypeHierarchyProvider() {}
 
 func unmarshalTypeHierarchyProvider(dec *jsontext.Decoder, val *TypeHierarchyProvider) error {
@@ -3348,14 +3423,16 @@ func unmarshalTypeHierarchyProviderValue(raw jsontext.Value, val *TypeHierarchyP
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TypeHierarchyProvider", raw)
+	return newUnionError("TypeHierarchyProvider", raw)
 }
 
 // InlineValueProvider is one of: Boolean, *InlineValueOptions, *InlineValueRegistrationOptions.
 type InlineValueProvider interface{ isInlineValueProvider() }
 
-func (Boolean) isInlineValueProvider()                         {}
-func (*InlineValueOptions) isInlineValueProvider()             {}
+func (Boolean) isInlineValueProvider() {}
+
+func (*InlineValueOptions) isInlineValueProvider() {}
+
 func (*InlineValueRegistrationOptions) isInlineValueProvider() {}
 
 func unmarshalInlineValueProvider(dec *jsontext.Decoder, val *InlineValueProvider) error {
@@ -3415,14 +3492,16 @@ func unmarshalInlineValueProviderValue(raw jsontext.Value, val *InlineValueProvi
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlineValueProvider", raw)
+	return newUnionError("InlineValueProvider", raw)
 }
 
 // InlayHintProvider is one of: Boolean, *InlayHintOptions, *InlayHintRegistrationOptions.
 type InlayHintProvider interface{ isInlayHintProvider() }
 
-func (Boolean) isInlayHintProvider()                       {}
-func (*InlayHintOptions) isInlayHintProvider()             {}
+func (Boolean) isInlayHintProvider() {}
+
+func (*InlayHintOptions) isInlayHintProvider() {}
+
 func (*InlayHintRegistrationOptions) isInlayHintProvider() {}
 
 func unmarshalInlayHintProvider(dec *jsontext.Decoder, val *InlayHintProvider) error {
@@ -3482,13 +3561,14 @@ func unmarshalInlayHintProviderValue(raw jsontext.Value, val *InlayHintProvider)
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlayHintProvider", raw)
+	return newUnionError("InlayHintProvider", raw)
 }
 
 // DiagnosticProvider is one of: *DiagnosticOptions, *DiagnosticRegistrationOptions.
 type DiagnosticProvider interface{ isDiagnosticProvider() }
 
-func (*DiagnosticOptions) isDiagnosticProvider()             {}
+func (*DiagnosticOptions) isDiagnosticProvider() {}
+
 func (*DiagnosticRegistrationOptions) isDiagnosticProvider() {}
 
 func unmarshalDiagnosticProvider(dec *jsontext.Decoder, val *DiagnosticProvider) error {
@@ -3548,13 +3628,14 @@ func unmarshalDiagnosticProviderValue(raw jsontext.Value, val *DiagnosticProvide
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into DiagnosticProvider", raw)
+	return newUnionError("DiagnosticProvider", raw)
 }
 
 // InlineCompletionProvider is one of: Boolean, *InlineCompletionOptions.
 type InlineCompletionProvider interface{ isInlineCompletionProvider() }
 
-func (Boolean) isInlineCompletionProvider()                  {}
+func (Boolean) isInlineCompletionProvider() {}
+
 func (*InlineCompletionOptions) isInlineCompletionProvider() {}
 
 func unmarshalInlineCompletionProvider(dec *jsontext.Decoder, val *InlineCompletionProvider) error {
@@ -3593,13 +3674,14 @@ func unmarshalInlineCompletionProviderValue(raw jsontext.Value, val *InlineCompl
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into InlineCompletionProvider", raw)
+	return newUnionError("InlineCompletionProvider", raw)
 }
 
 // CompletionItemDefaultsEditRange is one of: *Range, *EditRangeWithInsertReplace.
 type CompletionItemDefaultsEditRange interface{ isCompletionItemDefaultsEditRange() }
 
-func (*Range) isCompletionItemDefaultsEditRange()                      {}
+func (*Range) isCompletionItemDefaultsEditRange() {}
+
 func (*EditRangeWithInsertReplace) isCompletionItemDefaultsEditRange() {}
 
 func unmarshalCompletionItemDefaultsEditRange(dec *jsontext.Decoder, val *CompletionItemDefaultsEditRange) error {
@@ -3659,16 +3741,19 @@ func unmarshalCompletionItemDefaultsEditRangeValue(raw jsontext.Value, val *Comp
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into CompletionItemDefaultsEditRange", raw)
+	return newUnionError("CompletionItemDefaultsEditRange", raw)
 }
 
 // NotebookDocumentFilterNotebook is one of: String, *NotebookDocumentFilterNotebookType, *NotebookDocumentFilterScheme, *NotebookDocumentFilterPattern.
 type NotebookDocumentFilterNotebook interface{ isNotebookDocumentFilterNotebook() }
 
-func (String) isNotebookDocumentFilterNotebook()                              {}
+func (String) isNotebookDocumentFilterNotebook() {}
+
 func (*NotebookDocumentFilterNotebookType) isNotebookDocumentFilterNotebook() {}
-func (*NotebookDocumentFilterScheme) isNotebookDocumentFilterNotebook()       {}
-func (*NotebookDocumentFilterPattern) isNotebookDocumentFilterNotebook()      {}
+
+func (*NotebookDocumentFilterScheme) isNotebookDocumentFilterNotebook() {}
+
+func (*NotebookDocumentFilterPattern) isNotebookDocumentFilterNotebook() {}
 
 func unmarshalNotebookDocumentFilterNotebook(dec *jsontext.Decoder, val *NotebookDocumentFilterNotebook) error {
 	raw, err := dec.ReadValue()
@@ -3755,13 +3840,14 @@ func unmarshalNotebookDocumentFilterNotebookValue(raw jsontext.Value, val *Noteb
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into NotebookDocumentFilterNotebook", raw)
+	return newUnionError("NotebookDocumentFilterNotebook", raw)
 }
 
 // TextDocumentSyncOptionsSave is one of: Boolean, *SaveOptions.
 type TextDocumentSyncOptionsSave interface{ isTextDocumentSyncOptionsSave() }
 
-func (Boolean) isTextDocumentSyncOptionsSave()      {}
+func (Boolean) isTextDocumentSyncOptionsSave() {}
+
 func (*SaveOptions) isTextDocumentSyncOptionsSave() {}
 
 func unmarshalTextDocumentSyncOptionsSave(dec *jsontext.Decoder, val *TextDocumentSyncOptionsSave) error {
@@ -3800,13 +3886,14 @@ func unmarshalTextDocumentSyncOptionsSaveValue(raw jsontext.Value, val *TextDocu
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into TextDocumentSyncOptionsSave", raw)
+	return newUnionError("TextDocumentSyncOptionsSave", raw)
 }
 
 // WorkspaceOptionsTextDocumentContent is one of: *TextDocumentContentOptions, *TextDocumentContentRegistrationOptions.
 type WorkspaceOptionsTextDocumentContent interface{ isWorkspaceOptionsTextDocumentContent() }
 
-func (*TextDocumentContentOptions) isWorkspaceOptionsTextDocumentContent()             {}
+func (*TextDocumentContentOptions) isWorkspaceOptionsTextDocumentContent() {}
+
 func (*TextDocumentContentRegistrationOptions) isWorkspaceOptionsTextDocumentContent() {}
 
 func unmarshalWorkspaceOptionsTextDocumentContent(dec *jsontext.Decoder, val *WorkspaceOptionsTextDocumentContent) error {
@@ -3866,13 +3953,14 @@ func unmarshalWorkspaceOptionsTextDocumentContentValue(raw jsontext.Value, val *
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into WorkspaceOptionsTextDocumentContent", raw)
+	return newUnionError("WorkspaceOptionsTextDocumentContent", raw)
 }
 
 // ParameterInformationLabel is one of: String, ParameterInformationLabelTuple.
 type ParameterInformationLabel interface{ isParameterInformationLabel() }
 
-func (String) isParameterInformationLabel()                         {}
+func (String) isParameterInformationLabel() {}
+
 func (ParameterInformationLabelTuple) isParameterInformationLabel() {}
 
 func unmarshalParameterInformationLabel(dec *jsontext.Decoder, val *ParameterInformationLabel) error {
@@ -3903,13 +3991,14 @@ func unmarshalParameterInformationLabelValue(raw jsontext.Value, val *ParameterI
 		*val = v
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into ParameterInformationLabel", raw)
+	return newUnionError("ParameterInformationLabel", raw)
 }
 
 // ChangeNotifications is one of: String, Boolean.
 type ChangeNotifications interface{ isChangeNotifications() }
 
-func (String) isChangeNotifications()  {}
+func (String) isChangeNotifications() {}
+
 func (Boolean) isChangeNotifications() {}
 
 func unmarshalChangeNotifications(dec *jsontext.Decoder, val *ChangeNotifications) error {
@@ -3940,14 +4029,15 @@ func unmarshalChangeNotificationsValue(raw jsontext.Value, val *ChangeNotificati
 		*val = Boolean(v)
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into ChangeNotifications", raw)
+	return newUnionError("ChangeNotifications", raw)
 }
 
 // RelativePatternBaseURI is one of: *WorkspaceFolder, URI.
 type RelativePatternBaseURI interface{ isRelativePatternBaseURI() }
 
 func (*WorkspaceFolder) isRelativePatternBaseURI() {}
-func (URI) isRelativePatternBaseURI()              {}
+
+func (URI) isRelativePatternBaseURI() {}
 
 func unmarshalRelativePatternBaseURI(dec *jsontext.Decoder, val *RelativePatternBaseURI) error {
 	raw, err := dec.ReadValue()
@@ -3992,13 +4082,14 @@ func unmarshalRelativePatternBaseURIValue(raw jsontext.Value, val *RelativePatte
 		*val = URI(v)
 		return nil
 	}
-	return fmt.Errorf("cannot unmarshal %s into RelativePatternBaseURI", raw)
+	return newUnionError("RelativePatternBaseURI", raw)
 }
 
 // ClientSemanticTokensRequestOptionsRange is one of: Boolean, *SemanticTokensOptionsRange.
 type ClientSemanticTokensRequestOptionsRange interface{ isClientSemanticTokensRequestOptionsRange() }
 
-func (Boolean) isClientSemanticTokensRequestOptionsRange()                     {}
+func (Boolean) isClientSemanticTokensRequestOptionsRange() {}
+
 func (*SemanticTokensOptionsRange) isClientSemanticTokensRequestOptionsRange() {}
 
 func unmarshalClientSemanticTokensRequestOptionsRange(dec *jsontext.Decoder, val *ClientSemanticTokensRequestOptionsRange) error {
@@ -4030,13 +4121,14 @@ func unmarshalClientSemanticTokensRequestOptionsRangeValue(raw jsontext.Value, v
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into ClientSemanticTokensRequestOptionsRange", raw)
+	return newUnionError("ClientSemanticTokensRequestOptionsRange", raw)
 }
 
 // ClientSemanticTokensRequestOptionsFull is one of: Boolean, *ClientSemanticTokensRequestFullDelta.
 type ClientSemanticTokensRequestOptionsFull interface{ isClientSemanticTokensRequestOptionsFull() }
 
-func (Boolean) isClientSemanticTokensRequestOptionsFull()                               {}
+func (Boolean) isClientSemanticTokensRequestOptionsFull() {}
+
 func (*ClientSemanticTokensRequestFullDelta) isClientSemanticTokensRequestOptionsFull() {}
 
 func unmarshalClientSemanticTokensRequestOptionsFull(dec *jsontext.Decoder, val *ClientSemanticTokensRequestOptionsFull) error {
@@ -4075,7 +4167,7 @@ func unmarshalClientSemanticTokensRequestOptionsFullValue(raw jsontext.Value, va
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into ClientSemanticTokensRequestOptionsFull", raw)
+	return newUnionError("ClientSemanticTokensRequestOptionsFull", raw)
 }
 
 // FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport is one of: *FullDocumentDiagnosticReport, *UnchangedDocumentDiagnosticReport.
@@ -4083,6 +4175,7 @@ type FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport interface{
 
 func (*FullDocumentDiagnosticReport) isFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport() {
 }
+
 func (*UnchangedDocumentDiagnosticReport) isFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport() {
 }
 
@@ -4119,13 +4212,14 @@ func unmarshalFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReportVal
 			}
 		}
 	}
-	return fmt.Errorf("cannot unmarshal %s into FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport", raw)
+	return newUnionError("FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport", raw)
 }
 
 // CommandOrCodeAction is one of: *Command, *CodeAction.
 type CommandOrCodeAction interface{ isCommandOrCodeAction() }
 
-func (*Command) isCommandOrCodeAction()    {}
+func (*Command) isCommandOrCodeAction() {}
+
 func (*CodeAction) isCommandOrCodeAction() {}
 
 func unmarshalCommandOrCodeAction(dec *jsontext.Decoder, val *CommandOrCodeAction) error {
@@ -4136,58 +4230,6 @@ func unmarshalCommandOrCodeAction(dec *jsontext.Decoder, val *CommandOrCodeActio
 	return unmarshalCommandOrCodeActionValue(raw, val)
 }
 
-func unmarshalCommandOrCodeActionValue(raw jsontext.Value, val *CommandOrCodeAction) error {
-	switch raw.Kind() {
-	case 'n':
-		*val = nil
-		return dvNullValue(raw)
-	case '{':
-		if objectHasAndKnownGuard(raw, []string{"title"}, []string{"title", "kind", "diagnostics", "isPreferred", "disabled", "edit", "command", "data", "tags"}) {
-			var v CodeAction
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-		if objectHasAndKnownGuard(raw, []string{"title", "command"}, []string{"title", "tooltip", "command", "arguments"}) {
-			var v Command
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-		if objectHasKeys(raw, "title", "command") {
-			var v Command
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-		if objectHasKeys(raw, "title") {
-			var v CodeAction
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-		{
-			var v CodeAction
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-		{
-			var v Command
-			if v.unmarshalLSPValue(raw) == nil {
-				*val = &v
-				return nil
-			}
-		}
-	}
-	return fmt.Errorf("cannot unmarshal %s into CommandOrCodeAction", raw)
-}
-
 // ImplementationResult is an alias for DefinitionResult: the two share one result shape.
 type ImplementationResult = DefinitionResult
 
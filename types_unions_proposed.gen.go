@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+import (
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+func unmarshalCommandOrCodeActionValue(raw jsontext.Value, val *CommandOrCodeAction) error {
+	switch raw.Kind() {
+	case 'n':
+		*val = nil
+		return dvNullValue(raw)
+	case '{':
+		if objectHasAndKnownGuard(raw, []string{"title"}, []string{"title", "kind", "diagnostics", "isPreferred", "disabled", "edit", "command", "data", "tags"}) {
+			var v CodeAction
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+		if objectHasAndKnownGuard(raw, []string{"title", "command"}, []string{"title", "tooltip", "command", "arguments"}) {
+			var v Command
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+		if objectHasKeys(raw, "title", "command") {
+			var v Command
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+		if objectHasKeys(raw, "title") {
+			var v CodeAction
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+		{
+			var v CodeAction
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+		{
+			var v Command
+			if v.unmarshalLSPValue(raw) == nil {
+				*val = &v
+				return nil
+			}
+		}
+	}
+	return newUnionError("CommandOrCodeAction", raw)
+}
@@ -0,0 +1,368 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+package protocol
+
+var unionArmsCallHierarchyProvider = registerUnionArms("CallHierarchyProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*CallHierarchyOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[CallHierarchyOptions]},
+	{name: "*CallHierarchyRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[CallHierarchyRegistrationOptions]},
+})
+
+var unionArmsChangeNotifications = registerUnionArms("ChangeNotifications", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+})
+
+var unionArmsClientSemanticTokensRequestOptionsFull = registerUnionArms("ClientSemanticTokensRequestOptionsFull", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*ClientSemanticTokensRequestFullDelta", kind: '{', known: []string{"delta"}, decode: decodeArm[ClientSemanticTokensRequestFullDelta]},
+})
+
+var unionArmsClientSemanticTokensRequestOptionsRange = registerUnionArms("ClientSemanticTokensRequestOptionsRange", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*SemanticTokensOptionsRange", kind: '{', decode: decodeArm[SemanticTokensOptionsRange]},
+})
+
+var unionArmsCodeActionProvider = registerUnionArms("CodeActionProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*CodeActionOptions", kind: '{', known: []string{"codeActionKinds", "documentation", "resolveProvider", "workDoneProgress"}, decode: decodeArm[CodeActionOptions]},
+})
+
+var unionArmsColorProvider = registerUnionArms("ColorProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DocumentColorOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[DocumentColorOptions]},
+	{name: "*DocumentColorRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[DocumentColorRegistrationOptions]},
+})
+
+var unionArmsCompletionItemDefaultsEditRange = registerUnionArms("CompletionItemDefaultsEditRange", []unionArm{
+	{name: "*Range", kind: '{', required: []string{"start", "end"}, known: []string{"start", "end"}, decode: decodeArm[Range]},
+	{name: "*EditRangeWithInsertReplace", kind: '{', required: []string{"insert", "replace"}, known: []string{"insert", "replace"}, decode: decodeArm[EditRangeWithInsertReplace]},
+})
+
+var unionArmsCompletionItemTextEdit = registerUnionArms("CompletionItemTextEdit", []unionArm{
+	{name: "*TextEdit", kind: '{', required: []string{"range", "newText"}, known: []string{"range", "newText"}, decode: decodeArm[TextEdit]},
+	{name: "*InsertReplaceEdit", kind: '{', required: []string{"newText", "insert", "replace"}, known: []string{"newText", "insert", "replace"}, decode: decodeArm[InsertReplaceEdit]},
+})
+
+var unionArmsCompletionResult = registerUnionArms("CompletionResult", []unionArm{
+	{name: "CompletionItemSlice", kind: '[', elem: true, required: []string{"label"}, known: []string{"label", "labelDetails", "kind", "tags", "detail", "documentation", "deprecated", "preselect", "sortText", "filterText", "insertText", "insertTextFormat", "insertTextMode", "textEdit", "textEditText", "additionalTextEdits", "commitCharacters", "command", "data"}, decode: decodeArm[CompletionItemSlice]},
+	{name: "*CompletionList", kind: '{', required: []string{"isIncomplete", "items"}, known: []string{"isIncomplete", "itemDefaults", "applyKind", "items"}, decode: decodeArm[CompletionList]},
+})
+
+var unionArmsDeclaration = registerUnionArms("Declaration", []unionArm{
+	{name: "*Location", kind: '{', required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[Location]},
+	{name: "LocationSlice", kind: '[', elem: true, required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[LocationSlice]},
+})
+
+var unionArmsDeclarationProvider = registerUnionArms("DeclarationProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DeclarationOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[DeclarationOptions]},
+	{name: "*DeclarationRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"workDoneProgress", "documentSelector", "id"}, decode: decodeArm[DeclarationRegistrationOptions]},
+})
+
+var unionArmsDeclarationResult = registerUnionArms("DeclarationResult", []unionArm{
+	{name: "*Location", kind: '{', required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[Location]},
+	{name: "LocationSlice", kind: '[', elem: true, required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[LocationSlice]},
+	{name: "DeclarationLinkSlice", kind: '[', elem: true, required: []string{"targetUri", "targetRange", "targetSelectionRange"}, known: []string{"originSelectionRange", "targetUri", "targetRange", "targetSelectionRange"}, decode: decodeArmWith[DeclarationLinkSlice]},
+})
+
+var unionArmsDefinitionProvider = registerUnionArms("DefinitionProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DefinitionOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[DefinitionOptions]},
+})
+
+var unionArmsDefinitionResult = registerUnionArms("DefinitionResult", []unionArm{
+	{name: "*Location", kind: '{', required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[Location]},
+	{name: "LocationSlice", kind: '[', elem: true, required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[LocationSlice]},
+	{name: "DefinitionLinkSlice", kind: '[', elem: true, required: []string{"targetUri", "targetRange", "targetSelectionRange"}, known: []string{"originSelectionRange", "targetUri", "targetRange", "targetSelectionRange"}, decode: decodeArmWith[DefinitionLinkSlice]},
+})
+
+var unionArmsDiagnosticProvider = registerUnionArms("DiagnosticProvider", []unionArm{
+	{name: "*DiagnosticOptions", kind: '{', required: []string{"interFileDependencies", "workspaceDiagnostics"}, known: []string{"identifier", "interFileDependencies", "workspaceDiagnostics", "workDoneProgress"}, decode: decodeArm[DiagnosticOptions]},
+	{name: "*DiagnosticRegistrationOptions", kind: '{', required: []string{"documentSelector", "interFileDependencies", "workspaceDiagnostics"}, known: []string{"documentSelector", "identifier", "interFileDependencies", "workspaceDiagnostics", "workDoneProgress", "id"}, decode: decodeArm[DiagnosticRegistrationOptions]},
+})
+
+var unionArmsDidChangeConfigurationRegistrationOptionsSection = registerUnionArms("DidChangeConfigurationRegistrationOptionsSection", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "StringSlice", kind: '[', decode: decodeArmWith[StringSlice]},
+})
+
+var unionArmsDocumentChange = registerUnionArms("DocumentChange", []unionArm{
+	{name: "*TextDocumentEdit", kind: '{', required: []string{"textDocument", "edits"}, known: []string{"textDocument", "edits"}, decode: decodeArm[TextDocumentEdit]},
+	{name: "*CreateFile", kind: '{', kindConst: "create", required: []string{"kind", "uri"}, known: []string{"kind", "uri", "options", "annotationId"}, decode: decodeArm[CreateFile]},
+	{name: "*RenameFile", kind: '{', kindConst: "rename", required: []string{"kind", "oldUri", "newUri"}, known: []string{"kind", "oldUri", "newUri", "options", "annotationId"}, decode: decodeArm[RenameFile]},
+	{name: "*DeleteFile", kind: '{', kindConst: "delete", required: []string{"kind", "uri"}, known: []string{"kind", "uri", "options", "annotationId"}, decode: decodeArm[DeleteFile]},
+})
+
+var unionArmsDocumentDiagnosticReport = registerUnionArms("DocumentDiagnosticReport", []unionArm{
+	{name: "*RelatedFullDocumentDiagnosticReport", kind: '{', kindConst: "full", required: []string{"kind", "items"}, known: []string{"relatedDocuments", "kind", "resultId", "items"}, decode: decodeArm[RelatedFullDocumentDiagnosticReport]},
+	{name: "*RelatedUnchangedDocumentDiagnosticReport", kind: '{', kindConst: "unchanged", required: []string{"kind", "resultId"}, known: []string{"relatedDocuments", "kind", "resultId"}, decode: decodeArm[RelatedUnchangedDocumentDiagnosticReport]},
+})
+
+var unionArmsDocumentFilter = registerUnionArms("DocumentFilter", []unionArm{
+	{name: "*TextDocumentFilterLanguage", kind: '{', required: []string{"language"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterLanguage]},
+	{name: "*TextDocumentFilterScheme", kind: '{', required: []string{"scheme"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterScheme]},
+	{name: "*TextDocumentFilterPattern", kind: '{', required: []string{"pattern"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterPattern]},
+	{name: "*NotebookCellTextDocumentFilter", kind: '{', required: []string{"notebook"}, known: []string{"notebook", "language"}, decode: decodeArm[NotebookCellTextDocumentFilter]},
+})
+
+var unionArmsDocumentFormattingProvider = registerUnionArms("DocumentFormattingProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DocumentFormattingOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[DocumentFormattingOptions]},
+})
+
+var unionArmsDocumentHighlightProvider = registerUnionArms("DocumentHighlightProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DocumentHighlightOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[DocumentHighlightOptions]},
+})
+
+var unionArmsDocumentRangeFormattingProvider = registerUnionArms("DocumentRangeFormattingProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DocumentRangeFormattingOptions", kind: '{', known: []string{"rangesSupport", "workDoneProgress"}, decode: decodeArm[DocumentRangeFormattingOptions]},
+})
+
+var unionArmsDocumentSymbolProvider = registerUnionArms("DocumentSymbolProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*DocumentSymbolOptions", kind: '{', known: []string{"label", "workDoneProgress"}, decode: decodeArm[DocumentSymbolOptions]},
+})
+
+var unionArmsDocumentSymbolResult = registerUnionArms("DocumentSymbolResult", []unionArm{
+	{name: "SymbolInformationSlice", kind: '[', elem: true, required: []string{"location", "name", "kind"}, known: []string{"deprecated", "location", "name", "kind", "tags", "containerName"}, decode: decodeArm[SymbolInformationSlice]},
+	{name: "DocumentSymbolSlice", kind: '[', elem: true, required: []string{"name", "kind", "range", "selectionRange"}, known: []string{"name", "detail", "kind", "tags", "deprecated", "range", "selectionRange", "children"}, decode: decodeArm[DocumentSymbolSlice]},
+})
+
+var unionArmsFoldingRangeProvider = registerUnionArms("FoldingRangeProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*FoldingRangeOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[FoldingRangeOptions]},
+	{name: "*FoldingRangeRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[FoldingRangeRegistrationOptions]},
+})
+
+var unionArmsFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport = registerUnionArms("FullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport", []unionArm{
+	{name: "*FullDocumentDiagnosticReport", kind: '{', kindConst: "full", required: []string{"kind", "items"}, known: []string{"kind", "resultId", "items"}, decode: decodeArm[FullDocumentDiagnosticReport]},
+	{name: "*UnchangedDocumentDiagnosticReport", kind: '{', kindConst: "unchanged", required: []string{"kind", "resultId"}, known: []string{"kind", "resultId"}, decode: decodeArm[UnchangedDocumentDiagnosticReport]},
+})
+
+var unionArmsGlobPattern = registerUnionArms("GlobPattern", []unionArm{
+	{name: "Pattern", kind: '"', decode: decodeArmWith[Pattern]},
+	{name: "*RelativePattern", kind: '{', required: []string{"baseUri", "pattern"}, known: []string{"baseUri", "pattern"}, decode: decodeArm[RelativePattern]},
+})
+
+var unionArmsHoverContents = registerUnionArms("HoverContents", []unionArm{
+	{name: "*MarkupContent", kind: '{', required: []string{"kind", "value"}, known: []string{"kind", "value"}, decode: decodeArm[MarkupContent]},
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "*MarkedStringWithLanguage", kind: '{', required: []string{"language", "value"}, known: []string{"language", "value"}, decode: decodeArm[MarkedStringWithLanguage]},
+	{name: "MarkedStringSlice", kind: '[', decode: decodeArm[MarkedStringSlice]},
+})
+
+var unionArmsHoverProvider = registerUnionArms("HoverProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*HoverOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[HoverOptions]},
+})
+
+var unionArmsImplementationProvider = registerUnionArms("ImplementationProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*ImplementationOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[ImplementationOptions]},
+	{name: "*ImplementationRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[ImplementationRegistrationOptions]},
+})
+
+var unionArmsInlayHintLabel = registerUnionArms("InlayHintLabel", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "InlayHintLabelPartSlice", kind: '[', elem: true, required: []string{"value"}, known: []string{"value", "tooltip", "location", "command"}, decode: decodeArm[InlayHintLabelPartSlice]},
+})
+
+var unionArmsInlayHintProvider = registerUnionArms("InlayHintProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*InlayHintOptions", kind: '{', known: []string{"resolveProvider", "workDoneProgress"}, decode: decodeArm[InlayHintOptions]},
+	{name: "*InlayHintRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"resolveProvider", "workDoneProgress", "documentSelector", "id"}, decode: decodeArm[InlayHintRegistrationOptions]},
+})
+
+var unionArmsInlayHintTooltip = registerUnionArms("InlayHintTooltip", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "*MarkupContent", kind: '{', required: []string{"kind", "value"}, known: []string{"kind", "value"}, decode: decodeArm[MarkupContent]},
+})
+
+var unionArmsInlineCompletionItemInsertText = registerUnionArms("InlineCompletionItemInsertText", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "*StringValue", kind: '{', kindConst: "snippet", required: []string{"kind", "value"}, known: []string{"kind", "value"}, decode: decodeArm[StringValue]},
+})
+
+var unionArmsInlineCompletionProvider = registerUnionArms("InlineCompletionProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*InlineCompletionOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[InlineCompletionOptions]},
+})
+
+var unionArmsInlineCompletionResult = registerUnionArms("InlineCompletionResult", []unionArm{
+	{name: "*InlineCompletionList", kind: '{', required: []string{"items"}, known: []string{"items"}, decode: decodeArm[InlineCompletionList]},
+	{name: "InlineCompletionItemSlice", kind: '[', elem: true, required: []string{"insertText"}, known: []string{"insertText", "filterText", "range", "command"}, decode: decodeArm[InlineCompletionItemSlice]},
+})
+
+var unionArmsInlineValue = registerUnionArms("InlineValue", []unionArm{
+	{name: "*InlineValueText", kind: '{', required: []string{"range", "text"}, known: []string{"range", "text"}, decode: decodeArm[InlineValueText]},
+	{name: "*InlineValueVariableLookup", kind: '{', required: []string{"range", "caseSensitiveLookup"}, known: []string{"range", "variableName", "caseSensitiveLookup"}, decode: decodeArm[InlineValueVariableLookup]},
+	{name: "*InlineValueEvaluatableExpression", kind: '{', required: []string{"range"}, known: []string{"range", "expression"}, decode: decodeArm[InlineValueEvaluatableExpression]},
+})
+
+var unionArmsInlineValueProvider = registerUnionArms("InlineValueProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*InlineValueOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[InlineValueOptions]},
+	{name: "*InlineValueRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"workDoneProgress", "documentSelector", "id"}, decode: decodeArm[InlineValueRegistrationOptions]},
+})
+
+var unionArmsLinkedEditingRangeProvider = registerUnionArms("LinkedEditingRangeProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*LinkedEditingRangeOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[LinkedEditingRangeOptions]},
+	{name: "*LinkedEditingRangeRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[LinkedEditingRangeRegistrationOptions]},
+})
+
+var unionArmsMarkedString = registerUnionArms("MarkedString", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "*MarkedStringWithLanguage", kind: '{', required: []string{"language", "value"}, known: []string{"language", "value"}, decode: decodeArm[MarkedStringWithLanguage]},
+})
+
+var unionArmsMonikerProvider = registerUnionArms("MonikerProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*MonikerOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[MonikerOptions]},
+	{name: "*MonikerRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress"}, decode: decodeArm[MonikerRegistrationOptions]},
+})
+
+var unionArmsNotebookDocumentFilter = registerUnionArms("NotebookDocumentFilter", []unionArm{
+	{name: "*NotebookDocumentFilterNotebookType", kind: '{', required: []string{"notebookType"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterNotebookType]},
+	{name: "*NotebookDocumentFilterScheme", kind: '{', required: []string{"scheme"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterScheme]},
+	{name: "*NotebookDocumentFilterPattern", kind: '{', required: []string{"pattern"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterPattern]},
+})
+
+var unionArmsNotebookDocumentFilterNotebook = registerUnionArms("NotebookDocumentFilterNotebook", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "*NotebookDocumentFilterNotebookType", kind: '{', required: []string{"notebookType"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterNotebookType]},
+	{name: "*NotebookDocumentFilterScheme", kind: '{', required: []string{"scheme"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterScheme]},
+	{name: "*NotebookDocumentFilterPattern", kind: '{', required: []string{"pattern"}, known: []string{"notebookType", "scheme", "pattern"}, decode: decodeArm[NotebookDocumentFilterPattern]},
+})
+
+var unionArmsNotebookDocumentSync = registerUnionArms("NotebookDocumentSync", []unionArm{
+	{name: "*NotebookDocumentSyncOptions", kind: '{', required: []string{"notebookSelector"}, known: []string{"notebookSelector", "save"}, decode: decodeArm[NotebookDocumentSyncOptions]},
+	{name: "*NotebookDocumentSyncRegistrationOptions", kind: '{', required: []string{"notebookSelector"}, known: []string{"notebookSelector", "save", "id"}, decode: decodeArm[NotebookDocumentSyncRegistrationOptions]},
+})
+
+var unionArmsNotebookSelector = registerUnionArms("NotebookSelector", []unionArm{
+	{name: "*NotebookDocumentFilterWithNotebook", kind: '{', required: []string{"notebook"}, known: []string{"notebook", "cells"}, decode: decodeArm[NotebookDocumentFilterWithNotebook]},
+	{name: "*NotebookDocumentFilterWithCells", kind: '{', required: []string{"cells"}, known: []string{"notebook", "cells"}, decode: decodeArm[NotebookDocumentFilterWithCells]},
+})
+
+var unionArmsParameterInformationLabel = registerUnionArms("ParameterInformationLabel", []unionArm{
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+	{name: "ParameterInformationLabelTuple", kind: '[', decode: decodeArmWith[ParameterInformationLabelTuple]},
+})
+
+var unionArmsPrepareRenameResult = registerUnionArms("PrepareRenameResult", []unionArm{
+	{name: "*Range", kind: '{', required: []string{"start", "end"}, known: []string{"start", "end"}, decode: decodeArm[Range]},
+	{name: "*PrepareRenamePlaceholder", kind: '{', required: []string{"range", "placeholder"}, known: []string{"range", "placeholder"}, decode: decodeArm[PrepareRenamePlaceholder]},
+	{name: "*PrepareRenameDefaultBehavior", kind: '{', required: []string{"defaultBehavior"}, known: []string{"defaultBehavior"}, decode: decodeArm[PrepareRenameDefaultBehavior]},
+})
+
+var unionArmsProgressToken = registerUnionArms("ProgressToken", []unionArm{
+	{name: "Integer", kind: '0', decode: decodeScalarArm(dvScalarInt32)},
+	{name: "String", kind: '"', decode: decodeScalarArm(dvScalarString)},
+})
+
+var unionArmsReferencesProvider = registerUnionArms("ReferencesProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*ReferenceOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[ReferenceOptions]},
+})
+
+var unionArmsRelativePatternBaseURI = registerUnionArms("RelativePatternBaseURI", []unionArm{
+	{name: "*WorkspaceFolder", kind: '{', required: []string{"uri", "name"}, known: []string{"uri", "name"}, decode: decodeArm[WorkspaceFolder]},
+	{name: "URI", kind: '"', decode: decodeScalarArm(dvScalarURI)},
+})
+
+var unionArmsRenameProvider = registerUnionArms("RenameProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*RenameOptions", kind: '{', known: []string{"prepareProvider", "workDoneProgress"}, decode: decodeArm[RenameOptions]},
+})
+
+var unionArmsSelectionRangeProvider = registerUnionArms("SelectionRangeProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*SelectionRangeOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[SelectionRangeOptions]},
+	{name: "*SelectionRangeRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"workDoneProgress", "documentSelector", "id"}, decode: decodeArm[SelectionRangeRegistrationOptions]},
+})
+
+var unionArmsSemanticTokensDeltaResult = registerUnionArms("SemanticTokensDeltaResult", []unionArm{
+	{name: "*SemanticTokens", kind: '{', required: []string{"data"}, known: []string{"resultId", "data"}, decode: decodeArm[SemanticTokens]},
+	{name: "*SemanticTokensDelta", kind: '{', required: []string{"edits"}, known: []string{"resultId", "edits"}, decode: decodeArm[SemanticTokensDelta]},
+})
+
+var unionArmsSemanticTokensOptionsFull = registerUnionArms("SemanticTokensOptionsFull", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*SemanticTokensFullDelta", kind: '{', known: []string{"delta"}, decode: decodeArm[SemanticTokensFullDelta]},
+})
+
+var unionArmsSemanticTokensProvider = registerUnionArms("SemanticTokensProvider", []unionArm{
+	{name: "*SemanticTokensOptions", kind: '{', required: []string{"legend"}, known: []string{"legend", "range", "full", "workDoneProgress"}, decode: decodeArm[SemanticTokensOptions]},
+	{name: "*SemanticTokensRegistrationOptions", kind: '{', required: []string{"documentSelector", "legend"}, known: []string{"documentSelector", "legend", "range", "full", "workDoneProgress", "id"}, decode: decodeArm[SemanticTokensRegistrationOptions]},
+})
+
+var unionArmsTextDocumentContentChangeEvent = registerUnionArms("TextDocumentContentChangeEvent", []unionArm{
+	{name: "*TextDocumentContentChangePartial", kind: '{', required: []string{"range", "text"}, known: []string{"range", "rangeLength", "text"}, decode: decodeArm[TextDocumentContentChangePartial]},
+	{name: "*TextDocumentContentChangeWholeDocument", kind: '{', required: []string{"text"}, known: []string{"text"}, decode: decodeArm[TextDocumentContentChangeWholeDocument]},
+})
+
+var unionArmsTextDocumentEditElement = registerUnionArms("TextDocumentEditElement", []unionArm{
+	{name: "*TextEdit", kind: '{', required: []string{"range", "newText"}, known: []string{"range", "newText"}, decode: decodeArm[TextEdit]},
+	{name: "*AnnotatedTextEdit", kind: '{', required: []string{"annotationId", "range", "newText"}, known: []string{"annotationId", "range", "newText"}, decode: decodeArm[AnnotatedTextEdit]},
+	{name: "*SnippetTextEdit", kind: '{', required: []string{"range", "snippet"}, known: []string{"range", "snippet", "annotationId"}, decode: decodeArm[SnippetTextEdit]},
+})
+
+var unionArmsTextDocumentFilter = registerUnionArms("TextDocumentFilter", []unionArm{
+	{name: "*TextDocumentFilterLanguage", kind: '{', required: []string{"language"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterLanguage]},
+	{name: "*TextDocumentFilterScheme", kind: '{', required: []string{"scheme"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterScheme]},
+	{name: "*TextDocumentFilterPattern", kind: '{', required: []string{"pattern"}, known: []string{"language", "scheme", "pattern"}, decode: decodeArm[TextDocumentFilterPattern]},
+})
+
+var unionArmsTextDocumentSync = registerUnionArms("TextDocumentSync", []unionArm{
+	{name: "*TextDocumentSyncOptions", kind: '{', known: []string{"openClose", "change", "willSave", "willSaveWaitUntil", "save"}, decode: decodeArm[TextDocumentSyncOptions]},
+	{name: "TextDocumentSyncKind", kind: '0', decode: decodeArmWith[TextDocumentSyncKind]},
+})
+
+var unionArmsTextDocumentSyncOptionsSave = registerUnionArms("TextDocumentSyncOptionsSave", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*SaveOptions", kind: '{', known: []string{"includeText"}, decode: decodeArm[SaveOptions]},
+})
+
+var unionArmsTypeDefinitionProvider = registerUnionArms("TypeDefinitionProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*TypeDefinitionOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[TypeDefinitionOptions]},
+	{name: "*TypeDefinitionRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[TypeDefinitionRegistrationOptions]},
+})
+
+var unionArmsTypeHierarchyProvider = registerUnionArms("TypeHierarchyProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*TypeHierarchyOptions", kind: '{', known: []string{"workDoneProgress"}, decode: decodeArm[TypeHierarchyOptions]},
+	{name: "*TypeHierarchyRegistrationOptions", kind: '{', required: []string{"documentSelector"}, known: []string{"documentSelector", "workDoneProgress", "id"}, decode: decodeArm[TypeHierarchyRegistrationOptions]},
+})
+
+var unionArmsWorkspaceDocumentDiagnosticReport = registerUnionArms("WorkspaceDocumentDiagnosticReport", []unionArm{
+	{name: "*WorkspaceFullDocumentDiagnosticReport", kind: '{', kindConst: "full", required: []string{"uri", "version", "kind", "items"}, known: []string{"uri", "version", "kind", "resultId", "items"}, decode: decodeArm[WorkspaceFullDocumentDiagnosticReport]},
+	{name: "*WorkspaceUnchangedDocumentDiagnosticReport", kind: '{', kindConst: "unchanged", required: []string{"uri", "version", "kind", "resultId"}, known: []string{"uri", "version", "kind", "resultId"}, decode: decodeArm[WorkspaceUnchangedDocumentDiagnosticReport]},
+})
+
+var unionArmsWorkspaceOptionsTextDocumentContent = registerUnionArms("WorkspaceOptionsTextDocumentContent", []unionArm{
+	{name: "*TextDocumentContentOptions", kind: '{', required: []string{"schemes"}, known: []string{"schemes"}, decode: decodeArm[TextDocumentContentOptions]},
+	{name: "*TextDocumentContentRegistrationOptions", kind: '{', required: []string{"schemes"}, known: []string{"schemes", "id"}, decode: decodeArm[TextDocumentContentRegistrationOptions]},
+})
+
+var unionArmsWorkspaceSymbolLocation = registerUnionArms("WorkspaceSymbolLocation", []unionArm{
+	{name: "*Location", kind: '{', required: []string{"uri", "range"}, known: []string{"uri", "range"}, decode: decodeArm[Location]},
+	{name: "*LocationUriOnly", kind: '{', required: []string{"uri"}, known: []string{"uri"}, decode: decodeArm[LocationUriOnly]},
+})
+
+var unionArmsWorkspaceSymbolProvider = registerUnionArms("WorkspaceSymbolProvider", []unionArm{
+	{name: "Boolean", kind: 't', decode: decodeScalarArm(dvScalarBool)},
+	{name: "*WorkspaceSymbolOptions", kind: '{', known: []string{"resolveProvider", "workDoneProgress"}, decode: decodeArm[WorkspaceSymbolOptions]},
+})
+
+var unionArmsWorkspaceSymbolResult = registerUnionArms("WorkspaceSymbolResult", []unionArm{
+	{name: "SymbolInformationSlice", kind: '[', elem: true, required: []string{"location", "name", "kind"}, known: []string{"deprecated", "location", "name", "kind", "tags", "containerName"}, decode: decodeArm[SymbolInformationSlice]},
+	{name: "WorkspaceSymbolSlice", kind: '[', elem: true, required: []string{"location", "name", "kind"}, known: []string{"location", "data", "name", "kind", "tags", "containerName"}, decode: decodeArm[WorkspaceSymbolSlice]},
+})
@@ -0,0 +1,13 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Code generated by internal/genlsp from metaModel.json; DO NOT EDIT.
+
+//go:build lsp_proposed
+
+package protocol
+
+var unionArmsCommandOrCodeAction = registerUnionArms("CommandOrCodeAction", []unionArm{
+	{name: "*Command", kind: '{', required: []string{"title", "command"}, known: []string{"title", "tooltip", "command", "arguments"}, decode: decodeArm[Command]},
+	{name: "*CodeAction", kind: '{', required: []string{"title"}, known: []string{"title", "kind", "diagnostics", "isPreferred", "disabled", "edit", "command", "data", "tags"}, decode: decodeArm[CodeAction]},
+})
@@ -0,0 +1,221 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// UnionError reports a JSON value that no arm of a union type accepts.
+type UnionError struct {
+	// Union is the Go name of the union type, such as "InlayHintTooltip".
+	Union string
+
+	// Value is the rejected JSON value.
+	Value jsontext.Value
+
+	// Arms explains why each arm of the union rejected Value, in declaration
+	// order. It is filled in only by decoding under
+	// [UnmarshalOptions.DiagnoseUnions].
+	Arms []UnionArmError
+}
+
+// UnionArmError explains why one arm of a union rejected a value.
+type UnionArmError struct {
+	// Arm is the Go type of the arm, such as "*MarkupContent" or "String".
+	Arm string
+
+	// Kind is the JSON kind the arm decodes: '{', '[', '"', '0' or 't' (for
+	// both booleans).
+	Kind jsontext.Kind
+
+	// Discriminator is the "kind" member value that selects the arm, or "".
+	Discriminator string
+
+	// Required and Known are the keys the union dispatcher checks to choose
+	// the arm: those the arm requires and all those it knows. For an array
+	// arm they apply to the first element.
+	Required []string
+	Known    []string
+
+	// Missing lists the required keys absent from the value, and Unknown the
+	// keys of the value the arm does not know.
+	Missing []string
+	Unknown []string
+
+	// Err is the error decoding the value as the arm alone, or nil when the
+	// arm accepts it in isolation but the dispatcher did not pick it. A
+	// nested union mismatch is a diagnosed *UnionError.
+	Err error
+}
+
+// unionArms lists the arms of every union type, for diagnosing values no
+// arm accepts. The generated code fills it in with registerUnionArms, one
+// union per declaration, so the entries of proposed unions and arms stay
+// behind the lsp_proposed build tag.
+var unionArms = map[string][]unionArm{}
+
+// registerUnionArms records arms as the arms of union and returns them.
+func registerUnionArms(union string, arms []unionArm) []unionArm {
+	unionArms[union] = arms
+	return arms
+}
+
+// unionArm describes one arm of a generated union; see unionArms.
+type unionArm struct {
+	name      string
+	kind      jsontext.Kind
+	kindConst string
+	elem      bool // required and known apply to the first array element
+	required  []string
+	known     []string
+	decode    func(raw jsontext.Value) error
+}
+
+// decodeArm decodes raw as the byte-walked arm T alone.
+func decodeArm[T any, PT boxedArm[T]](raw jsontext.Value) error {
+	var v T
+	return PT(&v).unmarshalLSPValue(raw)
+}
+
+// decodeArmWith decodes raw as the arm T alone through the json package.
+func decodeArmWith[T any](raw jsontext.Value) error {
+	var v T
+	return decodeWith(raw, &v)
+}
+
+// decodeScalarArm adapts a scalar decoder to decode an arm alone.
+func decodeScalarArm[T any](decode func([]byte) (T, error)) func(jsontext.Value) error {
+	return func(raw jsontext.Value) error {
+		_, err := decode(raw)
+		return err
+	}
+}
+
+// newUnionError returns the error a union decoder reports for raw. It owns a
+// copy of raw, which may alias a borrowed input.
+func newUnionError(union string, raw jsontext.Value) error {
+	return &UnionError{Union: union, Value: raw.Clone()}
+}
+
+// Error implements error. A diagnosed error also lists each arm's reason.
+func (e *UnionError) Error() string {
+	msg := fmt.Sprintf("cannot unmarshal %s into %s", e.Value, e.Union)
+	if len(e.Arms) == 0 {
+		return msg
+	}
+	var b strings.Builder
+	b.WriteString(msg)
+	for i, arm := range e.Arms {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(arm.String())
+	}
+	return b.String()
+}
+
+// String formats a as "<arm>: <reason>".
+func (a UnionArmError) String() string {
+	var reasons []string
+	if len(a.Missing) > 0 {
+		reasons = append(reasons, "missing "+quoteKeys(a.Missing))
+	}
+	if len(a.Unknown) > 0 {
+		reasons = append(reasons, "unknown "+quoteKeys(a.Unknown))
+	}
+	if a.Err != nil {
+		reasons = append(reasons, a.Err.Error())
+	}
+	if len(reasons) == 0 {
+		reasons = append(reasons, "accepts the value")
+	}
+	return a.Arm + ": " + strings.Join(reasons, ", ")
+}
+
+// quoteKeys formats keys as a comma-separated list of quoted names.
+func quoteKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = fmt.Sprintf("%q", k)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// diagnoseUnions fills in the arms of the union mismatch err reports, if any.
+func diagnoseUnions(err error) {
+	var uerr *UnionError
+	if errors.As(err, &uerr) {
+		uerr.diagnose()
+	}
+}
+
+// diagnose replays e.Value against every arm of e.Union, diagnosing nested
+// union mismatches too.
+func (e *UnionError) diagnose() {
+	if e.Arms != nil {
+		return
+	}
+	arms := unionArms[e.Union]
+	e.Arms = make([]UnionArmError, 0, len(arms))
+	kind := e.Value.Kind()
+	if kind == 'f' {
+		kind = 't'
+	}
+	for _, arm := range arms {
+		d := UnionArmError{
+			Arm: arm.name, Kind: arm.kind, Discriminator: arm.kindConst,
+			Required: arm.required, Known: arm.known,
+		}
+		if kind != arm.kind {
+			d.Err = fmt.Errorf("expected %s, found %s", kindName(arm.kind), kindName(kind))
+			e.Arms = append(e.Arms, d)
+			continue
+		}
+		if arm.elem {
+			arrayFirst(e.Value, func(elem []byte) {
+				d.Missing, d.Unknown = keyDiff(elem, arm.required, arm.known)
+			})
+		} else if arm.kind == '{' {
+			d.Missing, d.Unknown = keyDiff(e.Value, arm.required, arm.known)
+		}
+		if got, _ := objectKind(e.Value); arm.kindConst != "" && got != arm.kindConst {
+			d.Err = fmt.Errorf(`member "kind" is %q, not %q`, got, arm.kindConst)
+		} else {
+			d.Err = arm.decode(e.Value)
+			diagnoseUnions(d.Err)
+		}
+		e.Arms = append(e.Arms, d)
+	}
+}
+
+// keyDiff returns the required keys absent from the object raw and its keys
+// outside known. An arm without known keys accepts any key.
+func keyDiff(raw []byte, required, known []string) (missing, unknown []string) {
+	present := map[string]bool{}
+	objectKeys(raw, func(key []byte) bool {
+		name, ok := unquoteJSONString([]byte(`"` + string(key) + `"`))
+		if !ok {
+			return true
+		}
+		present[name] = true
+		if len(known) > 0 && !slices.Contains(known, name) {
+			unknown = append(unknown, name)
+		}
+		return true
+	})
+	for _, r := range required {
+		if !present[r] {
+			missing = append(missing, r)
+		}
+	}
+	return missing, unknown
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestDiagnoseUnions(t *testing.T) {
+	t.Parallel()
+
+	validRange := `{"start":{"line":0,"character":0},"end":{"line":0,"character":0}}`
+	tests := map[string]struct {
+		input   string
+		decode  func([]byte, UnmarshalOptions) error
+		union   string
+		want    []UnionArmError
+		wantMsg string
+	}{
+		"error: no arm accepts the kind": {
+			input:  `true`,
+			decode: decodeAs[ProgressToken],
+			union:  "ProgressToken",
+			want: []UnionArmError{
+				{Arm: "Integer", Kind: '0', Err: errors.New("expected number, found boolean")},
+				{Arm: "String", Kind: '"', Err: errors.New("expected string, found boolean")},
+			},
+			wantMsg: "cannot unmarshal true into ProgressToken: Integer: expected number, found boolean; String: expected string, found boolean",
+		},
+		"error: keys checked and per-arm decode errors": {
+			input:  `{"label":"a","textEdit":{"newText":1,"range":` + validRange + `}}`,
+			decode: decodeAs[CompletionItem],
+			union:  "CompletionItemTextEdit",
+			want: []UnionArmError{
+				{
+					Arm: "*TextEdit", Kind: '{',
+					Required: []string{"range", "newText"}, Known: []string{"range", "newText"},
+					Err: errors.New("protocol: invalid JSON string at offset 11"),
+				},
+				{
+					Arm: "*InsertReplaceEdit", Kind: '{',
+					Required: []string{"newText", "insert", "replace"}, Known: []string{"newText", "insert", "replace"},
+					Missing: []string{"insert", "replace"}, Unknown: []string{"range"},
+					Err: errors.New("protocol: invalid JSON string at offset 11"),
+				},
+			},
+		},
+		"error: array arm checks the first element": {
+			input:  `[{"name":1,"kind":1,"data":{}},{}]`,
+			decode: decodeAs[WorkspaceSymbolResult],
+			union:  "WorkspaceSymbolResult",
+			want: []UnionArmError{
+				{
+					Arm: "SymbolInformationSlice", Kind: '[',
+					Required: []string{"location", "name", "kind"},
+					Known:    []string{"deprecated", "location", "name", "kind", "tags", "containerName"},
+					Missing:  []string{"location"}, Unknown: []string{"data"},
+					Err: errors.New("protocol: invalid JSON string at offset 9"),
+				},
+				{
+					Arm: "WorkspaceSymbolSlice", Kind: '[',
+					Required: []string{"location", "name", "kind"},
+					Known:    []string{"location", "data", "name", "kind", "tags", "containerName"},
+					Missing:  []string{"location"},
+					Err:      errors.New("protocol: invalid JSON string at offset 9"),
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.decode([]byte(tt.input), UnmarshalOptions{})
+			var plain *UnionError
+			if !errors.As(err, &plain) || plain.Union != tt.union || plain.Arms != nil {
+				t.Fatalf("Unmarshal() error = %v, want an undiagnosed *UnionError for %s", err, tt.union)
+			}
+
+			err = tt.decode([]byte(tt.input), UnmarshalOptions{DiagnoseUnions: true})
+			var uerr *UnionError
+			if !errors.As(err, &uerr) {
+				t.Fatalf("Unmarshal() error = %v, want a *UnionError", err)
+			}
+			if len(uerr.Arms) != len(unionArms[tt.union]) {
+				t.Fatalf("Arms = %v, want one per arm of %s", uerr.Arms, tt.union)
+			}
+			if tt.want != nil {
+				if diff := gocmp.Diff(tt.want, uerr.Arms, gocmp.Comparer(sameMessage)); diff != "" {
+					t.Fatalf("Arms mismatch (-want +got):\n%s", diff)
+				}
+			}
+			if tt.wantMsg != "" && err.Error() != tt.wantMsg {
+				t.Fatalf("Error() = %q, want %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func decodeAs[T any](data []byte, o UnmarshalOptions) error {
+	var v T
+	return o.Unmarshal(data, &v)
+}
+
+// sameMessage compares errors by message.
+func sameMessage(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Error() == b.Error()
+}
+
+func TestDiagnoseUnionsNested(t *testing.T) {
+	t.Parallel()
+
+	input := `{"isIncomplete":false,"items":[{"label":"a","textEdit":{"newText":1}}]}`
+	err := decodeAs[CompletionResult]([]byte(input), UnmarshalOptions{DiagnoseUnions: true})
+	var outer *UnionError
+	if !errors.As(err, &outer) || outer.Union != "CompletionResult" {
+		t.Fatalf("Unmarshal() error = %v, want a CompletionResult *UnionError", err)
+	}
+	var inner *UnionError
+	list := outer.Arms[len(outer.Arms)-1]
+	if list.Arm != "*CompletionList" || !errors.As(list.Err, &inner) {
+		t.Fatalf("%s arm error = %v, want a nested *UnionError", list.Arm, list.Err)
+	}
+	if inner.Union != "CompletionItemTextEdit" || len(inner.Arms) != 2 {
+		t.Fatalf("nested error = %v, want CompletionItemTextEdit diagnosed", inner)
+	}
+}
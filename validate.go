@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+// ErrUnknownMethod is reported when validating a payload for a method the
+// meta-model does not define.
+var ErrUnknownMethod = errors.New("not a method of the protocol")
+
+// methodShape is the meta-model shape of a method's params and result.
+type methodShape struct {
+	params       *shape // nil when the method takes no params
+	result       *shape // nil for notifications
+	notification bool
+}
+
+// lookupMethodShape returns the shapes of method, or an error wrapping
+// [ErrUnknownMethod].
+func lookupMethodShape(method string) (methodShape, error) {
+	m, ok := methodShapes[method]
+	if !ok {
+		return methodShape{}, fmt.Errorf("protocol: %q: %w", method, ErrUnknownMethod)
+	}
+	return m, nil
+}
+
+// ValidateParams checks the raw params of method against the type the
+// meta-model declares for them, and returns a [*ValidationError] listing
+// every violation. Absent params are valid only for methods that take none.
+// Members the meta-model does not declare, such as capabilities of newer
+// clients or vendor extensions, are allowed, as the protocol requires; use
+// [UnmarshalOptions.Strict] to reject them.
+func ValidateParams(method string, params []byte) error {
+	m, err := lookupMethodShape(method)
+	if err != nil {
+		return err
+	}
+	return validatePayload(params, m.params, "params")
+}
+
+// ValidateResult checks the raw result of the request method against the
+// type the meta-model declares for it, and returns a [*ValidationError]
+// listing every violation. An absent result is treated as null. As with
+// [ValidateParams], undeclared members are allowed.
+func ValidateResult(method string, result []byte) error {
+	m, err := lookupMethodShape(method)
+	if err != nil {
+		return err
+	}
+	if m.notification {
+		return fmt.Errorf("protocol: %q is a notification and has no result", method)
+	}
+	if len(bytes.TrimSpace(result)) == 0 {
+		result = []byte("null")
+	}
+	return validatePayload(result, m.result, "result")
+}
+
+// validatePayload checks data against s; a nil s accepts only an absent or
+// null payload, and an absent payload is a violation otherwise.
+func validatePayload(data []byte, s *shape, what string) error {
+	empty := len(bytes.TrimSpace(data)) == 0
+	var violations []Violation
+	switch {
+	case s == nil && empty:
+	case s == nil:
+		violations = validateShape(data, shapeOfNull, "", false)
+	case empty:
+		violations = []Violation{{Message: fmt.Sprintf("missing %s of %s", what, s)}}
+	default:
+		violations = validateShape(data, s, "", false)
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// ValidationHandler returns a [jsonrpc2.Handler] that checks the params of
+// every standard method against the meta-model with [ValidateParams] before
+// passing the request to handler. Requests whose params violate it are
+// rejected with an error wrapping [jsonrpc2.ErrInvalidParams] and the
+// [*ValidationError]; notifications are dropped in the same way, since they
+// cannot be answered. Non-standard methods pass through unchecked, and so do
+// members the meta-model does not declare.
+func ValidationHandler(handler jsonrpc2.Handler) jsonrpc2.Handler {
+	return func(ctx context.Context, req *jsonrpc2.Request) (any, error) {
+		if err := ValidateParams(req.Method(), req.Params()); err != nil && !errors.Is(err, ErrUnknownMethod) {
+			return nil, fmt.Errorf("%w: %w", jsonrpc2.ErrInvalidParams, err)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.lsp.dev/jsonrpc2"
+)
+
+func TestValidateParams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method  string
+		params  string
+		want    []Violation // nil when the params conform
+		wantErr error
+	}{
+		"success: request params": {
+			method: MethodTextDocumentHover,
+			params: `{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2}}`,
+		},
+		"success: no params": {
+			method: MethodShutdown,
+		},
+		"success: null for no params": {
+			method: MethodExit,
+			params: "null",
+		},
+		"success: unknown nested capability": {
+			method: MethodInitialize,
+			params: `{"processId":null,"rootUri":null,"capabilities":{"textDocument":{"hover":{"futureFlag":true}}}}`,
+		},
+		"success: vendor member": {
+			method: MethodTextDocumentHover,
+			params: `{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2},"vendor":{"x":1}}`,
+		},
+		"success: vendor member in a union arm": {
+			method: MethodTextDocumentDidChange,
+			params: `{"textDocument":{"uri":"file:///a.go","version":1},"contentChanges":[{"text":"x","vendor":1}]}`,
+		},
+		"error: all violations are reported": {
+			method: MethodTextDocumentHover,
+			params: `{"textDocument":{},"position":{"line":"1","character":2},"extra":0}`,
+			want: []Violation{
+				{Pointer: "/textDocument", Message: `missing required member "uri" of TextDocumentIdentifier`},
+				{Pointer: "/position/line", Message: "expected uinteger, found string"},
+			},
+		},
+		"error: wrong union arm": {
+			method: MethodTextDocumentDidChange,
+			params: `{"textDocument":{"uri":"file:///a.go","version":1},"contentChanges":[{"range":{"start":{"line":0,"character":0}},"text":"x"}]}`,
+			want: []Violation{
+				{Pointer: "/contentChanges/0", Message: "object matches no arm of TextDocumentContentChangeEvent"},
+			},
+		},
+		"error: missing params": {
+			method: MethodTextDocumentHover,
+			want:   []Violation{{Message: "missing params of HoverParams"}},
+		},
+		"error: unexpected params": {
+			method: MethodShutdown,
+			params: `{}`,
+			want:   []Violation{{Message: "expected null, found object"}},
+		},
+		"error: unknown method": {
+			method:  "custom/method",
+			params:  `{}`,
+			wantErr: ErrUnknownMethod,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateParams(tt.method, []byte(tt.params))
+			checkValidation(t, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateResult(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method  string
+		result  string
+		want    []Violation // nil when the result conforms
+		wantErr bool
+	}{
+		"success: null result": {
+			method: MethodTextDocumentHover,
+			result: "null",
+		},
+		"success: absent result is null": {
+			method: MethodShutdown,
+		},
+		"success: union result": {
+			method: MethodTextDocumentHover,
+			result: `{"contents":{"kind":"markdown","value":"x"}}`,
+		},
+		"error: union arm violation": {
+			method: MethodTextDocumentHover,
+			result: `{"contents":{"kind":"markdown"}}`,
+			want:   []Violation{{Pointer: "/contents", Message: `missing required member "value" of MarkupContent`}},
+		},
+		"error: notification has no result": {
+			method:  MethodExit,
+			result:  "null",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateResult(tt.method, []byte(tt.result))
+			if tt.wantErr {
+				var verr *ValidationError
+				if err == nil || errors.As(err, &verr) {
+					t.Fatalf("ValidateResult() error = %v, want a non-validation error", err)
+				}
+				return
+			}
+			checkValidation(t, err, tt.want, nil)
+		})
+	}
+}
+
+func checkValidation(t *testing.T, err error, want []Violation, wantErr error) {
+	t.Helper()
+
+	switch {
+	case wantErr != nil:
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("error = %v, want wrapping %v", err, wantErr)
+		}
+	case want == nil:
+		if err != nil {
+			t.Fatalf("error = %v, want nil", err)
+		}
+	default:
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("error = %v, want a *ValidationError", err)
+		}
+		if !slices.Equal(verr.Violations, want) {
+			t.Fatalf("Violations = %v, want %v", verr.Violations, want)
+		}
+	}
+}
+
+func TestValidateCorpus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func([]byte) error{
+		"completion_result.json":            validateWith(ValidateResult, MethodTextDocumentCompletion),
+		"completion_result_array.json":      validateWith(ValidateResult, MethodTextDocumentCompletion),
+		"didchange.json":                    validateWith(ValidateParams, MethodTextDocumentDidChange),
+		"initialize_request.json":           validateWith(ValidateParams, MethodInitialize),
+		"initialize_result.json":            validateWith(ValidateResult, MethodInitialize),
+		"publish_diagnostics.json":          validateWith(ValidateParams, MethodTextDocumentPublishDiagnostics),
+		"semantic_tokens.json":              validateWith(ValidateResult, MethodTextDocumentSemanticTokensFull),
+		"workspace_symbol_result.json":      validateWith(ValidateResult, MethodWorkspaceSymbol),
+		"workspace_symbol_result_info.json": validateWith(ValidateResult, MethodWorkspaceSymbol),
+	}
+	for name, validate := range tests {
+		t.Run("success: "+name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join("testdata", "corpus", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(data); err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+		})
+	}
+}
+
+func validateWith(validate func(string, []byte) error, method string) func([]byte) error {
+	return func(data []byte) error { return validate(method, data) }
+}
+
+func TestValidationHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	reached := make(chan string, 4)
+	handler := func(_ context.Context, req *jsonrpc2.Request) (any, error) {
+		reached <- strings.Clone(req.Method())
+		return nil, nil
+	}
+	callerEnd, servedEnd := net.Pipe()
+	caller := jsonrpc2.NewConn(jsonrpc2.NewStream(callerEnd), jsonrpc2.WithCodec(lspCodec{}))
+	served := jsonrpc2.NewConn(jsonrpc2.NewStream(servedEnd), jsonrpc2.WithCodec(lspCodec{}))
+	caller.Go(ctx, jsonrpc2.MethodNotFoundHandler)
+	served.Go(ctx, ValidationHandler(handler))
+	defer closeJSONRPCConns(t, caller, served)
+
+	_, err := caller.Call(ctx, MethodTextDocumentHover, jsonrpc2.RawMessage(`{"textDocument":{"uri":1}}`), nil)
+	if !errors.Is(err, jsonrpc2.ErrInvalidParams) {
+		t.Fatalf("Call invalid params error = %v, want wrapping %v", err, jsonrpc2.ErrInvalidParams)
+	}
+
+	for _, method := range []string{MethodTextDocumentHover, "custom/method"} {
+		params := jsonrpc2.RawMessage(`{"textDocument":{"uri":"file:///a.go"},"position":{"line":0,"character":0}}`)
+		if _, err := caller.Call(ctx, method, params, nil); err != nil {
+			t.Fatalf("Call(%s): %v", method, err)
+		}
+	}
+	for _, want := range []string{MethodTextDocumentHover, "custom/method"} {
+		if got := <-reached; got != want {
+			t.Fatalf("handler reached by %q, want %q", got, want)
+		}
+	}
+}
+
+// initializeServer answers initialize with empty capabilities.
+type initializeServer struct {
+	UnimplementedServer
+}
+
+func (initializeServer) Initialize(context.Context, *InitializeParams) (*InitializeResult, error) {
+	return &InitializeResult{}, nil
+}
+
+func TestWithValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	a, b := net.Pipe()
+	_, serverConn, _ := NewServer(ctx, initializeServer{}, NewStream(a), WithValidation())
+	_, clientConn, _ := NewClient(ctx, &UnimplementedClient{}, NewStream(b))
+	defer closeJSONRPCConns(t, clientConn, serverConn)
+
+	// A newer client may send capabilities the meta-model does not know.
+	params := jsonrpc2.RawMessage(`{"processId":null,"rootUri":null,"capabilities":{"textDocument":{"hover":{"futureFlag":true}}}}`)
+	if _, err := clientConn.Call(ctx, MethodInitialize, params, nil); err != nil {
+		t.Fatalf("Call(initialize) with an unknown capability: %v", err)
+	}
+
+	_, err := clientConn.Call(ctx, MethodInitialize, jsonrpc2.RawMessage(`{"processId":"1","rootUri":null,"capabilities":{}}`), nil)
+	if !errors.Is(err, jsonrpc2.ErrInvalidParams) {
+		t.Fatalf("Call(initialize) invalid params error = %v, want wrapping %v", err, jsonrpc2.ErrInvalidParams)
+	}
+}
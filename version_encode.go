@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+// VersionPolicy selects how [MarshalVersion], and a connection configured with
+// [WithVersion], treat a value carrying fields introduced after the target
+// protocol version.
+type VersionPolicy int
+
+const (
+	// VersionStrip drops fields introduced after the target version from the
+	// encoded value.
+	VersionStrip VersionPolicy = iota
+
+	// VersionReject fails the encode with an error wrapping
+	// [ErrVersionUnsupported].
+	VersionReject
+)
+
+// ErrVersionUnsupported is reported when a field or method is not part of the
+// protocol version a value is encoded for.
+var ErrVersionUnsupported = errors.New("not available in the target protocol version")
+
+// MarshalVersion encodes v as [Marshal] does for a peer speaking protocol
+// version. Set fields introduced after version (see [FieldSince]) are dropped
+// under [VersionStrip] and reported under [VersionReject]; a value that uses
+// no newer field encodes exactly as Marshal would.
+func MarshalVersion(v any, version string, policy VersionPolicy) ([]byte, error) {
+	var w versionWalker
+	w.version = version
+	w.walk(reflect.ValueOf(v), "")
+
+	if len(w.newer) > 0 && policy == VersionReject {
+		f := w.newer[0]
+		return nil, fmt.Errorf("%w: %s (since %s) in LSP %s", ErrVersionUnsupported, f.pointer, f.since, version)
+	}
+
+	data, err := Marshal(v)
+	if err != nil || len(w.newer) == 0 {
+		return data, err
+	}
+
+	drop := make(map[jsontext.Pointer]bool, len(w.newer))
+	for _, f := range w.newer {
+		drop[jsontext.Pointer(f.pointer)] = true
+	}
+
+	return dropMembers(data, drop)
+}
+
+// newerField is a set field introduced after the walker's target version.
+type newerField struct {
+	pointer string // JSON Pointer of the member in the encoded value
+	since   string
+}
+
+// versionWalker finds the set fields of a value that a peer speaking version
+// does not know. It mirrors the encoder's shape: pointers, interfaces (union
+// arms) and Optional/Nullable wrappers are transparent, embedded structs are
+// inlined, and omitzero fields holding their zero value are not emitted.
+type versionWalker struct {
+	version string
+	newer   []newerField
+}
+
+func (w *versionWalker) walk(v reflect.Value, ptr string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), ptr)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return // raw JSON (LSPAny) and byte strings carry no typed fields
+		}
+		for i := range v.Len() {
+			w.walk(v.Index(i), ptr+"/"+strconv.Itoa(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), ptr+"/"+escapePointerToken(fmt.Sprint(iter.Key())))
+		}
+	case reflect.Struct:
+		w.walkStruct(v, ptr)
+	default:
+	}
+}
+
+func (w *versionWalker) walkStruct(v reflect.Value, ptr string) {
+	t := v.Type()
+	if isOptionalWrapper(t) {
+		if value := v.FieldByName("value"); value.IsValid() {
+			w.walk(value, ptr)
+		}
+		return
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		if f.Anonymous {
+			w.walk(fv, ptr)
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.Contains(opts, "omitzero") && fv.IsZero() {
+			continue
+		}
+		member := ptr + "/" + escapePointerToken(name)
+		if since := FieldSince(t.Name(), name); !VersionAvailable(since, w.version) {
+			w.newer = append(w.newer, newerField{pointer: member, since: since})
+			continue
+		}
+		w.walk(fv, member)
+	}
+}
+
+// isOptionalWrapper reports whether t is an Optional or Nullable
+// instantiation, whose wire form is that of its wrapped value.
+func isOptionalWrapper(t reflect.Type) bool {
+	if t.PkgPath() != reflect.TypeFor[Position]().PkgPath() {
+		return false
+	}
+	name := t.Name()
+	return strings.HasPrefix(name, "Optional[") || strings.HasPrefix(name, "Nullable[")
+}
+
+// escapePointerToken escapes a JSON Pointer reference token (RFC 6901).
+func escapePointerToken(s string) string {
+	if !strings.ContainsAny(s, "~/") {
+		return s
+	}
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+// dropMembers re-encodes data without the object members at the pointers in
+// drop.
+func dropMembers(data []byte, drop map[jsontext.Pointer]bool) ([]byte, error) {
+	dec := jsontext.NewDecoder(bytes.NewReader(data), wireOptions)
+	var out bytes.Buffer
+	out.Grow(len(data))
+	enc := jsontext.NewEncoder(&out, wireOptions)
+	for {
+		tok, err := dec.ReadToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if kind, n := dec.StackIndex(dec.StackDepth()); kind == '{' && n%2 == 1 && drop[dec.StackPointer()] {
+			// tok is the name of a dropped member; skip its value too.
+			if err := dec.SkipValue(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := enc.WriteToken(tok); err != nil {
+			return nil, err
+		}
+	}
+
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMarshalVersion(t *testing.T) {
+	t.Parallel()
+
+	item := &CompletionItem{
+		Label:        "fmt",
+		LabelDetails: &CompletionItemLabelDetails{Detail: new("(pkg)")},
+		TextEditText: NewOptional("fmt"),
+	}
+	list := &CompletionList{Items: []CompletionItem{*item}}
+
+	tests := map[string]struct {
+		v       any
+		version string
+		policy  VersionPolicy
+		want    string
+		wantErr bool
+	}{
+		"success: current version encodes everything": {
+			v: item, version: Version318, policy: VersionReject,
+			want: `{"label":"fmt","labelDetails":{"detail":"(pkg)"},"textEditText":"fmt"}`,
+		},
+		"success: strip drops newer fields": {
+			v: item, version: Version316, policy: VersionStrip,
+			want: `{"label":"fmt"}`,
+		},
+		"success: strip drops newer fields of nested values": {
+			v: list, version: Version316, policy: VersionStrip,
+			want: `{"isIncomplete":false,"items":[{"label":"fmt"}]}`,
+		},
+		"success: unset newer fields are not reported": {
+			v: &CompletionItem{Label: "fmt"}, version: Version316, policy: VersionReject,
+			want: `{"label":"fmt"}`,
+		},
+		"error: reject reports the first newer field": {
+			v: list, version: Version316, policy: VersionReject,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MarshalVersion(tt.v, tt.version, tt.policy)
+			if tt.wantErr {
+				if !errors.Is(err, ErrVersionUnsupported) {
+					t.Fatalf("MarshalVersion() error = %v, want ErrVersionUnsupported", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MarshalVersion(): %v", err)
+			}
+			if g, w := canon(t, got), canon(t, []byte(tt.want)); g != w {
+				t.Fatalf("MarshalVersion() = %s, want %s", g, w)
+			}
+		})
+	}
+}
+
+func TestVersionConnRejectsNewerMethods(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeConn{}
+	conn := &versionConn{Conn: fake, version: Version316}
+
+	if err := conn.Notify(context.Background(), MethodTextDocumentDidOpen, &DidOpenTextDocumentParams{}); err != nil {
+		t.Fatalf("Notify(didOpen): %v", err)
+	}
+	if _, err := conn.Call(context.Background(), MethodWorkspaceInlayHintRefresh, nil, nil); !errors.Is(err, ErrVersionUnsupported) {
+		t.Fatalf("Call(inlayHint/refresh) error = %v, want ErrVersionUnsupported", err)
+	}
+	if fake.notifyCnt != 1 || fake.callCount != 0 {
+		t.Fatalf("forwarded %d notifications and %d calls, want 1 and 0", fake.notifyCnt, fake.callCount)
+	}
+}
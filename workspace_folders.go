@@ -0,0 +1,187 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.lsp.dev/uri"
+)
+
+// WorkspaceFolders tracks the workspace folders of a multi-root server. It
+// merges the folders sent at initialization (or the deprecated root URI or
+// path of older clients), workspace/didChangeWorkspaceFolders notifications
+// and workspace/workspaceFolders responses into one set of folders, and
+// reports each change to the functions registered with
+// [WorkspaceFolders.OnChange].
+//
+// Folders are identified by scheme, authority and path, ignoring a trailing
+// slash, so the same folder sent in two spellings is kept once. The zero
+// value is an empty set ready to use.
+type WorkspaceFolders struct {
+	mu       sync.RWMutex
+	folders  []workspaceFolder
+	onChange []func(WorkspaceFoldersChangeEvent)
+}
+
+// workspaceFolder is a folder with its identifying components.
+type workspaceFolder struct {
+	WorkspaceFolder
+	key uri.Components
+}
+
+func newWorkspaceFolder(f WorkspaceFolder) workspaceFolder {
+	key := f.URI.Components()
+	key.Scheme = strings.ToLower(key.Scheme)
+	key.Authority = strings.ToLower(key.Authority)
+	key.Path = strings.TrimSuffix(key.Path, "/")
+	key.Query, key.Fragment = "", ""
+	if f.Name == "" {
+		f.Name = uri.Basename(f.URI)
+	}
+	return workspaceFolder{WorkspaceFolder: f, key: key}
+}
+
+// contains reports whether the folder holds the resource with components c.
+func (f *workspaceFolder) contains(c uri.Components) bool {
+	if !strings.EqualFold(c.Scheme, f.key.Scheme) || !strings.EqualFold(c.Authority, f.key.Authority) {
+		return false
+	}
+	return c.Path == f.key.Path || strings.HasPrefix(c.Path, f.key.Path+"/")
+}
+
+// OnChange registers fn to be called with the folders added and removed by
+// each change to the set. Calls happen after the change, in registration
+// order, on the goroutine that made it.
+func (w *WorkspaceFolders) OnChange(fn func(event WorkspaceFoldersChangeEvent)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onChange = append(w.onChange, fn)
+}
+
+// Initialize sets the folders from params: its WorkspaceFolders when the
+// client sent any, otherwise the folder at RootURI or, failing that,
+// RootPath. A folder without a name is named after the last element of its
+// path.
+func (w *WorkspaceFolders) Initialize(params *InitializeParams) {
+	var folders []WorkspaceFolder
+	if fs, ok := params.WorkspaceFolders.Get(); ok && len(fs) > 0 {
+		folders = fs
+	} else if params.RootURI != nil && *params.RootURI != "" {
+		folders = []WorkspaceFolder{{URI: *params.RootURI}}
+	} else if path, ok := params.RootPath.Get(); ok && path != "" {
+		folders = []WorkspaceFolder{{URI: uri.File(path)}}
+	}
+	w.Set(folders)
+}
+
+// DidChange applies a workspace/didChangeWorkspaceFolders notification.
+// Removing an unknown folder or adding a known one changes nothing.
+func (w *WorkspaceFolders) DidChange(params *DidChangeWorkspaceFoldersParams) {
+	w.update(func(folders []workspaceFolder) []workspaceFolder {
+		for _, f := range params.Event.Removed {
+			key := newWorkspaceFolder(f).key
+			folders = slices.DeleteFunc(folders, func(g workspaceFolder) bool { return g.key == key })
+		}
+		for _, f := range params.Event.Added {
+			folders = appendWorkspaceFolder(folders, newWorkspaceFolder(f))
+		}
+		return folders
+	})
+}
+
+// Set replaces the folders with folders, such as a workspace/workspaceFolders
+// response.
+func (w *WorkspaceFolders) Set(folders []WorkspaceFolder) {
+	w.update(func([]workspaceFolder) []workspaceFolder {
+		var next []workspaceFolder
+		for _, f := range folders {
+			next = appendWorkspaceFolder(next, newWorkspaceFolder(f))
+		}
+		return next
+	})
+}
+
+// Sync replaces the folders with those client reports in response to a
+// workspace/workspaceFolders request.
+func (w *WorkspaceFolders) Sync(ctx context.Context, client Client) error {
+	folders, err := client.WorkspaceFolders(ctx)
+	if err != nil {
+		return fmt.Errorf("protocol: workspace folders: %w", err)
+	}
+	w.Set(folders)
+	return nil
+}
+
+// appendWorkspaceFolder appends f to folders unless they hold it already.
+func appendWorkspaceFolder(folders []workspaceFolder, f workspaceFolder) []workspaceFolder {
+	if slices.ContainsFunc(folders, func(g workspaceFolder) bool { return g.key == f.key }) {
+		return folders
+	}
+	return append(folders, f)
+}
+
+// update replaces the folders with the result of fn, which may modify the
+// slice it is passed, and reports the difference to the change functions.
+func (w *WorkspaceFolders) update(fn func(folders []workspaceFolder) []workspaceFolder) {
+	w.mu.Lock()
+	old := w.folders
+	next := fn(slices.Clone(old))
+	w.folders = next
+	onChange := w.onChange
+	w.mu.Unlock()
+
+	var event WorkspaceFoldersChangeEvent
+	for _, f := range next {
+		if !slices.ContainsFunc(old, func(g workspaceFolder) bool { return g.key == f.key }) {
+			event.Added = append(event.Added, f.WorkspaceFolder)
+		}
+	}
+	for _, f := range old {
+		if !slices.ContainsFunc(next, func(g workspaceFolder) bool { return g.key == f.key }) {
+			event.Removed = append(event.Removed, f.WorkspaceFolder)
+		}
+	}
+	if len(event.Added) == 0 && len(event.Removed) == 0 {
+		return
+	}
+	for _, fn := range onChange {
+		fn(event)
+	}
+}
+
+// Folders returns the current folders in the order they were added.
+func (w *WorkspaceFolders) Folders() []WorkspaceFolder {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	folders := make([]WorkspaceFolder, len(w.folders))
+	for i := range w.folders {
+		folders[i] = w.folders[i].WorkspaceFolder
+	}
+	return folders
+}
+
+// Owner returns the folder containing the document u: the folder with the
+// longest path that is u's path or a parent of it, for the same scheme and
+// authority. With nested folders the innermost one owns the document.
+func (w *WorkspaceFolders) Owner(u uri.URI) (WorkspaceFolder, bool) {
+	c := u.Components()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var owner *workspaceFolder
+	for i := range w.folders {
+		f := &w.folders[i]
+		if f.contains(c) && (owner == nil || len(f.key.Path) > len(owner.key.Path)) {
+			owner = f
+		}
+	}
+	if owner == nil {
+		return WorkspaceFolder{}, false
+	}
+	return owner.WorkspaceFolder, true
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Go Language Server Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.lsp.dev/uri"
+)
+
+func TestWorkspaceFoldersInitialize(t *testing.T) {
+	t.Parallel()
+
+	root := uri.URI("file:///src/root")
+	tests := map[string]struct {
+		params *InitializeParams
+		want   []WorkspaceFolder
+	}{
+		"success: workspace folders win": {
+			params: &InitializeParams{
+				WorkspaceFoldersInitializeParams: WorkspaceFoldersInitializeParams{WorkspaceFolders: NewNullable([]WorkspaceFolder{
+					{URI: "file:///src/a", Name: "A"},
+					{URI: "file:///src/b/"},
+					{URI: "file:///src/a/"},
+				})},
+				RootURI: &root,
+			},
+			want: []WorkspaceFolder{{URI: "file:///src/a", Name: "A"}, {URI: "file:///src/b/", Name: "b"}},
+		},
+		"success: null folders fall back to root uri": {
+			params: &InitializeParams{
+				WorkspaceFoldersInitializeParams: WorkspaceFoldersInitializeParams{WorkspaceFolders: NullNullable[[]WorkspaceFolder]()},
+				RootURI:                          &root,
+				RootPath:                         NewNullable("/ignored"),
+			},
+			want: []WorkspaceFolder{{URI: root, Name: "root"}},
+		},
+		"success: root path": {
+			params: &InitializeParams{RootPath: NewNullable("/src/legacy")},
+			want:   []WorkspaceFolder{{URI: "file:///src/legacy", Name: "legacy"}},
+		},
+		"success: no folder": {
+			params: &InitializeParams{},
+			want:   []WorkspaceFolder{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w WorkspaceFolders
+			w.Initialize(tt.params)
+			if diff := gocmp.Diff(tt.want, w.Folders()); diff != "" {
+				t.Errorf("Folders() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkspaceFoldersChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		w      WorkspaceFolders
+		events []WorkspaceFoldersChangeEvent
+	)
+	w.OnChange(func(event WorkspaceFoldersChangeEvent) { events = append(events, event) })
+
+	w.Set([]WorkspaceFolder{{URI: "file:///src/a", Name: "a"}, {URI: "file:///src/b", Name: "b"}})
+	w.DidChange(&DidChangeWorkspaceFoldersParams{Event: WorkspaceFoldersChangeEvent{
+		Added:   []WorkspaceFolder{{URI: "file:///src/c", Name: "c"}, {URI: "file:///src/a/", Name: "a again"}},
+		Removed: []WorkspaceFolder{{URI: "file:///src/b/", Name: "b"}, {URI: "file:///src/x", Name: "x"}},
+	}})
+	// Re-adding a known folder is not a change.
+	w.DidChange(&DidChangeWorkspaceFoldersParams{Event: WorkspaceFoldersChangeEvent{
+		Added: []WorkspaceFolder{{URI: "file:///src/c/", Name: "c"}},
+	}})
+
+	client := &workspaceFoldersClient{folders: []WorkspaceFolder{{URI: "file:///src/c", Name: "c"}, {URI: "file:///src/d", Name: "d"}}}
+	if err := w.Sync(t.Context(), client); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	want := []WorkspaceFoldersChangeEvent{
+		{Added: []WorkspaceFolder{{URI: "file:///src/a", Name: "a"}, {URI: "file:///src/b", Name: "b"}}},
+		{Added: []WorkspaceFolder{{URI: "file:///src/c", Name: "c"}}, Removed: []WorkspaceFolder{{URI: "file:///src/b", Name: "b"}}},
+		{Added: []WorkspaceFolder{{URI: "file:///src/d", Name: "d"}}, Removed: []WorkspaceFolder{{URI: "file:///src/a", Name: "a"}}},
+	}
+	if diff := gocmp.Diff(want, events); diff != "" {
+		t.Errorf("change events mismatch (-want +got):\n%s", diff)
+	}
+	if diff := gocmp.Diff(client.folders, w.Folders()); diff != "" {
+		t.Errorf("Folders() mismatch (-want +got):\n%s", diff)
+	}
+
+	errFailed := errors.New("failed")
+	if err := w.Sync(t.Context(), &workspaceFoldersClient{err: errFailed}); !errors.Is(err, errFailed) {
+		t.Errorf("Sync() error = %v, want %v", err, errFailed)
+	}
+	if got := len(w.Folders()); got != 2 {
+		t.Errorf("failed Sync() left %d folders, want 2", got)
+	}
+}
+
+// workspaceFoldersClient answers workspace/workspaceFolders.
+type workspaceFoldersClient struct {
+	UnimplementedClient
+	folders []WorkspaceFolder
+	err     error
+}
+
+func (c *workspaceFoldersClient) WorkspaceFolders(context.Context) ([]WorkspaceFolder, error) {
+	return c.folders, c.err
+}
+
+func TestWorkspaceFoldersOwner(t *testing.T) {
+	t.Parallel()
+
+	var w WorkspaceFolders
+	w.Set([]WorkspaceFolder{
+		{URI: "file:///src/mono", Name: "mono"},
+		{URI: "file:///src/mono/services/api/", Name: "api"},
+		{URI: "file:///", Name: "root"},
+		{URI: "vscode-vfs://github/org/repo", Name: "remote"},
+	})
+
+	tests := map[string]struct {
+		doc  uri.URI
+		want string
+	}{
+		"success: innermost folder":        {doc: "file:///src/mono/services/api/main.go", want: "api"},
+		"success: outer folder":            {doc: "file:///src/mono/services/web/main.go", want: "mono"},
+		"success: folder itself":           {doc: "file:///src/mono", want: "mono"},
+		"success: prefix is not a parent":  {doc: "file:///src/monorepo/x.go", want: "root"},
+		"success: other scheme":            {doc: "vscode-vfs://github/org/repo/a.go", want: "remote"},
+		"success: percent encoded segment": {doc: "file:///src/mono/services/api/a%20b.go", want: "api"},
+		"error: other authority":           {doc: "vscode-vfs://gitlab/org/repo/a.go"},
+		"error: scheme with no folder":     {doc: "untitled:Untitled-1"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := w.Owner(tt.doc)
+			if got.Name != tt.want || ok != (tt.want != "") {
+				t.Errorf("Owner(%s) = %q, %t, want %q", tt.doc, got.Name, ok, tt.want)
+			}
+		})
+	}
+}